@@ -28,5 +28,94 @@ const (
 	// UnableToFindFloatingIPNetworkReason is used when the floating ip network is not found.
 	UnableToFindNetwork = "UnableToFindNetwork"
 
+	// ReservedIPNotFoundReason is used when a floating IP reserved for a claim does not exist.
+	ReservedIPNotFoundReason = "ReservedIPNotFound"
+
+	// UnableToFindSubnetReason is used when the subnet of the floating ip network is not found.
+	UnableToFindSubnetReason = "UnableToFindSubnet"
+
+	// UnableToFindQoSPolicyReason is used when the QoS policy of the floating ips is not found.
+	UnableToFindQoSPolicyReason = "UnableToFindQoSPolicy"
+
+	// InvalidTemplateReason is used when a template of the pool can't be parsed or executed.
+	InvalidTemplateReason = "InvalidTemplate"
+
+	// UtilizationBelowThresholdCondition reports whether the share of MaxIPs which is claimed is below the
+	// UtilizationThreshold of the floating ip pool.
+	UtilizationBelowThresholdCondition = "UtilizationBelowThreshold"
+
+	// UtilizationThresholdExceededReason is used when the claimed floating ips reach the UtilizationThreshold.
+	UtilizationThresholdExceededReason = "UtilizationThresholdExceeded"
+
 	CreateServerError ServerStatusError = "CreateError"
+
+	// InstanceResizedCondition reports on the in-place resize of the server instance of an OpenStackServer.
+	InstanceResizedCondition = "InstanceResized"
+
+	// InstanceResizingReason is used while the server instance is being resized.
+	InstanceResizingReason = "Resizing"
+
+	// InstanceResizeFailedReason is used when the server instance could not be resized.
+	InstanceResizeFailedReason = "ResizeFailed"
+
+	// InstanceResizeRevertedReason is used when the resize of the server instance was reverted.
+	InstanceResizeRevertedReason = "ResizeReverted"
+
+	// InstanceRebuiltCondition reports on the rebuild of the server instance of an OpenStackServer.
+	InstanceRebuiltCondition = "InstanceRebuilt"
+
+	// InstanceRebuildingReason is used while the server instance is being rebuilt.
+	InstanceRebuildingReason = "Rebuilding"
+
+	// InstanceRebuildFailedReason is used when the server instance could not be rebuilt.
+	InstanceRebuildFailedReason = "RebuildFailed"
+
+	// InstanceRemediatedCondition reports on the recovery of the server instance of an OpenStackServer.
+	InstanceRemediatedCondition = "InstanceRemediated"
+
+	// InstanceRemediatingReason is used after a remediation action was taken on the server instance.
+	InstanceRemediatingReason = "Remediating"
+
+	// InstanceRemediationFailedReason is used when a remediation action failed or the server instance can't be recovered.
+	InstanceRemediationFailedReason = "RemediationFailed"
+
+	// InstanceAvailabilityZoneFallbackReason is used when no valid host was found for the server instance
+	// and it is being created again in a fallback availability zone.
+	InstanceAvailabilityZoneFallbackReason = "AvailabilityZoneFallback"
+
+	// ServerGroupReadyCondition reports on the server group of an OpenStackServerGroup.
+	ServerGroupReadyCondition = "ServerGroupReady"
+
+	// ServerGroupCreateFailedReason is used when the server group could not be created.
+	ServerGroupCreateFailedReason = "ServerGroupCreateFailed"
+
+	// ServerGroupInUseReason is used when the deletion of the server group waits for the OpenStackServers referencing it.
+	ServerGroupInUseReason = "ServerGroupInUse"
+
+	// ImageReadyCondition reports on the Glance image of an OpenStackImage.
+	ImageReadyCondition = "ImageReady"
+
+	// ImageImportingReason is used while the data of the image is transferred to Glance.
+	ImageImportingReason = "Importing"
+
+	// ImageImportFailedReason is used when the image could not be created or its data could not be transferred.
+	ImageImportFailedReason = "ImageImportFailed"
+
+	// ImageChecksumMismatchReason is used when the checksum of the image data does not match the expected checksum.
+	ImageChecksumMismatchReason = "ChecksumMismatch"
+
+	// ImageInUseReason is used when the deletion of the image waits for the OpenStackServers referencing it.
+	ImageInUseReason = "ImageInUse"
+
+	// VolumeSnapshotsReadyCondition reports on the snapshots taken by an OpenStackVolumeSnapshotSchedule.
+	VolumeSnapshotsReadyCondition = "VolumeSnapshotsReady"
+
+	// ServerNotFoundReason is used when the OpenStackServer referenced by a schedule is not found.
+	ServerNotFoundReason = "ServerNotFound"
+
+	// VolumeSnapshotFailedReason is used when taking or deleting a snapshot fails.
+	VolumeSnapshotFailedReason = "VolumeSnapshotFailed"
+
+	// VolumeSnapshotErrorReason is used when a snapshot is in error state.
+	VolumeSnapshotErrorReason = "VolumeSnapshotError"
 )
@@ -20,9 +20,11 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
 )
 
 const (
@@ -34,6 +36,15 @@ const (
 
 	// OpenStackFloatingIPPoolIP.
 	DeleteFloatingIPFinalizer = "openstackfloatingippool.infrastructure.cluster.x-k8s.io/delete-floating-ip"
+
+	// FloatingIPAnnotation pins the floating IP allocated to an IPAddressClaim. It is also set on the
+	// IPAddress of the claim. The floating IP is never tagged with the pool, and it is dropped from the
+	// pool instead of being deleted or reused when the claim is released.
+	FloatingIPAnnotation = "openstackfloatingippool.infrastructure.cluster.x-k8s.io/floating-ip"
+
+	// FloatingIPClaimTagPrefix prefixes the UID of the IPAddressClaim in the tag of a floating IP which is being
+	// allocated to the claim.
+	FloatingIPClaimTagPrefix = "cluster-api-provider-openstack-fip-claim-"
 )
 
 // ReclaimPolicy is a string type alias to represent reclaim policies for floating ips.
@@ -47,6 +58,7 @@ const (
 )
 
 // OpenStackFloatingIPPoolSpec defines the desired state of OpenStackFloatingIPPool.
+// +kubebuilder:validation:XValidation:rule="!has(self.utilizationThreshold) || has(self.maxIPs)",message="utilizationThreshold requires maxIPs"
 type OpenStackFloatingIPPoolSpec struct {
 	// PreAllocatedFloatingIPs is a list of floating IPs precreated in OpenStack that should be used by this pool.
 	// These are used before allocating new ones and are not deleted from OpenStack when the pool is deleted.
@@ -57,6 +69,15 @@ type OpenStackFloatingIPPoolSpec struct {
 	// +optional
 	MaxIPs *int `json:"maxIPs,omitempty"`
 
+	// UtilizationThreshold is the percentage of MaxIPs which may be claimed
+	// before the pool warns that it is running out of floating IPs. The
+	// UtilizationBelowThreshold condition is set to false and an event is
+	// emitted when it is reached.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +optional
+	UtilizationThreshold *int32 `json:"utilizationThreshold,omitempty"`
+
 	// IdentityRef is a reference to a identity to be used when reconciling this pool.
 	// +kubebuilder:validation:Required
 	IdentityRef infrav1.OpenStackIdentityReference `json:"identityRef"`
@@ -68,6 +89,136 @@ type OpenStackFloatingIPPoolSpec struct {
 	// The stratergy to use for reclaiming floating ips when they are released from a machine
 	// +kubebuilder:validation:Enum=Retain;Delete
 	ReclaimPolicy ReclaimPolicy `json:"reclaimPolicy"`
+
+	// FloatingIPSubnet is the subnet of the floating IP network to allocate
+	// new floating IPs from. If not set, Neutron picks a subnet.
+	// +optional
+	FloatingIPSubnet *infrav1.SubnetParam `json:"floatingIPSubnet,omitempty"`
+
+	// QoSPolicy is the QoS policy applied to new floating IPs.
+	// +optional
+	QoSPolicy *QoSPolicyParam `json:"qosPolicy,omitempty"`
+
+	// DNSName is a template of the DNS name of a floating IP created for a
+	// claim, e.g. "{{ .ClaimName }}". It is a Go template with the fields
+	// PoolName, Namespace, ClaimName, ClusterName and Labels of the claim.
+	// It requires the DNS integration of Neutron.
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+
+	// DNSDomain is a template of the DNS domain of a floating IP created for
+	// a claim, e.g. "{{ .ClusterName }}.example.com.". It is a Go template with
+	// the same fields as DNSName.
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	DNSDomain string `json:"dnsDomain,omitempty"`
+
+	// Description is a template of the description of new floating IPs. It is
+	// a Go template with the fields PoolName and Namespace. If not set, the
+	// description names the pool. The UID of the pool is always appended to
+	// the description.
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Reservations pins floating IPs to the claims matching them. A reserved
+	// floating IP is only allocated to a matching claim, and is never deleted
+	// when it is released.
+	// +kubebuilder:validation:MaxItems:=256
+	// +listType=map
+	// +listMapKey=ip
+	// +optional
+	Reservations []FloatingIPReservation `json:"reservations,omitempty"`
+
+	// StickyGracePeriod enables sticky allocation. A floating IP released by
+	// a claim is held for a claim with the same name for this period, before
+	// it is returned to the pool or deleted according to the reclaim policy.
+	// +optional
+	StickyGracePeriod *metav1.Duration `json:"stickyGracePeriod,omitempty"`
+}
+
+// FloatingIPReservation pins a floating IP to the claims matching it.
+// +kubebuilder:validation:XValidation:rule="has(self.claimName) != has(self.claimSelector)",message="exactly one of claimName or claimSelector must be set"
+type FloatingIPReservation struct {
+	// IP is the floating IP. It must already exist in OpenStack.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format:=ipv4
+	IP string `json:"ip"`
+
+	// ClaimName is the name of the IPAddressClaim the floating IP is
+	// allocated to.
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// ClaimSelector selects the IPAddressClaims the floating IP may be
+	// allocated to by their labels. The claims of machines carry the labels of
+	// the OpenStackMachine.
+	// +optional
+	ClaimSelector *metav1.LabelSelector `json:"claimSelector,omitempty"`
+}
+
+// QoSPolicyParam specifies an OpenStack QoS policy. It may be specified by either ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type QoSPolicyParam struct {
+	// ID is the uuid of the QoS policy. It will not be validated.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	ID optional.String `json:"id,omitempty"`
+
+	// Filter specifies a filter to select the QoS policy. It must match exactly one QoS policy.
+	// +optional
+	Filter *QoSPolicyFilter `json:"filter,omitempty"`
+}
+
+// QoSPolicyFilter specifies a filter to select a QoS policy. At least one parameter must be specified.
+// +kubebuilder:validation:MinProperties:=1
+type QoSPolicyFilter struct {
+	// Name is the name of the QoS policy.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ProjectID is the ID of the project of the QoS policy.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+// QoSPolicyStatus contains basic information about a QoS policy.
+type QoSPolicyStatus struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// FloatingIPDNSRecord is the DNS name and domain a floating IP was created with.
+type FloatingIPDNSRecord struct {
+	// IP is the floating IP.
+	// +kubebuilder:validation:Required
+	IP string `json:"ip"`
+
+	// DNSName is the DNS name of the floating IP.
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+
+	// DNSDomain is the DNS domain of the floating IP.
+	// +optional
+	DNSDomain string `json:"dnsDomain,omitempty"`
+}
+
+// StickyFloatingIP is a released floating IP which is held for a claim.
+type StickyFloatingIP struct {
+	// IP is the floating IP.
+	// +kubebuilder:validation:Required
+	IP string `json:"ip"`
+
+	// ClaimName is the name of the IPAddressClaim which released the floating IP.
+	// +kubebuilder:validation:Required
+	ClaimName string `json:"claimName"`
+
+	// ExpirationTime is the time after which the floating IP is no longer
+	// held for the claim.
+	// +kubebuilder:validation:Required
+	ExpirationTime metav1.Time `json:"expirationTime"`
 }
 
 // OpenStackFloatingIPPoolStatus defines the observed state of OpenStackFloatingIPPool.
@@ -84,10 +235,36 @@ type OpenStackFloatingIPPoolStatus struct {
 	// +optional
 	FailedIPs []string `json:"failedIPs,omitempty"`
 
+	// StickyIPs are the released floating IPs which are held for the claims
+	// which released them.
+	// +listType=map
+	// +listMapKey=ip
+	// +optional
+	StickyIPs []StickyFloatingIP `json:"stickyIPs,omitempty"`
+
 	// floatingIPNetwork contains information about the network used for floating ips
 	// +optional
 	FloatingIPNetwork *infrav1.NetworkStatus `json:"floatingIPNetwork,omitempty"`
 
+	// FloatingIPSubnet contains information about the subnet new floating IPs are allocated from.
+	// +optional
+	FloatingIPSubnet *infrav1.Subnet `json:"floatingIPSubnet,omitempty"`
+
+	// QoSPolicy contains information about the QoS policy applied to new floating IPs.
+	// +optional
+	QoSPolicy *QoSPolicyStatus `json:"qosPolicy,omitempty"`
+
+	// FloatingIPDescription is the description of new floating IPs.
+	// +optional
+	FloatingIPDescription string `json:"floatingIPDescription,omitempty"`
+
+	// DNSRecords are the DNS names and domains of the floating IPs of the
+	// pool which were created with one.
+	// +listType=map
+	// +listMapKey=ip
+	// +optional
+	DNSRecords []FloatingIPDNSRecord `json:"dnsRecords,omitempty"`
+
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
 }
 
@@ -127,6 +304,30 @@ func (r *OpenStackFloatingIPPool) GetFloatingIPTag() string {
 	return fmt.Sprintf("cluster-api-provider-openstack-fip-pool-%s", r.Name)
 }
 
+// GetFloatingIPClaimTag returns the tag of a floating IP which is being allocated to the IPAddressClaim with the given UID.
+func (r *OpenStackFloatingIPPool) GetFloatingIPClaimTag(claimUID types.UID) string {
+	return FloatingIPClaimTagPrefix + string(claimUID)
+}
+
+// GetFloatingIPDescription returns the description of the floating IPs created by the pool.
+func (r *OpenStackFloatingIPPool) GetFloatingIPDescription() string {
+	if r.Status.FloatingIPDescription != "" {
+		return r.Status.FloatingIPDescription
+	}
+	return r.NewFloatingIPDescription(fmt.Sprintf("Created by cluster-api-provider-openstack OpenStackFloatingIPPool %s/%s", r.Namespace, r.Name))
+}
+
+// NewFloatingIPDescription returns the description of the floating IPs created by the pool with the given text. The UID
+// of the pool is appended to the text, so that only the floating IPs created by the pool are found by their description.
+func (r *OpenStackFloatingIPPool) NewFloatingIPDescription(text string) string {
+	suffix := fmt.Sprintf(" (pool UID %s)", r.UID)
+	// Neutron limits descriptions to 255 characters
+	if runes := []rune(text); len(runes)+len(suffix) > 255 {
+		text = string(runes[:255-len(suffix)])
+	}
+	return text + suffix
+}
+
 var _ infrav1.IdentityRefProvider = &OpenStackFloatingIPPool{}
 
 // GetIdentifyRef returns the FloatingIPPool's namespace and IdentityRef.
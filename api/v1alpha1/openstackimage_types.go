@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+const (
+	// OpenStackImageFinalizer allows the OpenStackImage controller to delete
+	// the Glance image before removing it from the apiserver.
+	OpenStackImageFinalizer = "openstackimage.infrastructure.cluster.x-k8s.io"
+
+	// OpenStackMachineTemplateOpenStackImageIndex is the field index of OpenStackMachineTemplates by the name of the referenced OpenStackImage.
+	OpenStackMachineTemplateOpenStackImageIndex = "spec.template.spec.image.openStackImageRef.name"
+
+	// OpenStackServerOpenStackImageIndex is the field index of OpenStackServers by the name of the referenced OpenStackImage.
+	OpenStackServerOpenStackImageIndex = "spec.image.openStackImageRef.name"
+)
+
+// ImageChecksumAlgorithm is the hash algorithm of an image checksum.
+// +kubebuilder:validation:Enum:=sha256;sha512
+type ImageChecksumAlgorithm string
+
+const (
+	ImageChecksumAlgorithmSHA256 ImageChecksumAlgorithm = "sha256"
+	ImageChecksumAlgorithmSHA512 ImageChecksumAlgorithm = "sha512"
+)
+
+// ImageImportMethod is the way the image data is transferred to Glance.
+// +kubebuilder:validation:Enum:=Auto;WebDownload;Upload
+type ImageImportMethod string
+
+const (
+	// ImageImportMethodAuto uses WebDownload if the source is a URL, Glance
+	// supports the web-download import method and the checksum algorithm
+	// matches the hashing algorithm of Glance, and Upload otherwise.
+	ImageImportMethodAuto ImageImportMethod = "Auto"
+	// ImageImportMethodWebDownload lets Glance download the image from the URL.
+	ImageImportMethodWebDownload ImageImportMethod = "WebDownload"
+	// ImageImportMethodUpload streams the image from the source to Glance
+	// through the controller.
+	ImageImportMethodUpload ImageImportMethod = "Upload"
+)
+
+// ImageSource is the location of the image data. Exactly one of the
+// properties must be set.
+// +kubebuilder:validation:MinProperties:=1
+// +kubebuilder:validation:MaxProperties:=1
+type ImageSource struct {
+	// URL is an http or https URL of the image.
+	// +kubebuilder:validation:Pattern:=`^https?://`
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// OCI is a reference to an OCI artifact containing the image as its only
+	// layer, in the form registry/repository:tag or
+	// registry/repository@digest. Only registries which allow anonymous pulls
+	// are supported.
+	// +optional
+	OCI string `json:"oci,omitempty"`
+}
+
+// ImageChecksum is the expected checksum of the image data.
+type ImageChecksum struct {
+	// Algorithm is the hash algorithm of the checksum.
+	// +required
+	Algorithm ImageChecksumAlgorithm `json:"algorithm"`
+
+	// Value is the hex encoded checksum.
+	// +kubebuilder:validation:Pattern:=`^[0-9a-fA-F]+$`
+	// +required
+	Value string `json:"value"`
+}
+
+// OpenStackImageSpec defines the desired state of OpenStackImage.
+// +kubebuilder:validation:XValidation:rule="!has(self.importMethod) || self.importMethod != 'WebDownload' || has(self.source.url)",message="importMethod WebDownload requires a source url"
+type OpenStackImageSpec struct {
+	// Source is the location of the image data.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="source is immutable"
+	// +required
+	Source ImageSource `json:"source"`
+
+	// Checksum is the expected checksum of the image data. The image is
+	// deleted again if the data does not match it.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="checksum is immutable"
+	// +required
+	Checksum ImageChecksum `json:"checksum"`
+
+	// DiskFormat is the disk format of the image.
+	// +kubebuilder:validation:Enum:=ami;ari;aki;vhd;vhdx;vmdk;raw;qcow2;vdi;ploop;iso
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="diskFormat is immutable"
+	// +required
+	DiskFormat string `json:"diskFormat"`
+
+	// ContainerFormat is the container format of the image.
+	// +kubebuilder:validation:Enum:=ami;ari;aki;bare;ovf;ova;docker;compressed
+	// +kubebuilder:default:=bare
+	// +optional
+	ContainerFormat string `json:"containerFormat,omitempty"`
+
+	// ImportMethod is the way the image data is transferred to Glance.
+	// +kubebuilder:default:=Auto
+	// +optional
+	ImportMethod ImageImportMethod `json:"importMethod,omitempty"`
+
+	// IdentityRef is a reference to a identity to be used when reconciling this image.
+	// +kubebuilder:validation:Required
+	IdentityRef infrav1.OpenStackIdentityReference `json:"identityRef"`
+}
+
+// OpenStackImageStatus defines the observed state of OpenStackImage.
+type OpenStackImageStatus struct {
+	// Ready is true when the image is active and its checksum was verified.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// ID is the ID of the Glance image.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// ImportMethod is the way the image data was transferred to Glance.
+	// +optional
+	ImportMethod ImageImportMethod `json:"importMethod,omitempty"`
+
+	// Conditions defines current service state of the OpenStackImage.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=openstackimages,scope=Namespaced,categories=cluster-api,shortName=osimg
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="OpenStackImage is ready"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id",description="ID of the Glance image"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of OpenStackImage"
+
+// OpenStackImage is the Schema for the openstackimages API.
+// It is a Glance image which is imported and deleted by CAPO.
+type OpenStackImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackImageSpec   `json:"spec,omitempty"`
+	Status OpenStackImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackImageList contains a list of OpenStackImage.
+type OpenStackImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackImage `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackImage resource.
+func (r *OpenStackImage) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackImage to the predescribed clusterv1.Conditions.
+func (r *OpenStackImage) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetImageTag returns the tag of the Glance image of the OpenStackImage.
+func (r *OpenStackImage) GetImageTag() string {
+	return fmt.Sprintf("cluster-api-provider-openstack-image:%s/%s", r.Namespace, r.Name)
+}
+
+var _ infrav1.IdentityRefProvider = &OpenStackImage{}
+
+// GetIdentityRef returns the OpenStackImage's namespace and IdentityRef.
+func (r *OpenStackImage) GetIdentityRef() (*string, *infrav1.OpenStackIdentityReference) {
+	return &r.Namespace, &r.Spec.IdentityRef
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackImage{}, &OpenStackImageList{})
+}
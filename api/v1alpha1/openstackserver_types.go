@@ -34,7 +34,7 @@ const (
 )
 
 // OpenStackServerSpec defines the desired state of OpenStackServer.
-// +kubebuilder:validation:XValidation:message="at least one of flavor or flavorID must be set",rule=(has(self.flavor) || has(self.flavorID))
+// +kubebuilder:validation:XValidation:message="at least one of flavor, flavorID or flavorSelector must be set",rule=(has(self.flavor) || has(self.flavorID) || has(self.flavorSelector))
 type OpenStackServerSpec struct {
 	// AdditionalBlockDevices is a list of specifications for additional block devices to attach to the server instance.
 	// +listType=map
@@ -46,6 +46,16 @@ type OpenStackServerSpec struct {
 	//+optional
 	AvailabilityZone optional.String `json:"availabilityZone,omitempty"`
 
+	// AvailabilityZoneFallback is an ordered list of availability zones in
+	// which to retry creating the server instance if Nova cannot find a
+	// valid host for it in AvailabilityZone. The errored server instance is
+	// deleted before it is created again in the next availability zone.
+	// +kubebuilder:validation:MaxItems:=16
+	// +kubebuilder:validation:items:MinLength:=1
+	// +listType=set
+	// +optional
+	AvailabilityZoneFallback []string `json:"availabilityZoneFallback,omitempty"`
+
 	// ConfigDrive is a flag to enable config drive for the server instance.
 	// +optional
 	ConfigDrive optional.Bool `json:"configDrive,omitempty"`
@@ -61,10 +71,56 @@ type OpenStackServerSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	FlavorID *string `json:"flavorID,omitempty"`
 
+	// FlavorSelector selects the smallest or largest flavor which satisfies
+	// the given resource requirements. It is used if neither Flavor nor
+	// FlavorID is set. The selected flavor is recorded in the resolved spec
+	// and does not change afterwards.
+	// +optional
+	FlavorSelector *infrav1.FlavorSelector `json:"flavorSelector,omitempty"`
+
+	// Accelerators are the PCI devices and placement resources which the
+	// flavor must provide. If FlavorSelector is set, only flavors which
+	// provide them are selected. Otherwise the flavor is checked when the
+	// server is created.
+	// +optional
+	Accelerators *infrav1.AcceleratorRequirements `json:"accelerators,omitempty"`
+
 	// FloatingIPPoolRef is a reference to a FloatingIPPool to allocate a floating IP from.
 	// +optional
 	FloatingIPPoolRef *corev1.TypedLocalObjectReference `json:"floatingIPPoolRef,omitempty"`
 
+	// HotAttachVolumes enables adding and removing additionalBlockDevices of
+	// type Volume after the server instance has been created. Added volumes
+	// are created and attached to the running server instance, and removed
+	// volumes are detached. Removed volumes are only deleted if they were
+	// created to be attached. Other changes to additionalBlockDevices are not
+	// allowed.
+	// +optional
+	HotAttachVolumes optional.Bool `json:"hotAttachVolumes,omitempty"`
+
+	// InPlaceResize enables changing flavor and flavorID after the server
+	// instance has been created. The server instance is then resized in
+	// place instead of being recreated. The resize is confirmed once the
+	// server instance has been resized to the requested flavor, and reverted
+	// if the requested flavor changes while the resize waits for
+	// confirmation.
+	// +optional
+	InPlaceResize optional.Bool `json:"inPlaceResize,omitempty"`
+
+	// InPlaceRebuild enables changing image after the server instance has
+	// been created. The server instance is then rebuilt with the new image
+	// instead of being recreated. Its ports, floating IPs and volumes are
+	// preserved, and the user data is injected again. Rebuilding erases the
+	// root disk of the server instance.
+	// +optional
+	InPlaceRebuild optional.Bool `json:"inPlaceRebuild,omitempty"`
+
+	// Remediation is the policy for recovering the server instance when it
+	// is no longer ACTIVE. The server instance is not recovered if it is not
+	// set.
+	// +optional
+	Remediation *infrav1.InstanceRemediation `json:"remediation,omitempty"`
+
 	// IdentityRef is a reference to a secret holding OpenStack credentials.
 	// +required
 	IdentityRef infrav1.OpenStackIdentityReference `json:"identityRef"`
@@ -77,6 +133,12 @@ type OpenStackServerSpec struct {
 	// +required
 	Ports []infrav1.PortOpts `json:"ports"`
 
+	// RequireEncryptedVolumes requires the root volume and the volume block
+	// devices of the server instance to use an encrypted volume type. The
+	// encrypted attribute of each volume is checked once it has been created.
+	// +optional
+	RequireEncryptedVolumes optional.Bool `json:"requireEncryptedVolumes,omitempty"`
+
 	// RootVolume is the specification for the root volume of the server instance.
 	// +optional
 	RootVolume *infrav1.RootVolume `json:"rootVolume,omitempty"`
@@ -151,11 +213,94 @@ type OpenStackServerStatus struct {
 	// +optional
 	Resources *ServerResources `json:"resources,omitempty"`
 
+	// Resize is the status of the in-place resize of the server instance.
+	// It is only set while a resize is in progress, or if it failed.
+	// +optional
+	Resize *ServerResizeStatus `json:"resize,omitempty"`
+
+	// Rebuild is the status of the rebuild of the server instance. It is only
+	// set while a rebuild is in progress, or if it failed.
+	// +optional
+	Rebuild *ServerRebuildStatus `json:"rebuild,omitempty"`
+
+	// Remediation is the status of the recovery of the server instance.
+	// +optional
+	Remediation *ServerRemediationStatus `json:"remediation,omitempty"`
+
+	// AvailabilityZone is the availability zone in which the server instance
+	// is created. It is only set once the server instance has been moved to a
+	// fallback availability zone.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// AvailabilityZoneAttempts records the availability zones in which the
+	// server instance could not be created because Nova found no valid host.
+	// +listType=atomic
+	// +optional
+	AvailabilityZoneAttempts []infrav1.AvailabilityZoneAttempt `json:"availabilityZoneAttempts,omitempty"`
+
 	// Conditions defines current service state of the OpenStackServer.
 	// +optional
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
 }
 
+// ServerRemediationStatus is the status of the recovery of a server instance.
+type ServerRemediationStatus struct {
+	// ErrorReboots is the number of times the server instance has been hard
+	// rebooted because it was in ERROR.
+	// +optional
+	ErrorReboots int32 `json:"errorReboots,omitempty"`
+
+	// Attempts is the number of consecutive remediation actions taken since
+	// the server instance was last ACTIVE.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// LastAction is the last remediation action taken.
+	// +optional
+	LastAction ServerRemediationAction `json:"lastAction,omitempty"`
+
+	// LastActionTime is the time of the last remediation action.
+	// +optional
+	LastActionTime *metav1.Time `json:"lastActionTime,omitempty"`
+}
+
+// ServerRemediationAction is a remediation action taken on a server instance.
+type ServerRemediationAction string
+
+const (
+	// ServerRemediationActionStart starts a SHUTOFF server instance.
+	ServerRemediationActionStart ServerRemediationAction = "Start"
+	// ServerRemediationActionReboot hard reboots a server instance in ERROR.
+	ServerRemediationActionReboot ServerRemediationAction = "Reboot"
+	// ServerRemediationActionUnpause unpauses a PAUSED server instance.
+	ServerRemediationActionUnpause ServerRemediationAction = "Unpause"
+)
+
+// ServerRebuildStatus is the status of a rebuild of a server instance.
+type ServerRebuildStatus struct {
+	// ImageID is the ID of the image the server instance is rebuilt with.
+	// +required
+	ImageID string `json:"imageID"`
+
+	// Failed is true if the server instance could not be rebuilt. A failed
+	// rebuild is not retried until the requested image changes.
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+}
+
+// ServerResizeStatus is the status of an in-place resize of a server instance.
+type ServerResizeStatus struct {
+	// FlavorID is the ID of the flavor the server instance is resized to.
+	// +required
+	FlavorID string `json:"flavorID"`
+
+	// Failed is true if the server instance could not be resized. A failed
+	// resize is not retried until the requested flavor changes.
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+}
+
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
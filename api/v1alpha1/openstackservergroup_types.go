@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+const (
+	// OpenStackServerGroupFinalizer allows the OpenStackServerGroup controller to delete
+	// the server group once it is no longer referenced before removing it from the apiserver.
+	OpenStackServerGroupFinalizer = "openstackservergroup.infrastructure.cluster.x-k8s.io"
+
+	// OpenStackServerServerGroupIndex is the field index of OpenStackServers by the name of the referenced OpenStackServerGroup.
+	OpenStackServerServerGroupIndex = "spec.serverGroup.serverGroupRef.name"
+
+	// OpenStackMachineTemplateServerGroupIndex is the field index of OpenStackMachineTemplates by the name of the referenced OpenStackServerGroup.
+	OpenStackMachineTemplateServerGroupIndex = "spec.template.spec.serverGroup.serverGroupRef.name"
+)
+
+// ServerGroupPolicy is the scheduling policy of a server group.
+// +kubebuilder:validation:Enum:=affinity;anti-affinity;soft-affinity;soft-anti-affinity
+type ServerGroupPolicy string
+
+const (
+	// ServerGroupPolicyAffinity schedules all servers of the group on the same host.
+	ServerGroupPolicyAffinity ServerGroupPolicy = "affinity"
+	// ServerGroupPolicyAntiAffinity schedules every server of the group on a different host.
+	ServerGroupPolicyAntiAffinity ServerGroupPolicy = "anti-affinity"
+	// ServerGroupPolicySoftAffinity schedules the servers of the group on the same host if possible.
+	ServerGroupPolicySoftAffinity ServerGroupPolicy = "soft-affinity"
+	// ServerGroupPolicySoftAntiAffinity schedules the servers of the group on different hosts if possible.
+	ServerGroupPolicySoftAntiAffinity ServerGroupPolicy = "soft-anti-affinity"
+)
+
+// OpenStackServerGroupSpec defines the desired state of OpenStackServerGroup.
+// +kubebuilder:validation:XValidation:rule="!has(self.maxServerPerHost) || self.policy == 'anti-affinity'",message="maxServerPerHost may only be set if policy is anti-affinity"
+type OpenStackServerGroupSpec struct {
+	// Policy is the scheduling policy of the server group.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="policy is immutable"
+	// +required
+	Policy ServerGroupPolicy `json:"policy"`
+
+	// MaxServerPerHost is the maximum number of servers of the group on a
+	// single host. It may only be set if policy is anti-affinity, and
+	// requires Nova microversion 2.64.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="maxServerPerHost is immutable"
+	// +optional
+	MaxServerPerHost *int32 `json:"maxServerPerHost,omitempty"`
+
+	// IdentityRef is a reference to a identity to be used when reconciling this server group.
+	// +kubebuilder:validation:Required
+	IdentityRef infrav1.OpenStackIdentityReference `json:"identityRef"`
+}
+
+// OpenStackServerGroupStatus defines the observed state of OpenStackServerGroup.
+type OpenStackServerGroupStatus struct {
+	// Ready is true when the server group has been created.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// ID is the ID of the server group.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Members is the list of the IDs of the servers in the server group.
+	// +listType=set
+	// +optional
+	Members []string `json:"members,omitempty"`
+
+	// Conditions defines current service state of the OpenStackServerGroup.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=openstackservergroups,scope=Namespaced,categories=cluster-api,shortName=ossg
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Policy",type="string",JSONPath=".spec.policy",description="Scheduling policy of the server group"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="OpenStackServerGroup is ready"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id",description="ID of the server group"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of OpenStackServerGroup"
+
+// OpenStackServerGroup is the Schema for the openstackservergroups API.
+// It is a Nova server group which is created and deleted by CAPO.
+type OpenStackServerGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackServerGroupSpec   `json:"spec,omitempty"`
+	Status OpenStackServerGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackServerGroupList contains a list of OpenStackServerGroup.
+type OpenStackServerGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackServerGroup `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackServerGroup resource.
+func (r *OpenStackServerGroup) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackServerGroup to the predescribed clusterv1.Conditions.
+func (r *OpenStackServerGroup) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+var _ infrav1.IdentityRefProvider = &OpenStackServerGroup{}
+
+// GetIdentityRef returns the OpenStackServerGroup's namespace and IdentityRef.
+func (r *OpenStackServerGroup) GetIdentityRef() (*string, *infrav1.OpenStackIdentityReference) {
+	return &r.Namespace, &r.Spec.IdentityRef
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackServerGroup{}, &OpenStackServerGroupList{})
+}
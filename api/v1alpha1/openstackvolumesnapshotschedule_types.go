@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+const (
+	// OpenStackVolumeSnapshotScheduleFinalizer allows the OpenStackVolumeSnapshotSchedule controller to clean up
+	// the snapshots created by the schedule before removing it from the apiserver.
+	OpenStackVolumeSnapshotScheduleFinalizer = "openstackvolumesnapshotschedule.infrastructure.cluster.x-k8s.io"
+
+	// OpenStackVolumeSnapshotScheduleServerIndex is the field index of schedules by the name of the referenced OpenStackServer.
+	OpenStackVolumeSnapshotScheduleServerIndex = "spec.serverRef.name"
+
+	// VolumeSnapshotScheduleMetadataKey is the metadata key of the snapshots and backups created by a schedule.
+	// Its value is the namespaced name of the schedule.
+	VolumeSnapshotScheduleMetadataKey = "cluster-api-provider-openstack-snapshot-schedule"
+
+	// RootVolumeName is the name of the root volume of a server in the volumes of a schedule.
+	RootVolumeName = "root"
+)
+
+// VolumeSnapshotType is the type of copy a schedule takes of a volume.
+// +kubebuilder:validation:Enum:=Snapshot;Backup
+type VolumeSnapshotType string
+
+const (
+	// VolumeSnapshotTypeSnapshot creates Cinder snapshots. Snapshots are stored
+	// with the volume, and a volume can't be deleted while it has snapshots.
+	VolumeSnapshotTypeSnapshot VolumeSnapshotType = "Snapshot"
+	// VolumeSnapshotTypeBackup creates Cinder backups. Backups are stored in
+	// the backup service, independently of the volume.
+	VolumeSnapshotTypeBackup VolumeSnapshotType = "Backup"
+)
+
+// OpenStackVolumeSnapshotScheduleSpec defines the desired state of OpenStackVolumeSnapshotSchedule.
+// +kubebuilder:validation:XValidation:rule="!has(self.incremental) || !self.incremental || self.type == 'Backup'",message="incremental may only be set if type is Backup"
+type OpenStackVolumeSnapshotScheduleSpec struct {
+	// ServerRef is a reference to the OpenStackServer whose volumes are
+	// snapshotted. The OpenStackServer of an OpenStackMachine has the same
+	// name as the OpenStackMachine.
+	// +required
+	ServerRef corev1.LocalObjectReference `json:"serverRef"`
+
+	// IdentityRef is a reference to a identity to be used when reconciling this schedule.
+	// +kubebuilder:validation:Required
+	IdentityRef infrav1.OpenStackIdentityReference `json:"identityRef"`
+
+	// Volumes is the list of volumes of the server to snapshot. Volumes are
+	// referenced by the name of their additional block device, or by "root"
+	// for the root volume. If empty, all volumes of the server are
+	// snapshotted.
+	// +listType=set
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+
+	// Type is the type of copy to take of each volume.
+	// +kubebuilder:default:=Snapshot
+	// +optional
+	Type VolumeSnapshotType `json:"type,omitempty"`
+
+	// Interval is the time between two snapshots of the volumes. Snapshots are
+	// taken when the current time crosses a multiple of the interval.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="interval must be at least 1m"
+	// +required
+	Interval metav1.Duration `json:"interval"`
+
+	// Incremental creates incremental backups. It may only be set if type is Backup.
+	// The first backup of a volume is a full backup, and a new full backup is
+	// taken whenever the chain of incremental backups depending on the last
+	// one would outgrow the retention policy, or the last backup is not
+	// available. A chain is only deleted once none of its backups is kept by
+	// the retention policy.
+	// +optional
+	Incremental bool `json:"incremental,omitempty"`
+
+	// Retention is the retention policy of the snapshots taken by this schedule.
+	// +optional
+	Retention VolumeSnapshotRetention `json:"retention,omitempty"`
+
+	// Suspend stops taking new snapshots. The retention policy is still enforced.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// VolumeSnapshotRetention defines which snapshots of a schedule are kept.
+// Snapshots which satisfy any of the limits are deleted.
+type VolumeSnapshotRetention struct {
+	// MaxCount is the maximum number of snapshots kept per volume. The oldest
+	// snapshots are deleted first.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// MaxAge is the maximum age of a snapshot.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// OpenStackVolumeSnapshotScheduleStatus defines the observed state of OpenStackVolumeSnapshotSchedule.
+type OpenStackVolumeSnapshotScheduleStatus struct {
+	// LastScheduleTime is the time at which the last snapshots were taken.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Snapshots is the list of snapshots or backups taken by this schedule
+	// which have not been deleted by the retention policy.
+	// +listType=atomic
+	// +optional
+	Snapshots []VolumeSnapshotStatus `json:"snapshots,omitempty"`
+
+	// Conditions defines current service state of the schedule.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// VolumeSnapshotStatus is the status of a snapshot or backup of a volume.
+type VolumeSnapshotStatus struct {
+	// ID is the ID of the snapshot or backup.
+	// +required
+	ID string `json:"id"`
+
+	// Name is the name of the snapshot or backup.
+	// +required
+	Name string `json:"name"`
+
+	// Volume is the name of the snapshotted volume in the schedule.
+	// +required
+	Volume string `json:"volume"`
+
+	// VolumeID is the ID of the snapshotted volume.
+	// +required
+	VolumeID string `json:"volumeID"`
+
+	// CreatedAt is the time at which the snapshot or backup was taken.
+	// +required
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// Status is the status of the snapshot or backup as reported by Cinder.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Incremental is true if this is an incremental backup, which depends on
+	// the previous backups of the volume up to the last full backup.
+	// +optional
+	Incremental bool `json:"incremental,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=openstackvolumesnapshotschedules,scope=Namespaced,categories=cluster-api,shortName=osvss
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Server",type="string",JSONPath=".spec.serverRef.name",description="OpenStackServer whose volumes are snapshotted"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the snapshots"
+// +kubebuilder:printcolumn:name="Interval",type="string",JSONPath=".spec.interval",description="Time between snapshots"
+// +kubebuilder:printcolumn:name="Last Schedule",type="date",JSONPath=".status.lastScheduleTime",description="Time of the last snapshots"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of OpenStackVolumeSnapshotSchedule"
+
+// OpenStackVolumeSnapshotSchedule is the Schema for the openstackvolumesnapshotschedules API.
+// It takes periodic Cinder snapshots or backups of the volumes of an OpenStackServer.
+type OpenStackVolumeSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackVolumeSnapshotScheduleSpec   `json:"spec,omitempty"`
+	Status OpenStackVolumeSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackVolumeSnapshotScheduleList contains a list of OpenStackVolumeSnapshotSchedule.
+type OpenStackVolumeSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackVolumeSnapshotSchedule `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackVolumeSnapshotSchedule resource.
+func (r *OpenStackVolumeSnapshotSchedule) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackVolumeSnapshotSchedule to the predescribed clusterv1.Conditions.
+func (r *OpenStackVolumeSnapshotSchedule) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+var _ infrav1.IdentityRefProvider = &OpenStackVolumeSnapshotSchedule{}
+
+// GetIdentityRef returns the OpenStackVolumeSnapshotSchedule's namespace and IdentityRef.
+func (r *OpenStackVolumeSnapshotSchedule) GetIdentityRef() (*string, *infrav1.OpenStackIdentityReference) {
+	return &r.Namespace, &r.Spec.IdentityRef
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackVolumeSnapshotSchedule{}, &OpenStackVolumeSnapshotScheduleList{})
+}
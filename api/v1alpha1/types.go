@@ -37,6 +37,46 @@ type ResolvedServerSpec struct {
 	// Ports is the fully resolved list of ports to create for the server.
 	// +optional
 	Ports []infrav1.ResolvedPortSpec `json:"ports,omitempty"`
+
+	// Volumes is the list of resolved references of the root volume and the
+	// additional block devices of type Volume.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Volumes []ResolvedVolumeSpec `json:"volumes,omitempty"`
+}
+
+// ResolvedVolumeSpec contains the resolved references of a volume.
+type ResolvedVolumeSpec struct {
+	// Name is the name of the additional block device, or "root" for the root volume.
+	// +required
+	Name string `json:"name"`
+
+	// SnapshotID is the ID of the snapshot to create the volume from.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// VolumeID is the ID of the volume to clone the volume from.
+	// +optional
+	VolumeID string `json:"volumeID,omitempty"`
+
+	// VolumeTypeID is the ID of the volume type of the volume.
+	// +optional
+	VolumeTypeID string `json:"volumeTypeID,omitempty"`
+
+	// Multiattach is true if the volume type of the volume supports multi-attach.
+	// +optional
+	Multiattach bool `json:"multiattach,omitempty"`
+
+	// SameHostVolumeIDs are the IDs of the volumes to create the volume on the same backend as.
+	// +listType=atomic
+	// +optional
+	SameHostVolumeIDs []string `json:"sameHostVolumeIDs,omitempty"`
+
+	// DifferentHostVolumeIDs are the IDs of the volumes to create the volume on a different backend than.
+	// +listType=atomic
+	// +optional
+	DifferentHostVolumeIDs []string `json:"differentHostVolumeIDs,omitempty"`
 }
 
 // ServerResources contains references to OpenStack resources created for the server.
@@ -44,4 +84,52 @@ type ServerResources struct {
 	// Ports is the status of the ports created for the server.
 	// +optional
 	Ports []infrav1.PortStatus `json:"ports,omitempty"`
+
+	// Volumes is the status of the volumes of the additional block devices
+	// of type Volume. It is only reported if hotAttachVolumes is set.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Volumes []ServerVolumeStatus `json:"volumes,omitempty"`
+}
+
+// VolumeAttachmentState is the state of the attachment of a volume to a server.
+// +kubebuilder:validation:Enum:=Attaching;Attached;Detaching
+type VolumeAttachmentState string
+
+const (
+	// VolumeAttachmentStateAttaching means that the volume is not yet attached to the server.
+	VolumeAttachmentStateAttaching VolumeAttachmentState = "Attaching"
+	// VolumeAttachmentStateAttached means that the volume is attached to the server.
+	VolumeAttachmentStateAttached VolumeAttachmentState = "Attached"
+	// VolumeAttachmentStateDetaching means that the block device of the volume
+	// was removed from the spec, and the volume is being detached from the
+	// server before it is deleted.
+	VolumeAttachmentStateDetaching VolumeAttachmentState = "Detaching"
+)
+
+// ServerVolumeStatus is the status of a volume created for an additional block device of a server.
+type ServerVolumeStatus struct {
+	// Name is the name of the additional block device.
+	// +required
+	Name string `json:"name"`
+
+	// VolumeID is the ID of the volume.
+	// +required
+	VolumeID string `json:"volumeID"`
+
+	// Device is the device name of the volume in the server, as reported by Nova.
+	// +optional
+	Device string `json:"device,omitempty"`
+
+	// State is the state of the attachment of the volume to the server.
+	// +required
+	State VolumeAttachmentState `json:"state"`
+	// Created is true if the volume was created to be attached to the running
+	// server. Only such volumes are deleted when they are removed from the
+	// server or the server is deleted. Other volumes, such as those attached
+	// when the server was created or pre-existing volumes with the same name,
+	// are only detached.
+	// +optional
+	Created bool `json:"created,omitempty"`
 }
@@ -28,6 +28,71 @@ import (
 	corev1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloatingIPDNSRecord) DeepCopyInto(out *FloatingIPDNSRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPDNSRecord.
+func (in *FloatingIPDNSRecord) DeepCopy() *FloatingIPDNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(FloatingIPDNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloatingIPReservation) DeepCopyInto(out *FloatingIPReservation) {
+	*out = *in
+	if in.ClaimSelector != nil {
+		in, out := &in.ClaimSelector, &out.ClaimSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPReservation.
+func (in *FloatingIPReservation) DeepCopy() *FloatingIPReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FloatingIPReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageChecksum) DeepCopyInto(out *ImageChecksum) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageChecksum.
+func (in *ImageChecksum) DeepCopy() *ImageChecksum {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageChecksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSource) DeepCopyInto(out *ImageSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSource.
+func (in *ImageSource) DeepCopy() *ImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackClusterIdentity) DeepCopyInto(out *OpenStackClusterIdentity) {
 	*out = *in
@@ -194,12 +259,39 @@ func (in *OpenStackFloatingIPPoolSpec) DeepCopyInto(out *OpenStackFloatingIPPool
 		*out = new(int)
 		**out = **in
 	}
+	if in.UtilizationThreshold != nil {
+		in, out := &in.UtilizationThreshold, &out.UtilizationThreshold
+		*out = new(int32)
+		**out = **in
+	}
 	out.IdentityRef = in.IdentityRef
 	if in.FloatingIPNetwork != nil {
 		in, out := &in.FloatingIPNetwork, &out.FloatingIPNetwork
 		*out = new(v1beta1.NetworkParam)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FloatingIPSubnet != nil {
+		in, out := &in.FloatingIPSubnet, &out.FloatingIPSubnet
+		*out = new(v1beta1.SubnetParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QoSPolicy != nil {
+		in, out := &in.QoSPolicy, &out.QoSPolicy
+		*out = new(QoSPolicyParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]FloatingIPReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StickyGracePeriod != nil {
+		in, out := &in.StickyGracePeriod, &out.StickyGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolSpec.
@@ -230,11 +322,33 @@ func (in *OpenStackFloatingIPPoolStatus) DeepCopyInto(out *OpenStackFloatingIPPo
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StickyIPs != nil {
+		in, out := &in.StickyIPs, &out.StickyIPs
+		*out = make([]StickyFloatingIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FloatingIPNetwork != nil {
 		in, out := &in.FloatingIPNetwork, &out.FloatingIPNetwork
 		*out = new(v1beta1.NetworkStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FloatingIPSubnet != nil {
+		in, out := &in.FloatingIPSubnet, &out.FloatingIPSubnet
+		*out = new(v1beta1.Subnet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QoSPolicy != nil {
+		in, out := &in.QoSPolicy, &out.QoSPolicy
+		*out = new(QoSPolicyStatus)
+		**out = **in
+	}
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = make([]FloatingIPDNSRecord, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(corev1beta1.Conditions, len(*in))
@@ -254,6 +368,105 @@ func (in *OpenStackFloatingIPPoolStatus) DeepCopy() *OpenStackFloatingIPPoolStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImage) DeepCopyInto(out *OpenStackImage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImage.
+func (in *OpenStackImage) DeepCopy() *OpenStackImage {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImageList) DeepCopyInto(out *OpenStackImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImageList.
+func (in *OpenStackImageList) DeepCopy() *OpenStackImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImageSpec) DeepCopyInto(out *OpenStackImageSpec) {
+	*out = *in
+	out.Source = in.Source
+	out.Checksum = in.Checksum
+	out.IdentityRef = in.IdentityRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImageSpec.
+func (in *OpenStackImageSpec) DeepCopy() *OpenStackImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImageStatus) DeepCopyInto(out *OpenStackImageStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImageStatus.
+func (in *OpenStackImageStatus) DeepCopy() *OpenStackImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackServer) DeepCopyInto(out *OpenStackServer) {
 	*out = *in
@@ -281,6 +494,113 @@ func (in *OpenStackServer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackServerGroup) DeepCopyInto(out *OpenStackServerGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackServerGroup.
+func (in *OpenStackServerGroup) DeepCopy() *OpenStackServerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackServerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackServerGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackServerGroupList) DeepCopyInto(out *OpenStackServerGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackServerGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackServerGroupList.
+func (in *OpenStackServerGroupList) DeepCopy() *OpenStackServerGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackServerGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackServerGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackServerGroupSpec) DeepCopyInto(out *OpenStackServerGroupSpec) {
+	*out = *in
+	if in.MaxServerPerHost != nil {
+		in, out := &in.MaxServerPerHost, &out.MaxServerPerHost
+		*out = new(int32)
+		**out = **in
+	}
+	out.IdentityRef = in.IdentityRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackServerGroupSpec.
+func (in *OpenStackServerGroupSpec) DeepCopy() *OpenStackServerGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackServerGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackServerGroupStatus) DeepCopyInto(out *OpenStackServerGroupStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackServerGroupStatus.
+func (in *OpenStackServerGroupStatus) DeepCopy() *OpenStackServerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackServerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackServerList) DeepCopyInto(out *OpenStackServerList) {
 	*out = *in
@@ -328,6 +648,11 @@ func (in *OpenStackServerSpec) DeepCopyInto(out *OpenStackServerSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AvailabilityZoneFallback != nil {
+		in, out := &in.AvailabilityZoneFallback, &out.AvailabilityZoneFallback
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ConfigDrive != nil {
 		in, out := &in.ConfigDrive, &out.ConfigDrive
 		*out = new(bool)
@@ -343,11 +668,41 @@ func (in *OpenStackServerSpec) DeepCopyInto(out *OpenStackServerSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FlavorSelector != nil {
+		in, out := &in.FlavorSelector, &out.FlavorSelector
+		*out = new(v1beta1.FlavorSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = new(v1beta1.AcceleratorRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FloatingIPPoolRef != nil {
 		in, out := &in.FloatingIPPoolRef, &out.FloatingIPPoolRef
 		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HotAttachVolumes != nil {
+		in, out := &in.HotAttachVolumes, &out.HotAttachVolumes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InPlaceResize != nil {
+		in, out := &in.InPlaceResize, &out.InPlaceResize
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InPlaceRebuild != nil {
+		in, out := &in.InPlaceRebuild, &out.InPlaceRebuild
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(v1beta1.InstanceRemediation)
+		(*in).DeepCopyInto(*out)
+	}
 	out.IdentityRef = in.IdentityRef
 	in.Image.DeepCopyInto(&out.Image)
 	if in.Ports != nil {
@@ -357,6 +712,11 @@ func (in *OpenStackServerSpec) DeepCopyInto(out *OpenStackServerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RequireEncryptedVolumes != nil {
+		in, out := &in.RequireEncryptedVolumes, &out.RequireEncryptedVolumes
+		*out = new(bool)
+		**out = **in
+	}
 	if in.RootVolume != nil {
 		in, out := &in.RootVolume, &out.RootVolume
 		*out = new(v1beta1.RootVolume)
@@ -441,6 +801,28 @@ func (in *OpenStackServerStatus) DeepCopyInto(out *OpenStackServerStatus) {
 		*out = new(ServerResources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resize != nil {
+		in, out := &in.Resize, &out.Resize
+		*out = new(ServerResizeStatus)
+		**out = **in
+	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(ServerRebuildStatus)
+		**out = **in
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(ServerRemediationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailabilityZoneAttempts != nil {
+		in, out := &in.AvailabilityZoneAttempts, &out.AvailabilityZoneAttempts
+		*out = make([]v1beta1.AvailabilityZoneAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(corev1beta1.Conditions, len(*in))
@@ -460,6 +842,177 @@ func (in *OpenStackServerStatus) DeepCopy() *OpenStackServerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackVolumeSnapshotSchedule) DeepCopyInto(out *OpenStackVolumeSnapshotSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackVolumeSnapshotSchedule.
+func (in *OpenStackVolumeSnapshotSchedule) DeepCopy() *OpenStackVolumeSnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackVolumeSnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackVolumeSnapshotSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackVolumeSnapshotScheduleList) DeepCopyInto(out *OpenStackVolumeSnapshotScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackVolumeSnapshotSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackVolumeSnapshotScheduleList.
+func (in *OpenStackVolumeSnapshotScheduleList) DeepCopy() *OpenStackVolumeSnapshotScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackVolumeSnapshotScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackVolumeSnapshotScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackVolumeSnapshotScheduleSpec) DeepCopyInto(out *OpenStackVolumeSnapshotScheduleSpec) {
+	*out = *in
+	out.ServerRef = in.ServerRef
+	out.IdentityRef = in.IdentityRef
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Interval = in.Interval
+	in.Retention.DeepCopyInto(&out.Retention)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackVolumeSnapshotScheduleSpec.
+func (in *OpenStackVolumeSnapshotScheduleSpec) DeepCopy() *OpenStackVolumeSnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackVolumeSnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackVolumeSnapshotScheduleStatus) DeepCopyInto(out *OpenStackVolumeSnapshotScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]VolumeSnapshotStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackVolumeSnapshotScheduleStatus.
+func (in *OpenStackVolumeSnapshotScheduleStatus) DeepCopy() *OpenStackVolumeSnapshotScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackVolumeSnapshotScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyFilter) DeepCopyInto(out *QoSPolicyFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyFilter.
+func (in *QoSPolicyFilter) DeepCopy() *QoSPolicyFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyParam) DeepCopyInto(out *QoSPolicyParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(QoSPolicyFilter)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyParam.
+func (in *QoSPolicyParam) DeepCopy() *QoSPolicyParam {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyStatus) DeepCopyInto(out *QoSPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyStatus.
+func (in *QoSPolicyStatus) DeepCopy() *QoSPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedServerSpec) DeepCopyInto(out *ResolvedServerSpec) {
 	*out = *in
@@ -470,6 +1023,13 @@ func (in *ResolvedServerSpec) DeepCopyInto(out *ResolvedServerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]ResolvedVolumeSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedServerSpec.
@@ -482,6 +1042,80 @@ func (in *ResolvedServerSpec) DeepCopy() *ResolvedServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedVolumeSpec) DeepCopyInto(out *ResolvedVolumeSpec) {
+	*out = *in
+	if in.SameHostVolumeIDs != nil {
+		in, out := &in.SameHostVolumeIDs, &out.SameHostVolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DifferentHostVolumeIDs != nil {
+		in, out := &in.DifferentHostVolumeIDs, &out.DifferentHostVolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedVolumeSpec.
+func (in *ResolvedVolumeSpec) DeepCopy() *ResolvedVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerRebuildStatus) DeepCopyInto(out *ServerRebuildStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerRebuildStatus.
+func (in *ServerRebuildStatus) DeepCopy() *ServerRebuildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerRebuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerRemediationStatus) DeepCopyInto(out *ServerRemediationStatus) {
+	*out = *in
+	if in.LastActionTime != nil {
+		in, out := &in.LastActionTime, &out.LastActionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerRemediationStatus.
+func (in *ServerRemediationStatus) DeepCopy() *ServerRemediationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerRemediationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerResizeStatus) DeepCopyInto(out *ServerResizeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerResizeStatus.
+func (in *ServerResizeStatus) DeepCopy() *ServerResizeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerResizeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerResources) DeepCopyInto(out *ServerResources) {
 	*out = *in
@@ -490,6 +1124,11 @@ func (in *ServerResources) DeepCopyInto(out *ServerResources) {
 		*out = make([]v1beta1.PortStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]ServerVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerResources.
@@ -501,3 +1140,75 @@ func (in *ServerResources) DeepCopy() *ServerResources {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerVolumeStatus) DeepCopyInto(out *ServerVolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerVolumeStatus.
+func (in *ServerVolumeStatus) DeepCopy() *ServerVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StickyFloatingIP) DeepCopyInto(out *StickyFloatingIP) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StickyFloatingIP.
+func (in *StickyFloatingIP) DeepCopy() *StickyFloatingIP {
+	if in == nil {
+		return nil
+	}
+	out := new(StickyFloatingIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotRetention) DeepCopyInto(out *VolumeSnapshotRetention) {
+	*out = *in
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotRetention.
+func (in *VolumeSnapshotRetention) DeepCopy() *VolumeSnapshotRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotStatus.
+func (in *VolumeSnapshotStatus) DeepCopy() *VolumeSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
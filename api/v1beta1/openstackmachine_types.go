@@ -92,7 +92,7 @@ type SchedulerHintAdditionalProperty struct {
 }
 
 // OpenStackMachineSpec defines the desired state of OpenStackMachine.
-// +kubebuilder:validation:XValidation:message="at least one of flavor or flavorID must be set",rule=(has(self.flavor) || has(self.flavorID))
+// +kubebuilder:validation:XValidation:message="at least one of flavor, flavorID or flavorSelector must be set",rule=(has(self.flavor) || has(self.flavorID) || has(self.flavorSelector))
 type OpenStackMachineSpec struct {
 	// ProviderID is the unique identifier as specified by the cloud provider.
 	ProviderID *string `json:"providerID,omitempty"`
@@ -106,6 +106,20 @@ type OpenStackMachineSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	FlavorID *string `json:"flavorID,omitempty"`
 
+	// FlavorSelector selects the smallest or largest flavor which satisfies
+	// the given resource requirements. It is used if neither Flavor nor
+	// FlavorID is set. The selected flavor is recorded in the resolved spec
+	// and does not change afterwards.
+	// +optional
+	FlavorSelector *FlavorSelector `json:"flavorSelector,omitempty"`
+
+	// Accelerators are the PCI devices and placement resources which the
+	// flavor must provide. If FlavorSelector is set, only flavors which
+	// provide them are selected. Otherwise the flavor is checked when the
+	// server is created.
+	// +optional
+	Accelerators *AcceleratorRequirements `json:"accelerators,omitempty"`
+
 	// The image to use for your server instance.
 	// If the rootVolume is specified, this will be used when creating the root volume.
 	// +required
@@ -148,6 +162,26 @@ type OpenStackMachineSpec struct {
 	// +optional
 	AdditionalBlockDevices []AdditionalBlockDevice `json:"additionalBlockDevices,omitempty"`
 
+	// HotAttachVolumes enables adding and removing additionalBlockDevices of
+	// type Volume after the machine has been created. The changes are passed
+	// to the OpenStackServer of the machine, which creates and attaches added
+	// volumes to the running server instance, and detaches removed volumes.
+	// Removed volumes are only deleted if they were created to be attached.
+	// Other changes to additionalBlockDevices are not allowed.
+	// +optional
+	HotAttachVolumes optional.Bool `json:"hotAttachVolumes,omitempty"`
+
+	// AvailabilityZoneFallback is an ordered list of availability zones in
+	// which to retry creating the server instance if Nova cannot find a
+	// valid host for it in the availability zone of the machine. The
+	// errored server instance is deleted before it is created again in the
+	// next availability zone.
+	// +kubebuilder:validation:MaxItems:=16
+	// +kubebuilder:validation:items:MinLength:=1
+	// +listType=set
+	// +optional
+	AvailabilityZoneFallback []string `json:"availabilityZoneFallback,omitempty"`
+
 	// The server group to assign the machine to.
 	// +optional
 	ServerGroup *ServerGroupParam `json:"serverGroup,omitempty"`
@@ -261,6 +295,12 @@ type OpenStackMachineStatus struct {
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
+	// AvailabilityZoneAttempts records the availability zones in which the
+	// server instance of the machine could not be created.
+	// +listType=atomic
+	// +optional
+	AvailabilityZoneAttempts []AvailabilityZoneAttempt `json:"availabilityZoneAttempts,omitempty"`
+
 	// Conditions defines current service state of the OpenStackMachine.
 	// This field surfaces into Machine's status.conditions[InfrastructureReady] condition.
 	// The Ready condition must surface issues during the entire lifecycle of the OpenStackMachine
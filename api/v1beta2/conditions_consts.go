@@ -55,6 +55,24 @@ const (
 	LoadBalancerMemberErrorReason = "LoadBalancerMemberError"
 	// FloatingIPErrorReason used when the floating ip could not be created or attached.
 	FloatingIPErrorReason = "FloatingIPError"
+
+	// LoadBalancerMemberDrainedCondition reports on the draining of the load balancer members of a machine which is being deleted. Ready indicates that the drain timeout has expired, that Octavia reports that the members no longer serve connections, or that the machine has no members left, and the members can be removed.
+	LoadBalancerMemberDrainedCondition string = "LoadBalancerMemberDrained"
+
+	// LoadBalancerMemberDrainingReason used while the load balancer members of the machine are being drained until the drain timeout expires.
+	LoadBalancerMemberDrainingReason = "LoadBalancerMemberDraining"
+)
+
+const (
+	// ConsoleLogCapturedCondition reports on the capture of the console log of a failed instance. The message names the Secret storing the console log.
+	ConsoleLogCapturedCondition string = "ConsoleLogCaptured"
+
+	// InstanceErrorReason used when the console log was captured because the instance is in ERROR.
+	InstanceErrorReason = "InstanceError"
+	// NodeTimeoutReason used when the console log was captured because the instance did not become a node in time.
+	NodeTimeoutReason = "NodeTimeout"
+	// ConsoleLogCaptureFailedReason used when the console log could not be captured.
+	ConsoleLogCaptureFailedReason = "ConsoleLogCaptureFailed"
 )
 
 const (
@@ -93,6 +111,9 @@ const (
 	RouterReconcileFailedReason = "RouterCreateFailed"
 	// SecurityGroupReconcileFailedReason is used when security group reconciliation fails.
 	SecurityGroupReconcileFailedReason = "SecurityGroupCreateFailed"
+	// StatelessSecurityGroupsUnsupportedReason is used when stateless security groups are requested
+	// but the cloud doesn't support them.
+	StatelessSecurityGroupsUnsupportedReason = "StatelessSecurityGroupsUnsupported"
 	// APIEndpointConfigFailedReason is used when API endpoint configuration fails.
 	APIEndpointConfigFailedReason = "APIEndpointConfigFailed"
 )
@@ -14,6 +14,7 @@ type OpenStackClusterExtensionsSpec struct {
 	Networking        *ClusterNetworkingExtensionsSpec        `json:"networking,omitempty"`
 	NetworkInterfaces *ClusterNetworkInterfacesExtensionsSpec `json:"networkInterfaces,omitempty"`
 	OpenStack         *ClusterOpenStackExtensionsSpec         `json:"openStack,omitempty"`
+	LoadBalancers     *ClusterLoadBalancersExtensionsSpec     `json:"loadBalancers,omitempty"`
 }
 
 type ClusterNetworkingExtensionsSpec struct {
@@ -28,6 +29,30 @@ const (
 	KubeNetworkPluginFlannel = "flannel"
 )
 
+type ClusterLoadBalancersExtensionsSpec struct {
+	Ingress *IngressLoadBalancerExtensionsSpec `json:"ingress,omitempty"`
+}
+
+type IngressLoadBalancerExtensionsSpec struct {
+	// +kubebuilder:validation:Enum=octavia;keepalived
+	// +kubebuilder:default=keepalived
+	// Supported values: octavia, keepalived. Defaults to keepalived.
+	// In octavia mode the ingress VIP is an Octavia load balancer in front of the worker nodes.
+	// The mode cannot be changed once the cluster has been created.
+	Mode string `json:"mode,omitempty"`
+
+	// Ports forwarded to the worker nodes in octavia mode. Defaults to 80 and 443.
+	// +listType=set
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=65535
+	Ports []int `json:"ports,omitempty"`
+}
+
+const (
+	IngressLoadBalancerModeKeepalived = "keepalived"
+	IngressLoadBalancerModeOctavia    = "octavia"
+)
+
 type ClusterNetworkInterfacesExtensionsSpec struct {
 	// Required when kubeNetworkPlugin is set to flannel.
 	Flannel string `json:"flannel,omitempty"`
@@ -62,7 +87,8 @@ type ClusterLoadBalancersExtensionsStatus struct {
 }
 
 type ClusterVIPStatus struct {
-	VIP string `json:"vip,omitempty"`
+	VIP        string `json:"vip,omitempty"`
+	FloatingIP string `json:"floatingIP,omitempty"`
 }
 
 type ClusterPlatformExtensionsStatus struct {
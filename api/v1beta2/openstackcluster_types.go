@@ -27,6 +27,10 @@ const (
 	// ClusterFinalizer allows ReconcileOpenStackCluster to clean up OpenStack resources associated with OpenStackCluster before
 	// removing it from the apiserver.
 	ClusterFinalizer = "openstackcluster.infrastructure.cluster.x-k8s.io"
+
+	// OpenStackClusterTLSSecretIndex is the field index of OpenStackClusters by the names of the
+	// Secrets referenced by the TLSSecretName of their API server load balancer listeners.
+	OpenStackClusterTLSSecretIndex = "spec.apiServerLoadBalancer.listeners.tlsSecretName"
 )
 
 // OpenStackClusterSpec defines the desired state of OpenStackCluster.
@@ -194,6 +198,23 @@ type OpenStackClusterSpec struct {
 	// +kubebuilder:validation:Required
 	IdentityRef OpenStackIdentityReference `json:"identityRef"`
 
+	// VolumePolicy is the policy enforced on the volumes of the machines of
+	// the cluster. Changes only apply to machines created afterwards.
+	// +optional
+	VolumePolicy *VolumePolicy `json:"volumePolicy,omitempty"`
+
+	// InstanceRemediation is the policy for recovering the instances of the
+	// machines of the cluster which are no longer ACTIVE. Changes only apply
+	// to machines created afterwards.
+	// +optional
+	InstanceRemediation *InstanceRemediation `json:"instanceRemediation,omitempty"`
+
+	// ConsoleLogCapture is the policy for capturing the console log of the
+	// instances of machines of the cluster which fail. The console log is
+	// stored in a Secret owned by the OpenStackMachine.
+	// +optional
+	ConsoleLogCapture *ConsoleLogCapture `json:"consoleLogCapture,omitempty"`
+
 	// Extensions stores provider-specific knobs consumed by bootstrap/control-plane integrations.
 	// +optional
 	Extensions *OpenStackClusterExtensionsSpec `json:"extensions,omitempty"`
@@ -248,10 +269,25 @@ type OpenStackClusterStatus struct {
 	// +optional
 	BastionSecurityGroup *SecurityGroupStatus `json:"bastionSecurityGroup,omitempty"`
 
+	// AddressGroups contains the information about the OpenStack address
+	// groups created by CAPO for inline remoteAddressGroup addresses in
+	// managed security group rules.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AddressGroups []AddressGroupStatus `json:"addressGroups,omitempty"`
+
 	// Bastion contains the information about the deployed bastion host
 	// +optional
 	Bastion *BastionStatus `json:"bastion,omitempty"`
 
+	// Servers contains information about the OpenStackServers of the cluster
+	// which are neither machines nor the bastion.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Servers []StandaloneServerStatus `json:"servers,omitempty"`
+
 	// Conditions defines current service state of the OpenStackCluster.
 	// This field surfaces into Cluster's status.conditions[InfrastructureReady] condition.
 	// The Ready condition must surface issues during the entire lifecycle of the OpenStackCluster
@@ -322,6 +358,41 @@ type ManagedSecurityGroups struct {
 	// +kubebuilder:default=false
 	// +kubebuilder:validation:Required
 	AllowAllInClusterTraffic bool `json:"allowAllInClusterTraffic"`
+
+	// controlPlane defines settings for the control plane security group.
+	// +optional
+	ControlPlane *ManagedSecurityGroupSettings `json:"controlPlane,omitempty"`
+
+	// worker defines settings for the worker security group.
+	// +optional
+	Worker *ManagedSecurityGroupSettings `json:"worker,omitempty"`
+
+	// bastion defines settings for the bastion security group.
+	// +optional
+	Bastion *ManagedSecurityGroupSettings `json:"bastion,omitempty"`
+}
+
+// ManagedSecurityGroupSettings defines settings for a single managed security group.
+type ManagedSecurityGroupSettings struct {
+	// stateful specifies whether the security group is stateful. Stateless
+	// security groups do not track connections, so return traffic must be
+	// explicitly allowed by rules. Setting this to false requires the
+	// stateful-security-group Neutron extension. Defaults to true.
+	// This field is immutable.
+	// +optional
+	Stateful *bool `json:"stateful,omitempty"`
+
+	// description overrides the description of the security group.
+	// Defaults to "Cluster API managed group".
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// tags overrides the tags set on the security group. If not specified,
+	// the tags of the cluster are used.
+	// +listType=set
+	// +optional
+	Tags []string `json:"tags,omitempty"`
 }
 
 var _ IdentityRefProvider = &OpenStackCluster{}
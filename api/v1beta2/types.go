@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
@@ -33,8 +34,8 @@ type ResourceReference struct {
 	Name string `json:"name"`
 }
 
-// ImageParam describes a glance image. It can be specified by ID, filter, or a
-// reference to an ORC Image.
+// ImageParam describes a glance image. It can be specified by ID, filter, a
+// reference to an ORC Image, or a reference to an OpenStackImage.
 // +kubebuilder:validation:MaxProperties:=1
 // +kubebuilder:validation:MinProperties:=1
 type ImageParam struct {
@@ -53,6 +54,12 @@ type ImageParam struct {
 	// referring object.
 	// +optional
 	ImageRef *ResourceReference `json:"imageRef,omitempty"`
+
+	// OpenStackImageRef is a reference to an OpenStackImage in the same
+	// namespace as the referring object. The image is imported and deleted
+	// by CAPO.
+	// +optional
+	OpenStackImageRef *ResourceReference `json:"openStackImageRef,omitempty"`
 }
 
 // ImageFilter describes a query for an image.
@@ -264,6 +271,42 @@ func (f *RouterFilter) IsZero() bool {
 		f.FilterByNeutronTags.IsZero()
 }
 
+// LoadBalancerParam specifies an existing OpenStack load balancer. It may be specified by either ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type LoadBalancerParam struct {
+	// ID is the ID of the load balancer to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	ID optional.String `json:"id,omitempty"`
+
+	// Filter specifies a filter to select an OpenStack load balancer. If provided, cannot be empty.
+	// +optional
+	Filter *LoadBalancerFilter `json:"filter,omitempty"`
+}
+
+// LoadBalancerFilter specifies a query to select an OpenStack load balancer. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type LoadBalancerFilter struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"projectID,omitempty"`
+	VIPAddress  string `json:"vipAddress,omitempty"`
+
+	FilterByNeutronTags `json:",inline"`
+}
+
+func (f *LoadBalancerFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == "" &&
+		f.Description == "" &&
+		f.ProjectID == "" &&
+		f.VIPAddress == "" &&
+		f.FilterByNeutronTags.IsZero()
+}
+
 type SubnetSpec struct {
 	// CIDR is representing the IP address range used to create the subnet, e.g. 10.0.0.0/24.
 	// This field is required when defining a subnet.
@@ -502,6 +545,30 @@ type BastionStatus struct {
 	Resources *MachineResources `json:"resources,omitempty"`
 }
 
+// StandaloneServerStatus contains information about an OpenStackServer of a
+// cluster which is neither a machine nor the bastion.
+type StandaloneServerStatus struct {
+	// Name is the name of the OpenStackServer.
+	// +required
+	Name string `json:"name"`
+
+	// ID is the ID of the server instance.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// State is the state of the server instance.
+	// +optional
+	State InstanceState `json:"state,omitempty"`
+
+	// IP is the first internal IP address of the server instance.
+	// +optional
+	IP string `json:"ip,omitempty"`
+
+	// Ready is true when the server instance is ready.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+}
+
 type RootVolume struct {
 	// SizeGiB is the size of the block device in gibibytes (GiB).
 	// +kubebuilder:validation:Required
@@ -529,6 +596,8 @@ type BlockDeviceStorage struct {
 }
 
 // BlockDeviceVolume contains additional storage options for a volume block device.
+// +kubebuilder:validation:XValidation:rule="!has(self.snapshotRef) || !has(self.volumeRef)",message="snapshotRef and volumeRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.multiattach) || has(self.type)",message="type must be set if multiattach is set"
 type BlockDeviceVolume struct {
 	// Type is the Cinder volume type of the volume.
 	// If omitted, the default Cinder volume type that is configured in the OpenStack cloud
@@ -541,6 +610,174 @@ type BlockDeviceVolume struct {
 	// availability zone.
 	// +optional
 	AvailabilityZone *VolumeAvailabilityZone `json:"availabilityZone,omitempty"`
+
+	// SnapshotRef is a reference to a Cinder volume snapshot to create the
+	// volume from. A root volume created from a snapshot is not created from
+	// the image of the machine. It cannot be set together with volumeRef.
+	// +optional
+	SnapshotRef *VolumeSnapshotParam `json:"snapshotRef,omitempty"`
+
+	// VolumeRef is a reference to an existing Cinder volume to clone the
+	// volume from. A root volume cloned from a volume is not created from the
+	// image of the machine. It cannot be set together with snapshotRef.
+	// +optional
+	VolumeRef *VolumeParam `json:"volumeRef,omitempty"`
+
+	// Metadata is a list of metadata key/value pairs to set on the volume.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Metadata []VolumeMetadata `json:"metadata,omitempty"`
+
+	// SchedulerHints are hints to the Cinder scheduler to place the volume
+	// on the same or on a different backend as other volumes.
+	// +optional
+	SchedulerHints *VolumeSchedulerHints `json:"schedulerHints,omitempty"`
+
+	// Multiattach states whether the volume must be attachable to multiple
+	// servers. Cinder decides this from the volume type, so if set, type must
+	// also be set and the volume type must support multi-attach if true, or
+	// must not support it if false. If not set, the volume type decides.
+	// +optional
+	Multiattach optional.Bool `json:"multiattach,omitempty"`
+}
+
+// VolumeMetadata is a metadata key/value pair of a volume.
+type VolumeMetadata struct {
+	// Key is the volume metadata key
+	// +kubebuilder:validation:MaxLength:=255
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Value is the volume metadata value
+	// +kubebuilder:validation:MaxLength:=255
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+}
+
+// VolumePolicy is a policy enforced on the volumes of the machines of a cluster.
+type VolumePolicy struct {
+	// RequireEncryption requires the root volume and the volume block devices
+	// of every machine to use an encrypted volume type. The volume type must
+	// then be set explicitly. The encryption is checked on each volume once it
+	// has been created.
+	// +optional
+	RequireEncryption optional.Bool `json:"requireEncryption,omitempty"`
+}
+
+// InstanceRemediation is a policy for recovering the instances of the
+// machines of a cluster which are no longer ACTIVE.
+type InstanceRemediation struct {
+	// StartShutoff starts instances which are SHUTOFF.
+	// +optional
+	StartShutoff optional.Bool `json:"startShutoff,omitempty"`
+
+	// Unpause unpauses instances which are PAUSED.
+	// +optional
+	Unpause optional.Bool `json:"unpause,omitempty"`
+
+	// MaxErrorReboots is the number of times an instance in ERROR is hard
+	// rebooted before it is failed permanently. Instances in ERROR are not
+	// rebooted if it is not set.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=10
+	// +optional
+	MaxErrorReboots *int32 `json:"maxErrorReboots,omitempty"`
+
+	// Backoff is the time to wait after a remediation action before taking
+	// another one. It doubles after every consecutive action, and is reset
+	// once the instance is ACTIVE again. Defaults to 1m.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
+// ConsoleLogCapture is a policy for capturing the console log of the
+// instances of machines which fail.
+type ConsoleLogCapture struct {
+	// Lines is the number of lines at the end of the console log which are
+	// captured. Defaults to 500.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=10000
+	// +optional
+	Lines *int32 `json:"lines,omitempty"`
+
+	// NodeTimeout is the time an ACTIVE instance has to become a node before
+	// its console log is captured. If it is not set the console log is only
+	// captured for instances in ERROR.
+	// +optional
+	NodeTimeout *metav1.Duration `json:"nodeTimeout,omitempty"`
+}
+
+// VolumeSchedulerHints are hints to the Cinder scheduler for the placement of a volume.
+// +kubebuilder:validation:MinProperties:=1
+type VolumeSchedulerHints struct {
+	// SameHost is a list of volumes. The volume is created on the same
+	// backend as these volumes.
+	// +listType=atomic
+	// +optional
+	SameHost []VolumeParam `json:"sameHost,omitempty"`
+
+	// DifferentHost is a list of volumes. The volume is created on a
+	// different backend than these volumes.
+	// +listType=atomic
+	// +optional
+	DifferentHost []VolumeParam `json:"differentHost,omitempty"`
+}
+
+// VolumeSnapshotParam specifies a Cinder volume snapshot. It may be specified by ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type VolumeSnapshotParam struct {
+	// ID is the ID of the volume snapshot to use.
+	// +kubebuilder:validation:Format:=uuid
+	ID optional.String `json:"id,omitempty"`
+
+	// Filter specifies a query to select a volume snapshot. If provided, it cannot be empty.
+	Filter *VolumeSnapshotFilter `json:"filter,omitempty"`
+}
+
+// VolumeSnapshotFilter specifies a query to select a Cinder volume snapshot. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type VolumeSnapshotFilter struct {
+	// Name is the name of a volume snapshot to look for.
+	Name optional.String `json:"name,omitempty"`
+
+	// VolumeID is the ID of the volume the snapshot was taken of.
+	// +kubebuilder:validation:Format:=uuid
+	VolumeID optional.String `json:"volumeID,omitempty"`
+}
+
+func (f *VolumeSnapshotFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == nil && f.VolumeID == nil
+}
+
+// VolumeParam specifies a Cinder volume. It may be specified by ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type VolumeParam struct {
+	// ID is the ID of the volume to use.
+	// +kubebuilder:validation:Format:=uuid
+	ID optional.String `json:"id,omitempty"`
+
+	// Filter specifies a query to select a volume. If provided, it cannot be empty.
+	Filter *VolumeFilter `json:"filter,omitempty"`
+}
+
+// VolumeFilter specifies a query to select a Cinder volume. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type VolumeFilter struct {
+	// Name is the name of a volume to look for.
+	Name optional.String `json:"name,omitempty"`
+}
+
+func (f *VolumeFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == nil
 }
 
 // VolumeAZSource specifies where to obtain the availability zone for a volume.
@@ -552,6 +789,15 @@ const (
 	VolumeAZFromMachine VolumeAZSource = "Machine"
 )
 
+// MemberDrainMode is how load balancer members stop receiving new connections while they are drained.
+// +kubebuilder:validation:Enum=Weight;AdminStateDown
+type MemberDrainMode string
+
+const (
+	MemberDrainModeWeight         MemberDrainMode = "Weight"
+	MemberDrainModeAdminStateDown MemberDrainMode = "AdminStateDown"
+)
+
 // VolumeAZName is the name of a volume availability zone. It may not contain spaces.
 // +kubebuilder:validation:Pattern:="^[^ ]+$"
 // +kubebuilder:validation:MinLength:=1
@@ -598,7 +844,163 @@ type AdditionalBlockDevice struct {
 	Storage BlockDeviceStorage `json:"storage"`
 }
 
-// ServerGroupParam specifies an OpenStack server group. It may be specified by ID or filter, but not both.
+// FlavorPreference is the order in which flavors matching a FlavorSelector
+// are preferred.
+// +kubebuilder:validation:Enum:=SmallestMatching;LargestMatching
+type FlavorPreference string
+
+const (
+	// FlavorPreferenceSmallestMatching prefers the matching flavor with the
+	// fewest vCPUs, then the least RAM, then the smallest disk.
+	FlavorPreferenceSmallestMatching FlavorPreference = "SmallestMatching"
+	// FlavorPreferenceLargestMatching prefers the matching flavor with the
+	// most vCPUs, then the most RAM, then the largest disk.
+	FlavorPreferenceLargestMatching FlavorPreference = "LargestMatching"
+)
+
+// FlavorSelector selects a flavor by its resources instead of by name.
+// +kubebuilder:validation:XValidation:rule="!has(self.minRAMMiB) || !has(self.maxRAMMiB) || self.minRAMMiB <= self.maxRAMMiB",message="minRAMMiB must not be greater than maxRAMMiB"
+type FlavorSelector struct {
+	// MinVCPUs is the minimum number of vCPUs of the flavor.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MinVCPUs *int32 `json:"minVCPUs,omitempty"`
+
+	// MinRAMMiB is the minimum amount of RAM of the flavor in MiB.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MinRAMMiB *int32 `json:"minRAMMiB,omitempty"`
+
+	// MaxRAMMiB is the maximum amount of RAM of the flavor in MiB.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxRAMMiB *int32 `json:"maxRAMMiB,omitempty"`
+
+	// MinDiskGiB is the minimum size of the root disk of the flavor in GiB.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MinDiskGiB *int32 `json:"minDiskGiB,omitempty"`
+
+	// ExtraSpecs are extra specs the flavor must have with the given values.
+	// Selecting flavors by extra specs requires Nova microversion 2.61.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	ExtraSpecs []FlavorExtraSpec `json:"extraSpecs,omitempty"`
+
+	// Preference is the order in which matching flavors are preferred.
+	// Flavors which are equal in this order are sorted by name.
+	// +kubebuilder:default:=SmallestMatching
+	// +optional
+	Preference FlavorPreference `json:"preference,omitempty"`
+}
+
+// FlavorExtraSpec is an extra spec of a flavor.
+type FlavorExtraSpec struct {
+	// Key is the key of the extra spec.
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	Key string `json:"key"`
+
+	// Value is the value of the extra spec.
+	// +required
+	Value string `json:"value"`
+}
+
+// AvailabilityZoneAttempt records a failure to create a server instance in
+// an availability zone.
+type AvailabilityZoneAttempt struct {
+	// Zone is the availability zone in which the server instance could not
+	// be created.
+	// +required
+	Zone string `json:"zone"`
+
+	// Message is the fault reported by Nova for the server instance.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Time is the time at which the failure was observed.
+	// +required
+	Time metav1.Time `json:"time"`
+}
+
+// AcceleratorRequirements are the PCI devices and placement resources which
+// the flavor of a machine must provide.
+type AcceleratorRequirements struct {
+	// PCIAliases are PCI passthrough aliases configured in Nova, such as a
+	// GPU model, with the number of devices of each alias required. They are
+	// matched against the pci_passthrough:alias extra spec of the flavor.
+	// +kubebuilder:validation:MaxItems:=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PCIAliases []PCIAliasRequirement `json:"pciAliases,omitempty"`
+
+	// Resources are placement resource classes, such as VGPU, with the
+	// amount of each resource class required. They are matched against the
+	// resources:<class> extra specs of the flavor.
+	// +kubebuilder:validation:MaxItems:=16
+	// +listType=map
+	// +listMapKey=resourceClass
+	// +optional
+	Resources []PlacementResourceRequirement `json:"resources,omitempty"`
+}
+
+// PCIAliasRequirement is a number of PCI devices of a PCI passthrough alias.
+type PCIAliasRequirement struct {
+	// Name is the name of the PCI passthrough alias.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Pattern:=`^[^:,]+$`
+	// +required
+	Name string `json:"name"`
+
+	// Count is the number of devices required.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default:=1
+	// +optional
+	Count int32 `json:"count,omitempty"`
+}
+
+// PlacementResourceRequirement is an amount of a placement resource class.
+type PlacementResourceRequirement struct {
+	// ResourceClass is the name of a standard or custom placement resource
+	// class, such as VGPU or CUSTOM_FPGA.
+	// +kubebuilder:validation:Pattern:=`^[A-Z0-9_]+$`
+	// +kubebuilder:validation:MaxLength:=255
+	// +required
+	ResourceClass string `json:"resourceClass"`
+
+	// Count is the amount of the resource class required.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default:=1
+	// +optional
+	Count int32 `json:"count,omitempty"`
+}
+
+const (
+	// PCIAliasResourcePrefix prefixes the name of a PCI passthrough alias
+	// to form the name of the extended resource reported in the capacity of
+	// an OpenStackMachineTemplate.
+	PCIAliasResourcePrefix = "pci.openstack.org/"
+
+	// PlacementResourcePrefix prefixes the name of a placement resource
+	// class to form the name of the extended resource reported in the
+	// capacity of an OpenStackMachineTemplate.
+	PlacementResourcePrefix = "placement.openstack.org/"
+)
+
+// StandardResourceClasses are the placement resource classes which are
+// provided by every flavor and reported as CPU, memory and storage. They
+// can't be required as accelerators.
+var StandardResourceClasses = map[string]bool{
+	"VCPU":      true,
+	"PCPU":      true,
+	"MEMORY_MB": true,
+	"DISK_GB":   true,
+}
+
+// ServerGroupParam specifies an OpenStack server group. It may be specified by
+// ID, filter, or a reference to an OpenStackServerGroup, but only one of them.
 // +kubebuilder:validation:MaxProperties:=1
 // +kubebuilder:validation:MinProperties:=1
 type ServerGroupParam struct {
@@ -608,6 +1010,12 @@ type ServerGroupParam struct {
 
 	// Filter specifies a query to select an OpenStack server group. If provided, it cannot be empty.
 	Filter *ServerGroupFilter `json:"filter,omitempty"`
+
+	// ServerGroupRef is a reference to an OpenStackServerGroup in the same
+	// namespace as the referring object. The server group is created and
+	// deleted by CAPO.
+	// +optional
+	ServerGroupRef *ResourceReference `json:"serverGroupRef,omitempty"`
 }
 
 // ServerGroupFilter specifies a query to select an OpenStack server group. At least one property must be set.
@@ -679,6 +1087,11 @@ type LoadBalancer struct {
 	ID         string `json:"id"`
 	IP         string `json:"ip"`
 	InternalIP string `json:"internalIP"`
+	// Adopted is true if the load balancer was not created by CAPO but
+	// adopted from spec.apiServerLoadBalancer.loadBalancerRef. An adopted
+	// load balancer is not deleted together with the cluster.
+	//+optional
+	Adopted bool `json:"adopted,omitempty"`
 	//+optional
 	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
 	//+optional
@@ -703,6 +1116,18 @@ type SecurityGroupStatus struct {
 	ID string `json:"id"`
 }
 
+// AddressGroupStatus represents the basic information of an OpenStack
+// Neutron address group managed by CAPO.
+type AddressGroupStatus struct {
+	// name of the address group
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// id of the address group
+	// +kubebuilder:validation:Required
+	ID string `json:"id"`
+}
+
 // SecurityGroupRuleSpec represent the basic information of the associated OpenStack
 // Security Group Role.
 // For now this is only used for the allNodesSecurityGroupRules but when we add
@@ -748,19 +1173,65 @@ type SecurityGroupRuleSpec struct {
 	Protocol *string `json:"protocol,omitempty"`
 
 	// remoteGroupID is the remote group ID to be associated with this security group rule.
-	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
 	// +optional
 	RemoteGroupID *string `json:"remoteGroupID,omitempty"`
 
 	// remoteIPPrefix is the remote IP prefix to be associated with this security group rule.
-	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
 	// +optional
 	RemoteIPPrefix *string `json:"remoteIPPrefix,omitempty"`
 
 	// remoteManagedGroups is the remote managed groups to be associated with this security group rule.
-	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
 	// +optional
 	RemoteManagedGroups []ManagedSecurityGroupName `json:"remoteManagedGroups,omitempty"`
+
+	// remoteAddressGroup is the Neutron address group to be associated with this security group rule.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
+	// +optional
+	RemoteAddressGroup *AddressGroupParam `json:"remoteAddressGroup,omitempty"`
+}
+
+// AddressGroupParam specifies an OpenStack Neutron address group. It may be
+// specified by ID, by filter, or as an inline list of addresses, but only one
+// of them.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type AddressGroupParam struct {
+	// ID is the ID of an existing address group. Must be in UUID format.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	ID optional.String `json:"id,omitempty"`
+
+	// Filter specifies a query to select an existing address group. It must match exactly one address group.
+	// +optional
+	Filter *AddressGroupFilter `json:"filter,omitempty"`
+
+	// Addresses is an inline list of CIDRs. CAPO creates and owns an address
+	// group holding these addresses, keeps its membership in sync with the
+	// spec, and deletes it when the cluster is deleted.
+	// +kubebuilder:validation:MinItems:=1
+	// +listType=set
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// AddressGroupFilter specifies a query to select an OpenStack address group. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type AddressGroupFilter struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"projectID,omitempty"`
+}
+
+func (f *AddressGroupFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == "" &&
+		f.Description == "" &&
+		f.ProjectID == ""
 }
 
 // +kubebuilder:validation:Enum=bastion;controlplane;worker
@@ -789,6 +1260,21 @@ var (
 	// InstanceStateShutoff is the string representing an instance in a shutoff state.
 	InstanceStateShutoff = InstanceState("SHUTOFF")
 
+	// InstanceStatePaused is the string representing an instance in a paused state.
+	InstanceStatePaused = InstanceState("PAUSED")
+
+	// InstanceStateRebuild is the string representing an instance which is being rebuilt.
+	InstanceStateRebuild = InstanceState("REBUILD")
+
+	// InstanceStateResize is the string representing an instance which is being resized.
+	InstanceStateResize = InstanceState("RESIZE")
+
+	// InstanceStateVerifyResize is the string representing a resized instance waiting for the resize to be confirmed or reverted.
+	InstanceStateVerifyResize = InstanceState("VERIFY_RESIZE")
+
+	// InstanceStateRevertResize is the string representing an instance whose resize is being reverted.
+	InstanceStateRevertResize = InstanceState("REVERT_RESIZE")
+
 	// InstanceStateDeleted is the string representing an instance in a deleted state.
 	InstanceStateDeleted = InstanceState("DELETED")
 
@@ -837,6 +1323,7 @@ func (b *Bastion) IsEnabled() bool {
 	return b.Enabled == nil || *b.Enabled
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerRef) || (!has(self.network) && !has(self.subnets) && !has(self.provider) && !has(self.flavor) && !has(self.availabilityZone))",message="network, subnets, provider, flavor and availabilityZone cannot be set when loadBalancerRef is set"
 type APIServerLoadBalancer struct {
 	// Enabled defines whether a load balancer should be created. This value
 	// defaults to true if an APIServerLoadBalancer is given.
@@ -849,6 +1336,16 @@ type APIServerLoadBalancer struct {
 	// +kubebuilder:default:=true
 	Enabled *bool `json:"enabled"`
 
+	// LoadBalancerRef references an existing load balancer, for example one
+	// with a pre-approved VIP, which is adopted as the API server load
+	// balancer instead of creating a new one. CAPO only manages the
+	// listeners, pools and members it creates on the adopted load balancer,
+	// and never deletes the load balancer itself or its floating IP.
+	// Network, Subnets, Provider, Flavor and AvailabilityZone cannot be set
+	// together with LoadBalancerRef.
+	// +optional
+	LoadBalancerRef *LoadBalancerParam `json:"loadBalancerRef,omitempty"`
+
 	// AdditionalPorts adds additional tcp ports to the load balancer.
 	// +optional
 	// +listType=set
@@ -888,6 +1385,39 @@ type APIServerLoadBalancer struct {
 	// Monitor contains configuration for the load balancer health monitor.
 	//+optional
 	Monitor *APIServerLoadBalancerMonitor `json:"monitor,omitempty"`
+
+	// MemberDrainTimeout enables graceful draining of load balancer members
+	// when a machine is deleted. The members of the machine stop receiving
+	// new connections as configured by MemberDrainMode, and they are removed
+	// once this timeout has expired, or earlier once Octavia reports that they
+	// no longer serve connections. If not set, members are removed
+	// immediately.
+	//+optional
+	MemberDrainTimeout *metav1.Duration `json:"memberDrainTimeout,omitempty"`
+
+	// MemberDrainMode is how the members of a deleted machine stop receiving
+	// new connections while they are drained. Weight sets their weight to 0,
+	// and AdminStateDown disables them for providers which ignore member
+	// weights. Defaults to Weight.
+	//+optional
+	MemberDrainMode *MemberDrainMode `json:"memberDrainMode,omitempty"`
+
+	// Listeners defines additional listeners on the load balancer. Each
+	// listener has a dedicated pool whose members are the machines of the
+	// cluster selected by the listener's member selector.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Listeners []LoadBalancerListener `json:"listeners,omitempty"`
+
+	// Pools defines additional pools on the load balancer which are not the
+	// default pool of a listener. Requests are routed to them by the L7
+	// policies of the HTTP and TERMINATED_HTTPS listeners. Pool names share
+	// a namespace with listener names.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Pools []LoadBalancerPool `json:"pools,omitempty"`
 }
 
 // APIServerLoadBalancerMonitor contains configuration for the load balancer health monitor.
@@ -919,8 +1449,271 @@ type APIServerLoadBalancerMonitor struct {
 	MaxRetriesDown int `json:"maxRetriesDown,omitempty"`
 }
 
+// LoadBalancerProtocol is the protocol of a load balancer listener.
+// +kubebuilder:validation:Enum:=TCP;UDP;HTTP;TERMINATED_HTTPS
+type LoadBalancerProtocol string
+
+const (
+	LoadBalancerProtocolTCP             LoadBalancerProtocol = "TCP"
+	LoadBalancerProtocolUDP             LoadBalancerProtocol = "UDP"
+	LoadBalancerProtocolHTTP            LoadBalancerProtocol = "HTTP"
+	LoadBalancerProtocolTerminatedHTTPS LoadBalancerProtocol = "TERMINATED_HTTPS"
+)
+
+// LoadBalancerAlgorithm is the algorithm used to distribute traffic between the members of a pool.
+// +kubebuilder:validation:Enum:=ROUND_ROBIN;LEAST_CONNECTIONS;SOURCE_IP;SOURCE_IP_PORT
+type LoadBalancerAlgorithm string
+
+const (
+	LoadBalancerAlgorithmRoundRobin       LoadBalancerAlgorithm = "ROUND_ROBIN"
+	LoadBalancerAlgorithmLeastConnections LoadBalancerAlgorithm = "LEAST_CONNECTIONS"
+	LoadBalancerAlgorithmSourceIP         LoadBalancerAlgorithm = "SOURCE_IP"
+	LoadBalancerAlgorithmSourceIPPort     LoadBalancerAlgorithm = "SOURCE_IP_PORT"
+)
+
+// LoadBalancerMonitorType is the type of a load balancer health monitor.
+// +kubebuilder:validation:Enum:=TCP;UDP-CONNECT;HTTP;HTTPS;PING
+type LoadBalancerMonitorType string
+
+const (
+	LoadBalancerMonitorTypeTCP        LoadBalancerMonitorType = "TCP"
+	LoadBalancerMonitorTypeUDPConnect LoadBalancerMonitorType = "UDP-CONNECT"
+	LoadBalancerMonitorTypeHTTP       LoadBalancerMonitorType = "HTTP"
+	LoadBalancerMonitorTypeHTTPS      LoadBalancerMonitorType = "HTTPS"
+	LoadBalancerMonitorTypePING       LoadBalancerMonitorType = "PING"
+)
+
+// LoadBalancerL7RuleType is the part of a request an L7 rule matches against.
+// +kubebuilder:validation:Enum:=HOST_NAME;PATH
+type LoadBalancerL7RuleType string
+
+const (
+	LoadBalancerL7RuleTypeHostName LoadBalancerL7RuleType = "HOST_NAME"
+	LoadBalancerL7RuleTypePath     LoadBalancerL7RuleType = "PATH"
+)
+
+// LoadBalancerL7RuleCompareType is the comparison applied by an L7 rule.
+// +kubebuilder:validation:Enum:=EQUAL_TO;STARTS_WITH;ENDS_WITH;CONTAINS;REGEX
+type LoadBalancerL7RuleCompareType string
+
+const (
+	LoadBalancerL7RuleCompareTypeEqualTo    LoadBalancerL7RuleCompareType = "EQUAL_TO"
+	LoadBalancerL7RuleCompareTypeStartsWith LoadBalancerL7RuleCompareType = "STARTS_WITH"
+	LoadBalancerL7RuleCompareTypeEndsWith   LoadBalancerL7RuleCompareType = "ENDS_WITH"
+	LoadBalancerL7RuleCompareTypeContains   LoadBalancerL7RuleCompareType = "CONTAINS"
+	LoadBalancerL7RuleCompareTypeRegex      LoadBalancerL7RuleCompareType = "REGEX"
+)
+
+// LoadBalancerMemberRole selects machines by their role in the cluster.
+// +kubebuilder:validation:Enum:=ControlPlane;Worker
+type LoadBalancerMemberRole string
+
+const (
+	LoadBalancerMemberRoleControlPlane LoadBalancerMemberRole = "ControlPlane"
+	LoadBalancerMemberRoleWorker       LoadBalancerMemberRole = "Worker"
+)
+
+// LoadBalancerListener defines a listener on the cluster load balancer and
+// the pool which backs it.
+// +kubebuilder:validation:XValidation:rule="has(self.protocol) && self.protocol == 'TERMINATED_HTTPS' ? has(self.defaultTLSContainerRef) != has(self.tlsSecretName) : !has(self.defaultTLSContainerRef) && !has(self.tlsSecretName)",message="exactly one of defaultTLSContainerRef or tlsSecretName is required when protocol is TERMINATED_HTTPS, and both are forbidden otherwise"
+// +kubebuilder:validation:XValidation:rule="!has(self.l7Policies) || (has(self.protocol) && (self.protocol == 'HTTP' || self.protocol == 'TERMINATED_HTTPS'))",message="l7Policies are only supported when protocol is HTTP or TERMINATED_HTTPS"
+// +kubebuilder:validation:XValidation:rule="!has(self.memberTLS) || !self.memberTLS || (has(self.protocol) && (self.protocol == 'HTTP' || self.protocol == 'TERMINATED_HTTPS'))",message="memberTLS is only supported when protocol is HTTP or TERMINATED_HTTPS"
+type LoadBalancerListener struct {
+	// Name is the name of the listener. It is used to name the Octavia
+	// listener, pool and health monitor, and must be unique within the load
+	// balancer.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern:="^[a-z]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+
+	// Protocol is the protocol of the listener. The pool uses the same
+	// protocol, except for TERMINATED_HTTPS whose pool uses HTTP.
+	// +kubebuilder:default:=TCP
+	// +optional
+	Protocol LoadBalancerProtocol `json:"protocol,omitempty"`
+
+	// Port is the port the listener listens on.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port"`
+
+	// MemberPort is the port of the members traffic is forwarded to.
+	// Defaults to Port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	MemberPort *int `json:"memberPort,omitempty"`
+
+	// DefaultTLSContainerRef is the URI of the Barbican secret containing
+	// the certificate used to terminate TLS. Either DefaultTLSContainerRef or
+	// TLSSecretName is required when Protocol is TERMINATED_HTTPS.
+	// +optional
+	DefaultTLSContainerRef optional.String `json:"defaultTLSContainerRef,omitempty"`
+
+	// TLSSecretName is the name of a Secret of type kubernetes.io/tls in the
+	// namespace of the OpenStackCluster containing the certificate used to
+	// terminate TLS. The controller uploads the certificate to Barbican and
+	// updates the listener when the content of the Secret changes. Either
+	// DefaultTLSContainerRef or TLSSecretName is required when Protocol is
+	// TERMINATED_HTTPS.
+	// +optional
+	TLSSecretName optional.String `json:"tlsSecretName,omitempty"`
+
+	// Algorithm is the load balancing algorithm of the pool. Defaults to
+	// SOURCE_IP_PORT for the ovn provider and ROUND_ROBIN otherwise.
+	// +optional
+	Algorithm LoadBalancerAlgorithm `json:"algorithm,omitempty"`
+
+	// MemberTLS makes the load balancer connect to the members of the pool
+	// with TLS, e.g. to forward the requests of a TERMINATED_HTTPS listener
+	// to members which only accept HTTPS. The certificates of the members
+	// are not verified. It is only supported by HTTP and TERMINATED_HTTPS
+	// listeners.
+	// +optional
+	MemberTLS bool `json:"memberTLS,omitempty"`
+
+	// Members selects the machines which are members of the pool.
+	// +kubebuilder:validation:Required
+	Members LoadBalancerMemberSelector `json:"members"`
+
+	// Monitor contains configuration for the health monitor of the pool.
+	// +optional
+	Monitor *LoadBalancerListenerMonitor `json:"monitor,omitempty"`
+
+	// L7Policies route the requests matching their rules to one of the
+	// named pools of the load balancer instead of the pool of the listener.
+	// Policies are evaluated in order and the first matching policy wins.
+	// They are only supported by HTTP and TERMINATED_HTTPS listeners.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	L7Policies []LoadBalancerL7Policy `json:"l7Policies,omitempty"`
+}
+
+// LoadBalancerPool defines a pool of the cluster load balancer which is not
+// the default pool of a listener. The pool uses the HTTP protocol.
+type LoadBalancerPool struct {
+	// Name is the name of the pool. It is used to name the Octavia pool and
+	// health monitor, and must be unique among the listeners and pools of
+	// the load balancer.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern:="^[a-z]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+
+	// MemberPort is the port of the members traffic is forwarded to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	MemberPort int `json:"memberPort"`
+
+	// Algorithm is the load balancing algorithm of the pool. Defaults to
+	// SOURCE_IP_PORT for the ovn provider and ROUND_ROBIN otherwise.
+	// +optional
+	Algorithm LoadBalancerAlgorithm `json:"algorithm,omitempty"`
+
+	// MemberTLS makes the load balancer connect to the members of the pool
+	// with TLS. The certificates of the members are not verified.
+	// +optional
+	MemberTLS bool `json:"memberTLS,omitempty"`
+
+	// Members selects the machines which are members of the pool.
+	// +kubebuilder:validation:Required
+	Members LoadBalancerMemberSelector `json:"members"`
+
+	// Monitor contains configuration for the health monitor of the pool.
+	// The monitor type defaults to HTTPS if MemberTLS is set, and HTTP
+	// otherwise.
+	// +optional
+	Monitor *LoadBalancerListenerMonitor `json:"monitor,omitempty"`
+}
+
+// LoadBalancerL7Policy routes the requests of a listener which match all of
+// its rules to a named pool.
+type LoadBalancerL7Policy struct {
+	// Name is the name of the policy. It must be unique within the listener.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern:="^[a-z]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+
+	// Pool is the name of the pool from the Pools of the load balancer the
+	// matching requests are routed to.
+	// +kubebuilder:validation:Required
+	Pool string `json:"pool"`
+
+	// Rules are the conditions a request must all match for the policy to
+	// apply.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Rules []LoadBalancerL7Rule `json:"rules"`
+}
+
+// LoadBalancerL7Rule is a condition on the requests of a listener.
+type LoadBalancerL7Rule struct {
+	// Type is the part of the request the rule matches against.
+	// +kubebuilder:validation:Required
+	Type LoadBalancerL7RuleType `json:"type"`
+
+	// CompareType is the comparison applied between the request and Value.
+	// +kubebuilder:default:=EQUAL_TO
+	// +optional
+	CompareType LoadBalancerL7RuleCompareType `json:"compareType,omitempty"`
+
+	// Value is the value the request is compared to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Value string `json:"value"`
+
+	// Invert inverts the result of the comparison.
+	// +optional
+	Invert bool `json:"invert,omitempty"`
+}
+
+// LoadBalancerMemberSelector selects the machines which are members of a
+// load balancer pool. Exactly one of Role or MachineSelector must be set.
+// +kubebuilder:validation:MinProperties:=1
+// +kubebuilder:validation:MaxProperties:=1
+type LoadBalancerMemberSelector struct {
+	// Role selects all control plane or all worker machines of the cluster.
+	// +optional
+	Role LoadBalancerMemberRole `json:"role,omitempty"`
+
+	// MachineSelector selects the machines of the cluster whose labels match.
+	// +optional
+	MachineSelector *metav1.LabelSelector `json:"machineSelector,omitempty"`
+}
+
+// LoadBalancerListenerMonitor contains configuration for the health monitor
+// of a load balancer listener's pool.
+type LoadBalancerListenerMonitor struct {
+	// Type is the type of the health monitor. Defaults to UDP-CONNECT for
+	// UDP listeners, HTTPS for pools with MemberTLS, HTTP for HTTP and
+	// TERMINATED_HTTPS listeners, and TCP otherwise. The type must be
+	// supported by the protocol of the pool. Changing the type replaces the
+	// health monitor.
+	// +optional
+	Type LoadBalancerMonitorType `json:"type,omitempty"`
+
+	// URLPath is the HTTP path requested by HTTP and HTTPS monitors.
+	// Defaults to "/".
+	// +optional
+	URLPath optional.String `json:"urlPath,omitempty"`
+
+	// ExpectedCodes is the list of HTTP status codes expected in response
+	// from members by HTTP and HTTPS monitors, e.g. "200" or "200-204".
+	// Defaults to "200".
+	// +optional
+	ExpectedCodes optional.String `json:"expectedCodes,omitempty"`
+
+	APIServerLoadBalancerMonitor `json:",inline"`
+}
+
 func (s *APIServerLoadBalancer) IsZero() bool {
-	return s == nil || ((s.Enabled == nil || !*s.Enabled) && len(s.AdditionalPorts) == 0 && len(s.AllowedCIDRs) == 0 && ptr.Deref(s.Provider, "") == "")
+	return s == nil || ((s.Enabled == nil || !*s.Enabled) && len(s.AdditionalPorts) == 0 && len(s.AllowedCIDRs) == 0 && ptr.Deref(s.Provider, "") == "" && len(s.Listeners) == 0 && len(s.Pools) == 0 && s.LoadBalancerRef == nil)
 }
 
 func (s *APIServerLoadBalancer) IsEnabled() bool {
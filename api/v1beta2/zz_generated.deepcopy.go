@@ -35,6 +35,11 @@ func (in *APIServerLoadBalancer) DeepCopyInto(out *APIServerLoadBalancer) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LoadBalancerRef != nil {
+		in, out := &in.LoadBalancerRef, &out.LoadBalancerRef
+		*out = new(LoadBalancerParam)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalPorts != nil {
 		in, out := &in.AdditionalPorts, &out.AdditionalPorts
 		*out = make([]int, len(*in))
@@ -77,6 +82,30 @@ func (in *APIServerLoadBalancer) DeepCopyInto(out *APIServerLoadBalancer) {
 		*out = new(APIServerLoadBalancerMonitor)
 		**out = **in
 	}
+	if in.MemberDrainTimeout != nil {
+		in, out := &in.MemberDrainTimeout, &out.MemberDrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MemberDrainMode != nil {
+		in, out := &in.MemberDrainMode, &out.MemberDrainMode
+		*out = new(MemberDrainMode)
+		**out = **in
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]LoadBalancerListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]LoadBalancerPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancer.
@@ -104,6 +133,31 @@ func (in *APIServerLoadBalancerMonitor) DeepCopy() *APIServerLoadBalancerMonitor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcceleratorRequirements) DeepCopyInto(out *AcceleratorRequirements) {
+	*out = *in
+	if in.PCIAliases != nil {
+		in, out := &in.PCIAliases, &out.PCIAliases
+		*out = make([]PCIAliasRequirement, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]PlacementResourceRequirement, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceleratorRequirements.
+func (in *AcceleratorRequirements) DeepCopy() *AcceleratorRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(AcceleratorRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdditionalBlockDevice) DeepCopyInto(out *AdditionalBlockDevice) {
 	*out = *in
@@ -120,6 +174,66 @@ func (in *AdditionalBlockDevice) DeepCopy() *AdditionalBlockDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressGroupFilter) DeepCopyInto(out *AddressGroupFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressGroupFilter.
+func (in *AddressGroupFilter) DeepCopy() *AddressGroupFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressGroupFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressGroupParam) DeepCopyInto(out *AddressGroupParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AddressGroupFilter)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressGroupParam.
+func (in *AddressGroupParam) DeepCopy() *AddressGroupParam {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressGroupParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressGroupStatus) DeepCopyInto(out *AddressGroupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressGroupStatus.
+func (in *AddressGroupStatus) DeepCopy() *AddressGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressPair) DeepCopyInto(out *AddressPair) {
 	*out = *in
@@ -155,6 +269,22 @@ func (in *AllocationPool) DeepCopy() *AllocationPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityZoneAttempt) DeepCopyInto(out *AvailabilityZoneAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZoneAttempt.
+func (in *AvailabilityZoneAttempt) DeepCopy() *AvailabilityZoneAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityZoneAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bastion) DeepCopyInto(out *Bastion) {
 	*out = *in
@@ -268,6 +398,31 @@ func (in *BlockDeviceVolume) DeepCopyInto(out *BlockDeviceVolume) {
 		*out = new(VolumeAvailabilityZone)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
+		*out = new(VolumeSnapshotParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeRef != nil {
+		in, out := &in.VolumeRef, &out.VolumeRef
+		*out = new(VolumeParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]VolumeMetadata, len(*in))
+		copy(*out, *in)
+	}
+	if in.SchedulerHints != nil {
+		in, out := &in.SchedulerHints, &out.SchedulerHints
+		*out = new(VolumeSchedulerHints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Multiattach != nil {
+		in, out := &in.Multiattach, &out.Multiattach
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockDeviceVolume.
@@ -335,6 +490,26 @@ func (in *ClusterInitialization) DeepCopy() *ClusterInitialization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLoadBalancersExtensionsSpec) DeepCopyInto(out *ClusterLoadBalancersExtensionsSpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressLoadBalancerExtensionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoadBalancersExtensionsSpec.
+func (in *ClusterLoadBalancersExtensionsSpec) DeepCopy() *ClusterLoadBalancersExtensionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLoadBalancersExtensionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterLoadBalancersExtensionsStatus) DeepCopyInto(out *ClusterLoadBalancersExtensionsStatus) {
 	*out = *in
@@ -535,6 +710,31 @@ func (in *ClusterVIPStatus) DeepCopy() *ClusterVIPStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleLogCapture) DeepCopyInto(out *ConsoleLogCapture) {
+	*out = *in
+	if in.Lines != nil {
+		in, out := &in.Lines, &out.Lines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeTimeout != nil {
+		in, out := &in.NodeTimeout, &out.NodeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleLogCapture.
+func (in *ConsoleLogCapture) DeepCopy() *ConsoleLogCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleLogCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalRouterIPParam) DeepCopyInto(out *ExternalRouterIPParam) {
 	*out = *in
@@ -611,6 +811,61 @@ func (in *FixedIP) DeepCopy() *FixedIP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorExtraSpec) DeepCopyInto(out *FlavorExtraSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorExtraSpec.
+func (in *FlavorExtraSpec) DeepCopy() *FlavorExtraSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorExtraSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorSelector) DeepCopyInto(out *FlavorSelector) {
+	*out = *in
+	if in.MinVCPUs != nil {
+		in, out := &in.MinVCPUs, &out.MinVCPUs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRAMMiB != nil {
+		in, out := &in.MinRAMMiB, &out.MinRAMMiB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRAMMiB != nil {
+		in, out := &in.MaxRAMMiB, &out.MaxRAMMiB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinDiskGiB != nil {
+		in, out := &in.MinDiskGiB, &out.MinDiskGiB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraSpecs != nil {
+		in, out := &in.ExtraSpecs, &out.ExtraSpecs
+		*out = make([]FlavorExtraSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorSelector.
+func (in *FlavorSelector) DeepCopy() *FlavorSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageFilter) DeepCopyInto(out *ImageFilter) {
 	*out = *in
@@ -626,18 +881,278 @@ func (in *ImageFilter) DeepCopyInto(out *ImageFilter) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFilter.
-func (in *ImageFilter) DeepCopy() *ImageFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFilter.
+func (in *ImageFilter) DeepCopy() *ImageFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageParam) DeepCopyInto(out *ImageParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(ImageFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.OpenStackImageRef != nil {
+		in, out := &in.OpenStackImageRef, &out.OpenStackImageRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageParam.
+func (in *ImageParam) DeepCopy() *ImageParam {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressLoadBalancerExtensionsSpec) DeepCopyInto(out *IngressLoadBalancerExtensionsSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressLoadBalancerExtensionsSpec.
+func (in *IngressLoadBalancerExtensionsSpec) DeepCopy() *IngressLoadBalancerExtensionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressLoadBalancerExtensionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRemediation) DeepCopyInto(out *InstanceRemediation) {
+	*out = *in
+	if in.StartShutoff != nil {
+		in, out := &in.StartShutoff, &out.StartShutoff
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Unpause != nil {
+		in, out := &in.Unpause, &out.Unpause
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxErrorReboots != nil {
+		in, out := &in.MaxErrorReboots, &out.MaxErrorReboots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRemediation.
+func (in *InstanceRemediation) DeepCopy() *InstanceRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerNetwork != nil {
+		in, out := &in.LoadBalancerNetwork, &out.LoadBalancerNetwork
+		*out = new(NetworkStatusWithSubnets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
+func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerFilter) DeepCopyInto(out *LoadBalancerFilter) {
+	*out = *in
+	in.FilterByNeutronTags.DeepCopyInto(&out.FilterByNeutronTags)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerFilter.
+func (in *LoadBalancerFilter) DeepCopy() *LoadBalancerFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerL7Policy) DeepCopyInto(out *LoadBalancerL7Policy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]LoadBalancerL7Rule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerL7Policy.
+func (in *LoadBalancerL7Policy) DeepCopy() *LoadBalancerL7Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerL7Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerL7Rule) DeepCopyInto(out *LoadBalancerL7Rule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerL7Rule.
+func (in *LoadBalancerL7Rule) DeepCopy() *LoadBalancerL7Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerL7Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerListener) DeepCopyInto(out *LoadBalancerListener) {
+	*out = *in
+	if in.MemberPort != nil {
+		in, out := &in.MemberPort, &out.MemberPort
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultTLSContainerRef != nil {
+		in, out := &in.DefaultTLSContainerRef, &out.DefaultTLSContainerRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSSecretName != nil {
+		in, out := &in.TLSSecretName, &out.TLSSecretName
+		*out = new(string)
+		**out = **in
+	}
+	in.Members.DeepCopyInto(&out.Members)
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(LoadBalancerListenerMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.L7Policies != nil {
+		in, out := &in.L7Policies, &out.L7Policies
+		*out = make([]LoadBalancerL7Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerListener.
+func (in *LoadBalancerListener) DeepCopy() *LoadBalancerListener {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerListenerMonitor) DeepCopyInto(out *LoadBalancerListenerMonitor) {
+	*out = *in
+	if in.URLPath != nil {
+		in, out := &in.URLPath, &out.URLPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpectedCodes != nil {
+		in, out := &in.ExpectedCodes, &out.ExpectedCodes
+		*out = new(string)
+		**out = **in
+	}
+	out.APIServerLoadBalancerMonitor = in.APIServerLoadBalancerMonitor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerListenerMonitor.
+func (in *LoadBalancerListenerMonitor) DeepCopy() *LoadBalancerListenerMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerListenerMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerMemberSelector) DeepCopyInto(out *LoadBalancerMemberSelector) {
+	*out = *in
+	if in.MachineSelector != nil {
+		in, out := &in.MachineSelector, &out.MachineSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerMemberSelector.
+func (in *LoadBalancerMemberSelector) DeepCopy() *LoadBalancerMemberSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageFilter)
+	out := new(LoadBalancerMemberSelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageParam) DeepCopyInto(out *ImageParam) {
+func (in *LoadBalancerParam) DeepCopyInto(out *LoadBalancerParam) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
@@ -646,52 +1161,38 @@ func (in *ImageParam) DeepCopyInto(out *ImageParam) {
 	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
-		*out = new(ImageFilter)
+		*out = new(LoadBalancerFilter)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ImageRef != nil {
-		in, out := &in.ImageRef, &out.ImageRef
-		*out = new(ResourceReference)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageParam.
-func (in *ImageParam) DeepCopy() *ImageParam {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerParam.
+func (in *LoadBalancerParam) DeepCopy() *LoadBalancerParam {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageParam)
+	out := new(LoadBalancerParam)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+func (in *LoadBalancerPool) DeepCopyInto(out *LoadBalancerPool) {
 	*out = *in
-	if in.AllowedCIDRs != nil {
-		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.LoadBalancerNetwork != nil {
-		in, out := &in.LoadBalancerNetwork, &out.LoadBalancerNetwork
-		*out = new(NetworkStatusWithSubnets)
+	in.Members.DeepCopyInto(&out.Members)
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(LoadBalancerListenerMonitor)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
-func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPool.
+func (in *LoadBalancerPool) DeepCopy() *LoadBalancerPool {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancer)
+	out := new(LoadBalancerPool)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -776,6 +1277,36 @@ func (in *MachineResources) DeepCopy() *MachineResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecurityGroupSettings) DeepCopyInto(out *ManagedSecurityGroupSettings) {
+	*out = *in
+	if in.Stateful != nil {
+		in, out := &in.Stateful, &out.Stateful
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecurityGroupSettings.
+func (in *ManagedSecurityGroupSettings) DeepCopy() *ManagedSecurityGroupSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecurityGroupSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSecurityGroups) DeepCopyInto(out *ManagedSecurityGroups) {
 	*out = *in
@@ -800,6 +1331,21 @@ func (in *ManagedSecurityGroups) DeepCopyInto(out *ManagedSecurityGroups) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ManagedSecurityGroupSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(ManagedSecurityGroupSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(ManagedSecurityGroupSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecurityGroups.
@@ -956,6 +1502,11 @@ func (in *OpenStackClusterExtensionsSpec) DeepCopyInto(out *OpenStackClusterExte
 		*out = new(ClusterOpenStackExtensionsSpec)
 		**out = **in
 	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(ClusterLoadBalancersExtensionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterExtensionsSpec.
@@ -1150,6 +1701,21 @@ func (in *OpenStackClusterSpec) DeepCopyInto(out *OpenStackClusterSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.IdentityRef = in.IdentityRef
+	if in.VolumePolicy != nil {
+		in, out := &in.VolumePolicy, &out.VolumePolicy
+		*out = new(VolumePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceRemediation != nil {
+		in, out := &in.InstanceRemediation, &out.InstanceRemediation
+		*out = new(InstanceRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsoleLogCapture != nil {
+		in, out := &in.ConsoleLogCapture, &out.ConsoleLogCapture
+		*out = new(ConsoleLogCapture)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Extensions != nil {
 		in, out := &in.Extensions, &out.Extensions
 		*out = new(OpenStackClusterExtensionsSpec)
@@ -1217,11 +1783,21 @@ func (in *OpenStackClusterStatus) DeepCopyInto(out *OpenStackClusterStatus) {
 		*out = new(SecurityGroupStatus)
 		**out = **in
 	}
+	if in.AddressGroups != nil {
+		in, out := &in.AddressGroups, &out.AddressGroups
+		*out = make([]AddressGroupStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Bastion != nil {
 		in, out := &in.Bastion, &out.Bastion
 		*out = new(BastionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]StandaloneServerStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1473,6 +2049,16 @@ func (in *OpenStackMachineSpec) DeepCopyInto(out *OpenStackMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FlavorSelector != nil {
+		in, out := &in.FlavorSelector, &out.FlavorSelector
+		*out = new(FlavorSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = new(AcceleratorRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Image.DeepCopyInto(&out.Image)
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
@@ -1515,6 +2101,16 @@ func (in *OpenStackMachineSpec) DeepCopyInto(out *OpenStackMachineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HotAttachVolumes != nil {
+		in, out := &in.HotAttachVolumes, &out.HotAttachVolumes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AvailabilityZoneFallback != nil {
+		in, out := &in.AvailabilityZoneFallback, &out.AvailabilityZoneFallback
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ServerGroup != nil {
 		in, out := &in.ServerGroup, &out.ServerGroup
 		*out = new(ServerGroupParam)
@@ -1587,6 +2183,13 @@ func (in *OpenStackMachineStatus) DeepCopyInto(out *OpenStackMachineStatus) {
 		*out = new(MachineResources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AvailabilityZoneAttempts != nil {
+		in, out := &in.AvailabilityZoneAttempts, &out.AvailabilityZoneAttempts
+		*out = make([]AvailabilityZoneAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1725,6 +2328,36 @@ func (in *OpenStackMachineTemplateStatus) DeepCopy() *OpenStackMachineTemplateSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PCIAliasRequirement) DeepCopyInto(out *PCIAliasRequirement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PCIAliasRequirement.
+func (in *PCIAliasRequirement) DeepCopy() *PCIAliasRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(PCIAliasRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementResourceRequirement) DeepCopyInto(out *PlacementResourceRequirement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementResourceRequirement.
+func (in *PlacementResourceRequirement) DeepCopy() *PlacementResourceRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementResourceRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortOpts) DeepCopyInto(out *PortOpts) {
 	*out = *in
@@ -2184,6 +2817,11 @@ func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
 		*out = make([]ManagedSecurityGroupName, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemoteAddressGroup != nil {
+		in, out := &in.RemoteAddressGroup, &out.RemoteAddressGroup
+		*out = new(AddressGroupParam)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
@@ -2244,6 +2882,11 @@ func (in *ServerGroupParam) DeepCopyInto(out *ServerGroupParam) {
 		*out = new(ServerGroupFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServerGroupRef != nil {
+		in, out := &in.ServerGroupRef, &out.ServerGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupParam.
@@ -2271,6 +2914,21 @@ func (in *ServerMetadata) DeepCopy() *ServerMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StandaloneServerStatus) DeepCopyInto(out *StandaloneServerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandaloneServerStatus.
+func (in *StandaloneServerStatus) DeepCopy() *StandaloneServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StandaloneServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -2391,3 +3049,162 @@ func (in *VolumeAvailabilityZone) DeepCopy() *VolumeAvailabilityZone {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeFilter) DeepCopyInto(out *VolumeFilter) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFilter.
+func (in *VolumeFilter) DeepCopy() *VolumeFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMetadata) DeepCopyInto(out *VolumeMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMetadata.
+func (in *VolumeMetadata) DeepCopy() *VolumeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeParam) DeepCopyInto(out *VolumeParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(VolumeFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeParam.
+func (in *VolumeParam) DeepCopy() *VolumeParam {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicy) DeepCopyInto(out *VolumePolicy) {
+	*out = *in
+	if in.RequireEncryption != nil {
+		in, out := &in.RequireEncryption, &out.RequireEncryption
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicy.
+func (in *VolumePolicy) DeepCopy() *VolumePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSchedulerHints) DeepCopyInto(out *VolumeSchedulerHints) {
+	*out = *in
+	if in.SameHost != nil {
+		in, out := &in.SameHost, &out.SameHost
+		*out = make([]VolumeParam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DifferentHost != nil {
+		in, out := &in.DifferentHost, &out.DifferentHost
+		*out = make([]VolumeParam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSchedulerHints.
+func (in *VolumeSchedulerHints) DeepCopy() *VolumeSchedulerHints {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSchedulerHints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotFilter) DeepCopyInto(out *VolumeSnapshotFilter) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotFilter.
+func (in *VolumeSnapshotFilter) DeepCopy() *VolumeSnapshotFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotParam) DeepCopyInto(out *VolumeSnapshotParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(VolumeSnapshotFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotParam.
+func (in *VolumeSnapshotParam) DeepCopy() *VolumeSnapshotParam {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotParam)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -324,6 +324,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                          schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
 		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                           schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
 		"k8s.io/apimachinery/pkg/version.Info":                                                              schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.FloatingIPDNSRecord":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_FloatingIPDNSRecord(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.FloatingIPReservation":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_FloatingIPReservation(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ImageChecksum":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ImageChecksum(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ImageSource":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ImageSource(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentity":                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentity(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityList":              schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentityList(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentitySpec":              schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentitySpec(ref),
@@ -332,17 +336,45 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolList":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolList(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolSpec":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolStatus":             schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImage":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImage(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageList":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImageList(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageSpec":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImageSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageStatus":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImageStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServer(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroup":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroup(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupList":                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroupList(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupSpec":                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroupSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupStatus":                schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroupStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerList":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerList(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotSchedule":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotSchedule(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleList":       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotScheduleList(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleSpec":       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotScheduleSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleStatus":     schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotScheduleStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyFilter":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_QoSPolicyFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyParam":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_QoSPolicyParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyStatus":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_QoSPolicyStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedServerSpec":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedServerSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedVolumeSpec":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedVolumeSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerRebuildStatus":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerRebuildStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerRemediationStatus":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerRemediationStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResizeStatus":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerResizeStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResources":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerResources(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerVolumeStatus":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerVolumeStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.StickyFloatingIP":                          schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_StickyFloatingIP(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.VolumeSnapshotRetention":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_VolumeSnapshotRetention(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.VolumeSnapshotStatus":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_VolumeSnapshotStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancer":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancer(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancerMonitor":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancerMonitor(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AcceleratorRequirements":                    schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AcceleratorRequirements(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AdditionalBlockDevice(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupFilter":                         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressGroupFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupParam":                          schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressGroupParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupStatus":                         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressGroupStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressPair":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressPair(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AllocationPool":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AllocationPool(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AvailabilityZoneAttempt":                    schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AvailabilityZoneAttempt(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Bastion":                                    schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_Bastion(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BastionStatus":                              schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BastionStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BindingProfile":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BindingProfile(ref),
@@ -351,6 +383,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.CiliumNetworkingStatus":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_CiliumNetworkingStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterEndpointsExtensionsStatus":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterEndpointsExtensionsStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterInitialization":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterInitialization(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterLoadBalancersExtensionsSpec":         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterLoadBalancersExtensionsSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterLoadBalancersExtensionsStatus":       schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterLoadBalancersExtensionsStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterNetworkInterfacesExtensionsSpec":     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkInterfacesExtensionsSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterNetworkingExtensionsSpec":            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkingExtensionsSpec(ref),
@@ -362,17 +395,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformManagementStatus":            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformManagementStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformNTPStatus":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformNTPStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterVIPStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ConsoleLogCapture":                          schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ConsoleLogCapture(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ExternalRouterIPParam":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ExternalRouterIPParam(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FilterByNeutronTags":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FilterByNeutronTags(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FixedIP":                                    schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FixedIP(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorExtraSpec":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FlavorExtraSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorSelector":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FlavorSelector(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageFilter":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ImageFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam":                                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ImageParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.IngressLoadBalancerExtensionsSpec":          schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_IngressLoadBalancerExtensionsSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.InstanceRemediation":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_InstanceRemediation(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancer":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancer(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerFilter":                         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerL7Policy":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerL7Policy(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerL7Rule":                         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerL7Rule(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListener":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerListener(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListenerMonitor":                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerListenerMonitor(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerMemberSelector":                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerMemberSelector(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerParam":                          schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerPool":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerPool(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineInitialization":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_MachineInitialization(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineLoadBalancersSpec":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_MachineLoadBalancersSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineMemoryExtensionsSpec":                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_MachineMemoryExtensionsSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineNetworkInterfacesSpec":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_MachineNetworkInterfacesSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_MachineResources(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroupSettings":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ManagedSecurityGroupSettings(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroups":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ManagedSecurityGroups(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkFilter":                              schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_NetworkFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_NetworkParam(ref),
@@ -401,6 +448,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineTemplateResource":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachineTemplateResource(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineTemplateSpec":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachineTemplateSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineTemplateStatus":             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachineTemplateStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PCIAliasRequirement":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PCIAliasRequirement(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PlacementResourceRequirement":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PlacementResourceRequirement(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts":                                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PortOpts(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortStatus":                                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PortStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedFixedIP":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ResolvedFixedIP(ref),
@@ -422,12 +471,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupFilter":                          schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ServerGroupFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ServerGroupParam(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ServerMetadata(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.StandaloneServerStatus":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_StandaloneServerStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Subnet":                                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_Subnet(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetFilter":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_SubnetFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_SubnetParam(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetSpec":                                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_SubnetSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ValueSpec":                                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ValueSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeAvailabilityZone(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeFilter":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeMetadata":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeMetadata(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumePolicy":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumePolicy(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSchedulerHints":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeSchedulerHints(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotFilter":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeSnapshotFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotParam":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeSnapshotParam(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta1.APIEndpoint":                                              schema_cluster_api_api_core_v1beta1_APIEndpoint(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta1.Bootstrap":                                                schema_cluster_api_api_core_v1beta1_Bootstrap(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta1.Cluster":                                                  schema_cluster_api_api_core_v1beta1_Cluster(ref),
@@ -17184,6 +17241,136 @@ func schema_k8sio_apimachinery_pkg_version_Info(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_FloatingIPDNSRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPDNSRecord is the DNS name and domain a floating IP was created with.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the floating IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSName is the DNS name of the floating IP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSDomain is the DNS domain of the floating IP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"ip"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_FloatingIPReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPReservation pins a floating IP to the claims matching it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the floating IP. It must already exist in OpenStack.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the IPAddressClaim the floating IP is allocated to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimSelector selects the IPAddressClaims the floating IP may be allocated to by their labels. The claims of machines carry the labels of the OpenStackMachine.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"ip"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ImageChecksum(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageChecksum is the expected checksum of the image data.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the hash algorithm of the checksum.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the hex encoded checksum.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"algorithm", "value"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ImageSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageSource is the location of the image data. Exactly one of the properties must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is an http or https URL of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI is a reference to an OCI artifact containing the image as its only layer, in the form registry/repository:tag or registry/repository@digest. Only registries which allow anonymous pulls are supported.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -17458,6 +17645,13 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 							Format:      "int32",
 						},
 					},
+					"utilizationThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UtilizationThreshold is the percentage of MaxIPs which may be claimed before the pool warns that it is running out of floating IPs. The UtilizationBelowThreshold condition is set to false and an event is emitted when it is reached.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"identityRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IdentityRef is a reference to a identity to be used when reconciling this pool.",
@@ -17479,12 +17673,73 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 							Format:      "",
 						},
 					},
+					"floatingIPSubnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingIPSubnet is the subnet of the floating IP network to allocate new floating IPs from. If not set, Neutron picks a subnet.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+						},
+					},
+					"qosPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QoSPolicy is the QoS policy applied to new floating IPs.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyParam"),
+						},
+					},
+					"dnsName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSName is a template of the DNS name of a floating IP created for a claim, e.g. \"{{ .ClaimName }}\". It is a Go template with the fields PoolName, Namespace, ClaimName, ClusterName and Labels of the claim. It requires the DNS integration of Neutron.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSDomain is a template of the DNS domain of a floating IP created for a claim, e.g. \"{{ .ClusterName }}.example.com.\". It is a Go template with the same fields as DNSName.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a template of the description of new floating IPs. It is a Go template with the fields PoolName and Namespace. If not set, the description names the pool. The UID of the pool is always appended to the description.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reservations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"ip",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Reservations pins floating IPs to the claims matching them. A reserved floating IP is only allocated to a matching claim, and is never deleted when it is released.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.FloatingIPReservation"),
+									},
+								},
+							},
+						},
+					},
+					"stickyGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StickyGracePeriod enables sticky allocation. A floating IP released by a claim is held for a claim with the same name for this period, before it is returned to the pool or deleted according to the reclaim policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"identityRef", "reclaimPolicy"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.FloatingIPReservation", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
 	}
 }
 
@@ -17538,12 +17793,75 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 							},
 						},
 					},
+					"stickyIPs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"ip",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "StickyIPs are the released floating IPs which are held for the claims which released them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.StickyFloatingIP"),
+									},
+								},
+							},
+						},
+					},
 					"floatingIPNetwork": {
 						SchemaProps: spec.SchemaProps{
 							Description: "floatingIPNetwork contains information about the network used for floating ips",
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatus"),
 						},
 					},
+					"floatingIPSubnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingIPSubnet contains information about the subnet new floating IPs are allocated from.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Subnet"),
+						},
+					},
+					"qosPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QoSPolicy contains information about the QoS policy applied to new floating IPs.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyStatus"),
+						},
+					},
+					"floatingIPDescription": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingIPDescription is the description of new floating IPs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsRecords": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"ip",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSRecords are the DNS names and domains of the floating IPs of the pool which were created with one.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.FloatingIPDNSRecord"),
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -17561,15 +17879,15 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatus", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.FloatingIPDNSRecord", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.StickyFloatingIP", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Subnet", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServer is the Schema for the openstackservers API.",
+				Description: "OpenStackImage is the Schema for the openstackimages API. It is a Glance image which is imported and deleted by CAPO.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -17595,28 +17913,28 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec"),
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"),
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImageStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServerList contains a list of OpenStackServer.",
+				Description: "OpenStackImageList contains a list of OpenStackImage.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -17646,7 +17964,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"),
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImage"),
 									},
 								},
 							},
@@ -17657,458 +17975,438 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackImage"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServerSpec defines the desired state of OpenStackServer.",
+				Description: "OpenStackImageSpec defines the desired state of OpenStackImage.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"additionalBlockDevices": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AdditionalBlockDevices is a list of specifications for additional block devices to attach to the server instance.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice"),
-									},
-								},
-							},
+							Description: "Source is the location of the image data.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ImageSource"),
 						},
 					},
-					"availabilityZone": {
+					"checksum": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AvailabilityZone is the availability zone in which to create the server instance.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Checksum is the expected checksum of the image data. The image is deleted again if the data does not match it.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ImageChecksum"),
 						},
 					},
-					"configDrive": {
+					"diskFormat": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigDrive is a flag to enable config drive for the server instance.",
-							Type:        []string{"boolean"},
+							Description: "DiskFormat is the disk format of the image.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flavor": {
+					"containerFormat": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The flavor reference for the flavor for the server instance.",
+							Description: "ContainerFormat is the container format of the image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flavorID": {
+					"importMethod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlavorID allows flavors to be specified by ID.  This field takes precedence over Flavor.",
+							Description: "ImportMethod is the way the image data is transferred to Glance.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"floatingIPPoolRef": {
+					"identityRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FloatingIPPoolRef is a reference to a FloatingIPPool to allocate a floating IP from.",
-							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
-						},
-					},
-					"identityRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "IdentityRef is a reference to a secret holding OpenStack credentials.",
+							Description: "IdentityRef is a reference to a identity to be used when reconciling this image.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"),
 						},
 					},
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The image to use for the server instance.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam"),
-						},
-					},
-					"ports": {
+				},
+				Required: []string{"source", "checksum", "diskFormat", "identityRef"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ImageChecksum", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ImageSource", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackImageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackImageStatus defines the observed state of OpenStackImage.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ready": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports to be attached to the server instance.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts"),
-									},
-								},
-							},
+							Description: "Ready is true when the image is active and its checksum was verified.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"rootVolume": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RootVolume is the specification for the root volume of the server instance.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RootVolume"),
+							Description: "ID is the ID of the Glance image.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sshKeyName": {
+					"importMethod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHKeyName is the name of the SSH key to inject in the instance.",
-							Default:     "",
+							Description: "ImportMethod is the way the image data was transferred to Glance.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"securityGroups": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecurityGroups is a list of security groups names to assign to the instance.",
+							Description: "Conditions defines current service state of the OpenStackImage.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupParam"),
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"serverGroup": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ServerGroup is the server group to which the server instance belongs.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam"),
-						},
-					},
-					"serverMetadata": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"key",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServer is the Schema for the openstackservers API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerMetadata is a map of key value pairs to add to the server instance.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tags": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
-						},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags which will be added to the machine and all dependent resources which support them. These are in addition to Tags defined on the cluster. Requires Nova api 2.52 minimum!",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"trunk": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Trunk is a flag to indicate if the server instance is created on a trunk port or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"userDataRef": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UserDataRef is a reference to a secret containing the user data to be injected into the server instance.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec"),
 						},
 					},
-					"schedulerHintAdditionalProperties": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerHintAdditionalProperties are arbitrary key/value pairs that provide additional hints to the OpenStack scheduler. These hints can influence how instances are placed on the infrastructure, such as specifying certain host aggregates or availability zones.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SchedulerHintAdditionalProperty"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"),
 						},
 					},
 				},
-				Required: []string{"identityRef", "image", "ports", "sshKeyName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.TypedLocalObjectReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RootVolume", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SchedulerHintAdditionalProperty", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServerStatus defines the observed state of OpenStackServer.",
+				Description: "OpenStackServerGroup is the Schema for the openstackservergroups API. It is a Nova server group which is created and deleted by CAPO.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ready": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ready is true when the OpenStack server is ready.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"instanceID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstanceID is the ID of the server instance.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"instanceState": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstanceState is the state of the server instance.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"addresses": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Addresses is the list of addresses of the server instance.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.NodeAddress"),
-									},
-								},
-							},
-						},
-					},
-					"resolved": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resolved contains parts of the machine spec with all external references fully resolved.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedServerSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"resources": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources contains references to OpenStack resources created for the machine.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResources"),
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupSpec"),
 						},
 					},
-					"conditions": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions defines current service state of the OpenStackServer.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupStatus"),
 						},
 					},
 				},
-				Required: []string{"ready"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.NodeAddress", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedServerSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResources", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroupStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedServerSpec contains resolved references to resources required by the server.",
+				Description: "OpenStackServerGroupList contains a list of OpenStackServerGroup.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"serverGroupID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerGroupID is the ID of the server group the server should be added to and is calculated based on ServerGroupFilter.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageID": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageID is the ID of the image to use for the server and is calculated based on ImageFilter.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flavorID": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlavorID is the ID of the flavor to use.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"ports": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports is the fully resolved list of ports to create for the server.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedPortSpec"),
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroup"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedPortSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerGroup"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ServerResources contains references to OpenStack resources created for the server.",
+				Description: "OpenStackServerGroupSpec defines the desired state of OpenStackServerGroup.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ports": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports is the status of the ports created for the server.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortStatus"),
-									},
-								},
-							},
+							Description: "Policy is the scheduling policy of the server group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortStatus"},
+					"maxServerPerHost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxServerPerHost is the maximum number of servers of the group on a single host. It may only be set if policy is anti-affinity, and requires Nova microversion 2.64.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"identityRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdentityRef is a reference to a identity to be used when reconciling this server group.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"),
+						},
+					},
+				},
+				Required: []string{"policy", "identityRef"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "OpenStackServerGroupStatus defines the observed state of OpenStackServerGroup.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"ready": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines whether a load balancer should be created. This value defaults to true if an APIServerLoadBalancer is given.\n\nThere is no reason to set this to false. To disable creation of the API server loadbalancer, omit the APIServerLoadBalancer field in the cluster spec instead.",
+							Description: "Ready is true when the server group has been created.",
+							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"additionalPorts": {
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the server group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"members": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "AdditionalPorts adds additional tcp ports to the load balancer.",
+							Description: "Members is the list of the IDs of the servers in the server group.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int32",
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"allowedCIDRs": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
-						},
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AllowedCIDRs restrict access to all API-Server listeners to the given address CIDRs.",
+							Description: "Conditions defines current service state of the OpenStackServerGroup.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"provider": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServerList contains a list of OpenStackServer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider specifies name of a specific Octavia provider to use for the API load balancer. The Octavia default will be used if it is not specified.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"network": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Network defines which network should the load balancer be allocated on.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"subnets": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServerSpec defines the desired state of OpenStackServer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"additionalBlockDevices": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Subnets define which subnets should the load balancer be allocated on. It is expected that subnets are located on the network specified in this resource. Only the first element is taken into account. kubebuilder:validation:MaxLength:=2",
+							Description: "AdditionalBlockDevices is a list of specifications for additional block devices to attach to the server instance.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice"),
 									},
 								},
 							},
@@ -18116,291 +18414,2114 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBa
 					},
 					"availabilityZone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AvailabilityZone is the failure domain that will be used to create the APIServerLoadBalancer Spec.",
+							Description: "AvailabilityZone is the availability zone in which to create the server instance.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"availabilityZoneFallback": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZoneFallback is an ordered list of availability zones in which to retry creating the server instance if Nova cannot find a valid host for it in AvailabilityZone. The errored server instance is deleted before it is created again in the next availability zone.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"configDrive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigDrive is a flag to enable config drive for the server instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"flavor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Flavor is the flavor name that will be used to create the APIServerLoadBalancer Spec.",
+							Description: "The flavor reference for the flavor for the server instance.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"monitor": {
+					"flavorID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Monitor contains configuration for the load balancer health monitor.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancerMonitor"),
+							Description: "FlavorID allows flavors to be specified by ID.  This field takes precedence over Flavor.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"enabled"},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancerMonitor", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancerMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "APIServerLoadBalancerMonitor contains configuration for the load balancer health monitor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"delay": {
+					"flavorSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Delay is the time in seconds between sending probes to members.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "FlavorSelector selects the smallest or largest flavor which satisfies the given resource requirements. It is used if neither Flavor nor FlavorID is set. The selected flavor is recorded in the resolved spec and does not change afterwards.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorSelector"),
 						},
 					},
-					"timeout": {
+					"accelerators": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the maximum time in seconds for a monitor to wait for a connection to be established before it times out.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Accelerators are the PCI devices and placement resources which the flavor must provide. If FlavorSelector is set, only flavors which provide them are selected. Otherwise the flavor is checked when the server is created.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AcceleratorRequirements"),
 						},
 					},
-					"maxRetries": {
+					"floatingIPPoolRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxRetries is the number of successful checks before changing the operating status of the member to ONLINE.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "FloatingIPPoolRef is a reference to a FloatingIPPool to allocate a floating IP from.",
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
 					},
-					"maxRetriesDown": {
+					"hotAttachVolumes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxRetriesDown is the number of allowed check failures before changing the operating status of the member to ERROR.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "HotAttachVolumes enables adding and removing additionalBlockDevices of type Volume after the server instance has been created. Added volumes are created and attached to the running server instance, and removed volumes are detached. Removed volumes are only deleted if they were created to be attached. Other changes to additionalBlockDevices are not allowed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AdditionalBlockDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AdditionalBlockDevice is a block device to attach to the server.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"inPlaceResize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the block device in the context of a machine. If the block device is a volume, the Cinder volume will be named as a combination of the machine name and this name. Also, this name will be used for tagging the block device. Information about the block device tag can be obtained from the OpenStack metadata API or the config drive. Name cannot be 'root', which is reserved for the root volume.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "InPlaceResize enables changing flavor and flavorID after the server instance has been created. The server instance is then resized in place instead of being recreated. The resize is confirmed once the server instance has been resized to the requested flavor, and reverted if the requested flavor changes while the resize waits for confirmation.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"sizeGiB": {
+					"inPlaceRebuild": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SizeGiB is the size of the block device in gibibytes (GiB).",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "InPlaceRebuild enables changing image after the server instance has been created. The server instance is then rebuilt with the new image instead of being recreated. Its ports, floating IPs and volumes are preserved, and the user data is injected again. Rebuilding erases the root disk of the server instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"storage": {
+					"remediation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Storage specifies the storage type of the block device and additional storage options.",
+							Description: "Remediation is the policy for recovering the server instance when it is no longer ACTIVE. The server instance is not recovered if it is not set.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.InstanceRemediation"),
+						},
+					},
+					"identityRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdentityRef is a reference to a secret holding OpenStack credentials.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceStorage"),
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"),
 						},
 					},
-				},
-				Required: []string{"name", "sizeGiB", "storage"},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceStorage"},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressPair(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ipAddress": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPAddress is the IP address of the allowed address pair. Depending on the configuration of Neutron, it may be supported to specify a CIDR instead of a specific IP address.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The image to use for the server instance.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam"),
 						},
 					},
-					"macAddress": {
+					"ports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MACAddress is the MAC address of the allowed address pair. If not specified, the MAC address will be the MAC address of the port.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Ports to be attached to the server instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts"),
+									},
+								},
+							},
+						},
+					},
+					"requireEncryptedVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireEncryptedVolumes requires the root volume and the volume block devices of the server instance to use an encrypted volume type. The encrypted attribute of each volume is checked once it has been created.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rootVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RootVolume is the specification for the root volume of the server instance.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RootVolume"),
+						},
+					},
+					"sshKeyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeyName is the name of the SSH key to inject in the instance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"securityGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityGroups is a list of security groups names to assign to the instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupParam"),
+									},
+								},
+							},
+						},
+					},
+					"serverGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerGroup is the server group to which the server instance belongs.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam"),
+						},
+					},
+					"serverMetadata": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"key",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerMetadata is a map of key value pairs to add to the server instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata"),
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags which will be added to the machine and all dependent resources which support them. These are in addition to Tags defined on the cluster. Requires Nova api 2.52 minimum!",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"trunk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trunk is a flag to indicate if the server instance is created on a trunk port or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"userDataRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserDataRef is a reference to a secret containing the user data to be injected into the server instance.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"schedulerHintAdditionalProperties": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerHintAdditionalProperties are arbitrary key/value pairs that provide additional hints to the OpenStack scheduler. These hints can influence how instances are placed on the infrastructure, such as specifying certain host aggregates or availability zones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SchedulerHintAdditionalProperty"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"identityRef", "image", "ports", "sshKeyName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.TypedLocalObjectReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AcceleratorRequirements", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorSelector", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.InstanceRemediation", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RootVolume", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SchedulerHintAdditionalProperty", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServerStatus defines the observed state of OpenStackServer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true when the OpenStack server is ready.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"instanceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceID is the ID of the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"instanceState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceState is the state of the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"addresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addresses is the list of addresses of the server instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.NodeAddress"),
+									},
+								},
+							},
+						},
+					},
+					"resolved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resolved contains parts of the machine spec with all external references fully resolved.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedServerSpec"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains references to OpenStack resources created for the machine.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResources"),
+						},
+					},
+					"resize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resize is the status of the in-place resize of the server instance. It is only set while a resize is in progress, or if it failed.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResizeStatus"),
+						},
+					},
+					"rebuild": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rebuild is the status of the rebuild of the server instance. It is only set while a rebuild is in progress, or if it failed.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerRebuildStatus"),
+						},
+					},
+					"remediation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remediation is the status of the recovery of the server instance.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerRemediationStatus"),
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZone is the availability zone in which the server instance is created. It is only set once the server instance has been moved to a fallback availability zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availabilityZoneAttempts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZoneAttempts records the availability zones in which the server instance could not be created because Nova found no valid host.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AvailabilityZoneAttempt"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions defines current service state of the OpenStackServer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ready"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.NodeAddress", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedServerSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerRebuildStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerRemediationStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResizeStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AvailabilityZoneAttempt", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackVolumeSnapshotSchedule is the Schema for the openstackvolumesnapshotschedules API. It takes periodic Cinder snapshots or backups of the volumes of an OpenStackServer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotScheduleStatus"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackVolumeSnapshotScheduleList contains a list of OpenStackVolumeSnapshotSchedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackVolumeSnapshotSchedule"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackVolumeSnapshotScheduleSpec defines the desired state of OpenStackVolumeSnapshotSchedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerRef is a reference to the OpenStackServer whose volumes are snapshotted. The OpenStackServer of an OpenStackMachine has the same name as the OpenStackMachine.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"identityRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdentityRef is a reference to a identity to be used when reconciling this schedule.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes is the list of volumes of the server to snapshot. Volumes are referenced by the name of their additional block device, or by \"root\" for the root volume. If empty, all volumes of the server are snapshotted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of copy to take of each volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between two snapshots of the volumes. Snapshots are taken when the current time crosses a multiple of the interval.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"incremental": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Incremental creates incremental backups. It may only be set if type is Backup. The first backup of a volume is a full backup, and a new full backup is taken whenever the chain of incremental backups depending on the last one would outgrow the retention policy, or the last backup is not available. A chain is only deleted once none of its backups is kept by the retention policy.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention is the retention policy of the snapshots taken by this schedule.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.VolumeSnapshotRetention"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops taking new snapshots. The retention policy is still enforced.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"serverRef", "identityRef", "interval"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.VolumeSnapshotRetention", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackVolumeSnapshotScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackVolumeSnapshotScheduleStatus defines the observed state of OpenStackVolumeSnapshotSchedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the time at which the last snapshots were taken.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"snapshots": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Snapshots is the list of snapshots or backups taken by this schedule which have not been deleted by the retention policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.VolumeSnapshotStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions defines current service state of the schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.VolumeSnapshotStatus", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_QoSPolicyFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QoSPolicyFilter specifies a filter to select a QoS policy. At least one parameter must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the QoS policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectID is the ID of the project of the QoS policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_QoSPolicyParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QoSPolicyParam specifies an OpenStack QoS policy. It may be specified by either ID or filter, but not both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the uuid of the QoS policy. It will not be validated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter specifies a filter to select the QoS policy. It must match exactly one QoS policy.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.QoSPolicyFilter"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_QoSPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QoSPolicyStatus contains basic information about a QoS policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"name", "id"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedServerSpec contains resolved references to resources required by the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverGroupID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerGroupID is the ID of the server group the server should be added to and is calculated based on ServerGroupFilter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageID is the ID of the image to use for the server and is calculated based on ImageFilter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlavorID is the ID of the flavor to use.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is the fully resolved list of ports to create for the server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedPortSpec"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes is the list of resolved references of the root volume and the additional block devices of type Volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedVolumeSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedVolumeSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedPortSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedVolumeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedVolumeSpec contains the resolved references of a volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the additional block device, or \"root\" for the root volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotID is the ID of the snapshot to create the volume from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeID is the ID of the volume to clone the volume from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeTypeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypeID is the ID of the volume type of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"multiattach": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multiattach is true if the volume type of the volume supports multi-attach.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sameHostVolumeIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SameHostVolumeIDs are the IDs of the volumes to create the volume on the same backend as.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"differentHostVolumeIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DifferentHostVolumeIDs are the IDs of the volumes to create the volume on a different backend than.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerRebuildStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerRebuildStatus is the status of a rebuild of a server instance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageID is the ID of the image the server instance is rebuilt with.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is true if the server instance could not be rebuilt. A failed rebuild is not retried until the requested image changes.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"imageID"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerRemediationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerRemediationStatus is the status of the recovery of a server instance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"errorReboots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorReboots is the number of times the server instance has been hard rebooted because it was in ERROR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of consecutive remediation actions taken since the server instance was last ACTIVE.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAction is the last remediation action taken.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastActionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActionTime is the time of the last remediation action.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerResizeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerResizeStatus is the status of an in-place resize of a server instance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"flavorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlavorID is the ID of the flavor the server instance is resized to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is true if the server instance could not be resized. A failed resize is not retried until the requested flavor changes.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"flavorID"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerResources contains references to OpenStack resources created for the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is the status of the ports created for the server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortStatus"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes is the status of the volumes of the additional block devices of type Volume. It is only reported if hotAttachVolumes is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerVolumeStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerVolumeStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortStatus"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerVolumeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerVolumeStatus is the status of a volume created for an additional block device of a server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the additional block device.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeID is the ID of the volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"device": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Device is the device name of the volume in the server, as reported by Nova.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the attachment of the volume to the server.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is true if the volume was created to be attached to the running server. Only such volumes are deleted when they are removed from the server or the server is deleted. Other volumes, such as those attached when the server was created or pre-existing volumes with the same name, are only detached.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "volumeID", "state"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_StickyFloatingIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StickyFloatingIP is a released floating IP which is held for a claim.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the floating IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the IPAddressClaim which released the floating IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTime is the time after which the floating IP is no longer held for the claim.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"ip", "claimName", "expirationTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_VolumeSnapshotRetention(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotRetention defines which snapshots of a schedule are kept. Snapshots which satisfy any of the limits are deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxCount is the maximum number of snapshots kept per volume. The oldest snapshots are deleted first.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum age of a snapshot.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotStatus is the status of a snapshot or backup of a volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the snapshot or backup.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the snapshot or backup.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume is the name of the snapshotted volume in the schedule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeID is the ID of the snapshotted volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"createdAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedAt is the time at which the snapshot or backup was taken.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the snapshot or backup as reported by Cinder.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"incremental": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Incremental is true if this is an incremental backup, which depends on the previous backups of the volume up to the last full backup.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "name", "volume", "volumeID", "createdAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled defines whether a load balancer should be created. This value defaults to true if an APIServerLoadBalancer is given.\n\nThere is no reason to set this to false. To disable creation of the API server loadbalancer, omit the APIServerLoadBalancer field in the cluster spec instead.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"loadBalancerRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerRef references an existing load balancer, for example one with a pre-approved VIP, which is adopted as the API server load balancer instead of creating a new one. CAPO only manages the listeners, pools and members it creates on the adopted load balancer, and never deletes the load balancer itself or its floating IP. Network, Subnets, Provider, Flavor and AvailabilityZone cannot be set together with LoadBalancerRef.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerParam"),
+						},
+					},
+					"additionalPorts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalPorts adds additional tcp ports to the load balancer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"allowedCIDRs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCIDRs restrict access to all API-Server listeners to the given address CIDRs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider specifies name of a specific Octavia provider to use for the API load balancer. The Octavia default will be used if it is not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"network": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Network defines which network should the load balancer be allocated on.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam"),
+						},
+					},
+					"subnets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Subnets define which subnets should the load balancer be allocated on. It is expected that subnets are located on the network specified in this resource. Only the first element is taken into account. kubebuilder:validation:MaxLength:=2",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+									},
+								},
+							},
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZone is the failure domain that will be used to create the APIServerLoadBalancer Spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flavor is the flavor name that will be used to create the APIServerLoadBalancer Spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"monitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Monitor contains configuration for the load balancer health monitor.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancerMonitor"),
+						},
+					},
+					"memberDrainTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberDrainTimeout enables graceful draining of load balancer members when a machine is deleted. The members of the machine stop receiving new connections as configured by MemberDrainMode, and they are removed once this timeout has expired, or earlier once Octavia reports that they no longer serve connections. If not set, members are removed immediately.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"memberDrainMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberDrainMode is how the members of a deleted machine stop receiving new connections while they are drained. Weight sets their weight to 0, and AdminStateDown disables them for providers which ignore member weights. Defaults to Weight.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"listeners": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Listeners defines additional listeners on the load balancer. Each listener has a dedicated pool whose members are the machines of the cluster selected by the listener's member selector.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListener"),
+									},
+								},
+							},
+						},
+					},
+					"pools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Pools defines additional pools on the load balancer which are not the default pool of a listener. Requests are routed to them by the L7 policies of the HTTP and TERMINATED_HTTPS listeners. Pool names share a namespace with listener names.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerPool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancerMonitor", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListener", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerPool", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancerMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerLoadBalancerMonitor contains configuration for the load balancer health monitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay is the time in seconds between sending probes to members.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum time in seconds for a monitor to wait for a connection to be established before it times out.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of successful checks before changing the operating status of the member to ONLINE.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetriesDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetriesDown is the number of allowed check failures before changing the operating status of the member to ERROR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AcceleratorRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AcceleratorRequirements are the PCI devices and placement resources which the flavor of a machine must provide.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pciAliases": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PCIAliases are PCI passthrough aliases configured in Nova, such as a GPU model, with the number of devices of each alias required. They are matched against the pci_passthrough:alias extra spec of the flavor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PCIAliasRequirement"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"resourceClass",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources are placement resource classes, such as VGPU, with the amount of each resource class required. They are matched against the resources:<class> extra specs of the flavor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PlacementResourceRequirement"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PCIAliasRequirement", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PlacementResourceRequirement"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AdditionalBlockDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalBlockDevice is a block device to attach to the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the block device in the context of a machine. If the block device is a volume, the Cinder volume will be named as a combination of the machine name and this name. Also, this name will be used for tagging the block device. Information about the block device tag can be obtained from the OpenStack metadata API or the config drive. Name cannot be 'root', which is reserved for the root volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sizeGiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SizeGiB is the size of the block device in gibibytes (GiB).",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage specifies the storage type of the block device and additional storage options.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceStorage"),
+						},
+					},
+				},
+				Required: []string{"name", "sizeGiB", "storage"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceStorage"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressGroupFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddressGroupFilter specifies a query to select an OpenStack address group. At least one property must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressGroupParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddressGroupParam specifies an OpenStack Neutron address group. It may be specified by ID, by filter, or as an inline list of addresses, but only one of them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of an existing address group. Must be in UUID format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter specifies a query to select an existing address group. It must match exactly one address group.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupFilter"),
+						},
+					},
+					"addresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Addresses is an inline list of CIDRs. CAPO creates and owns an address group holding these addresses, keeps its membership in sync with the spec, and deletes it when the cluster is deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupFilter"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddressGroupStatus represents the basic information of an OpenStack Neutron address group managed by CAPO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the address group",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id of the address group",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "id"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AddressPair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPAddress is the IP address of the allowed address pair. Depending on the configuration of Neutron, it may be supported to specify a CIDR instead of a specific IP address.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"macAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MACAddress is the MAC address of the allowed address pair. If not specified, the MAC address will be the MAC address of the port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"ipAddress"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AllocationPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start represents the start of the AllocationPool, that is the lowest IP of the pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End represents the end of the AlloctionPool, that is the highest IP of the pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AvailabilityZoneAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AvailabilityZoneAttempt records a failure to create a server instance in an availability zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the availability zone in which the server instance could not be created.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the fault reported by Nova for the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time at which the failure was observed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"zone", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Bastion represents basic information about the bastion node. If you enable bastion, the spec has to be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled means that bastion is enabled. The bastion is enabled by default if this field is not specified. Set this field to false to disable the bastion.\n\nIt is not currently possible to remove the bastion from the cluster spec without first disabling it by setting this field to false and waiting until the bastion has been deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec for the bastion itself",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineSpec"),
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZone is the failure domain that will be used to create the Bastion Spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingIP which will be associated to the bastion machine. It's the IP address, not UUID. The floating IP should already exist and should not be associated with a port. If FIP of this address does not exist, CAPO will try to create it, but by default only OpenStack administrators have privileges to do so.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sshKeyName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"floatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resolved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resolved contains parts of the bastion's machine spec with all external references fully resolved.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedMachineSpec"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains references to OpenStack resources created for the bastion.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedMachineSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BindingProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ovsHWOffload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OVSHWOffload enables or disables the OVS hardware offload feature. This flag is not required on OpenStack clouds since Yoga as Nova will set it automatically when the port is attached. See: https://bugs.launchpad.net/nova/+bug/2020813",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"trustedVF": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustedVF enables or disables the “trusted mode” for the VF.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BlockDeviceStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlockDeviceStorage is the storage type of a block device to create and contains additional storage options.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of block device to create. This can be either \"Volume\" or \"Local\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume contains additional storage options for a volume block device.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceVolume"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-unions": []interface{}{
+						map[string]interface{}{
+							"discriminator": "type",
+							"fields-to-discriminateBy": map[string]interface{}{
+								"volume": "Volume",
+							},
 						},
 					},
 				},
-				Required: []string{"ipAddress"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceVolume"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_AllocationPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BlockDeviceVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "BlockDeviceVolume contains additional storage options for a volume block device.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"start": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Start represents the start of the AllocationPool, that is the lowest IP of the pool.",
-							Default:     "",
+							Description: "Type is the Cinder volume type of the volume. If omitted, the default Cinder volume type that is configured in the OpenStack cloud will be used.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"availabilityZone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End represents the end of the AlloctionPool, that is the highest IP of the pool.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "AvailabilityZone is the volume availability zone to create the volume in. If not specified, the volume will be created without an explicit availability zone.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone"),
+						},
+					},
+					"snapshotRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotRef is a reference to a Cinder volume snapshot to create the volume from. A root volume created from a snapshot is not created from the image of the machine. It cannot be set together with volumeRef.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotParam"),
+						},
+					},
+					"volumeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeRef is a reference to an existing Cinder volume to clone the volume from. A root volume cloned from a volume is not created from the image of the machine. It cannot be set together with snapshotRef.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam"),
+						},
+					},
+					"metadata": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"key",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata is a list of metadata key/value pairs to set on the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeMetadata"),
+									},
+								},
+							},
+						},
+					},
+					"schedulerHints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerHints are hints to the Cinder scheduler to place the volume on the same or on a different backend as other volumes.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSchedulerHints"),
+						},
+					},
+					"multiattach": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multiattach states whether the volume must be attachable to multiple servers. Cinder decides this from the volume type, so if set, type must also be set and the volume type must support multi-attach if true, or must not support it if false. If not set, the volume type decides.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"start", "end"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeMetadata", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSchedulerHints", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotParam"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_CiliumNetworkingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Bastion represents basic information about the bastion node. If you enable bastion, the spec has to be specified.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled means that bastion is enabled. The bastion is enabled by default if this field is not specified. Set this field to false to disable the bastion.\n\nIt is not currently possible to remove the bastion from the cluster spec without first disabling it by setting this field to false and waiting until the bastion has been deleted.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"spec": {
+					"defaultSubnetID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec for the bastion itself",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineSpec"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"availabilityZone": {
+					"securityGroupIDs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AvailabilityZone is the failure domain that will be used to create the Bastion Spec.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"floatingIP": {
+					"webhookEnable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FloatingIP which will be associated to the bastion machine. It's the IP address, not UUID. The floating IP should already exist and should not be associated with a port. If FIP of this address does not exist, CAPO will try to create it, but by default only OpenStack administrators have privileges to do so.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineSpec"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterEndpointsExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"keystone": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"name": {
+					"cinder": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"sshKeyName": {
+					"nova": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"state": {
+					"neutron": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"ip": {
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterInitialization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterInitialization represents the initialization status of the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provisioned": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Provisioned is set to true when the initial provisioning of the cluster infrastructure is completed. The value of this field is never updated after provisioning is completed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"floatingIP": {
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterLoadBalancersExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.IngressLoadBalancerExtensionsSpec"),
 						},
 					},
-					"resolved": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.IngressLoadBalancerExtensionsSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterLoadBalancersExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"controlPlane": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resolved contains parts of the bastion's machine spec with all external references fully resolved.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedMachineSpec"),
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"),
 						},
 					},
-					"resources": {
+					"ingress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources contains references to OpenStack resources created for the bastion.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources"),
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"),
+						},
+					},
+					"harbor": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedMachineSpec"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BindingProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkInterfacesExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ovsHWOffload": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OVSHWOffload enables or disables the OVS hardware offload feature. This flag is not required on OpenStack clouds since Yoga as Nova will set it automatically when the port is attached. See: https://bugs.launchpad.net/nova/+bug/2020813",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"trustedVF": {
+					"flannel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TrustedVF enables or disables the “trusted mode” for the VF.",
-							Type:        []string{"boolean"},
+							Description: "Required when kubeNetworkPlugin is set to flannel.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -18410,145 +20531,128 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BindingProfile(
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BlockDeviceStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkingExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlockDeviceStorage is the storage type of a block device to create and contains additional storage options.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kubeNetworkPlugin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of block device to create. This can be either \"Volume\" or \"Local\".",
+							Description: "Supported values: cilium, flannel.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volume": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Volume contains additional storage options for a volume block device.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceVolume"),
-						},
-					},
 				},
-				Required: []string{"type"},
+				Required: []string{"kubeNetworkPlugin"},
 			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-unions": []interface{}{
-						map[string]interface{}{
-							"discriminator": "type",
-							"fields-to-discriminateBy": map[string]interface{}{
-								"volume": "Volume",
-							},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkingExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cilium": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.CiliumNetworkingStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BlockDeviceVolume"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.CiliumNetworkingStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_BlockDeviceVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterOpenStackAppCredentialStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlockDeviceVolume contains additional storage options for a volume block device.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the Cinder volume type of the volume. If omitted, the default Cinder volume type that is configured in the OpenStack cloud will be used.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"availabilityZone": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AvailabilityZone is the volume availability zone to create the volume in. If not specified, the volume will be created without an explicit availability zone.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_CiliumNetworkingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterOpenStackExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterOpenStackExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"projectID": {
+					"mgmt": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"defaultSubnetID": {
+					"keystone": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"securityGroupIDs": {
+					"cinder": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"webhookEnable": {
+					"nova": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterEndpointsExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"keystone": {
+					"neutron": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"cinder": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"nova": {
+					"projectDomain": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"neutron": {
+					"appCredential": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackAppCredentialStatus"),
+						},
+					},
+					"region": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -18557,264 +20661,484 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterEndpoint
 				},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackAppCredentialStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterInitialization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterInitialization represents the initialization status of the cluster.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"provisioned": {
+					"ntp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provisioned is set to true when the initial provisioning of the cluster infrastructure is completed. The value of this field is never updated after provisioning is completed.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformNTPStatus"),
+						},
+					},
+					"management": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformManagementStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformManagementStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformNTPStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterLoadBalancersExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformManagementStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"controlPlane": {
+					"vip": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"ingress": {
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformNTPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"harbor": {
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterVIPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vip": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"floatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterVIPStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkInterfacesExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ConsoleLogCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ConsoleLogCapture is a policy for capturing the console log of the instances of machines which fail.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"flannel": {
+					"lines": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required when kubeNetworkPlugin is set to flannel.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Lines is the number of lines at the end of the console log which are captured. Defaults to 500.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeTimeout is the time an ACTIVE instance has to become a node before its console log is captured. If it is not set the console log is only captured for instances in ERROR.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkingExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ExternalRouterIPParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubeNetworkPlugin": {
+					"fixedIP": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Supported values: cilium, flannel.",
-							Default:     "",
+							Description: "The FixedIP in the corresponding subnet",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"subnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The subnet in which the FixedIP is used for the Gateway of this router",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+						},
+					},
 				},
-				Required: []string{"kubeNetworkPlugin"},
+				Required: []string{"subnet"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterNetworkingExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FilterByNeutronTags(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cilium": {
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.CiliumNetworkingStatus"),
+							Description: "Tags is a list of tags to filter by. If specified, the resource must have all of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TagsAny is a list of tags to filter by. If specified, the resource must have at least one of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NotTags is a list of tags to filter by. If specified, resources which contain all of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NotTagsAny is a list of tags to filter by. If specified, resources which contain any of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.CiliumNetworkingStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterOpenStackAppCredentialStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FixedIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"subnet": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Subnet is an openstack subnet query that will return the id of a subnet to create the fixed IP of a port in. This query must not return more than one subnet.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+						},
+					},
+					"ipAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPAddress is a specific IP address to assign to the port. If Subnet is also specified, IPAddress must be a valid IP address in the subnet. If Subnet is not specified, IPAddress must be a valid IP address in any subnet of the port's network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterOpenStackExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FlavorExtraSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "FlavorExtraSpec is an extra spec of a flavor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the extra spec.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the extra spec.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"key", "value"},
 			},
 		},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterOpenStackExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FlavorSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "FlavorSelector selects a flavor by its resources instead of by name.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mgmt": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"keystone": {
+					"minVCPUs": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"cinder": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MinVCPUs is the minimum number of vCPUs of the flavor.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"nova": {
+					"minRAMMiB": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MinRAMMiB is the minimum amount of RAM of the flavor in MiB.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"neutron": {
+					"maxRAMMiB": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MaxRAMMiB is the maximum amount of RAM of the flavor in MiB.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"project": {
+					"minDiskGiB": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MinDiskGiB is the minimum size of the root disk of the flavor in GiB.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"projectDomain": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+					"extraSpecs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"key",
+								},
+								"x-kubernetes-list-type": "map",
+							},
 						},
-					},
-					"appCredential": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackAppCredentialStatus"),
+							Description: "ExtraSpecs are extra specs the flavor must have with the given values. Selecting flavors by extra specs requires Nova microversion 2.61.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorExtraSpec"),
+									},
+								},
+							},
 						},
 					},
-					"region": {
+					"preference": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Preference is the order in which matching flavors are preferred. Flavors which are equal in this order are sorted by name.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackAppCredentialStatus"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorExtraSpec"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformExtensionsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ImageFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ImageFilter describes a query for an image.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ntp": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformNTPStatus"),
+							Description: "The name of the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"management": {
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformManagementStatus"),
+							Description: "The tags associated with the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformManagementStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterPlatformNTPStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformManagementStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ImageParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ImageParam describes a glance image. It can be specified by ID, filter, a reference to an ORC Image, or a reference to an OpenStackImage.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vip": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ID is the uuid of the image. ID will not be validated before use.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter describes a query for an image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageFilter"),
+						},
+					},
+					"imageRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageRef is a reference to an ORC Image in the same namespace as the referring object.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference"),
+						},
+					},
+					"openStackImageRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OpenStackImageRef is a reference to an OpenStackImage in the same namespace as the referring object. The image is imported and deleted by CAPO.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageFilter", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatformNTPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_IngressLoadBalancerExtensionsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"server": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Supported values: octavia, keepalived. Defaults to keepalived. In octavia mode the ingress VIP is an Octavia load balancer in front of the worker nodes. The mode cannot be changed once the cluster has been created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports forwarded to the worker nodes in octavia mode. Defaults to 80 and 443.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -18823,59 +21147,164 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterPlatform
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ClusterVIPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_InstanceRemediation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "InstanceRemediation is a policy for recovering the instances of the machines of a cluster which are no longer ACTIVE.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vip": {
+					"startShutoff": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "StartShutoff starts instances which are SHUTOFF.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"unpause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unpause unpauses instances which are PAUSED.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxErrorReboots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxErrorReboots is the number of times an instance in ERROR is hard rebooted before it is failed permanently. Instances in ERROR are not rebooted if it is not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is the time to wait after a remediation action before taking another one. It doubles after every consecutive action, and is reset once the instance is ACTIVE again. Defaults to 1m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ExternalRouterIPParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "LoadBalancer represents basic information about the associated OpenStack LoadBalancer.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"fixedIP": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The FixedIP in the corresponding subnet",
-							Type:        []string{"string"},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"internalIP": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"adopted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adopted is true if the load balancer was not created by CAPO but adopted from spec.apiServerLoadBalancer.loadBalancerRef. An adopted load balancer is not deleted together with the cluster.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"subnet": {
+					"allowedCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"loadBalancerNetwork": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The subnet in which the FixedIP is used for the Gateway of this router",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+							Description: "LoadBalancerNetwork contains information about network and/or subnets which the loadbalancer is allocated on. If subnets are specified within the LoadBalancerNetwork currently only the first subnet in the list is taken into account.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatusWithSubnets"),
 						},
 					},
 				},
-				Required: []string{"subnet"},
+				Required: []string{"name", "id", "ip", "internalIP"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatusWithSubnets"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FilterByNeutronTags(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "LoadBalancerFilter specifies a query to select an OpenStack load balancer. At least one property must be set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"vipAddress": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"tags": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -18962,182 +21391,384 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FilterByNeutron
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_FixedIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerL7Policy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "LoadBalancerL7Policy routes the requests of a listener which match all of its rules to a named pool.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"subnet": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subnet is an openstack subnet query that will return the id of a subnet to create the fixed IP of a port in. This query must not return more than one subnet.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"),
+							Description: "Name is the name of the policy. It must be unique within the listener.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ipAddress": {
+					"pool": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPAddress is a specific IP address to assign to the port. If Subnet is also specified, IPAddress must be a valid IP address in the subnet. If Subnet is not specified, IPAddress must be a valid IP address in any subnet of the port's network.",
+							Description: "Pool is the name of the pool from the Pools of the load balancer the matching requests are routed to.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"rules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules are the conditions a request must all match for the policy to apply.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerL7Rule"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"name", "pool", "rules"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerL7Rule"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ImageFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerL7Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageFilter describes a query for an image.",
+				Description: "LoadBalancerL7Rule is a condition on the requests of a listener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the part of the request the rule matches against.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compareType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompareType is the comparison applied between the request and Value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value the request is compared to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"invert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Invert inverts the result of the comparison.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "value"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerListener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadBalancerListener defines a listener on the cluster load balancer and the pool which backs it.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Description: "Name is the name of the listener. It is used to name the Octavia listener, pool and health monitor, and must be unique within the load balancer.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tags": {
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol of the listener. The pool uses the same protocol, except for TERMINATED_HTTPS whose pool uses HTTP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port the listener listens on.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"memberPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberPort is the port of the members traffic is forwarded to. Defaults to Port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"defaultTLSContainerRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultTLSContainerRef is the URI of the Barbican secret containing the certificate used to terminate TLS. Either DefaultTLSContainerRef or TLSSecretName is required when Protocol is TERMINATED_HTTPS.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSSecretName is the name of a Secret of type kubernetes.io/tls in the namespace of the OpenStackCluster containing the certificate used to terminate TLS. The controller uploads the certificate to Barbican and updates the listener when the content of the Secret changes. Either DefaultTLSContainerRef or TLSSecretName is required when Protocol is TERMINATED_HTTPS.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the load balancing algorithm of the pool. Defaults to SOURCE_IP_PORT for the ovn provider and ROUND_ROBIN otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memberTLS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberTLS makes the load balancer connect to the members of the pool with TLS, e.g. to forward the requests of a TERMINATED_HTTPS listener to members which only accept HTTPS. The certificates of the members are not verified. It is only supported by HTTP and TERMINATED_HTTPS listeners.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"members": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Members selects the machines which are members of the pool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerMemberSelector"),
+						},
+					},
+					"monitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Monitor contains configuration for the health monitor of the pool.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListenerMonitor"),
+						},
+					},
+					"l7Policies": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "The tags associated with the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Description: "L7Policies route the requests matching their rules to one of the named pools of the load balancer instead of the pool of the listener. Policies are evaluated in order and the first matching policy wins. They are only supported by HTTP and TERMINATED_HTTPS listeners.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerL7Policy"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"name", "port", "members"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerL7Policy", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListenerMonitor", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerMemberSelector"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ImageParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerListenerMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageParam describes a glance image. It can be specified by ID, filter, or a reference to an ORC Image.",
+				Description: "LoadBalancerListenerMonitor contains configuration for the health monitor of a load balancer listener's pool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the uuid of the image. ID will not be validated before use.",
+							Description: "Type is the type of the health monitor. Defaults to UDP-CONNECT for UDP listeners, HTTPS for pools with MemberTLS, HTTP for HTTP and TERMINATED_HTTPS listeners, and TCP otherwise. The type must be supported by the protocol of the pool. Changing the type replaces the health monitor.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"filter": {
+					"urlPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter describes a query for an image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageFilter"),
+							Description: "URLPath is the HTTP path requested by HTTP and HTTPS monitors. Defaults to \"/\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"imageRef": {
+					"expectedCodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageRef is a reference to an ORC Image in the same namespace as the referring object.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference"),
+							Description: "ExpectedCodes is the list of HTTP status codes expected in response from members by HTTP and HTTPS monitors, e.g. \"200\" or \"200-204\". Defaults to \"200\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay is the time in seconds between sending probes to members.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum time in seconds for a monitor to wait for a connection to be established before it times out.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of successful checks before changing the operating status of the member to ONLINE.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetriesDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetriesDown is the number of allowed check failures before changing the operating status of the member to ERROR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerMemberSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadBalancerMemberSelector selects the machines which are members of a load balancer pool. Exactly one of Role or MachineSelector must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role selects all control plane or all worker machines of the cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineSelector selects the machines of the cluster whose labels match.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadBalancerParam specifies an existing OpenStack load balancer. It may be specified by either ID or filter, but not both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the load balancer to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter specifies a filter to select an OpenStack load balancer. If provided, cannot be empty.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerFilter"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageFilter", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerFilter"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_LoadBalancerPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LoadBalancer represents basic information about the associated OpenStack LoadBalancer.",
+				Description: "LoadBalancerPool defines a pool of the cluster load balancer which is not the default pool of a listener. The pool uses the HTTP protocol.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name is the name of the pool. It is used to name the Octavia pool and health monitor, and must be unique among the listeners and pools of the load balancer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ip": {
+					"memberPort": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "MemberPort is the port of the members traffic is forwarded to.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"internalIP": {
+					"algorithm": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Algorithm is the load balancing algorithm of the pool. Defaults to SOURCE_IP_PORT for the ovn provider and ROUND_ROBIN otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"allowedCIDRs": {
+					"memberTLS": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "MemberTLS makes the load balancer connect to the members of the pool with TLS. The certificates of the members are not verified.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"tags": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Members selects the machines which are members of the pool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerMemberSelector"),
 						},
 					},
-					"loadBalancerNetwork": {
+					"monitor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerNetwork contains information about network and/or subnets which the loadbalancer is allocated on. If subnets are specified within the LoadBalancerNetwork currently only the first subnet in the list is taken into account.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatusWithSubnets"),
+							Description: "Monitor contains configuration for the health monitor of the pool. The monitor type defaults to HTTPS if MemberTLS is set, and HTTP otherwise.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListenerMonitor"),
 						},
 					},
 				},
-				Required: []string{"name", "id", "ip", "internalIP"},
+				Required: []string{"name", "memberPort", "members"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatusWithSubnets"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerListenerMonitor", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancerMemberSelector"},
 	}
 }
 
@@ -19249,6 +21880,53 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_MachineResource
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ManagedSecurityGroupSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedSecurityGroupSettings defines settings for a single managed security group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stateful": {
+						SchemaProps: spec.SchemaProps{
+							Description: "stateful specifies whether the security group is stateful. Stateless security groups do not track connections, so return traffic must be explicitly allowed by rules. Setting this to false requires the stateful-security-group Neutron extension. Defaults to true. This field is immutable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description overrides the description of the security group. Defaults to \"Cluster API managed group\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tags overrides the tags set on the security group. If not specified, the tags of the cluster are used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ManagedSecurityGroups(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19336,12 +22014,30 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ManagedSecurity
 							Format:      "",
 						},
 					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "controlPlane defines settings for the control plane security group.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroupSettings"),
+						},
+					},
+					"worker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "worker defines settings for the worker security group.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroupSettings"),
+						},
+					},
+					"bastion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "bastion defines settings for the bastion security group.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroupSettings"),
+						},
+					},
 				},
 				Required: []string{"allowAllInClusterTraffic"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupRuleSpec"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroupSettings", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupRuleSpec"},
 	}
 }
 
@@ -19673,11 +22369,16 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackCluste
 							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackExtensionsSpec"),
 						},
 					},
+					"loadBalancers": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterLoadBalancersExtensionsSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterNetworkInterfacesExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterNetworkingExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackExtensionsSpec"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterLoadBalancersExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterNetworkInterfacesExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterNetworkingExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterOpenStackExtensionsSpec"},
 	}
 }
 
@@ -19979,6 +22680,24 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackCluste
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference"),
 						},
 					},
+					"volumePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumePolicy is the policy enforced on the volumes of the machines of the cluster. Changes only apply to machines created afterwards.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumePolicy"),
+						},
+					},
+					"instanceRemediation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceRemediation is the policy for recovering the instances of the machines of the cluster which are no longer ACTIVE. Changes only apply to machines created afterwards.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.InstanceRemediation"),
+						},
+					},
+					"consoleLogCapture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsoleLogCapture is the policy for capturing the console log of the instances of machines of the cluster which fail. The console log is stored in a Secret owned by the OpenStackMachine.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ConsoleLogCapture"),
+						},
+					},
 					"extensions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Extensions stores provider-specific knobs consumed by bootstrap/control-plane integrations.",
@@ -19990,7 +22709,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackCluste
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Bastion", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ExternalRouterIPParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroups", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackClusterExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RouterParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetSpec", "sigs.k8s.io/cluster-api/api/core/v1beta1.APIEndpoint"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Bastion", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ConsoleLogCapture", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ExternalRouterIPParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.InstanceRemediation", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ManagedSecurityGroups", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackClusterExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RouterParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SubnetSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumePolicy", "sigs.k8s.io/cluster-api/api/core/v1beta1.APIEndpoint"},
 	}
 }
 
@@ -20072,12 +22791,56 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackCluste
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupStatus"),
 						},
 					},
+					"addressGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AddressGroups contains the information about the OpenStack address groups created by CAPO for inline remoteAddressGroup addresses in managed security group rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupStatus"),
+									},
+								},
+							},
+						},
+					},
 					"bastion": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Bastion contains the information about the deployed bastion host",
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BastionStatus"),
 						},
 					},
+					"servers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Servers contains information about the OpenStackServers of the cluster which are neither machines nor the bastion.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.StandaloneServerStatus"),
+									},
+								},
+							},
+						},
+					},
 					"failureReason": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FailureReason will be set in the event that there is a terminal problem reconciling the OpenStackCluster and will contain a succinct value suitable for machine interpretation.\n\nThis field should not be set for transitive errors that a controller faces that are expected to be fixed automatically over time (like service outages), but instead indicate that something is fundamentally wrong with the OpenStackCluster's spec or the configuration of the controller, and that manual intervention is required. Examples of terminal errors would be invalid combinations of settings in the spec, values that are unsupported by the controller, or the responsible controller itself being critically misconfigured.\n\nAny transient errors that occur during the reconciliation of OpenStackClusters can be added as events to the OpenStackCluster object and/or logged in the controller's output.\n\nDeprecated: This field is deprecated and will be removed in a future API version. Use status.conditions to report failures.",
@@ -20117,7 +22880,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackCluste
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BastionStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterInitialization", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatusWithSubnets", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackClusterExtensionsStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Router", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupStatus", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta1.FailureDomainSpec"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.BastionStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ClusterInitialization", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.LoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.NetworkStatusWithSubnets", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackClusterExtensionsStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.Router", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.StandaloneServerStatus", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta1.FailureDomainSpec"},
 	}
 }
 
@@ -20464,6 +23227,18 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachin
 							Format:      "",
 						},
 					},
+					"flavorSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlavorSelector selects the smallest or largest flavor which satisfies the given resource requirements. It is used if neither Flavor nor FlavorID is set. The selected flavor is recorded in the resolved spec and does not change afterwards.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorSelector"),
+						},
+					},
+					"accelerators": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Accelerators are the PCI devices and placement resources which the flavor must provide. If FlavorSelector is set, only flavors which provide them are selected. Otherwise the flavor is checked when the server is created.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AcceleratorRequirements"),
+						},
+					},
 					"image": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The image to use for your server instance. If the rootVolume is specified, this will be used when creating the root volume.",
@@ -20590,6 +23365,33 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachin
 							},
 						},
 					},
+					"hotAttachVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HotAttachVolumes enables adding and removing additionalBlockDevices of type Volume after the machine has been created. The changes are passed to the OpenStackServer of the machine, which creates and attaches added volumes to the running server instance, and detaches removed volumes. Removed volumes are only deleted if they were created to be attached. Other changes to additionalBlockDevices are not allowed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"availabilityZoneFallback": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZoneFallback is an ordered list of availability zones in which to retry creating the server instance if Nova cannot find a valid host for it in the availability zone of the machine. The errored server instance is deleted before it is created again in the next availability zone.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"serverGroup": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The server group to assign the machine to.",
@@ -20641,7 +23443,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachin
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RootVolume", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SchedulerHintAdditionalProperty", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AcceleratorRequirements", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AdditionalBlockDevice", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.FlavorSelector", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ImageParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineExtensionsSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.PortOpts", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.RootVolume", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SchedulerHintAdditionalProperty", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.SecurityGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerMetadata"},
 	}
 }
 
@@ -20720,6 +23522,25 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachin
 							Format:      "",
 						},
 					},
+					"availabilityZoneAttempts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZoneAttempts records the availability zones in which the server instance of the machine could not be created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AvailabilityZoneAttempt"),
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Conditions defines current service state of the OpenStackMachine. This field surfaces into Machine's status.conditions[InfrastructureReady] condition. The Ready condition must surface issues during the entire lifecycle of the OpenStackMachine (both during initial provisioning and after the initial provisioning is completed).",
@@ -20744,7 +23565,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachin
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.NodeAddress", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineInitialization", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineExtensionsStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedMachineSpec", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
+			"k8s.io/api/core/v1.NodeAddress", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AvailabilityZoneAttempt", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineInitialization", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.MachineResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.OpenStackMachineExtensionsStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResolvedMachineSpec", "sigs.k8s.io/cluster-api/api/core/v1beta1.Condition"},
 	}
 }
 
@@ -20924,6 +23745,64 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackMachin
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PCIAliasRequirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PCIAliasRequirement is a number of PCI devices of a PCI passthrough alias.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the PCI passthrough alias.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of devices required.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PlacementResourceRequirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlacementResourceRequirement is an amount of a placement resource class.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceClass is the name of a standard or custom placement resource class, such as VGPU or CUSTOM_FPGA.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the amount of the resource class required.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"resourceClass"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_PortOpts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21542,12 +24421,59 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_RootVolume(ref
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone"),
 						},
 					},
+					"snapshotRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotRef is a reference to a Cinder volume snapshot to create the volume from. A root volume created from a snapshot is not created from the image of the machine. It cannot be set together with volumeRef.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotParam"),
+						},
+					},
+					"volumeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeRef is a reference to an existing Cinder volume to clone the volume from. A root volume cloned from a volume is not created from the image of the machine. It cannot be set together with snapshotRef.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam"),
+						},
+					},
+					"metadata": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"key",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata is a list of metadata key/value pairs to set on the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeMetadata"),
+									},
+								},
+							},
+						},
+					},
+					"schedulerHints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerHints are hints to the Cinder scheduler to place the volume on the same or on a different backend as other volumes.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSchedulerHints"),
+						},
+					},
+					"multiattach": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multiattach states whether the volume must be attachable to multiple servers. Cinder decides this from the volume type, so if set, type must also be set and the volume type must support multi-attach if true, or must not support it if false. If not set, the volume type decides.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"sizeGiB"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeMetadata", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSchedulerHints", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotParam"},
 	}
 }
 
@@ -22056,21 +24982,21 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_SecurityGroupRu
 					},
 					"remoteGroupID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "remoteGroupID is the remote group ID to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.",
+							Description: "remoteGroupID is the remote group ID to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"remoteIPPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "remoteIPPrefix is the remote IP prefix to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.",
+							Description: "remoteIPPrefix is the remote IP prefix to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"remoteManagedGroups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "remoteManagedGroups is the remote managed groups to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.",
+							Description: "remoteManagedGroups is the remote managed groups to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -22083,10 +25009,18 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_SecurityGroupRu
 							},
 						},
 					},
+					"remoteAddressGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "remoteAddressGroup is the Neutron address group to be associated with this security group rule. You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupParam"),
+						},
+					},
 				},
 				Required: []string{"name", "direction"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.AddressGroupParam"},
 	}
 }
 
@@ -22144,7 +25078,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ServerGroupPara
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ServerGroupParam specifies an OpenStack server group. It may be specified by ID or filter, but not both.",
+				Description: "ServerGroupParam specifies an OpenStack server group. It may be specified by ID, filter, or a reference to an OpenStackServerGroup, but only one of them.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"id": {
@@ -22160,11 +25094,17 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ServerGroupPara
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupFilter"),
 						},
 					},
+					"serverGroupRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerGroupRef is a reference to an OpenStackServerGroup in the same namespace as the referring object. The server group is created and deleted by CAPO.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupFilter"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ResourceReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.ServerGroupFilter"},
 	}
 }
 
@@ -22197,6 +25137,56 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_ServerMetadata(
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_StandaloneServerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StandaloneServerStatus contains information about an OpenStackServer of a cluster which is neither a machine nor the bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the OpenStackServer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the first internal IP address of the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true when the server instance is ready.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_Subnet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22532,6 +25522,212 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeAvailabil
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeFilter specifies a query to select a Cinder volume. At least one property must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of a volume to look for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeMetadata is a metadata key/value pair of a volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the volume metadata key",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the volume metadata value",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"key", "value"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeParam specifies a Cinder volume. It may be specified by ID or filter, but not both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the volume to use.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter specifies a query to select a volume. If provided, it cannot be empty.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeFilter"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumePolicy is a policy enforced on the volumes of the machines of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requireEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireEncryption requires the root volume and the volume block devices of every machine to use an encrypted volume type. The volume type must then be set explicitly. The encryption is checked on each volume once it has been created.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeSchedulerHints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSchedulerHints are hints to the Cinder scheduler for the placement of a volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sameHost": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SameHost is a list of volumes. The volume is created on the same backend as these volumes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam"),
+									},
+								},
+							},
+						},
+					},
+					"differentHost": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DifferentHost is a list of volumes. The volume is created on a different backend than these volumes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeParam"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeSnapshotFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotFilter specifies a query to select a Cinder volume snapshot. At least one property must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of a volume snapshot to look for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeID is the ID of the volume the snapshot was taken of.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeSnapshotParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotParam specifies a Cinder volume snapshot. It may be specified by ID or filter, but not both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the volume snapshot to use.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter specifies a query to select a volume snapshot. If provided, it cannot be empty.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeSnapshotFilter"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta1_APIEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -27,6 +27,8 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	exthelpers "sigs.k8s.io/cluster-api-provider-openstack/pkg/extensions"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -119,7 +121,8 @@ func (r *OpenStackMachineReconciler) reconcileAllowedAddressPairsForVIPs(ctx con
 			desiredPairs = append(desiredPairs, infrav1.AddressPair{IPAddress: vip})
 		}
 	}
-	if osm.Spec.Extensions.LoadBalancers.IngressVIP && clusterExt.LoadBalancers.Ingress != nil {
+	// In octavia mode the ingress VIP belongs to the load balancer, not to the machines.
+	if osm.Spec.Extensions.LoadBalancers.IngressVIP && clusterExt.LoadBalancers.Ingress != nil && !isIngressLoadBalancerOctavia(osc) {
 		if vip := clusterExt.LoadBalancers.Ingress.VIP; vip != "" {
 			desiredPairs = append(desiredPairs, infrav1.AddressPair{IPAddress: vip})
 		}
@@ -205,12 +208,21 @@ func (r *OpenStackClusterReconciler) reconcileClusterLoadBalancers(ctx context.C
 	if ext.LoadBalancers.Ingress == nil {
 		ext.LoadBalancers.Ingress = &infrav1.ClusterVIPStatus{}
 	}
-	ingressIP, err := r.ensureIngressKeepalivedPort(ctx, scope, cluster, osc)
-	if err != nil {
-		return err
-	}
-	if ingressIP != "" {
-		ext.LoadBalancers.Ingress.VIP = ingressIP
+	var ingressIP string
+	if isIngressLoadBalancerOctavia(osc) {
+		// octavia 模式下 ingress VIP 为负载均衡器的私网IP
+		if err := reconcileIngressLoadBalancer(scope, cluster, ext.LoadBalancers.Ingress, osc); err != nil {
+			return err
+		}
+		ingressIP = ext.LoadBalancers.Ingress.VIP
+	} else {
+		ingressIP, err = r.ensureIngressKeepalivedPort(ctx, scope, cluster, osc)
+		if err != nil {
+			return err
+		}
+		if ingressIP != "" {
+			ext.LoadBalancers.Ingress.VIP = ingressIP
+		}
 	}
 
 	if ext.LoadBalancers.Harbor == nil {
@@ -608,6 +620,44 @@ func (r *OpenStackClusterReconciler) ensureIngressKeepalivedPort(ctx context.Con
 	})
 }
 
+// isIngressLoadBalancerOctavia returns true if ingress traffic is served by an Octavia load balancer instead of a keepalived VIP.
+func isIngressLoadBalancerOctavia(osc *infrav1.OpenStackCluster) bool {
+	ext := osc.Spec.Extensions
+	return ext != nil && ext.LoadBalancers != nil && ext.LoadBalancers.Ingress != nil &&
+		ext.LoadBalancers.Ingress.Mode == infrav1.IngressLoadBalancerModeOctavia
+}
+
+func reconcileIngressLoadBalancer(scope *scope.WithLogger, cluster *clusterv1.Cluster, ingressStatus *infrav1.ClusterVIPStatus, osc *infrav1.OpenStackCluster) error {
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+	if err := loadBalancerService.ReconcileIngressLoadBalancer(osc, names.ClusterResourceName(cluster), ingressStatus); err != nil {
+		return fmt.Errorf("reconcile ingress load balancer: %w", err)
+	}
+	return nil
+}
+
+func (r *OpenStackMachineReconciler) reconcileIngressLoadBalancerMember(scope *scope.WithLogger, osc *infrav1.OpenStackCluster, osm *infrav1.OpenStackMachine, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
+	ip := instanceNS.IP(osc.Status.Network.Name)
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+	return loadBalancerService.ReconcileIngressLoadBalancerMember(osc, osm, clusterResourceName, ip)
+}
+
+func removeIngressLoadBalancerMember(scope *scope.WithLogger, osc *infrav1.OpenStackCluster, osm *infrav1.OpenStackMachine, clusterResourceName string) error {
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+	if err := loadBalancerService.DeleteIngressLoadBalancerMember(osc, osm, clusterResourceName); err != nil {
+		return fmt.Errorf("remove machine from ingress load balancer: %w", err)
+	}
+	return nil
+}
+
 func (r *OpenStackClusterReconciler) ensureKeepalivedPort(ctx context.Context, scope *scope.WithLogger, osc *infrav1.OpenStackCluster, input keepalivedPortInput) (string, error) {
 	if osc.Status.Network == nil || osc.Status.Network.ID == "" {
 		return "", fmt.Errorf("cluster network is not ready")
@@ -20,6 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
@@ -46,7 +49,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
@@ -62,6 +67,18 @@ import (
 
 const (
 	waitForBastionToReconcile = 15 * time.Second
+
+	// availabilityZoneHealthWindow is how long a failure to schedule a
+	// server in an availability zone affects the health of the zone.
+	availabilityZoneHealthWindow = time.Hour
+
+	// Attributes of a failure domain reporting the health of its
+	// availability zone.
+	failureDomainHealthAttribute                   = "health"
+	failureDomainRecentSchedulingFailuresAttribute = "recentSchedulingFailures"
+
+	availabilityZoneHealthy  = "Healthy"
+	availabilityZoneDegraded = "Degraded"
 )
 
 // OpenStackClusterReconciler reconciles a OpenStackCluster object.
@@ -180,6 +197,17 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Standalone servers are attached to the cluster network and security
+	// groups, so they must be gone before these are deleted.
+	remaining, err := r.deleteStandaloneServers(ctx, scope, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if remaining > 0 {
+		scope.Logger().Info("Waiting for standalone OpenStackServer objects to be deleted", "remaining", remaining)
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
 	networkingService, err := networking.NewService(scope)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -201,6 +229,22 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 		}
 	}
 
+	if isIngressLoadBalancerOctavia(openStackCluster) {
+		loadBalancerService, err := loadbalancer.NewService(scope)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		result, err := loadBalancerService.DeleteIngressLoadBalancer(openStackCluster, clusterResourceName)
+		if err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete ingress load balancer: %w", err), false)
+			return reconcile.Result{}, fmt.Errorf("failed to delete ingress load balancer: %w", err)
+		}
+		if result != nil {
+			return *result, nil
+		}
+	}
+
 	// if ManagedSubnets was not set, no network was created.
 	if len(openStackCluster.Spec.ManagedSubnets) > 0 {
 		if err = networkingService.DeleteRouter(openStackCluster, clusterResourceName); err != nil {
@@ -224,6 +268,12 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 		return reconcile.Result{}, fmt.Errorf("failed to delete security groups: %w", err)
 	}
 
+	// Address groups can only be deleted once no security group rule references them.
+	if err = networkingService.DeleteAddressGroups(openStackCluster); err != nil {
+		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete address groups: %w", err), false)
+		return reconcile.Result{}, fmt.Errorf("failed to delete address groups: %w", err)
+	}
+
 	// Cluster is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(openStackCluster, infrav1.ClusterFinalizer)
 	scope.Logger().Info("Reconciled Cluster deleted successfully")
@@ -332,7 +382,13 @@ func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope
 		return reconcile.Result{}, err
 	}
 
-	err = reconcileNetworkComponents(scope, cluster, openStackCluster)
+	tlsCertificates, err := r.getLoadBalancerTLSCertificates(ctx, openStackCluster)
+	if err != nil {
+		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to get load balancer certificates: %w", err), false)
+		return reconcile.Result{}, fmt.Errorf("failed to get load balancer certificates: %w", err)
+	}
+
+	err = reconcileNetworkComponents(scope, cluster, openStackCluster, tlsCertificates)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -342,6 +398,11 @@ func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope
 		return ctrl.Result{}, err
 	}
 
+	zoneFailures, zoneHealthRequeue, err := r.getRecentAvailabilityZoneFailures(ctx, cluster, time.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Create a new list in case any AZs have been removed from OpenStack
 	openStackCluster.Status.FailureDomains = make(clusterv1beta1.FailureDomains)
 	for _, az := range availabilityZones {
@@ -355,6 +416,7 @@ func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope
 
 		openStackCluster.Status.FailureDomains[az.ZoneName] = clusterv1beta1.FailureDomainSpec{
 			ControlPlane: found,
+			Attributes:   availabilityZoneHealthAttributes(zoneFailures[az.ZoneName]),
 		}
 	}
 
@@ -390,11 +452,125 @@ func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope
 	}
 	scope.Logger().Info("Reconciled Bastion created successfully")
 
+	if err := r.reconcileStandaloneServers(ctx, cluster, openStackCluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if err := r.reconcileClusterExtensions(ctx, scope, cluster, openStackCluster); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	return reconcile.Result{}, nil
+	// Reconcile again to report availability zones as healthy once their
+	// failures are no longer recent
+	return reconcile.Result{RequeueAfter: zoneHealthRequeue}, nil
+}
+
+// getRecentAvailabilityZoneFailures counts the failures to schedule servers
+// of the cluster in each availability zone within the health window. It also
+// returns when the oldest of these failures leaves the window.
+func (r *OpenStackClusterReconciler) getRecentAvailabilityZoneFailures(ctx context.Context, cluster *clusterv1.Cluster, now time.Time) (map[string]int, time.Duration, error) {
+	serverList := &infrav1alpha1.OpenStackServerList{}
+	if err := r.Client.List(ctx, serverList, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+		return nil, 0, fmt.Errorf("listing servers: %w", err)
+	}
+
+	failures := make(map[string]int)
+	var requeueAfter time.Duration
+	for i := range serverList.Items {
+		for _, attempt := range serverList.Items[i].Status.AvailabilityZoneAttempts {
+			remaining := attempt.Time.Add(availabilityZoneHealthWindow).Sub(now)
+			if attempt.Zone == "" || remaining <= 0 {
+				continue
+			}
+			failures[attempt.Zone]++
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+	}
+	return failures, requeueAfter, nil
+}
+
+// listStandaloneServers returns the OpenStackServers of the cluster which are
+// neither the bastion nor the server of an OpenStackMachine.
+func (r *OpenStackClusterReconciler) listStandaloneServers(ctx context.Context, cluster *clusterv1.Cluster) ([]infrav1alpha1.OpenStackServer, error) {
+	serverList := &infrav1alpha1.OpenStackServerList{}
+	if err := r.Client.List(ctx, serverList, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+		return nil, fmt.Errorf("listing servers: %w", err)
+	}
+
+	var servers []infrav1alpha1.OpenStackServer
+	for i := range serverList.Items {
+		if isStandaloneServer(&serverList.Items[i]) {
+			servers = append(servers, serverList.Items[i])
+		}
+	}
+	return servers, nil
+}
+
+// reconcileStandaloneServers reports the standalone servers of the cluster in
+// its status.
+func (r *OpenStackClusterReconciler) reconcileStandaloneServers(ctx context.Context, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) error {
+	servers, err := r.listStandaloneServers(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	var statuses []infrav1.StandaloneServerStatus
+	for i := range servers {
+		server := &servers[i]
+		status := infrav1.StandaloneServerStatus{
+			Name:  server.Name,
+			ID:    ptr.Deref(server.Status.InstanceID, ""),
+			State: ptr.Deref(server.Status.InstanceState, ""),
+			Ready: server.Status.Ready,
+		}
+		for _, address := range server.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				status.IP = address.Address
+				break
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	slices.SortFunc(statuses, func(a, b infrav1.StandaloneServerStatus) int { return strings.Compare(a.Name, b.Name) })
+	openStackCluster.Status.Servers = statuses
+	return nil
+}
+
+// deleteStandaloneServers deletes the standalone servers of the cluster. It
+// returns the number of servers which still exist.
+func (r *OpenStackClusterReconciler) deleteStandaloneServers(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster) (int, error) {
+	servers, err := r.listStandaloneServers(ctx, cluster)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range servers {
+		server := &servers[i]
+		if !server.DeletionTimestamp.IsZero() {
+			continue
+		}
+		scope.Logger().Info("Deleting standalone OpenStackServer", "openStackServer", server.Name)
+		if err := r.Client.Delete(ctx, server); client.IgnoreNotFound(err) != nil {
+			return 0, fmt.Errorf("deleting standalone server %s: %w", server.Name, err)
+		}
+	}
+	return len(servers), nil
+}
+
+// availabilityZoneHealthAttributes returns the failure domain attributes
+// reporting the health of an availability zone with the given number of
+// recent scheduling failures.
+func availabilityZoneHealthAttributes(failures int) map[string]string {
+	health := availabilityZoneHealthy
+	if failures > 0 {
+		health = availabilityZoneDegraded
+	}
+	return map[string]string{
+		failureDomainHealthAttribute:                   health,
+		failureDomainRecentSchedulingFailuresAttribute: strconv.Itoa(failures),
+	}
 }
 
 func (r *OpenStackClusterReconciler) reconcileBastion(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (*ctrl.Result, error) {
@@ -674,6 +850,12 @@ func resolveLoadBalancerNetwork(openStackCluster *infrav1.OpenStackCluster, netw
 		openStackCluster.Status.APIServerLoadBalancer = lbStatus
 	}
 
+	// The network of an adopted load balancer is taken from its VIP when
+	// the load balancer is reconciled.
+	if lbSpec.LoadBalancerRef != nil {
+		return nil
+	}
+
 	lbNetStatus := lbStatus.LoadBalancerNetwork
 	if lbNetStatus == nil {
 		lbNetStatus = &infrav1.NetworkStatusWithSubnets{
@@ -727,7 +909,7 @@ func resolveLoadBalancerNetwork(openStackCluster *infrav1.OpenStackCluster, netw
 	return nil
 }
 
-func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) error {
+func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, tlsCertificates map[string]loadbalancer.TLSCertificate) error {
 	clusterResourceName := names.ClusterResourceName(cluster)
 
 	networkingService, err := networking.NewService(scope)
@@ -763,13 +945,20 @@ func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Clus
 
 	err = networkingService.ReconcileSecurityGroups(openStackCluster, clusterResourceName)
 	if err != nil {
-		v1beta1conditions.MarkFalse(openStackCluster, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to reconcile security groups: %v", err)
-		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to reconcile security groups: %w", err), false)
+		// Terminal errors, e.g. requesting stateless security groups on a cloud which doesn't support them, won't resolve on retry.
+		terminalError := &capoerrors.TerminalError{}
+		if errors.As(err, &terminalError) {
+			v1beta1conditions.MarkFalse(openStackCluster, infrav1.SecurityGroupsReadyCondition, terminalError.Reason, clusterv1beta1.ConditionSeverityError, "%s", terminalError.Message)
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to reconcile security groups: %w", err), true)
+		} else {
+			v1beta1conditions.MarkFalse(openStackCluster, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupReconcileFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to reconcile security groups: %v", err)
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to reconcile security groups: %w", err), false)
+		}
 		return fmt.Errorf("failed to reconcile security groups: %w", err)
 	}
 	v1beta1conditions.MarkTrue(openStackCluster, infrav1.SecurityGroupsReadyCondition)
 
-	err = reconcileControlPlaneEndpoint(scope, networkingService, openStackCluster, clusterResourceName)
+	err = reconcileControlPlaneEndpoint(scope, networkingService, openStackCluster, clusterResourceName, tlsCertificates)
 	if err != nil {
 		v1beta1conditions.MarkFalse(openStackCluster, infrav1.APIEndpointReadyCondition, infrav1.APIEndpointConfigFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to reconcile control plane endpoint: %v", err)
 		return err
@@ -894,7 +1083,7 @@ func reconcileProvisionedNetworkComponents(networkingService *networking.Service
 // reconcileControlPlaneEndpoint configures the control plane endpoint for the
 // cluster, creating it if necessary, and updates ControlPlaneEndpoint in the
 // cluster spec.
-func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *networking.Service, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *networking.Service, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, tlsCertificates map[string]loadbalancer.TLSCertificate) error {
 	// Calculate the port that we will use for the API server
 	apiServerPort := getAPIServerPort(openStackCluster)
 
@@ -911,7 +1100,7 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 			return err
 		}
 
-		terminalFailure, err := loadBalancerService.ReconcileLoadBalancer(openStackCluster, clusterResourceName, int(apiServerPort))
+		terminalFailure, err := loadBalancerService.ReconcileLoadBalancer(openStackCluster, clusterResourceName, int(apiServerPort), tlsCertificates)
 		if err != nil {
 			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to reconcile load balancer: %w", err), terminalFailure)
 			return fmt.Errorf("failed to reconcile load balancer: %w", err)
@@ -962,6 +1151,40 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 	return nil
 }
 
+// getLoadBalancerTLSCertificates returns the certificates of the API server
+// load balancer listeners which terminate TLS with a certificate from a
+// Secret, keyed by the name of the Secret.
+func (r *OpenStackClusterReconciler) getLoadBalancerTLSCertificates(ctx context.Context, openStackCluster *infrav1.OpenStackCluster) (map[string]loadbalancer.TLSCertificate, error) {
+	if !openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
+		return nil, nil
+	}
+
+	tlsCertificates := map[string]loadbalancer.TLSCertificate{}
+	for _, listener := range openStackCluster.Spec.APIServerLoadBalancer.Listeners {
+		if listener.TLSSecretName == nil {
+			continue
+		}
+		secretName := *listener.TLSSecretName
+		if _, ok := tlsCertificates[secretName]; ok {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: openStackCluster.Namespace, Name: secretName}, secret); err != nil {
+			return nil, err
+		}
+		certificate, privateKey := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+		if len(certificate) == 0 || len(privateKey) == 0 {
+			return nil, fmt.Errorf("secret %s must contain %s and %s", secretName, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+		}
+		tlsCertificates[secretName] = loadbalancer.TLSCertificate{
+			Certificate: certificate,
+			PrivateKey:  privateKey,
+		}
+	}
+	return tlsCertificates, nil
+}
+
 // getAPIServerPort returns the port to use for the API server based on the cluster spec.
 func getAPIServerPort(openStackCluster *infrav1.OpenStackCluster) int32 {
 	switch {
@@ -977,6 +1200,10 @@ func (r *OpenStackClusterReconciler) SetupWithManager(ctx context.Context, mgr c
 	clusterToInfraFn := util.ClusterToInfrastructureMapFunc(ctx, infrav1.SchemeGroupVersion.WithKind("OpenStackCluster"), mgr.GetClient(), &infrav1.OpenStackCluster{})
 	log := ctrl.LoggerFrom(ctx)
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &infrav1.OpenStackCluster{}, infrav1.OpenStackClusterTLSSecretIndex, tlsSecretNames); err != nil {
+		return fmt.Errorf("adding OpenStackClusters by TLS secret index: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&infrav1.OpenStackCluster{}).
@@ -1007,11 +1234,105 @@ func (r *OpenStackClusterReconciler) SetupWithManager(ctx context.Context, mgr c
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &infrav1.OpenStackCluster{}),
 			builder.WithPredicates(OpenStackServerReconcileComplete(log)),
 		).
+		Watches(
+			&infrav1alpha1.OpenStackServer{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
+				clusterName := o.GetLabels()[clusterv1.ClusterNameLabel]
+				if clusterName == "" {
+					return nil
+				}
+				cluster := &clusterv1.Cluster{}
+				if err := r.Client.Get(ctx, client.ObjectKey{Namespace: o.GetNamespace(), Name: clusterName}, cluster); err != nil {
+					log.V(4).Error(err, "Failed to get cluster of OpenStack server")
+					return nil
+				}
+				return clusterToInfraFn(ctx, cluster)
+			}),
+			builder.WithPredicates(predicate.Or(availabilityZoneAttemptsChanged(), standaloneServerChanged())),
+		).
+		// Listeners terminating TLS are updated when the certificate in their Secret changes.
+		// Only the metadata of Secrets is cached, as the controller reads them from the API server.
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.tlsSecretToOpenStackClusters),
+			builder.OnlyMetadata,
+		).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
 		WithEventFilter(predicates.ResourceIsNotExternallyManaged(mgr.GetScheme(), ctrl.LoggerFrom(ctx))).
 		Complete(r)
 }
 
+// tlsSecretNames returns the names of the Secrets referenced by the listeners
+// of the API server load balancer of an OpenStackCluster.
+func tlsSecretNames(obj client.Object) []string {
+	openStackCluster, ok := obj.(*infrav1.OpenStackCluster)
+	if !ok || openStackCluster.Spec.APIServerLoadBalancer == nil {
+		return nil
+	}
+	var secretNames []string
+	for _, listener := range openStackCluster.Spec.APIServerLoadBalancer.Listeners {
+		if listener.TLSSecretName != nil && !slices.Contains(secretNames, *listener.TLSSecretName) {
+			secretNames = append(secretNames, *listener.TLSSecretName)
+		}
+	}
+	return secretNames
+}
+
+// tlsSecretToOpenStackClusters maps a Secret to the OpenStackClusters whose
+// API server load balancer listeners terminate TLS with its certificate.
+func (r *OpenStackClusterReconciler) tlsSecretToOpenStackClusters(ctx context.Context, o client.Object) []reconcile.Request {
+	openStackClusters := &infrav1.OpenStackClusterList{}
+	if err := r.Client.List(ctx, openStackClusters, client.InNamespace(o.GetNamespace()), client.MatchingFields{infrav1.OpenStackClusterTLSSecretIndex: o.GetName()}); err != nil {
+		ctrl.LoggerFrom(ctx).V(4).Error(err, "Failed to list OpenStack clusters referencing TLS secret")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(openStackClusters.Items))
+	for i := range openStackClusters.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&openStackClusters.Items[i])})
+	}
+	return requests
+}
+
+// availabilityZoneAttemptsChanged returns a predicate which passes updates of
+// OpenStackServers which record a new availability zone attempt.
+func availabilityZoneAttemptsChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldServer, okOld := e.ObjectOld.(*infrav1alpha1.OpenStackServer)
+			newServer, okNew := e.ObjectNew.(*infrav1alpha1.OpenStackServer)
+			return okOld && okNew && len(newServer.Status.AvailabilityZoneAttempts) != len(oldServer.Status.AvailabilityZoneAttempts)
+		},
+	}
+}
+
+// standaloneServerChanged returns a predicate which passes the creation and
+// deletion of standalone servers, and updates of their reported status.
+func standaloneServerChanged() predicate.Funcs {
+	isStandalone := func(o client.Object) bool {
+		server, ok := o.(*infrav1alpha1.OpenStackServer)
+		return ok && isStandaloneServer(server)
+	}
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return isStandalone(e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return isStandalone(e.Object) },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldServer, okOld := e.ObjectOld.(*infrav1alpha1.OpenStackServer)
+			newServer, okNew := e.ObjectNew.(*infrav1alpha1.OpenStackServer)
+			if !okOld || !okNew || !isStandaloneServer(newServer) {
+				return false
+			}
+			return oldServer.Status.Ready != newServer.Status.Ready ||
+				!ptr.Equal(oldServer.Status.InstanceID, newServer.Status.InstanceID) ||
+				!ptr.Equal(oldServer.Status.InstanceState, newServer.Status.InstanceState) ||
+				!apiequality.Semantic.DeepEqual(oldServer.Status.Addresses, newServer.Status.Addresses)
+		},
+	}
+}
+
 func handleUpdateOSCError(openstackCluster *infrav1.OpenStackCluster, message error, isFatal bool) {
 	if isFatal {
 		err := capoerrors.DeprecatedCAPOUpdateClusterError
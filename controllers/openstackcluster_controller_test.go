@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr/testr"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
@@ -30,7 +32,9 @@ import (
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -40,6 +44,7 @@ import (
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
@@ -494,7 +499,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			},
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 
 		// Verify conditions are set correctly
@@ -580,7 +585,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			CIDR:      "2001:db8:2222:5555::/64",
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 		Expect(len(testCluster.Status.Network.Subnets)).To(Equal(2))
 
@@ -631,7 +636,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			ID: clusterNetworkID,
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 		Expect(testCluster.Status.Network.ID).To(Equal(clusterNetworkID))
 
@@ -854,7 +859,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			ID:         "floating-ip-id",
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 
 		// Verify API endpoint was set
@@ -915,7 +920,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			},
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 
 		// Verify API endpoint was set with fixed IP
@@ -960,7 +965,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// Simulate network lookup failure
 		networkClientRecorder.GetNetwork(clusterNetworkID).Return(nil, fmt.Errorf("unable to get network"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("error fetching cluster network"))
 
@@ -1012,7 +1017,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			NetworkID: clusterNetworkID,
 		}).Return(nil, fmt.Errorf("failed to list subnets"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 
 		// Verify NetworkReadyCondition is set to False
@@ -1076,7 +1081,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// Router lookup fails
 		networkClientRecorder.GetRouter(clusterRouterID).Return(nil, fmt.Errorf("unable to get router"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("error fetching cluster router"))
 
@@ -1153,7 +1158,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		networkClientRecorder.ListSecGroup(gomock.Any()).Return([]groups.SecGroup{}, nil).AnyTimes()
 		networkClientRecorder.CreateSecGroup(gomock.Any()).Return(nil, fmt.Errorf("quota exceeded")).AnyTimes()
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("failed to reconcile security groups"))
 
@@ -1227,7 +1232,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// Mock floating IP creation failure
 		networkClientRecorder.CreateFloatingIP(gomock.Any()).Return(nil, fmt.Errorf("quota exceeded"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 
 		// Verify APIEndpointReadyCondition is set to False
@@ -1316,3 +1321,174 @@ func Test_getAPIServerPort(t *testing.T) {
 		})
 	}
 }
+
+func Test_getRecentAvailabilityZoneFailures(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"}}
+	newServer := func(name, clusterName string, attempts ...infrav1.AvailabilityZoneAttempt) *infrav1alpha1.OpenStackServer {
+		return &infrav1alpha1.OpenStackServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+			},
+			Status: infrav1alpha1.OpenStackServerStatus{AvailabilityZoneAttempts: attempts},
+		}
+	}
+	attempt := func(zone string, age time.Duration) infrav1.AvailabilityZoneAttempt {
+		return infrav1.AvailabilityZoneAttempt{Zone: zone, Time: metav1.NewTime(now.Add(-age))}
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newServer("server-1", "test-cluster", attempt("az-1", 10*time.Minute), attempt("az-2", 2*time.Hour)),
+		newServer("server-2", "test-cluster", attempt("az-1", 40*time.Minute)),
+		newServer("server-3", "other-cluster", attempt("az-3", time.Minute)),
+	).Build()
+
+	r := &OpenStackClusterReconciler{Client: fakeClient}
+	failures, requeueAfter, err := r.getRecentAvailabilityZoneFailures(context.TODO(), cluster, now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(failures).To(Equal(map[string]int{"az-1": 2}))
+	g.Expect(requeueAfter).To(Equal(20 * time.Minute))
+
+	g.Expect(availabilityZoneHealthAttributes(failures["az-1"])).To(Equal(map[string]string{
+		failureDomainHealthAttribute:                   availabilityZoneDegraded,
+		failureDomainRecentSchedulingFailuresAttribute: "2",
+	}))
+	g.Expect(availabilityZoneHealthAttributes(failures["az-2"])).To(Equal(map[string]string{
+		failureDomainHealthAttribute:                   availabilityZoneHealthy,
+		failureDomainRecentSchedulingFailuresAttribute: "0",
+	}))
+}
+
+func newStandaloneServerTestObjects() []client.Object {
+	newServer := func(name string, ownerKind string) *infrav1alpha1.OpenStackServer {
+		server := &infrav1alpha1.OpenStackServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			},
+		}
+		if ownerKind != "" {
+			server.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: infrav1.SchemeGroupVersion.String(),
+				Kind:       ownerKind,
+				Name:       "owner",
+				UID:        "owner-uid",
+			}}
+		}
+		return server
+	}
+
+	helper := newServer("helper", "")
+	helper.Status = infrav1alpha1.OpenStackServerStatus{
+		Ready:         true,
+		InstanceID:    ptr.To("helper-id"),
+		InstanceState: ptr.To(infrav1.InstanceStateActive),
+		Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeHostName, Address: "helper"},
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.5"},
+		},
+	}
+	debug := newServer("debug", "")
+
+	return []client.Object{
+		helper,
+		debug,
+		newServer(bastionName("test-cluster"), "OpenStackCluster"),
+		newServer("machine-0", "OpenStackMachine"),
+	}
+}
+
+func Test_reconcileStandaloneServers(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newStandaloneServerTestObjects()...).Build()
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"}}
+	openStackCluster := &infrav1.OpenStackCluster{}
+
+	r := &OpenStackClusterReconciler{Client: fakeClient}
+	g.Expect(r.reconcileStandaloneServers(context.TODO(), cluster, openStackCluster)).To(Succeed())
+	g.Expect(openStackCluster.Status.Servers).To(Equal([]infrav1.StandaloneServerStatus{
+		{Name: "debug"},
+		{Name: "helper", ID: "helper-id", State: infrav1.InstanceStateActive, IP: "10.0.0.5", Ready: true},
+	}))
+}
+
+func Test_deleteStandaloneServers(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newStandaloneServerTestObjects()...).Build()
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"}}
+	scope := scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t))
+
+	r := &OpenStackClusterReconciler{Client: fakeClient}
+	remaining, err := r.deleteStandaloneServers(context.TODO(), scope, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(remaining).To(Equal(2))
+
+	// Only the bastion and the server of the machine are left
+	serverList := &infrav1alpha1.OpenStackServerList{}
+	g.Expect(fakeClient.List(context.TODO(), serverList)).To(Succeed())
+	var names []string
+	for i := range serverList.Items {
+		names = append(names, serverList.Items[i].Name)
+	}
+	g.Expect(names).To(ConsistOf(bastionName("test-cluster"), "machine-0"))
+
+	remaining, err = r.deleteStandaloneServers(context.TODO(), scope, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(remaining).To(BeZero())
+}
+
+func Test_tlsSecretToOpenStackClusters(t *testing.T) {
+	g := NewWithT(t)
+
+	newOpenStackCluster := func(namespace, name string, secretNames ...string) *infrav1.OpenStackCluster {
+		openStackCluster := &infrav1.OpenStackCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: infrav1.OpenStackClusterSpec{
+				APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{},
+			},
+		}
+		for _, secretName := range secretNames {
+			openStackCluster.Spec.APIServerLoadBalancer.Listeners = append(openStackCluster.Spec.APIServerLoadBalancer.Listeners, infrav1.LoadBalancerListener{
+				Name:          secretName,
+				TLSSecretName: ptr.To(secretName),
+			})
+		}
+		return openStackCluster
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(
+			newOpenStackCluster("test-namespace", "cluster-1", "api-tls", "dashboard-tls"),
+			newOpenStackCluster("test-namespace", "cluster-2", "api-tls", "api-tls"),
+			newOpenStackCluster("test-namespace", "cluster-3", "other-tls"),
+			newOpenStackCluster("other-namespace", "cluster-4", "api-tls"),
+			&infrav1.OpenStackCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-5", Namespace: "test-namespace"}},
+		).
+		WithIndex(&infrav1.OpenStackCluster{}, infrav1.OpenStackClusterTLSSecretIndex, tlsSecretNames).
+		Build()
+
+	r := &OpenStackClusterReconciler{Client: fakeClient}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "api-tls", Namespace: "test-namespace"}}
+	g.Expect(r.tlsSecretToOpenStackClusters(context.TODO(), secret)).To(ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "test-namespace", Name: "cluster-1"}},
+		reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "test-namespace", Name: "cluster-2"}},
+	))
+}
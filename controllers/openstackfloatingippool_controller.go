@@ -17,18 +17,27 @@ limitations under the License.
 package controllers
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -41,6 +50,7 @@ import (
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 )
 
@@ -48,7 +58,10 @@ const (
 	openStackFloatingIPPool = "OpenStackFloatingIPPool"
 )
 
-var errMaxIPsReached = errors.New("maximum number of IPs reached")
+var (
+	errMaxIPsReached   = errors.New("maximum number of IPs reached")
+	errReservedIPInUse = errors.New("reserved IP is in use")
+)
 
 var backoff = wait.Backoff{
 	Steps:    4,
@@ -77,6 +90,9 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 	log := ctrl.LoggerFrom(ctx)
 	pool := &infrav1alpha1.OpenStackFloatingIPPool{}
 	if err := r.Client.Get(ctx, req.NamespacedName, pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.DeleteFloatingIPPoolMetrics(req.Namespace, req.Name)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
@@ -86,11 +102,17 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 	}
 
 	defer func() {
+		r.reconcileUtilization(pool)
 		if err := patchHelper.Patch(ctx, pool); err != nil {
 			if reterr == nil {
 				reterr = fmt.Errorf("error patching OpenStackFloatingIPPool %s/%s: %w", pool.Namespace, pool.Name, err)
 			}
 		}
+		if !pool.DeletionTimestamp.IsZero() && !controllerutil.ContainsFinalizer(pool, infrav1alpha1.OpenStackFloatingIPPoolFinalizer) {
+			metrics.DeleteFloatingIPPoolMetrics(pool.Namespace, pool.Name)
+		} else {
+			metrics.ObserveFloatingIPPool(pool.Namespace, pool.Name, len(pool.Status.ClaimedIPs), len(pool.Status.AvailableIPs), len(pool.Status.FailedIPs), pool.Spec.MaxIPs)
+		}
 	}()
 
 	clientScope, err := r.ScopeFactory.NewClientScopeFromObject(ctx, r.Client, r.CaCertificates, log, pool)
@@ -106,6 +128,12 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 		return ctrl.Result{}, err
 	}
 
+	stickyRequeue, err := r.reconcileStickyIPs(scope, pool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	result := ctrl.Result{RequeueAfter: stickyRequeue}
+
 	if pool.DeletionTimestamp.IsZero() {
 		// Add finalizer if it does not exist
 		if controllerutil.AddFinalizer(pool, infrav1alpha1.OpenStackFloatingIPPoolFinalizer) {
@@ -120,11 +148,25 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 		return ctrl.Result{}, err
 	}
 
+	if err := r.reconcileFloatingIPOptions(scope, pool); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	claims := &ipamv1.IPAddressClaimList{}
 	if err := r.Client.List(context.Background(), claims, client.InNamespace(req.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFloatingIPPoolNameIndex: pool.Name}); err != nil {
 		return ctrl.Result{}, err
 	}
 
+	claimIPs, err := r.reconcileFloatingIPs(scope, pool, claims.Items)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	for _, claim := range claims.Items {
 		log := log.WithValues("claim", claim.Name)
 		if !claim.DeletionTimestamp.IsZero() {
@@ -138,13 +180,32 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 				return ctrl.Result{}, err
 			}
 			if apierrors.IsNotFound(err) {
-				ip, err := r.getIP(ctx, scope, pool)
-				if err != nil {
-					if errors.Is(err, errMaxIPsReached) {
-						log.Info("Maximum number of IPs reached, will not allocate more IPs.")
-						return ctrl.Result{}, nil
+				ip, ok := claimIPs[claim.UID]
+				if !ok {
+					ip, err = r.getIP(scope, pool, &claim)
+					if err != nil {
+						if errors.Is(err, errMaxIPsReached) {
+							log.Info("Maximum number of IPs reached, will not allocate more IPs.")
+							return result, nil
+						}
+						if errors.Is(err, errReservedIPInUse) {
+							log.Info("Floating IP reserved for the claim is in use, waiting for it to be released")
+							continue
+						}
+						return ctrl.Result{}, err
 					}
-					return ctrl.Result{}, err
+				}
+
+				// Tag the floating IP with the claim before creating the IPAddress, so that the
+				// allocation is resumed if the reconcile fails before the IPAddress exists.
+				// Floating IPs of the user, including those pinned by the claim, are never
+				// tagged with the pool, so that they are never adopted by it.
+				var tags []string
+				if !contains(union(pool.Spec.PreAllocatedFloatingIPs, reservedIPs(pool)), ip) && ip != claim.Annotations[infrav1alpha1.FloatingIPAnnotation] {
+					tags = append(tags, pool.GetFloatingIPTag())
+				}
+				if err := networkingService.TagFloatingIP(ip, append(tags, pool.GetFloatingIPClaimTag(claim.UID))...); err != nil {
+					return ctrl.Result{}, fmt.Errorf("tag floating IP %q for claim: %w", ip, err)
 				}
 
 				ipAddress = &ipamv1.IPAddress{
@@ -176,6 +237,9 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 						Prefix:  ptr.To(int32(32)),
 					},
 				}
+				if pinnedIP := claim.Annotations[infrav1alpha1.FloatingIPAnnotation]; pinnedIP != "" {
+					ipAddress.Annotations = map[string]string{infrav1alpha1.FloatingIPAnnotation: pinnedIP}
+				}
 
 				// Retry creating the IPAddress object
 				err = wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
@@ -185,21 +249,59 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 					return true, nil
 				})
 				if err != nil {
-					// If we failed to create the IPAddress, there might be an IP leak in OpenStack if we also failed to tag the IP after creation
+					// The floating IP is tagged with the claim, so it is allocated to the claim again in the next reconcile
 					scope.Logger().Error(err, "Failed to create IPAddress", "ip", ip)
 					return ctrl.Result{}, err
 				}
 			}
+			if !contains(pool.Status.ClaimedIPs, ipAddress.Spec.Address) {
+				pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ipAddress.Spec.Address)
+			}
 			claim.Status.AddressRef.Name = ipAddress.Name
 			if err = r.Client.Status().Update(ctx, &claim); err != nil {
 				log.Error(err, "Failed to update IPAddressClaim status", "claim", claim.Name, "ipaddress", ipAddress.Name)
 				return ctrl.Result{}, err
 			}
+			metrics.ObserveFloatingIPAllocation(pool.Namespace, pool.Name, time.Since(claim.CreationTimestamp.Time))
 			scope.Logger().Info("Claimed IP", "ip", ipAddress.Spec.Address)
 		}
 	}
 	v1beta1conditions.MarkTrue(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition)
-	return ctrl.Result{}, r.Client.Status().Update(ctx, pool)
+	pool.Status.ClaimedIPs = sortedIPs(pool.Status.ClaimedIPs)
+	pool.Status.AvailableIPs = sortedIPs(pool.Status.AvailableIPs)
+	pruneDNSRecords(pool, slices.Collect(maps.Values(claimIPs)))
+	return result, r.Client.Status().Update(ctx, pool)
+}
+
+// reconcileUtilization sets the UtilizationBelowThreshold condition, and
+// emits an event when the claimed floating IPs reach the threshold.
+func (r *OpenStackFloatingIPPoolReconciler) reconcileUtilization(pool *infrav1alpha1.OpenStackFloatingIPPool) {
+	if pool.Spec.UtilizationThreshold == nil || ptr.Deref(pool.Spec.MaxIPs, 0) <= 0 {
+		v1beta1conditions.Delete(pool, infrav1alpha1.UtilizationBelowThresholdCondition)
+		return
+	}
+
+	claimed, maxIPs, threshold := len(pool.Status.ClaimedIPs), *pool.Spec.MaxIPs, int(*pool.Spec.UtilizationThreshold)
+	utilization := claimed * 100 / maxIPs
+	if utilization < threshold {
+		v1beta1conditions.MarkTrue(pool, infrav1alpha1.UtilizationBelowThresholdCondition)
+		return
+	}
+
+	if !v1beta1conditions.IsFalse(pool, infrav1alpha1.UtilizationBelowThresholdCondition) {
+		r.Recorder.Eventf(pool, corev1.EventTypeWarning, infrav1alpha1.UtilizationThresholdExceededReason,
+			"%d of %d floating IPs are claimed, which reaches the utilization threshold of %d%%", claimed, maxIPs, threshold)
+	}
+	v1beta1conditions.MarkFalse(pool, infrav1alpha1.UtilizationBelowThresholdCondition, infrav1alpha1.UtilizationThresholdExceededReason, clusterv1beta1.ConditionSeverityWarning,
+		"%d of %d floating IPs are claimed (%d%%), the utilization threshold is %d%%", claimed, maxIPs, utilization, threshold)
+}
+
+// sortedIPs returns the sorted unique IPs, so that the status of a pool does
+// not depend on the order in which the IPs were found.
+func sortedIPs(ips []string) []string {
+	ips = slices.Clone(ips)
+	slices.Sort(ips)
+	return slices.Compact(ips)
 }
 
 func (r *OpenStackFloatingIPPoolReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) error {
@@ -221,7 +323,7 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileDelete(ctx context.Context,
 		return err
 	}
 
-	for _, ip := range diff(pool.Status.AvailableIPs, pool.Spec.PreAllocatedFloatingIPs) {
+	for _, ip := range diff(pool.Status.AvailableIPs, union(pool.Spec.PreAllocatedFloatingIPs, reservedIPs(pool))) {
 		if err := networkingService.DeleteFloatingIP(pool, ip); err != nil {
 			return fmt.Errorf("delete floating IP: %w", err)
 		}
@@ -289,56 +391,197 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileIPAddresses(ctx context.Con
 		}
 
 		if controllerutil.ContainsFinalizer(ipAddress, infrav1alpha1.DeleteFloatingIPFinalizer) {
-			if pool.Spec.ReclaimPolicy == infrav1alpha1.ReclaimDelete && !contains(pool.Spec.PreAllocatedFloatingIPs, ipAddress.Spec.Address) {
-				if err = networkingService.DeleteFloatingIP(pool, ipAddress.Spec.Address); err != nil {
-					return fmt.Errorf("delete floating IP %q: %w", ipAddress.Spec.Address, err)
+			ip := ipAddress.Spec.Address
+			switch {
+			case ipAddress.Annotations[infrav1alpha1.FloatingIPAnnotation] != "":
+				// Floating IPs pinned by the claim belong to the user, so they
+				// are dropped from the pool instead of being deleted or reused
+			case contains(reservedIPs(pool), ip):
+				// Reserved floating IPs are never deleted or held for the claim
+				pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, ip)
+			case pool.Spec.StickyGracePeriod != nil:
+				// The floating IP is already held if updating the IPAddress failed after it was released
+				if !contains(stickyIPs(pool), ip) {
+					pool.Status.StickyIPs = append(pool.Status.StickyIPs, infrav1alpha1.StickyFloatingIP{
+						IP:             ip,
+						ClaimName:      ipAddress.Spec.ClaimRef.Name,
+						ExpirationTime: metav1.NewTime(time.Now().Add(pool.Spec.StickyGracePeriod.Duration)),
+					})
+				}
+			case pool.Spec.ReclaimPolicy == infrav1alpha1.ReclaimDelete && !contains(pool.Spec.PreAllocatedFloatingIPs, ip):
+				if err = networkingService.DeleteFloatingIP(pool, ip); err != nil {
+					return fmt.Errorf("delete floating IP %q: %w", ip, err)
 				}
-			} else {
-				pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, ipAddress.Spec.Address)
+			default:
+				pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, ip)
 			}
 		}
-		controllerutil.RemoveFinalizer(ipAddress, infrav1alpha1.DeleteFloatingIPFinalizer)
+		released := controllerutil.RemoveFinalizer(ipAddress, infrav1alpha1.DeleteFloatingIPFinalizer)
 		if err := r.Client.Update(ctx, ipAddress); err != nil {
 			return err
 		}
+		if released {
+			metrics.ObserveFloatingIPRelease(pool.Namespace, pool.Name)
+		}
 	}
 	allIPs := union(pool.Status.AvailableIPs, pool.Spec.PreAllocatedFloatingIPs)
 	unclaimedIPs := diff(allIPs, pool.Status.ClaimedIPs)
-	pool.Status.AvailableIPs = diff(unclaimedIPs, pool.Status.FailedIPs)
+	pool.Status.AvailableIPs = sortedIPs(diff(diff(unclaimedIPs, pool.Status.FailedIPs), stickyIPs(pool)))
+	pool.Status.ClaimedIPs = sortedIPs(pool.Status.ClaimedIPs)
 	return nil
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) (string, error) {
-	// There's a potential leak of IPs here, if the reconcile loop fails after we claim an IP but before we create the IPAddress object.
-	var ip string
+// reconcileStickyIPs releases the sticky floating IPs whose grace period
+// expired, or all of them if sticky allocation was disabled or the pool is
+// being deleted. A released floating IP is returned to the pool or deleted
+// according to the reclaim policy. It returns the time until the next sticky
+// floating IP expires.
+func (r *OpenStackFloatingIPPoolReconciler) reconcileStickyIPs(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) (time.Duration, error) {
+	if len(pool.Status.StickyIPs) == 0 {
+		return 0, nil
+	}
 
 	networkingService, err := networking.NewService(scope)
 	if err != nil {
-		scope.Logger().Error(err, "Failed to create networking service")
-		return "", err
+		return 0, err
 	}
 
-	// Get tagged floating IPs and add them to the available IPs if they are not present in either the available IPs or the claimed IPs
-	// This is done to prevent leaking floating IPs if the floating IP was created but the IPAddress object was not
-	if len(pool.Status.AvailableIPs) == 0 {
-		taggedIPs, err := networkingService.GetFloatingIPsByTag(pool.GetFloatingIPTag())
-		if err != nil {
-			scope.Logger().Error(err, "Failed to get floating IPs by tag", "pool", pool.Name)
-			return "", err
+	releaseAll := pool.Spec.StickyGracePeriod == nil || !pool.DeletionTimestamp.IsZero()
+	var requeue time.Duration
+	held := []infrav1alpha1.StickyFloatingIP{}
+	for i, sticky := range pool.Status.StickyIPs {
+		if remaining := time.Until(sticky.ExpirationTime.Time); !releaseAll && remaining > 0 {
+			held = append(held, sticky)
+			if requeue == 0 || remaining < requeue {
+				requeue = remaining
+			}
+			continue
+		}
+
+		scope.Logger().Info("Releasing sticky floating IP", "ip", sticky.IP, "claim", sticky.ClaimName)
+		if pool.Spec.ReclaimPolicy == infrav1alpha1.ReclaimDelete && !contains(pool.Spec.PreAllocatedFloatingIPs, sticky.IP) {
+			if err := networkingService.DeleteFloatingIP(pool, sticky.IP); err != nil {
+				// Keep the floating IPs which were not released yet
+				pool.Status.StickyIPs = append(held, pool.Status.StickyIPs[i:]...)
+				return 0, fmt.Errorf("delete floating IP %q: %w", sticky.IP, err)
+			}
+		} else {
+			pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, sticky.IP)
 		}
-		for _, taggedIP := range taggedIPs {
-			if contains(pool.Status.AvailableIPs, taggedIP.FloatingIP) || contains(pool.Status.ClaimedIPs, taggedIP.FloatingIP) {
+	}
+	pool.Status.StickyIPs = held
+	return requeue, nil
+}
+
+// reservedIPs returns the floating IPs reserved by the reservations of the pool.
+func reservedIPs(pool *infrav1alpha1.OpenStackFloatingIPPool) []string {
+	ips := make([]string, 0, len(pool.Spec.Reservations))
+	for _, reservation := range pool.Spec.Reservations {
+		ips = append(ips, reservation.IP)
+	}
+	return ips
+}
+
+// stickyIPs returns the floating IPs which are held for the claims which released them.
+func stickyIPs(pool *infrav1alpha1.OpenStackFloatingIPPool) []string {
+	ips := make([]string, 0, len(pool.Status.StickyIPs))
+	for _, sticky := range pool.Status.StickyIPs {
+		ips = append(ips, sticky.IP)
+	}
+	return ips
+}
+
+// reservedIPForClaim returns the floating IP which is pinned to a claim by
+// its annotation, a reservation of the pool or a sticky floating IP, if any.
+// It returns errReservedIPInUse if the floating IPs reserved for the claim
+// are all allocated to other claims.
+func reservedIPForClaim(pool *infrav1alpha1.OpenStackFloatingIPPool, claim *ipamv1.IPAddressClaim) (string, error) {
+	if ip := claim.Annotations[infrav1alpha1.FloatingIPAnnotation]; ip != "" {
+		return ip, nil
+	}
+
+	var matched bool
+	for _, reservation := range pool.Spec.Reservations {
+		if reservation.ClaimName != "" {
+			if reservation.ClaimName != claim.Name {
+				continue
+			}
+		} else {
+			selector, err := metav1.LabelSelectorAsSelector(reservation.ClaimSelector)
+			if err != nil {
+				return "", fmt.Errorf("invalid claim selector of reservation %s: %w", reservation.IP, err)
+			}
+			if !selector.Matches(labels.Set(claim.Labels)) {
 				continue
 			}
-			scope.Logger().Info("Tagged floating IP found that was not known to the pool, adding it to the pool", "ip", taggedIP.FloatingIP)
-			pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, taggedIP.FloatingIP)
 		}
+		matched = true
+		if !contains(pool.Status.ClaimedIPs, reservation.IP) {
+			return reservation.IP, nil
+		}
+	}
+	if matched {
+		return "", errReservedIPInUse
+	}
+
+	for _, sticky := range pool.Status.StickyIPs {
+		if sticky.ClaimName == claim.Name {
+			return sticky.IP, nil
+		}
+	}
+	return "", nil
+}
+
+// getReservedIP allocates a floating IP reserved for a claim.
+func (r *OpenStackFloatingIPPoolReconciler) getReservedIP(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool, ip string) (string, error) {
+	if contains(pool.Status.ClaimedIPs, ip) {
+		return "", errReservedIPInUse
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return "", err
+	}
+	fp, err := networkingService.GetFloatingIP(ip)
+	if err != nil {
+		return "", fmt.Errorf("get floating IP: %w", err)
+	}
+	if fp == nil {
+		v1beta1conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1alpha1.ReservedIPNotFoundReason, clusterv1beta1.ConditionSeverityError, "Reserved floating IP %s does not exist", ip)
+		return "", fmt.Errorf("reserved floating IP %s does not exist", ip)
+	}
+
+	pool.Status.AvailableIPs = diff(pool.Status.AvailableIPs, []string{ip})
+	pool.Status.StickyIPs = slices.DeleteFunc(pool.Status.StickyIPs, func(sticky infrav1alpha1.StickyFloatingIP) bool {
+		return sticky.IP == ip
+	})
+	return fp.FloatingIP, nil
+}
+
+// getIP returns the floating IP to allocate to a claim: a floating IP reserved for the claim,
+// an available floating IP, or a new one. The floating IP is not yet claimed.
+func (r *OpenStackFloatingIPPoolReconciler) getIP(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool, claim *ipamv1.IPAddressClaim) (string, error) {
+	reservedIP, err := reservedIPForClaim(pool, claim)
+	if err != nil {
+		return "", err
+	}
+	if reservedIP != "" {
+		return r.getReservedIP(scope, pool, reservedIP)
+	}
+
+	var ip string
+	// Reserved and sticky floating IPs are only allocated to the claims they are held for
+	heldIPs := union(reservedIPs(pool), stickyIPs(pool))
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		scope.Logger().Error(err, "Failed to create networking service")
+		return "", err
 	}
 
-	if len(pool.Status.AvailableIPs) > 0 {
-		ip = pool.Status.AvailableIPs[0]
-		pool.Status.AvailableIPs = pool.Status.AvailableIPs[1:]
-		pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ip)
+	if i := slices.IndexFunc(pool.Status.AvailableIPs, func(availableIP string) bool { return !contains(heldIPs, availableIP) }); i >= 0 {
+		ip = pool.Status.AvailableIPs[i]
+		pool.Status.AvailableIPs = slices.Delete(pool.Status.AvailableIPs, i, i+1)
 	}
 
 	if ip != "" {
@@ -347,7 +590,6 @@ func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *sc
 			return "", fmt.Errorf("get floating IP: %w", err)
 		}
 		if fp != nil {
-			pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, fp.FloatingIP)
 			return fp.FloatingIP, nil
 		}
 		pool.Status.FailedIPs = append(pool.Status.FailedIPs, ip)
@@ -360,31 +602,109 @@ func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *sc
 		return "", errMaxIPsReached
 	}
 
-	fp, err := networkingService.CreateFloatingIPForPool(pool)
+	data := floatingIPTemplateDataForClaim(pool, claim)
+	dnsName, err := renderFloatingIPTemplate(pool, "dnsName", pool.Spec.DNSName, data)
+	if err != nil {
+		return "", err
+	}
+	dnsDomain, err := renderFloatingIPTemplate(pool, "dnsDomain", pool.Spec.DNSDomain, data)
+	if err != nil {
+		return "", err
+	}
+
+	// The floating IP is found by its description if the reconcile fails before it is tagged
+	fp, err := networkingService.CreateFloatingIPForPool(pool, dnsName, dnsDomain)
 	if err != nil {
 		scope.Logger().Error(err, "Failed to create floating IP", "pool", pool.Name)
 		v1beta1conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1.OpenStackErrorReason, clusterv1beta1.ConditionSeverityError, "Failed to create floating IP: %v", err)
 		return "", err
 	}
-	defer func() {
-		tag := pool.GetFloatingIPTag()
 
-		err := wait.ExponentialBackoffWithContext(ctx, backoff, func(context.Context) (bool, error) {
-			if err := networkingService.TagFloatingIP(fp.FloatingIP, tag); err != nil {
-				scope.Logger().Error(err, "Failed to tag floating IP, retrying", "ip", fp.FloatingIP, "tag", tag)
-				return false, err
-			}
-			return true, nil
+	if dnsName != "" || dnsDomain != "" {
+		pool.Status.DNSRecords = append(pool.Status.DNSRecords, infrav1alpha1.FloatingIPDNSRecord{
+			IP:        fp.FloatingIP,
+			DNSName:   dnsName,
+			DNSDomain: dnsDomain,
 		})
-		if err != nil {
-			scope.Logger().Error(err, "Failed to tag floating IP", "ip", fp.FloatingIP, "tag", tag)
-		}
-	}()
+	}
 
 	v1beta1conditions.MarkTrue(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition)
-	ip = fp.FloatingIP
-	pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ip)
-	return ip, nil
+	return fp.FloatingIP, nil
+}
+
+// floatingIPClaimUID returns the UID of the claim a floating IP is being allocated to from its tags, if any.
+func floatingIPClaimUID(tags []string) types.UID {
+	for _, tag := range tags {
+		if claimUID, found := strings.CutPrefix(tag, infrav1alpha1.FloatingIPClaimTagPrefix); found {
+			return types.UID(claimUID)
+		}
+	}
+	return ""
+}
+
+// reconcileFloatingIPs makes allocation recoverable if the reconcile fails
+// part way. It finds the floating IPs of the pool by their tag and by the
+// description they were created with, which contains the UID of the pool,
+// and returns the floating IPs tagged for claims which have no IPAddress yet,
+// so that they are allocated to the same claims. Floating IPs tagged for
+// claims which no longer exist are freed, and floating IPs which are neither
+// claimed nor held are adopted as available.
+func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPs(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool, claims []ipamv1.IPAddressClaim) (map[types.UID]string, error) {
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	taggedIPs, err := networkingService.GetFloatingIPsByTag(pool.GetFloatingIPTag())
+	if err != nil {
+		return nil, fmt.Errorf("get floating IPs by tag: %w", err)
+	}
+	createdIPs, err := networkingService.GetFloatingIPsByDescription(pool.GetFloatingIPDescription())
+	if err != nil {
+		return nil, fmt.Errorf("get floating IPs by description: %w", err)
+	}
+
+	pendingClaims := map[types.UID]bool{}
+	for i := range claims {
+		if claims[i].DeletionTimestamp.IsZero() && claims[i].Status.AddressRef.Name == "" {
+			pendingClaims[claims[i].UID] = true
+		}
+	}
+
+	claimIPs := map[types.UID]string{}
+	seen := map[string]bool{}
+	for _, fip := range append(taggedIPs, createdIPs...) {
+		ip := fip.FloatingIP
+		if seen[ip] || contains(pool.Status.ClaimedIPs, ip) {
+			continue
+		}
+		seen[ip] = true
+
+		claimUID := floatingIPClaimUID(fip.Tags)
+		if pendingClaims[claimUID] {
+			scope.Logger().Info("Resuming allocation of floating IP", "ip", ip, "claimUID", claimUID)
+			claimIPs[claimUID] = ip
+			pool.Status.AvailableIPs = diff(pool.Status.AvailableIPs, []string{ip})
+			continue
+		}
+
+		if claimUID != "" {
+			scope.Logger().Info("Freeing floating IP", "ip", ip, "claimUID", claimUID)
+			if err := networkingService.UntagFloatingIP(ip, pool.GetFloatingIPClaimTag(claimUID)); err != nil {
+				return nil, fmt.Errorf("untag floating IP %q: %w", ip, err)
+			}
+		}
+		if !contains(fip.Tags, pool.GetFloatingIPTag()) {
+			if err := networkingService.TagFloatingIP(ip, pool.GetFloatingIPTag()); err != nil {
+				return nil, fmt.Errorf("tag floating IP %q: %w", ip, err)
+			}
+		}
+		if !contains(pool.Status.AvailableIPs, ip) && !contains(stickyIPs(pool), ip) && !contains(pool.Status.FailedIPs, ip) {
+			scope.Logger().Info("Floating IP found that was not known to the pool, adding it to the pool", "ip", ip)
+			pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, ip)
+		}
+	}
+	return claimIPs, nil
 }
 
 func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) error {
@@ -422,6 +742,121 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *sc
 	return nil
 }
 
+// reconcileFloatingIPOptions resolves the subnet, the QoS policy and the
+// description of new floating IPs, and validates the DNS templates.
+func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPOptions(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) error {
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	pool.Status.FloatingIPSubnet = nil
+	if pool.Spec.FloatingIPSubnet != nil {
+		subnet, err := networkingService.GetNetworkSubnetByParam(pool.Status.FloatingIPNetwork.ID, pool.Spec.FloatingIPSubnet)
+		if err != nil {
+			v1beta1conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1alpha1.UnableToFindSubnetReason, clusterv1beta1.ConditionSeverityError, "Failed to find subnet: %v", err)
+			return fmt.Errorf("failed to find subnet: %w", err)
+		}
+		pool.Status.FloatingIPSubnet = &infrav1.Subnet{
+			ID:   subnet.ID,
+			Name: subnet.Name,
+			CIDR: subnet.CIDR,
+			Tags: subnet.Tags,
+		}
+	}
+
+	pool.Status.QoSPolicy = nil
+	if pool.Spec.QoSPolicy != nil {
+		policy, err := networkingService.GetQoSPolicyByParam(pool.Spec.QoSPolicy)
+		if err != nil {
+			v1beta1conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1alpha1.UnableToFindQoSPolicyReason, clusterv1beta1.ConditionSeverityError, "Failed to find QoS policy: %v", err)
+			return fmt.Errorf("failed to find QoS policy: %w", err)
+		}
+		pool.Status.QoSPolicy = &infrav1alpha1.QoSPolicyStatus{
+			ID:   policy.ID,
+			Name: policy.Name,
+		}
+	}
+
+	// The templates of the DNS name and domain are executed for each claim
+	for name, text := range map[string]string{"dnsName": pool.Spec.DNSName, "dnsDomain": pool.Spec.DNSDomain} {
+		if _, err := parseFloatingIPTemplate(pool, name, text); err != nil {
+			return err
+		}
+	}
+
+	description, err := renderFloatingIPTemplate(pool, "description", pool.Spec.Description, floatingIPTemplateData{
+		PoolName:  pool.Name,
+		Namespace: pool.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+	pool.Status.FloatingIPDescription = ""
+	if description != "" {
+		pool.Status.FloatingIPDescription = pool.NewFloatingIPDescription(description)
+	}
+	return nil
+}
+
+// floatingIPTemplateData is the data of the templates of the description,
+// DNS name and DNS domain of new floating IPs.
+type floatingIPTemplateData struct {
+	PoolName    string
+	Namespace   string
+	ClaimName   string
+	ClusterName string
+	Labels      map[string]string
+}
+
+func floatingIPTemplateDataForClaim(pool *infrav1alpha1.OpenStackFloatingIPPool, claim *ipamv1.IPAddressClaim) floatingIPTemplateData {
+	clusterName := claim.Spec.ClusterName
+	if clusterName == "" {
+		clusterName = claim.Labels[clusterv1.ClusterNameLabel]
+	}
+	return floatingIPTemplateData{
+		PoolName:    pool.Name,
+		Namespace:   claim.Namespace,
+		ClaimName:   claim.Name,
+		ClusterName: clusterName,
+		Labels:      claim.Labels,
+	}
+}
+
+func parseFloatingIPTemplate(pool *infrav1alpha1.OpenStackFloatingIPPool, name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1alpha1.InvalidTemplateReason, clusterv1beta1.ConditionSeverityError, "Invalid %s template: %v", name, err)
+		return nil, fmt.Errorf("parse %s template: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// renderFloatingIPTemplate executes a template of the pool. An empty template renders as an empty string.
+func renderFloatingIPTemplate(pool *infrav1alpha1.OpenStackFloatingIPPool, name, text string, data floatingIPTemplateData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := parseFloatingIPTemplate(pool, name, text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1alpha1.InvalidTemplateReason, clusterv1beta1.ConditionSeverityError, "Failed to execute %s template: %v", name, err)
+		return "", fmt.Errorf("execute %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// pruneDNSRecords removes the DNS records of floating IPs which no longer belong to the pool.
+func pruneDNSRecords(pool *infrav1alpha1.OpenStackFloatingIPPool, pendingIPs []string) {
+	poolIPs := union(union(pool.Status.ClaimedIPs, pool.Status.AvailableIPs), union(pool.Status.FailedIPs, union(stickyIPs(pool), pendingIPs)))
+	pool.Status.DNSRecords = slices.DeleteFunc(pool.Status.DNSRecords, func(record infrav1alpha1.FloatingIPDNSRecord) bool {
+		return !contains(poolIPs, record.IP)
+	})
+}
+
 func (r *OpenStackFloatingIPPoolReconciler) ipAddressClaimToPoolMapper(_ context.Context, o client.Object) []ctrl.Request {
 	claim, ok := o.(*ipamv1.IPAddressClaim)
 	if !ok {
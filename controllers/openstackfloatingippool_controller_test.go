@@ -17,21 +17,38 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"testing"
+	"time"
 
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/test/framework"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 )
 
@@ -176,3 +193,817 @@ var _ = Describe("OpenStackFloatingIPPool controller", func() {
 		Expect(condition.Message).To(ContainSubstring("Failed to create OpenStack client scope"))
 	})
 })
+
+func TestReservedIPForClaim(t *testing.T) {
+	pool := &infrav1alpha1.OpenStackFloatingIPPool{
+		Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+			Reservations: []infrav1alpha1.FloatingIPReservation{
+				{IP: "192.0.2.1", ClaimName: "named-claim"},
+				{IP: "192.0.2.2", ClaimSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "ingress"}}},
+				{IP: "192.0.2.3", ClaimSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "ingress"}}},
+			},
+		},
+		Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+			ClaimedIPs: []string{"192.0.2.2"},
+			StickyIPs: []infrav1alpha1.StickyFloatingIP{
+				{IP: "192.0.2.10", ClaimName: "sticky-claim"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		claim   *ipamv1.IPAddressClaim
+		claimed []string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "Claim without reservation",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "other-claim"}},
+		},
+		{
+			name: "Claim with annotation",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{
+				Name:        "named-claim",
+				Annotations: map[string]string{infrav1alpha1.FloatingIPAnnotation: "192.0.2.20"},
+			}},
+			want: "192.0.2.20",
+		},
+		{
+			name:  "Claim reserved by name",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "named-claim"}},
+			want:  "192.0.2.1",
+		},
+		{
+			name: "Claim reserved by selector skips claimed IPs",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{
+				Name:   "ingress-claim",
+				Labels: map[string]string{"role": "ingress"},
+			}},
+			want: "192.0.2.3",
+		},
+		{
+			name: "Claim reserved by selector with all IPs claimed",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{
+				Name:   "ingress-claim",
+				Labels: map[string]string{"role": "ingress"},
+			}},
+			claimed: []string{"192.0.2.2", "192.0.2.3"},
+			wantErr: errReservedIPInUse,
+		},
+		{
+			name:  "Claim with sticky IP",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "sticky-claim"}},
+			want:  "192.0.2.10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			pool := pool.DeepCopy()
+			if tt.claimed != nil {
+				pool.Status.ClaimedIPs = tt.claimed
+			}
+			got, err := reservedIPForClaim(pool, tt.claim)
+			if tt.wantErr != nil {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetIPSkipsHeldIPs(t *testing.T) {
+	g := NewWithT(t)
+	log := testr.New(t)
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+	pool := &infrav1alpha1.OpenStackFloatingIPPool{
+		Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+			Reservations: []infrav1alpha1.FloatingIPReservation{
+				{IP: "192.0.2.1", ClaimName: "reserved-claim"},
+			},
+		},
+		Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+			AvailableIPs: []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+			ClaimedIPs:   []string{},
+			StickyIPs: []infrav1alpha1.StickyFloatingIP{
+				{IP: "192.0.2.2", ClaimName: "sticky-claim", ExpirationTime: metav1.NewTime(time.Now().Add(time.Hour))},
+			},
+		},
+	}
+	mockScopeFactory.NetworkClient.EXPECT().ListFloatingIP(floatingips.ListOpts{FloatingIP: "192.0.2.3"}).
+		Return([]floatingips.FloatingIP{{FloatingIP: "192.0.2.3"}}, nil)
+	mockScopeFactory.NetworkClient.EXPECT().ListFloatingIP(floatingips.ListOpts{FloatingIP: "192.0.2.2"}).
+		Return([]floatingips.FloatingIP{{FloatingIP: "192.0.2.2"}}, nil)
+
+	r := &OpenStackFloatingIPPoolReconciler{}
+	ip, err := r.getIP(scopeWithLogger, pool, &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "other-claim"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ip).To(Equal("192.0.2.3"))
+	g.Expect(pool.Status.AvailableIPs).To(ConsistOf("192.0.2.1", "192.0.2.2"))
+
+	ip, err = r.getIP(scopeWithLogger, pool, &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "sticky-claim"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ip).To(Equal("192.0.2.2"))
+	g.Expect(pool.Status.AvailableIPs).To(ConsistOf("192.0.2.1"))
+	g.Expect(pool.Status.StickyIPs).To(BeEmpty())
+}
+
+func TestReconcileStickyIPs(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		reclaimPolicy infrav1alpha1.ReclaimPolicy
+		gracePeriod   *metav1.Duration
+		stickyIPs     []infrav1alpha1.StickyFloatingIP
+		expect        func(m *mock.MockNetworkClientMockRecorder)
+		wantSticky    []string
+		wantAvailable []string
+		wantRequeue   time.Duration
+	}{
+		{
+			name:          "Expired IPs are returned to the pool",
+			reclaimPolicy: infrav1alpha1.ReclaimRetain,
+			gracePeriod:   &metav1.Duration{Duration: time.Hour},
+			stickyIPs: []infrav1alpha1.StickyFloatingIP{
+				{IP: "192.0.2.1", ClaimName: "a", ExpirationTime: metav1.NewTime(now.Add(-time.Minute))},
+				{IP: "192.0.2.2", ClaimName: "b", ExpirationTime: metav1.NewTime(now.Add(10 * time.Minute))},
+				{IP: "192.0.2.3", ClaimName: "c", ExpirationTime: metav1.NewTime(now.Add(30 * time.Minute))},
+			},
+			wantSticky:    []string{"192.0.2.2", "192.0.2.3"},
+			wantAvailable: []string{"192.0.2.1"},
+			wantRequeue:   10 * time.Minute,
+		},
+		{
+			name:          "Expired IPs are deleted",
+			reclaimPolicy: infrav1alpha1.ReclaimDelete,
+			gracePeriod:   &metav1.Duration{Duration: time.Hour},
+			stickyIPs: []infrav1alpha1.StickyFloatingIP{
+				{IP: "192.0.2.1", ClaimName: "a", ExpirationTime: metav1.NewTime(now.Add(-time.Minute))},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListFloatingIP(floatingips.ListOpts{FloatingIP: "192.0.2.1"}).Return([]floatingips.FloatingIP{{ID: "fip-1", FloatingIP: "192.0.2.1"}}, nil)
+				m.DeleteFloatingIP("fip-1").Return(nil)
+			},
+			wantSticky:    []string{},
+			wantAvailable: []string{},
+		},
+		{
+			name:          "All IPs are released when sticky allocation is disabled",
+			reclaimPolicy: infrav1alpha1.ReclaimRetain,
+			stickyIPs: []infrav1alpha1.StickyFloatingIP{
+				{IP: "192.0.2.1", ClaimName: "a", ExpirationTime: metav1.NewTime(now.Add(time.Hour))},
+			},
+			wantSticky:    []string{},
+			wantAvailable: []string{"192.0.2.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+			if tt.expect != nil {
+				tt.expect(mockScopeFactory.NetworkClient.EXPECT())
+			}
+
+			pool := &infrav1alpha1.OpenStackFloatingIPPool{
+				Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+					ReclaimPolicy:     tt.reclaimPolicy,
+					StickyGracePeriod: tt.gracePeriod,
+				},
+				Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+					AvailableIPs: []string{},
+					StickyIPs:    tt.stickyIPs,
+				},
+			}
+			r := &OpenStackFloatingIPPoolReconciler{}
+			requeue, err := r.reconcileStickyIPs(scopeWithLogger, pool)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(requeue).To(BeNumerically("~", tt.wantRequeue, time.Second))
+			g.Expect(stickyIPs(pool)).To(ConsistOf(tt.wantSticky))
+			g.Expect(pool.Status.AvailableIPs).To(ConsistOf(tt.wantAvailable))
+		})
+	}
+}
+
+func TestReconcileIPAddressesHoldsStickyIPs(t *testing.T) {
+	g := NewWithT(t)
+	log := testr.New(t)
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+	pool := &infrav1alpha1.OpenStackFloatingIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "test-namespace"},
+		Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+			ReclaimPolicy:     infrav1alpha1.ReclaimDelete,
+			StickyGracePeriod: &metav1.Duration{Duration: time.Hour},
+			Reservations: []infrav1alpha1.FloatingIPReservation{
+				{IP: "192.0.2.2", ClaimName: "reserved-claim"},
+			},
+		},
+	}
+	releasedIPAddress := func(name, ip string) *ipamv1.IPAddress {
+		return &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         pool.Namespace,
+				Finalizers:        []string{infrav1alpha1.DeleteFloatingIPFinalizer},
+				DeletionTimestamp: ptr.To(metav1.Now()),
+			},
+			Spec: ipamv1.IPAddressSpec{
+				ClaimRef: ipamv1.IPAddressClaimReference{Name: name},
+				PoolRef:  ipamv1.IPPoolReference{Kind: openStackFloatingIPPool, Name: pool.Name},
+				Address:  ip,
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(ipamv1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(releasedIPAddress("sticky-claim", "192.0.2.1"), releasedIPAddress("reserved-claim", "192.0.2.2")).
+		WithIndex(&ipamv1.IPAddress{}, infrav1alpha1.OpenStackFloatingIPPoolNameIndex, func(o client.Object) []string {
+			return []string{o.(*ipamv1.IPAddress).Spec.PoolRef.Name}
+		}).
+		Build()
+
+	r := &OpenStackFloatingIPPoolReconciler{Client: fakeClient}
+	g.Expect(r.reconcileIPAddresses(ctx, scopeWithLogger, pool)).To(Succeed())
+
+	g.Expect(pool.Status.StickyIPs).To(HaveLen(1))
+	g.Expect(pool.Status.StickyIPs[0].IP).To(Equal("192.0.2.1"))
+	g.Expect(pool.Status.StickyIPs[0].ClaimName).To(Equal("sticky-claim"))
+	g.Expect(pool.Status.StickyIPs[0].ExpirationTime.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	g.Expect(pool.Status.AvailableIPs).To(ConsistOf("192.0.2.2"))
+}
+
+func TestReconcileIPAddressesReleasesPinnedIPs(t *testing.T) {
+	g := NewWithT(t)
+	log := testr.New(t)
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+	expirationTime := metav1.NewTime(time.Now().Add(time.Minute))
+	pool := &infrav1alpha1.OpenStackFloatingIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "test-namespace"},
+		Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+			ReclaimPolicy:     infrav1alpha1.ReclaimDelete,
+			StickyGracePeriod: &metav1.Duration{Duration: time.Hour},
+		},
+		Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+			// Held by a previous reconcile which failed to remove the finalizer
+			StickyIPs: []infrav1alpha1.StickyFloatingIP{
+				{IP: "192.0.2.1", ClaimName: "sticky-claim", ExpirationTime: expirationTime},
+			},
+		},
+	}
+	releasedIPAddress := func(name, ip string, annotations map[string]string) *ipamv1.IPAddress {
+		return &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         pool.Namespace,
+				Annotations:       annotations,
+				Finalizers:        []string{infrav1alpha1.DeleteFloatingIPFinalizer},
+				DeletionTimestamp: ptr.To(metav1.Now()),
+			},
+			Spec: ipamv1.IPAddressSpec{
+				ClaimRef: ipamv1.IPAddressClaimReference{Name: name},
+				PoolRef:  ipamv1.IPPoolReference{Kind: openStackFloatingIPPool, Name: pool.Name},
+				Address:  ip,
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(ipamv1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(
+			releasedIPAddress("sticky-claim", "192.0.2.1", nil),
+			releasedIPAddress("pinned-claim", "192.0.2.3", map[string]string{infrav1alpha1.FloatingIPAnnotation: "192.0.2.3"}),
+		).
+		WithIndex(&ipamv1.IPAddress{}, infrav1alpha1.OpenStackFloatingIPPoolNameIndex, func(o client.Object) []string {
+			return []string{o.(*ipamv1.IPAddress).Spec.PoolRef.Name}
+		}).
+		Build()
+
+	// The pinned floating IP is neither deleted nor returned to the pool
+	r := &OpenStackFloatingIPPoolReconciler{Client: fakeClient}
+	g.Expect(r.reconcileIPAddresses(ctx, scopeWithLogger, pool)).To(Succeed())
+
+	g.Expect(pool.Status.StickyIPs).To(Equal([]infrav1alpha1.StickyFloatingIP{
+		{IP: "192.0.2.1", ClaimName: "sticky-claim", ExpirationTime: expirationTime},
+	}))
+	g.Expect(pool.Status.AvailableIPs).To(BeEmpty())
+	g.Expect(pool.Status.ClaimedIPs).To(BeEmpty())
+}
+
+func TestReconcileFloatingIPOptions(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       infrav1alpha1.OpenStackFloatingIPPoolSpec
+		expect     func(m *mock.MockNetworkClientMockRecorder)
+		wantStatus infrav1alpha1.OpenStackFloatingIPPoolStatus
+		wantReason string
+	}{
+		{
+			name: "No options",
+			wantStatus: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+				FloatingIPNetwork: &infrav1.NetworkStatus{ID: "external-network"},
+			},
+		},
+		{
+			name: "Options are resolved",
+			spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+				FloatingIPSubnet: &infrav1.SubnetParam{Filter: &infrav1.SubnetFilter{Name: "public-routed"}},
+				QoSPolicy:        &infrav1alpha1.QoSPolicyParam{Filter: &infrav1alpha1.QoSPolicyFilter{Name: "fip-limit"}},
+				DNSName:          "{{ .ClaimName }}",
+				Description:      "{{ .Namespace }}/{{ .PoolName }}",
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListSubnet(subnets.ListOpts{Name: "public-routed", NetworkID: "external-network"}).
+					Return([]subnets.Subnet{{ID: "subnet-id", Name: "public-routed", CIDR: "203.0.113.0/24"}}, nil)
+				m.ListQoSPolicy(policies.ListOpts{Name: "fip-limit"}).
+					Return([]policies.Policy{{ID: "qos-policy-id", Name: "fip-limit"}}, nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+				FloatingIPNetwork:     &infrav1.NetworkStatus{ID: "external-network"},
+				FloatingIPSubnet:      &infrav1.Subnet{ID: "subnet-id", Name: "public-routed", CIDR: "203.0.113.0/24"},
+				QoSPolicy:             &infrav1alpha1.QoSPolicyStatus{ID: "qos-policy-id", Name: "fip-limit"},
+				FloatingIPDescription: "test-namespace/test-pool (pool UID 7d2f6c1e-3b4a-4c5d-8e9f-0a1b2c3d4e5f)",
+			},
+		},
+		{
+			name: "Subnet not found",
+			spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+				FloatingIPSubnet: &infrav1.SubnetParam{Filter: &infrav1.SubnetFilter{Name: "public-routed"}},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListSubnet(subnets.ListOpts{Name: "public-routed", NetworkID: "external-network"}).Return(nil, nil)
+			},
+			wantReason: infrav1alpha1.UnableToFindSubnetReason,
+		},
+		{
+			name: "Invalid DNS name template",
+			spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+				DNSName: "{{ .ClaimName",
+			},
+			wantReason: infrav1alpha1.InvalidTemplateReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(mockScopeFactory.NetworkClient.EXPECT())
+			}
+
+			pool := &infrav1alpha1.OpenStackFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "test-namespace", UID: "7d2f6c1e-3b4a-4c5d-8e9f-0a1b2c3d4e5f"},
+				Spec:       tt.spec,
+				Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+					FloatingIPNetwork: &infrav1.NetworkStatus{ID: "external-network"},
+				},
+			}
+			r := &OpenStackFloatingIPPoolReconciler{}
+			err := r.reconcileFloatingIPOptions(scope.NewWithLogger(mockScopeFactory, testr.New(t)), pool)
+			if tt.wantReason != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(v1beta1conditions.GetReason(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition)).To(Equal(tt.wantReason))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(pool.Status).To(Equal(tt.wantStatus))
+		})
+	}
+}
+
+func TestFloatingIPTemplates(t *testing.T) {
+	g := NewWithT(t)
+	pool := &infrav1alpha1.OpenStackFloatingIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "test-namespace"},
+	}
+	claim := &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-machine-0",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: "test-cluster",
+				"role":                     "ingress",
+			},
+		},
+	}
+	data := floatingIPTemplateDataForClaim(pool, claim)
+
+	dnsName, err := renderFloatingIPTemplate(pool, "dnsName", `{{ .ClaimName }}-{{ index .Labels "role" }}`, data)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dnsName).To(Equal("test-machine-0-ingress"))
+
+	dnsDomain, err := renderFloatingIPTemplate(pool, "dnsDomain", "{{ .ClusterName }}.example.com.", data)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dnsDomain).To(Equal("test-cluster.example.com."))
+
+	empty, err := renderFloatingIPTemplate(pool, "dnsName", "", data)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(empty).To(BeEmpty())
+
+	_, err = renderFloatingIPTemplate(pool, "dnsName", "{{ .Unknown }}", data)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(v1beta1conditions.GetReason(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition)).To(Equal(infrav1alpha1.InvalidTemplateReason))
+}
+
+func TestReconcileUtilization(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxIPs        *int
+		threshold     *int32
+		claimedIPs    []string
+		condition     *clusterv1beta1.Condition
+		wantCondition *clusterv1beta1.Condition
+		wantEvents    int
+	}{
+		{
+			name:       "No threshold",
+			maxIPs:     ptr.To(2),
+			claimedIPs: []string{"192.0.2.1", "192.0.2.2"},
+		},
+		{
+			name:          "Below threshold",
+			maxIPs:        ptr.To(4),
+			threshold:     ptr.To(int32(80)),
+			claimedIPs:    []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+			wantCondition: v1beta1conditions.TrueCondition(infrav1alpha1.UtilizationBelowThresholdCondition),
+		},
+		{
+			name:       "Threshold reached",
+			maxIPs:     ptr.To(4),
+			threshold:  ptr.To(int32(75)),
+			claimedIPs: []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+			condition:  v1beta1conditions.TrueCondition(infrav1alpha1.UtilizationBelowThresholdCondition),
+			wantCondition: v1beta1conditions.FalseCondition(infrav1alpha1.UtilizationBelowThresholdCondition, infrav1alpha1.UtilizationThresholdExceededReason, clusterv1beta1.ConditionSeverityWarning,
+				"3 of 4 floating IPs are claimed (75%%), the utilization threshold is 75%%"),
+			wantEvents: 1,
+		},
+		{
+			name:       "Threshold still exceeded",
+			maxIPs:     ptr.To(4),
+			threshold:  ptr.To(int32(75)),
+			claimedIPs: []string{"192.0.2.1", "192.0.2.2", "192.0.2.3", "192.0.2.4"},
+			condition: v1beta1conditions.FalseCondition(infrav1alpha1.UtilizationBelowThresholdCondition, infrav1alpha1.UtilizationThresholdExceededReason, clusterv1beta1.ConditionSeverityWarning,
+				"3 of 4 floating IPs are claimed (75%%), the utilization threshold is 75%%"),
+			wantCondition: v1beta1conditions.FalseCondition(infrav1alpha1.UtilizationBelowThresholdCondition, infrav1alpha1.UtilizationThresholdExceededReason, clusterv1beta1.ConditionSeverityWarning,
+				"4 of 4 floating IPs are claimed (100%%), the utilization threshold is 75%%"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			pool := &infrav1alpha1.OpenStackFloatingIPPool{
+				Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+					MaxIPs:               tt.maxIPs,
+					UtilizationThreshold: tt.threshold,
+				},
+				Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+					ClaimedIPs: tt.claimedIPs,
+				},
+			}
+			if tt.condition != nil {
+				v1beta1conditions.Set(pool, tt.condition)
+			}
+			recorder := record.NewFakeRecorder(10)
+			r := &OpenStackFloatingIPPoolReconciler{Recorder: recorder}
+			r.reconcileUtilization(pool)
+
+			condition := v1beta1conditions.Get(pool, infrav1alpha1.UtilizationBelowThresholdCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+				g.Expect(condition.Message).To(Equal(tt.wantCondition.Message))
+			}
+			g.Expect(recorder.Events).To(HaveLen(tt.wantEvents))
+		})
+	}
+}
+
+// fakeNeutron is a minimal in-memory Neutron for the floating IPs of a pool.
+type fakeNeutron struct {
+	fips      map[string]*floatingips.FloatingIP
+	created   int
+	failTag   bool
+	failClaim bool
+}
+
+func (n *fakeNeutron) expect(m *mock.MockNetworkClientMockRecorder) {
+	m.ListFloatingIP(gomock.Any()).DoAndReturn(func(opts floatingips.ListOptsBuilder) ([]floatingips.FloatingIP, error) {
+		listOpts := opts.(floatingips.ListOpts)
+		var result []floatingips.FloatingIP
+		for _, fip := range n.fips {
+			if (listOpts.FloatingIP == "" || listOpts.FloatingIP == fip.FloatingIP) &&
+				(listOpts.Description == "" || listOpts.Description == fip.Description) &&
+				(listOpts.Tags == "" || slices.Contains(fip.Tags, listOpts.Tags)) {
+				result = append(result, *fip)
+			}
+		}
+		return result, nil
+	}).AnyTimes()
+	m.CreateFloatingIP(gomock.Any()).DoAndReturn(func(opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error) {
+		n.created++
+		fip := &floatingips.FloatingIP{
+			ID:          fmt.Sprintf("fip-%d", n.created),
+			FloatingIP:  fmt.Sprintf("203.0.113.%d", n.created),
+			Description: opts.(floatingips.CreateOpts).Description,
+		}
+		n.fips[fip.FloatingIP] = fip
+		return fip, nil
+	}).AnyTimes()
+	m.AddAttributesTag("floatingips", gomock.Any(), gomock.Any()).DoAndReturn(func(_, id, tag string) error {
+		if n.failTag {
+			return fmt.Errorf("test error")
+		}
+		for _, fip := range n.fips {
+			if fip.ID == id {
+				fip.Tags = append(fip.Tags, tag)
+			}
+		}
+		return nil
+	}).AnyTimes()
+	m.DeleteAttributesTag("floatingips", gomock.Any(), gomock.Any()).DoAndReturn(func(_, id, tag string) error {
+		for _, fip := range n.fips {
+			if fip.ID == id {
+				fip.Tags = slices.DeleteFunc(fip.Tags, func(t string) bool { return t == tag })
+			}
+		}
+		return nil
+	}).AnyTimes()
+}
+
+func TestReconcileFloatingIPsIgnoresOtherFloatingIPs(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+	pool := &infrav1alpha1.OpenStackFloatingIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "test-namespace", UID: "7d2f6c1e-3b4a-4c5d-8e9f-0a1b2c3d4e5f"},
+	}
+	otherPool := &infrav1alpha1.OpenStackFloatingIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "other-namespace", UID: "0f1e2d3c-4b5a-4968-8776-655443322110"},
+	}
+	pool.Status.FloatingIPDescription = pool.NewFloatingIPDescription("public IP")
+	otherPool.Status.FloatingIPDescription = otherPool.NewFloatingIPDescription("public IP")
+
+	neutron := &fakeNeutron{fips: map[string]*floatingips.FloatingIP{
+		"198.51.100.1": {ID: "user-fip", FloatingIP: "198.51.100.1", Description: "public IP", Tags: []string{"user-tag"}},
+		"198.51.100.2": {ID: "other-pool-fip", FloatingIP: "198.51.100.2", Description: otherPool.GetFloatingIPDescription()},
+		"198.51.100.3": {ID: "pool-fip", FloatingIP: "198.51.100.3", Description: pool.GetFloatingIPDescription()},
+	}}
+	neutron.expect(mockScopeFactory.NetworkClient.EXPECT())
+
+	r := &OpenStackFloatingIPPoolReconciler{}
+	claimIPs, err := r.reconcileFloatingIPs(scope.NewWithLogger(mockScopeFactory, testr.New(t)), pool, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(claimIPs).To(BeEmpty())
+
+	// Only the floating IP created by the pool is adopted and tagged
+	g.Expect(pool.Status.AvailableIPs).To(Equal([]string{"198.51.100.3"}))
+	g.Expect(neutron.fips["198.51.100.1"].Tags).To(Equal([]string{"user-tag"}))
+	g.Expect(neutron.fips["198.51.100.2"].Tags).To(BeEmpty())
+	g.Expect(neutron.fips["198.51.100.3"].Tags).To(Equal([]string{pool.GetFloatingIPTag()}))
+}
+
+func TestFloatingIPPoolAllocationCrashPoints(t *testing.T) {
+	const claimName = "test-claim"
+	const claimUID = types.UID("5cc7a8b4-8a3f-4b5e-9c56-a2b7a1c4d6e8")
+	ctx := context.TODO()
+
+	tests := []struct {
+		name string
+		// crash prepares the failure of the first reconcile
+		crash func(n *fakeNeutron, failures map[string]bool)
+		// between is run between the failed and the next reconcile
+		between func(g Gomega, c client.Client)
+		// preAllocated allocates a pre-allocated floating IP of the user instead of creating one
+		preAllocated bool
+		// pinned allocates a floating IP of the user pinned by the annotation of the claim
+		pinned          bool
+		wantClaimed     bool
+		wantTags        []string
+		wantAvailableIP bool
+	}{
+		{
+			name: "Floating IP created but not tagged",
+			crash: func(n *fakeNeutron, _ map[string]bool) {
+				n.failTag = true
+			},
+			wantClaimed: true,
+			wantTags:    []string{"cluster-api-provider-openstack-fip-pool-test-pool", infrav1alpha1.FloatingIPClaimTagPrefix + string(claimUID)},
+		},
+		{
+			name: "Floating IP tagged but IPAddress not created",
+			crash: func(_ *fakeNeutron, failures map[string]bool) {
+				failures["createIPAddress"] = true
+			},
+			wantClaimed: true,
+			wantTags:    []string{"cluster-api-provider-openstack-fip-pool-test-pool", infrav1alpha1.FloatingIPClaimTagPrefix + string(claimUID)},
+		},
+		{
+			name: "Pre-allocated floating IP tagged but IPAddress not created",
+			crash: func(_ *fakeNeutron, failures map[string]bool) {
+				failures["createIPAddress"] = true
+			},
+			preAllocated: true,
+			wantClaimed:  true,
+			wantTags:     []string{"user-tag", infrav1alpha1.FloatingIPClaimTagPrefix + string(claimUID)},
+		},
+		{
+			name: "Pinned floating IP tagged but IPAddress not created",
+			crash: func(_ *fakeNeutron, failures map[string]bool) {
+				failures["createIPAddress"] = true
+			},
+			pinned:      true,
+			wantClaimed: true,
+			wantTags:    []string{"user-tag", infrav1alpha1.FloatingIPClaimTagPrefix + string(claimUID)},
+		},
+		{
+			name: "Floating IP tagged but claim deleted before IPAddress created",
+			crash: func(_ *fakeNeutron, failures map[string]bool) {
+				failures["createIPAddress"] = true
+			},
+			between: func(g Gomega, c client.Client) {
+				claim := &ipamv1.IPAddressClaim{}
+				g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "test-namespace", Name: claimName}, claim)).To(Succeed())
+				g.Expect(c.Delete(ctx, claim)).To(Succeed())
+			},
+			wantTags:        []string{"cluster-api-provider-openstack-fip-pool-test-pool"},
+			wantAvailableIP: true,
+		},
+		{
+			name: "IPAddress created but claim status not updated",
+			crash: func(_ *fakeNeutron, failures map[string]bool) {
+				failures["updateClaimStatus"] = true
+			},
+			wantClaimed: true,
+			wantTags:    []string{"cluster-api-provider-openstack-fip-pool-test-pool", infrav1alpha1.FloatingIPClaimTagPrefix + string(claimUID)},
+		},
+		{
+			name: "Claim status updated but pool status not written",
+			crash: func(_ *fakeNeutron, failures map[string]bool) {
+				failures["updatePoolStatus"] = true
+			},
+			wantClaimed: true,
+			wantTags:    []string{"cluster-api-provider-openstack-fip-pool-test-pool", infrav1alpha1.FloatingIPClaimTagPrefix + string(claimUID)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			neutron := &fakeNeutron{fips: map[string]*floatingips.FloatingIP{}}
+			neutron.expect(mockScopeFactory.NetworkClient.EXPECT())
+
+			pool := &infrav1alpha1.OpenStackFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-pool",
+					Namespace:  "test-namespace",
+					UID:        "7d2f6c1e-3b4a-4c5d-8e9f-0a1b2c3d4e5f",
+					Finalizers: []string{infrav1alpha1.OpenStackFloatingIPPoolFinalizer},
+				},
+				Spec: infrav1alpha1.OpenStackFloatingIPPoolSpec{
+					ReclaimPolicy: infrav1alpha1.ReclaimDelete,
+				},
+				Status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+					FloatingIPNetwork: &infrav1.NetworkStatus{ID: "external-network"},
+				},
+			}
+			if tt.preAllocated || tt.pinned {
+				neutron.fips["198.51.100.1"] = &floatingips.FloatingIP{ID: "user-fip", FloatingIP: "198.51.100.1", Tags: []string{"user-tag"}}
+			}
+			if tt.preAllocated {
+				pool.Spec.PreAllocatedFloatingIPs = []string{"198.51.100.1"}
+			}
+			claim := &ipamv1.IPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      claimName,
+					Namespace: pool.Namespace,
+					UID:       claimUID,
+				},
+				Spec: ipamv1.IPAddressClaimSpec{
+					PoolRef: ipamv1.IPPoolReference{
+						APIGroup: infrav1alpha1.SchemeGroupVersion.Group,
+						Kind:     openStackFloatingIPPool,
+						Name:     pool.Name,
+					},
+				},
+			}
+
+			if tt.pinned {
+				claim.Annotations = map[string]string{infrav1alpha1.FloatingIPAnnotation: "198.51.100.1"}
+			}
+
+			failures := map[string]bool{}
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(ipamv1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(pool, claim).
+				WithStatusSubresource(pool, claim).
+				WithIndex(&ipamv1.IPAddressClaim{}, infrav1alpha1.OpenStackFloatingIPPoolNameIndex, func(o client.Object) []string {
+					return []string{o.(*ipamv1.IPAddressClaim).Spec.PoolRef.Name}
+				}).
+				WithIndex(&ipamv1.IPAddress{}, infrav1alpha1.OpenStackFloatingIPPoolNameIndex, func(o client.Object) []string {
+					return []string{o.(*ipamv1.IPAddress).Spec.PoolRef.Name}
+				}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						if _, ok := obj.(*ipamv1.IPAddress); ok && failures["createIPAddress"] {
+							return fmt.Errorf("test error")
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+					SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						switch obj.(type) {
+						case *ipamv1.IPAddressClaim:
+							if failures["updateClaimStatus"] {
+								return fmt.Errorf("test error")
+							}
+						case *infrav1alpha1.OpenStackFloatingIPPool:
+							if failures["updatePoolStatus"] {
+								return fmt.Errorf("test error")
+							}
+						}
+						return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+					},
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						if _, ok := obj.(*infrav1alpha1.OpenStackFloatingIPPool); ok && failures["updatePoolStatus"] {
+							return fmt.Errorf("test error")
+						}
+						return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+
+			r := &OpenStackFloatingIPPoolReconciler{
+				Client:       fakeClient,
+				ScopeFactory: mockScopeFactory,
+			}
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pool)}
+
+			tt.crash(neutron, failures)
+			_, err := r.Reconcile(ctx, req)
+			g.Expect(err).To(HaveOccurred())
+
+			neutron.failTag = false
+			clear(failures)
+			if tt.between != nil {
+				tt.between(g, fakeClient)
+			}
+			// A reconcile which marks the pool ready conflicts with its own status update, and is retried
+			for range 3 {
+				if _, err = r.Reconcile(ctx, req); err == nil {
+					break
+				}
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// No floating IP was leaked
+			g.Expect(neutron.fips).To(HaveLen(1))
+			var fip *floatingips.FloatingIP
+			for _, f := range neutron.fips {
+				fip = f
+			}
+			g.Expect(fip.Tags).To(ConsistOf(tt.wantTags))
+
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pool), pool)).To(Succeed())
+			if tt.wantClaimed {
+				g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(claim), claim)).To(Succeed())
+				g.Expect(claim.Status.AddressRef.Name).To(Equal(claimName))
+				ipAddress := &ipamv1.IPAddress{}
+				g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: pool.Namespace, Name: claimName}, ipAddress)).To(Succeed())
+				g.Expect(ipAddress.Spec.Address).To(Equal(fip.FloatingIP))
+				g.Expect(pool.Status.ClaimedIPs).To(Equal([]string{fip.FloatingIP}))
+			} else {
+				g.Expect(pool.Status.ClaimedIPs).To(BeEmpty())
+			}
+			if tt.wantAvailableIP {
+				g.Expect(pool.Status.AvailableIPs).To(Equal([]string{fip.FloatingIP}))
+			} else {
+				g.Expect(pool.Status.AvailableIPs).To(BeEmpty())
+			}
+		})
+	}
+}
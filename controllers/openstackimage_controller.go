@@ -0,0 +1,378 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const (
+	// waitForImageToReconcile is the requeue interval while the image data is transferred or the image is still referenced.
+	waitForImageToReconcile = 10 * time.Second
+
+	// retryImageImportInterval is the requeue interval after an import failed or the checksum didn't match.
+	retryImageImportInterval = 10 * time.Minute
+
+	// imageDataIdleTimeout is how long the transfer of image data which is uploaded to Glance may stall.
+	imageDataIdleTimeout = 5 * time.Minute
+)
+
+// OpenStackImageReconciler reconciles a OpenStackImage object.
+type OpenStackImageReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	Scheme           *runtime.Scheme
+	CaCertificates   []byte // PEM encoded ca certificates.
+	// HTTPClient is used to download image data which is uploaded to Glance.
+	// It defaults to a client returned by compute.NewImageSourceHTTPClient.
+	HTTPClient *http.Client
+	// GlanceHashingAlgorithm is the hashing algorithm Glance uses for the
+	// checksums of images it downloads itself. It defaults to sha512, the
+	// default of Glance.
+	GlanceHashingAlgorithm infrav1alpha1.ImageChecksumAlgorithm
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackimages,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackservers,verbs=get;list;watch
+
+func (r *OpenStackImageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	openStackImage := &infrav1alpha1.OpenStackImage{}
+	if err := r.Client.Get(ctx, req.NamespacedName, openStackImage); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patchHelper, err := patch.NewHelper(openStackImage, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, openStackImage); err != nil {
+			if reterr == nil {
+				reterr = fmt.Errorf("error patching OpenStackImage %s/%s: %w", openStackImage.Namespace, openStackImage.Name, err)
+			}
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromObject(ctx, r.Client, r.CaCertificates, log, openStackImage)
+	if err != nil {
+		v1beta1conditions.MarkFalse(openStackImage, infrav1.OpenStackAuthenticationSucceeded, infrav1.OpenStackAuthenticationFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to create OpenStack client scope: %v", err)
+		return reconcile.Result{}, err
+	}
+	v1beta1conditions.MarkTrue(openStackImage, infrav1.OpenStackAuthenticationSucceeded)
+	scope := scope.NewWithLogger(clientScope, log)
+
+	if !openStackImage.DeletionTimestamp.IsZero() {
+		serverList := &infrav1alpha1.OpenStackServerList{}
+		if err := r.Client.List(ctx, serverList, client.InNamespace(openStackImage.Namespace), client.MatchingFields{infrav1alpha1.OpenStackServerOpenStackImageIndex: openStackImage.Name}); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.reconcileDelete(ctx, scope, openStackImage, len(serverList.Items))
+	}
+
+	if controllerutil.AddFinalizer(openStackImage, infrav1alpha1.OpenStackImageFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.setTemplateOwnerReferences(ctx, openStackImage); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return r.reconcileNormal(ctx, scope, openStackImage)
+}
+
+// setTemplateOwnerReferences makes every OpenStackMachineTemplate which
+// references the image an owner of it, so that the image is garbage
+// collected once the last of them is deleted.
+func (r *OpenStackImageReconciler) setTemplateOwnerReferences(ctx context.Context, openStackImage *infrav1alpha1.OpenStackImage) error {
+	templateList := &infrav1.OpenStackMachineTemplateList{}
+	if err := r.Client.List(ctx, templateList, client.InNamespace(openStackImage.Namespace), client.MatchingFields{infrav1alpha1.OpenStackMachineTemplateOpenStackImageIndex: openStackImage.Name}); err != nil {
+		return err
+	}
+
+	for i := range templateList.Items {
+		template := &templateList.Items[i]
+		if !template.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := controllerutil.SetOwnerReference(template, openStackImage, r.Scheme); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *OpenStackImageReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, openStackImage *infrav1alpha1.OpenStackImage) (ctrl.Result, error) {
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var image *images.Image
+	if openStackImage.Status.ID != "" {
+		image, err = computeService.GetImage(openStackImage.Status.ID)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if image == nil {
+			// The image was deleted outside of CAPO, import it again
+			scope.Logger().Info("Image not found, importing it again", "id", openStackImage.Status.ID)
+			resetImageStatus(openStackImage)
+		}
+	}
+
+	if image == nil {
+		image, err = computeService.GetOrCreateImage(ctx, openStackImage, getImageName(openStackImage), getImageTags(openStackImage), openStackImage.Spec.DiskFormat, openStackImage.Spec.ContainerFormat)
+		if err != nil {
+			v1beta1conditions.MarkFalse(openStackImage, infrav1alpha1.ImageReadyCondition, infrav1alpha1.ImageImportFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to create image: %v", err)
+			return ctrl.Result{}, err
+		}
+		openStackImage.Status.ID = image.ID
+	}
+
+	switch image.Status {
+	case images.ImageStatusActive:
+		// Data uploaded by the controller was verified while it was uploaded
+		if openStackImage.Status.ImportMethod != infrav1alpha1.ImageImportMethodUpload {
+			if err := compute.VerifyImageChecksum(image, &openStackImage.Spec.Checksum); err != nil {
+				// The data may have been uploaded without recording it,
+				// e.g. if the controller restarted before verifying it, in
+				// which case it is imported again.
+				if openStackImage.Status.ImportMethod == "" {
+					return r.retryImport(ctx, computeService, openStackImage, infrav1alpha1.ImageChecksumMismatchReason, fmt.Errorf("image data of unknown origin cannot be verified: %w", err))
+				}
+
+				// Downloading the image again would compute the same checksum
+				scope.Logger().Error(err, "Image downloaded by Glance does not match the checksum")
+				v1beta1conditions.MarkFalse(openStackImage, infrav1alpha1.ImageReadyCondition, infrav1alpha1.ImageChecksumMismatchReason, clusterv1beta1.ConditionSeverityError, "Image downloaded by Glance does not match the checksum, use importMethod Upload to verify it while it is uploaded: %v", err)
+				return ctrl.Result{}, nil
+			}
+		}
+
+		openStackImage.Status.Ready = true
+		v1beta1conditions.MarkTrue(openStackImage, infrav1alpha1.ImageReadyCondition)
+		return ctrl.Result{}, nil
+
+	case images.ImageStatusQueued:
+		if failed := compute.GetImageFailedImport(image); failed != "" {
+			return r.retryImport(ctx, computeService, openStackImage, infrav1alpha1.ImageImportFailedReason, fmt.Errorf("import method %s failed", failed))
+		}
+		// Glance has accepted the import but not started it yet
+		if openStackImage.Status.ImportMethod == infrav1alpha1.ImageImportMethodWebDownload {
+			return ctrl.Result{RequeueAfter: waitForImageToReconcile}, nil
+		}
+		return r.transferImageData(ctx, computeService, openStackImage)
+
+	case images.ImageStatusSaving, images.ImageStatusUploading, images.ImageStatusImporting:
+		v1beta1conditions.MarkFalse(openStackImage, infrav1alpha1.ImageReadyCondition, infrav1alpha1.ImageImportingReason, clusterv1beta1.ConditionSeverityInfo, "Image is %s", image.Status)
+		return ctrl.Result{RequeueAfter: waitForImageToReconcile}, nil
+
+	default:
+		return r.retryImport(ctx, computeService, openStackImage, infrav1alpha1.ImageImportFailedReason, fmt.Errorf("image is %s", image.Status))
+	}
+}
+
+// transferImageData lets Glance download the image data, or uploads it while
+// verifying its checksum.
+func (r *OpenStackImageReconciler) transferImageData(ctx context.Context, computeService *compute.Service, openStackImage *infrav1alpha1.OpenStackImage) (ctrl.Result, error) {
+	spec := &openStackImage.Spec
+
+	importMethod := spec.ImportMethod
+	if importMethod == "" || importMethod == infrav1alpha1.ImageImportMethodAuto {
+		importMethod = infrav1alpha1.ImageImportMethodUpload
+		// The checksum of an image downloaded by Glance can only be verified
+		// if Glance computes it with the same algorithm
+		if spec.Source.URL != "" && spec.Checksum.Algorithm == r.getGlanceHashingAlgorithm() {
+			webDownload, err := computeService.IsWebDownloadSupported(ctx)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if webDownload {
+				importMethod = infrav1alpha1.ImageImportMethodWebDownload
+			}
+		}
+	}
+
+	v1beta1conditions.MarkFalse(openStackImage, infrav1alpha1.ImageReadyCondition, infrav1alpha1.ImageImportingReason, clusterv1beta1.ConditionSeverityInfo, "Importing image with method %s", importMethod)
+
+	if importMethod == infrav1alpha1.ImageImportMethodWebDownload {
+		if err := computeService.ImportImageFromURL(ctx, openStackImage, openStackImage.Status.ID, spec.Source.URL); err != nil {
+			return ctrl.Result{}, err
+		}
+		openStackImage.Status.ImportMethod = importMethod
+		return ctrl.Result{RequeueAfter: waitForImageToReconcile}, nil
+	}
+
+	httpClient := r.HTTPClient
+	if httpClient == nil {
+		httpClient = compute.NewImageSourceHTTPClient()
+	}
+
+	// The transfer is aborted if it stalls, both while downloading and
+	// while uploading the image data.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	data, err := compute.OpenImageSource(ctx, httpClient, &spec.Source)
+	if err != nil {
+		return r.retryImport(ctx, computeService, openStackImage, infrav1alpha1.ImageImportFailedReason, err)
+	}
+	defer data.Close()
+
+	verifier, err := compute.NewImageChecksumVerifier(compute.NewIdleTimeoutReader(data, imageDataIdleTimeout, cancel), &spec.Checksum)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := computeService.UploadImage(ctx, openStackImage, openStackImage.Status.ID, verifier); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := verifier.Verify(); err != nil {
+		return r.retryImport(ctx, computeService, openStackImage, infrav1alpha1.ImageChecksumMismatchReason, err)
+	}
+	openStackImage.Status.ImportMethod = importMethod
+	return ctrl.Result{RequeueAfter: waitForImageToReconcile}, nil
+}
+
+func (r *OpenStackImageReconciler) getGlanceHashingAlgorithm() infrav1alpha1.ImageChecksumAlgorithm {
+	if r.GlanceHashingAlgorithm == "" {
+		return infrav1alpha1.ImageChecksumAlgorithmSHA512
+	}
+	return r.GlanceHashingAlgorithm
+}
+
+// retryImport deletes an image whose import failed so that it is imported
+// again after retryImageImportInterval.
+func (r *OpenStackImageReconciler) retryImport(ctx context.Context, computeService *compute.Service, openStackImage *infrav1alpha1.OpenStackImage, reason string, importErr error) (ctrl.Result, error) {
+	v1beta1conditions.MarkFalse(openStackImage, infrav1alpha1.ImageReadyCondition, reason, clusterv1beta1.ConditionSeverityError, "Failed to import image: %v", importErr)
+
+	if err := computeService.DeleteImage(ctx, openStackImage, openStackImage.Status.ID); err != nil {
+		return ctrl.Result{}, err
+	}
+	resetImageStatus(openStackImage)
+	return ctrl.Result{RequeueAfter: retryImageImportInterval}, nil
+}
+
+func (r *OpenStackImageReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, openStackImage *infrav1alpha1.OpenStackImage, referencingServers int) (ctrl.Result, error) {
+	scope.Logger().Info("Reconciling OpenStackImage delete")
+
+	if referencingServers > 0 {
+		v1beta1conditions.MarkFalse(openStackImage, infrav1alpha1.ImageReadyCondition, infrav1alpha1.ImageInUseReason, clusterv1beta1.ConditionSeverityInfo, "Waiting for %d OpenStackServers referencing the image to be deleted", referencingServers)
+		return ctrl.Result{RequeueAfter: waitForImageToReconcile}, nil
+	}
+
+	if openStackImage.Status.ID != "" {
+		computeService, err := compute.NewService(scope)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := computeService.DeleteImage(ctx, openStackImage, openStackImage.Status.ID); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(openStackImage, infrav1alpha1.OpenStackImageFinalizer)
+	scope.Logger().Info("Reconciled OpenStackImage deleted successfully")
+	return ctrl.Result{}, nil
+}
+
+func resetImageStatus(openStackImage *infrav1alpha1.OpenStackImage) {
+	openStackImage.Status.Ready = false
+	openStackImage.Status.ID = ""
+	openStackImage.Status.ImportMethod = ""
+}
+
+// getImageName returns the name of the image in Glance.
+func getImageName(openStackImage *infrav1alpha1.OpenStackImage) string {
+	return fmt.Sprintf("%s-%s", openStackImage.Namespace, openStackImage.Name)
+}
+
+// getImageTags returns the tags of the image in Glance. The first tag
+// identifies the image. Images of a cluster are additionally tagged with the
+// cluster.
+func getImageTags(openStackImage *infrav1alpha1.OpenStackImage) []string {
+	tags := []string{openStackImage.GetImageTag()}
+	if clusterName := openStackImage.Labels[clusterv1.ClusterNameLabel]; clusterName != "" {
+		tags = append(tags, fmt.Sprintf("cluster-api-provider-openstack:%s/%s", openStackImage.Namespace, clusterName))
+	}
+	return tags
+}
+
+func (r *OpenStackImageReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &infrav1.OpenStackMachineTemplate{}, infrav1alpha1.OpenStackMachineTemplateOpenStackImageIndex, func(obj client.Object) []string {
+		template, ok := obj.(*infrav1.OpenStackMachineTemplate)
+		if !ok {
+			return nil
+		}
+		if template.Spec.Template.Spec.Image.OpenStackImageRef == nil {
+			return nil
+		}
+		return []string{template.Spec.Template.Spec.Image.OpenStackImageRef.Name}
+	}); err != nil {
+		return fmt.Errorf("adding machine templates by image index: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1alpha1.OpenStackImage{}).
+		Watches(&infrav1.OpenStackMachineTemplate{},
+			handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+				template, ok := obj.(*infrav1.OpenStackMachineTemplate)
+				if !ok {
+					log.Info("Unexpected object in OpenStackMachineTemplate watch", "object", obj)
+					return nil
+				}
+				ref := template.Spec.Template.Spec.Image.OpenStackImageRef
+				if ref == nil {
+					return nil
+				}
+				return []reconcile.Request{{NamespacedName: client.ObjectKey{
+					Namespace: template.Namespace,
+					Name:      ref.Name,
+				}}}
+			}),
+		).
+		Complete(r)
+}
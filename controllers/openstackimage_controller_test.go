@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imageimport"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	. "github.com/onsi/gomega" //nolint:revive
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func TestOpenStackImageReconciler_reconcileNormal(t *testing.T) {
+	const (
+		imageData = "image data"
+		imageName = "test-ns-test-image"
+		imageTag  = "cluster-api-provider-openstack-image:test-ns/test-image"
+	)
+
+	sum := sha256.Sum256([]byte(imageData))
+	checksum := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, imageData)
+	}))
+	defer server.Close()
+	imageURL := server.URL + "/image.qcow2"
+
+	verifiedProperties := map[string]any{"os_hash_algo": "sha256", "os_hash_value": checksum}
+
+	tests := []struct {
+		name            string
+		importMethod    infrav1alpha1.ImageImportMethod
+		checksum        string
+		glanceAlgorithm infrav1alpha1.ImageChecksumAlgorithm
+		status          infrav1alpha1.OpenStackImageStatus
+		expect          func(m *mock.MockImageClientMockRecorder)
+		wantStatus      infrav1alpha1.OpenStackImageStatus
+		wantRequeue     time.Duration
+		wantReason      string
+		wantCondition   bool
+	}{
+		{
+			name: "Creates the image and imports it with web-download",
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.ListImages(images.ListOpts{Tags: []string{imageTag}}).Return(nil, nil)
+				m.CreateImage(gomock.Any(), images.CreateOpts{
+					Name:            imageName,
+					Tags:            []string{imageTag, "cluster-api-provider-openstack:test-ns/test-cluster"},
+					DiskFormat:      "qcow2",
+					ContainerFormat: "bare",
+				}).Return(&images.Image{ID: imageUUID, Status: images.ImageStatusQueued}, nil)
+				m.GetImportInfo(gomock.Any()).Return(&imageimport.ImportInfo{
+					ImportMethods: imageimport.ImportMethods{Value: []string{"glance-direct", "web-download"}},
+				}, nil)
+				m.CreateImport(gomock.Any(), imageUUID, imageimport.CreateOpts{Name: imageimport.WebDownloadMethod, URI: imageURL}).Return(nil)
+			},
+			wantStatus:  infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			wantRequeue: waitForImageToReconcile,
+			wantReason:  infrav1alpha1.ImageImportingReason,
+		},
+		{
+			name: "Uploads the image when web-download is not supported",
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.ListImages(images.ListOpts{Tags: []string{imageTag}}).Return([]images.Image{{ID: imageUUID, Status: images.ImageStatusQueued}}, nil)
+				m.GetImportInfo(gomock.Any()).Return(&imageimport.ImportInfo{
+					ImportMethods: imageimport.ImportMethods{Value: []string{"glance-direct"}},
+				}, nil)
+				m.UploadData(gomock.Any(), imageUUID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data io.Reader) error {
+					_, err := io.Copy(io.Discard, data)
+					return err
+				})
+			},
+			wantStatus:  infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodUpload},
+			wantRequeue: waitForImageToReconcile,
+			wantReason:  infrav1alpha1.ImageImportingReason,
+		},
+		{
+			name:            "Uploads the image when Glance uses another hashing algorithm",
+			glanceAlgorithm: infrav1alpha1.ImageChecksumAlgorithmSHA512,
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.ListImages(images.ListOpts{Tags: []string{imageTag}}).Return([]images.Image{{ID: imageUUID, Status: images.ImageStatusQueued}}, nil)
+				m.UploadData(gomock.Any(), imageUUID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data io.Reader) error {
+					_, err := io.Copy(io.Discard, data)
+					return err
+				})
+			},
+			wantStatus:  infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodUpload},
+			wantRequeue: waitForImageToReconcile,
+			wantReason:  infrav1alpha1.ImageImportingReason,
+		},
+		{
+			name:         "Deletes an uploaded image with a checksum mismatch",
+			importMethod: infrav1alpha1.ImageImportMethodUpload,
+			checksum:     "0123456789abcdef",
+			status:       infrav1alpha1.OpenStackImageStatus{ID: imageUUID},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage(imageUUID).Return(&images.Image{ID: imageUUID, Status: images.ImageStatusQueued}, nil)
+				m.UploadData(gomock.Any(), imageUUID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data io.Reader) error {
+					_, err := io.Copy(io.Discard, data)
+					return err
+				})
+				m.DeleteImage(gomock.Any(), imageUUID).Return(nil)
+			},
+			wantRequeue: retryImageImportInterval,
+			wantReason:  infrav1alpha1.ImageChecksumMismatchReason,
+		},
+		{
+			name:   "Waits for the import",
+			status: infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage(imageUUID).Return(&images.Image{ID: imageUUID, Status: images.ImageStatusImporting}, nil)
+			},
+			wantStatus:  infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			wantRequeue: waitForImageToReconcile,
+			wantReason:  infrav1alpha1.ImageImportingReason,
+		},
+		{
+			name:   "Verifies the checksum of an imported image",
+			status: infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage(imageUUID).Return(&images.Image{ID: imageUUID, Status: images.ImageStatusActive, Properties: verifiedProperties}, nil)
+			},
+			wantStatus:    infrav1alpha1.OpenStackImageStatus{Ready: true, ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			wantCondition: true,
+		},
+		{
+			name:     "Keeps an imported image with a checksum mismatch",
+			checksum: "0123456789abcdef",
+			status:   infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage(imageUUID).Return(&images.Image{ID: imageUUID, Status: images.ImageStatusActive, Properties: verifiedProperties}, nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			wantReason: infrav1alpha1.ImageChecksumMismatchReason,
+		},
+		{
+			name:   "Imports an image whose upload was not verified again",
+			status: infrav1alpha1.OpenStackImageStatus{ID: imageUUID},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage(imageUUID).Return(&images.Image{
+					ID:         imageUUID,
+					Status:     images.ImageStatusActive,
+					Properties: map[string]any{"os_hash_algo": "sha512", "os_hash_value": "0123456789abcdef"},
+				}, nil)
+				m.DeleteImage(gomock.Any(), imageUUID).Return(nil)
+			},
+			wantRequeue: retryImageImportInterval,
+			wantReason:  infrav1alpha1.ImageChecksumMismatchReason,
+		},
+		{
+			name:   "Deletes an image whose import failed",
+			status: infrav1alpha1.OpenStackImageStatus{ID: imageUUID, ImportMethod: infrav1alpha1.ImageImportMethodWebDownload},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage(imageUUID).Return(&images.Image{
+					ID:         imageUUID,
+					Status:     images.ImageStatusQueued,
+					Properties: map[string]any{"os_glance_failed_import": "web-download"},
+				}, nil)
+				m.DeleteImage(gomock.Any(), imageUUID).Return(nil)
+			},
+			wantRequeue: retryImageImportInterval,
+			wantReason:  infrav1alpha1.ImageImportFailedReason,
+		},
+		{
+			name:   "Imports an image deleted outside of CAPO again",
+			status: infrav1alpha1.OpenStackImageStatus{Ready: true, ID: "deleted", ImportMethod: infrav1alpha1.ImageImportMethodUpload},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.GetImage("deleted").Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+				m.ListImages(images.ListOpts{Tags: []string{imageTag}}).Return([]images.Image{{ID: imageUUID, Status: images.ImageStatusActive, Properties: verifiedProperties}}, nil)
+			},
+			wantStatus:    infrav1alpha1.OpenStackImageStatus{Ready: true, ID: imageUUID},
+			wantCondition: true,
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockScopeFactory.ImageClient.EXPECT())
+
+			openStackImage := &infrav1alpha1.OpenStackImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-image",
+					Namespace: "test-ns",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+				},
+				Spec: infrav1alpha1.OpenStackImageSpec{
+					Source: infrav1alpha1.ImageSource{URL: imageURL},
+					Checksum: infrav1alpha1.ImageChecksum{
+						Algorithm: infrav1alpha1.ImageChecksumAlgorithmSHA256,
+						Value:     checksum,
+					},
+					DiskFormat:      "qcow2",
+					ContainerFormat: "bare",
+					ImportMethod:    infrav1alpha1.ImageImportMethodAuto,
+				},
+				Status: tt.status,
+			}
+			if tt.importMethod != "" {
+				openStackImage.Spec.ImportMethod = tt.importMethod
+			}
+			if tt.checksum != "" {
+				openStackImage.Spec.Checksum.Value = tt.checksum
+			}
+
+			reconciler := OpenStackImageReconciler{
+				HTTPClient:             server.Client(),
+				GlanceHashingAlgorithm: infrav1alpha1.ImageChecksumAlgorithmSHA256,
+			}
+			if tt.glanceAlgorithm != "" {
+				reconciler.GlanceHashingAlgorithm = tt.glanceAlgorithm
+			}
+			res, err := reconciler.reconcileNormal(context.TODO(), scope.NewWithLogger(mockScopeFactory, log), openStackImage)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantRequeue))
+
+			g.Expect(v1beta1conditions.IsTrue(openStackImage, infrav1alpha1.ImageReadyCondition)).To(Equal(tt.wantCondition))
+			if tt.wantReason != "" {
+				g.Expect(v1beta1conditions.GetReason(openStackImage, infrav1alpha1.ImageReadyCondition)).To(Equal(tt.wantReason))
+			}
+			openStackImage.Status.Conditions = nil
+			g.Expect(openStackImage.Status).To(Equal(tt.wantStatus))
+		})
+	}
+}
+
+func TestOpenStackImageReconciler_reconcileDelete(t *testing.T) {
+	tests := []struct {
+		name               string
+		status             infrav1alpha1.OpenStackImageStatus
+		referencingServers int
+		expect             func(m *mock.MockImageClientMockRecorder)
+		wantRequeue        time.Duration
+		wantFinalizer      bool
+	}{
+		{
+			name:               "Waits for the servers referencing the image",
+			status:             infrav1alpha1.OpenStackImageStatus{Ready: true, ID: imageUUID},
+			referencingServers: 1,
+			wantRequeue:        waitForImageToReconcile,
+			wantFinalizer:      true,
+		},
+		{
+			name:   "Deletes the image",
+			status: infrav1alpha1.OpenStackImageStatus{Ready: true, ID: imageUUID},
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.DeleteImage(gomock.Any(), imageUUID).Return(nil)
+			},
+		},
+		{
+			name: "Image was never created",
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(mockScopeFactory.ImageClient.EXPECT())
+			}
+
+			openStackImage := &infrav1alpha1.OpenStackImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-image",
+					Namespace:  "test-ns",
+					Finalizers: []string{infrav1alpha1.OpenStackImageFinalizer},
+				},
+				Status: tt.status,
+			}
+
+			reconciler := OpenStackImageReconciler{}
+			res, err := reconciler.reconcileDelete(context.TODO(), scope.NewWithLogger(mockScopeFactory, log), openStackImage, tt.referencingServers)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantRequeue))
+			g.Expect(controllerutil.ContainsFinalizer(openStackImage, infrav1alpha1.OpenStackImageFinalizer)).To(Equal(tt.wantFinalizer))
+		})
+	}
+}
@@ -20,10 +20,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -69,6 +72,13 @@ const (
 	waitForInstanceBecomeActiveToReconcile    = 60 * time.Second
 	waitForBuildingInstanceToReconcile        = 10 * time.Second
 	deleteServerRequeueDelay                  = 10 * time.Second
+	loadBalancerMemberDrainRequeueDelay       = 10 * time.Second
+
+	defaultConsoleLogLines = 500
+	// consoleLogMaxSize bounds the size of the console log stored in a Secret.
+	consoleLogMaxSize = 256 * 1024
+	// consoleLogSecretKey is the key of the console log in its Secret.
+	consoleLogSecretKey = "console.log"
 )
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachines,verbs=get;list;watch;create;update;patch;delete
@@ -76,7 +86,7 @@ const (
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims;ipaddressclaims/status,verbs=get;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses;ipaddresses/status,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusteridentities,verbs=get;list;watch
@@ -189,6 +199,8 @@ func patchMachine(ctx context.Context, patchHelper *patch.Helper, openStackMachi
 			clusterv1.ReadyCondition,
 			string(infrav1.InstanceReadyCondition),
 			string(infrav1.APIServerIngressReadyCondition),
+			string(infrav1.LoadBalancerMemberDrainedCondition),
+			string(infrav1.ConsoleLogCapturedCondition),
 		}},
 	)
 	return patchHelper.Patch(ctx, openStackMachine, options...)
@@ -258,7 +270,25 @@ func (r *OpenStackMachineReconciler) reconcileDelete(ctx context.Context, scope
 	}
 
 	if util.IsControlPlaneMachine(machine) {
-		if err := removeAPIServerEndpoint(scope, openStackCluster, openStackMachine, instanceStatus, clusterResourceName); err != nil {
+		result, err := removeAPIServerEndpoint(scope, openStackCluster, machine, openStackMachine, instanceStatus, clusterResourceName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if result != nil {
+			return *result, nil
+		}
+	} else if hasLoadBalancerListeners(openStackCluster) {
+		result, err := removeLoadBalancerMember(scope, openStackCluster, machine, openStackMachine, clusterResourceName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if result != nil {
+			return *result, nil
+		}
+	}
+
+	if !util.IsControlPlaneMachine(machine) && isIngressLoadBalancerOctavia(openStackCluster) {
+		if err := removeIngressLoadBalancerMember(scope, openStackCluster, openStackMachine, clusterResourceName); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
@@ -280,19 +310,96 @@ func (r *OpenStackMachineReconciler) reconcileDelete(ctx context.Context, scope
 	return ctrl.Result{}, nil
 }
 
-func removeAPIServerEndpoint(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, clusterResourceName string) error {
+// hasLoadBalancerListeners returns true if the cluster load balancer has listeners or named pools which may select worker machines.
+func hasLoadBalancerListeners(openStackCluster *infrav1.OpenStackCluster) bool {
+	return openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() && (len(openStackCluster.Spec.APIServerLoadBalancer.Listeners) > 0 || len(openStackCluster.Spec.APIServerLoadBalancer.Pools) > 0)
+}
+
+// removeLoadBalancerMember removes a worker machine from the pools of the load balancer listeners.
+func removeLoadBalancerMember(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) (*ctrl.Result, error) {
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, clusterResourceName)
+	if err != nil || result != nil {
+		return result, err
+	}
+
+	if err := loadBalancerService.DeleteLoadBalancerMember(openStackCluster, machine, openStackMachine, clusterResourceName); err != nil {
+		return nil, fmt.Errorf("remove machine from load balancer: %w", err)
+	}
+	return nil, nil
+}
+
+// drainLoadBalancerMember drains the load balancer members of the machine if
+// the cluster has a member drain timeout. Octavia doesn't report the
+// connections of a member, so the members are removed once the drain timeout
+// has expired, or earlier if Octavia reports that none of them serves
+// connections anymore. It returns a result to requeue with until then, so
+// that the drain does not block the reconciliation of other machines.
+func drainLoadBalancerMember(scope *scope.WithLogger, loadBalancerService *loadbalancer.Service, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) (*ctrl.Result, error) {
+	drainTimeout := openStackCluster.Spec.APIServerLoadBalancer.MemberDrainTimeout
+	if drainTimeout == nil || drainTimeout.Duration <= 0 {
+		return nil, nil
+	}
+
+	// The members were drained by a previous reconcile.
+	drainedCondition := v1beta1conditions.Get(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)
+	if drainedCondition != nil && drainedCondition.Status == corev1.ConditionTrue {
+		return nil, nil
+	}
+
+	drained, err := loadBalancerService.DrainLoadBalancerMember(openStackCluster, machine, openStackMachine, clusterResourceName)
+	if err != nil {
+		return nil, fmt.Errorf("drain load balancer members: %w", err)
+	}
+	if drained {
+		v1beta1conditions.MarkTrue(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)
+		return nil, nil
+	}
+
+	// The drain starts when the condition is first set to draining, so the
+	// condition is not updated again while draining to keep its transition time.
+	if drainedCondition == nil || drainedCondition.Reason != infrav1.LoadBalancerMemberDrainingReason {
+		v1beta1conditions.MarkFalse(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition, infrav1.LoadBalancerMemberDrainingReason, clusterv1beta1.ConditionSeverityInfo, "Draining load balancer members for %s before removing them", drainTimeout.Duration)
+		return &ctrl.Result{RequeueAfter: min(drainTimeout.Duration, loadBalancerMemberDrainRequeueDelay)}, nil
+	}
+
+	remaining := drainTimeout.Duration - time.Since(drainedCondition.LastTransitionTime.Time)
+	if remaining > 0 {
+		scope.Logger().Info("Waiting for load balancer members to be drained", "remaining", remaining)
+		return &ctrl.Result{RequeueAfter: min(remaining, loadBalancerMemberDrainRequeueDelay)}, nil
+	}
+
+	scope.Logger().Info("Load balancer members drained, removing them", "timeout", drainTimeout.Duration)
+	v1beta1conditions.MarkTrue(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)
+	return nil, nil
+}
+
+func removeAPIServerEndpoint(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, clusterResourceName string) (*ctrl.Result, error) {
 	if openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
 		loadBalancerService, err := loadbalancer.NewService(scope)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		err = loadBalancerService.DeleteLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)
+		result, err := drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, clusterResourceName)
+		if err != nil {
+			v1beta1conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.LoadBalancerMemberErrorReason, clusterv1beta1.ConditionSeverityWarning, "Machine could not be drained from load balancer: %v", err)
+			return nil, err
+		}
+		if result != nil {
+			return result, nil
+		}
+
+		err = loadBalancerService.DeleteLoadBalancerMember(openStackCluster, machine, openStackMachine, clusterResourceName)
 		if err != nil {
 			v1beta1conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.LoadBalancerMemberErrorReason, clusterv1beta1.ConditionSeverityWarning, "Machine could not be removed from load balancer: %v", err)
-			return err
+			return nil, err
 		}
-		return nil
+		return nil, nil
 	}
 
 	// XXX(mdbooth): This looks wrong to me. Surely we should only ever
@@ -307,12 +414,12 @@ func removeAPIServerEndpoint(scope *scope.WithLogger, openStackCluster *infrav1.
 				capoerrors.DeprecatedCAPIUpdateMachineError,
 				fmt.Errorf("get network status for OpenStack instance %s with ID %s: %v", instanceStatus.Name(), instanceStatus.ID(), err),
 			)
-			return nil
+			return nil, nil
 		}
 
 		networkingService, err := networking.NewService(scope)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		addresses := instanceNS.Addresses()
@@ -320,13 +427,13 @@ func removeAPIServerEndpoint(scope *scope.WithLogger, openStackCluster *infrav1.
 			if address.Type == corev1.NodeExternalIP {
 				if err = networkingService.DeleteFloatingIP(openStackMachine, address.Address); err != nil {
 					v1beta1conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.FloatingIPErrorReason, clusterv1beta1.ConditionSeverityError, "Deleting floating IP failed: %v", err)
-					return fmt.Errorf("delete floating IP %q: %w", address.Address, err)
+					return nil, fmt.Errorf("delete floating IP %q: %w", address.Address, err)
 				}
 			}
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
 // GetPortIDs returns a list of port IDs from a list of PortStatus.
@@ -367,6 +474,9 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 	scope.Logger().Info("Reconciling Machine")
 
 	machineServer, waitingForServer, err := r.reconcileMachineServer(ctx, scope, openStackMachine, openStackCluster, machine)
+	if machineServer != nil {
+		openStackMachine.Status.AvailabilityZoneAttempts = machineServer.Status.AvailabilityZoneAttempts
+	}
 	if err != nil || waitingForServer {
 		return ctrl.Result{}, err
 	}
@@ -407,15 +517,28 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 	openStackMachine.Status.Addresses = addresses
 
 	if util.IsControlPlaneMachine(machine) {
-		err = r.reconcileAPIServerLoadBalancer(scope, openStackCluster, openStackMachine, instanceStatus, instanceNS, clusterResourceName)
+		err = r.reconcileAPIServerLoadBalancer(scope, openStackCluster, machine, openStackMachine, instanceStatus, instanceNS, clusterResourceName)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
 		v1beta1conditions.MarkTrue(openStackMachine, infrav1.APIServerIngressReadyCondition)
+	} else if hasLoadBalancerListeners(openStackCluster) {
+		if err := r.reconcileLoadBalancerMember(scope, openStackCluster, machine, openStackMachine, instanceNS, clusterResourceName); err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile load balancer member: %w", err)
+		}
+	}
+
+	if !util.IsControlPlaneMachine(machine) && isIngressLoadBalancerOctavia(openStackCluster) {
+		if err := r.reconcileIngressLoadBalancerMember(scope, openStackCluster, openStackMachine, instanceNS, clusterResourceName); err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile ingress load balancer member: %w", err)
+		}
 	}
 
 	result := r.reconcileMachineState(scope, openStackMachine, machine, machineServer)
+
+	consoleLogRequeue := r.reconcileConsoleLog(ctx, scope, computeService, openStackCluster, openStackMachine, machine, machineServer)
+
 	if result != nil {
 		return *result, nil
 	}
@@ -426,7 +549,7 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: consoleLogRequeue}, nil
 }
 
 // reconcileMachineState updates the conditions of the OpenStackMachine instance based on the instance state
@@ -467,6 +590,12 @@ func (r *OpenStackMachineReconciler) reconcileMachineState(scope *scope.WithLogg
 		// so the error could be something temporary.
 		// If not, it is more likely a configuration error so we set failure and never retry.
 		scope.Logger().Info("Machine instance state is ERROR", "id", openStackServer.Status.InstanceID)
+		// The instance may still be recovered by the remediation policy of the server.
+		if !IsServerTerminalError(openStackServer) {
+			v1beta1conditions.MarkFalse(openStackMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStateErrorReason, clusterv1beta1.ConditionSeverityWarning, "Instance is being remediated")
+			v1beta1conditions.MarkFalse(openStackMachine, clusterv1beta1.ReadyCondition, infrav1.InstanceStateErrorReason, clusterv1beta1.ConditionSeverityWarning, "Instance is in ERROR state and is being remediated")
+			return &ctrl.Result{RequeueAfter: waitForInstanceBecomeActiveToReconcile}
+		}
 		if !machine.Status.NodeRef.IsDefined() {
 			err := fmt.Errorf("instance state %v is unexpected", openStackServer.Status.InstanceState)
 			openStackMachine.SetFailure(capoerrors.DeprecatedCAPIUpdateMachineError, err)
@@ -496,6 +625,103 @@ func (r *OpenStackMachineReconciler) reconcileMachineState(scope *scope.WithLogg
 	return nil
 }
 
+// consoleLogSecretName returns the name of the Secret storing the console log of the instance of a machine.
+func consoleLogSecretName(openStackMachine *infrav1.OpenStackMachine) string {
+	return openStackMachine.Name + "-console-log"
+}
+
+// reconcileConsoleLog captures the console log of the instance of a machine
+// once it is in ERROR or did not become a node within the node timeout of the
+// console log capture policy of the cluster. The console log is stored in a
+// Secret owned by the OpenStackMachine. It returns the time after which the
+// machine must be reconciled again to check the node timeout.
+func (r *OpenStackMachineReconciler) reconcileConsoleLog(ctx context.Context, scope *scope.WithLogger, computeService *compute.Service, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, machine *clusterv1.Machine, openStackServer *infrav1alpha1.OpenStackServer) time.Duration {
+	policy := openStackCluster.Spec.ConsoleLogCapture
+	if policy == nil || openStackServer.Status.InstanceID == nil || v1beta1conditions.IsTrue(openStackMachine, infrav1.ConsoleLogCapturedCondition) {
+		return 0
+	}
+
+	var reason string
+	switch ptr.Deref(openStackServer.Status.InstanceState, infrav1.InstanceStateUndefined) {
+	case infrav1.InstanceStateError:
+		reason = infrav1.InstanceErrorReason
+	case infrav1.InstanceStateActive:
+		if policy.NodeTimeout == nil || machine.Status.NodeRef.IsDefined() {
+			return 0
+		}
+		activeSince := v1beta1conditions.GetLastTransitionTime(openStackMachine, infrav1.InstanceReadyCondition)
+		if activeSince == nil {
+			return 0
+		}
+		if remaining := policy.NodeTimeout.Duration - time.Since(activeSince.Time); remaining > 0 {
+			return remaining
+		}
+		reason = infrav1.NodeTimeoutReason
+	default:
+		return 0
+	}
+
+	instanceID := *openStackServer.Status.InstanceID
+	lines := int(ptr.Deref(policy.Lines, defaultConsoleLogLines))
+	consoleLog, err := computeService.GetInstanceConsoleLog(instanceID, lines)
+	if err == nil {
+		err = r.storeConsoleLog(ctx, openStackMachine, consoleLog)
+	}
+	if err != nil {
+		scope.Logger().Error(err, "Failed to capture console log", "id", instanceID)
+		r.Recorder.Eventf(openStackMachine, corev1.EventTypeWarning, infrav1.ConsoleLogCaptureFailedReason, "Failed to capture console log of instance %s: %v", instanceID, err)
+		v1beta1conditions.MarkFalse(openStackMachine, infrav1.ConsoleLogCapturedCondition, infrav1.ConsoleLogCaptureFailedReason, clusterv1beta1.ConditionSeverityWarning, "Failed to capture console log of instance %s: %v", instanceID, err)
+		return waitForInstanceBecomeActiveToReconcile
+	}
+
+	secretName := consoleLogSecretName(openStackMachine)
+	condition := v1beta1conditions.TrueCondition(infrav1.ConsoleLogCapturedCondition)
+	condition.Reason = reason
+	condition.Message = fmt.Sprintf("Console log of instance %s stored in Secret %s", instanceID, secretName)
+	v1beta1conditions.Set(openStackMachine, condition)
+	r.Recorder.Eventf(openStackMachine, corev1.EventTypeNormal, "ConsoleLogCaptured", "Captured console log of instance %s in Secret %s", instanceID, secretName)
+	return 0
+}
+
+// storeConsoleLog stores the end of a console log, bounded to
+// consoleLogMaxSize, in the console log Secret of a machine.
+func (r *OpenStackMachineReconciler) storeConsoleLog(ctx context.Context, openStackMachine *infrav1.OpenStackMachine, consoleLog string) error {
+	if len(consoleLog) > consoleLogMaxSize {
+		consoleLog = consoleLog[len(consoleLog)-consoleLogMaxSize:]
+		// Drop the partial first line
+		if i := strings.IndexByte(consoleLog, '\n'); i >= 0 {
+			consoleLog = consoleLog[i+1:]
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consoleLogSecretName(openStackMachine),
+			Namespace: openStackMachine.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrPatch(ctx, r.Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[clusterv1.ClusterNameLabel] = openStackMachine.Labels[clusterv1.ClusterNameLabel]
+		secret.OwnerReferences = []metav1.OwnerReference{
+			{
+				APIVersion: infrav1.SchemeGroupVersion.String(),
+				Kind:       "OpenStackMachine",
+				Name:       openStackMachine.Name,
+				UID:        openStackMachine.UID,
+			},
+		}
+		secret.Data = map[string][]byte{consoleLogSecretKey: []byte(consoleLog)}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("store console log in Secret %s: %w", secret.Name, err)
+	}
+	return nil
+}
+
 func (r *OpenStackMachineReconciler) getMachineServer(ctx context.Context, openStackMachine *infrav1.OpenStackMachine) (*infrav1alpha1.OpenStackServer, error) {
 	machineServer := &infrav1alpha1.OpenStackServer{}
 	machineServerName := client.ObjectKey{
@@ -527,9 +753,13 @@ func openStackMachineSpecToOpenStackServerSpec(openStackMachineSpec *infrav1.Ope
 
 	openStackServerSpec := &infrav1alpha1.OpenStackServerSpec{
 		AdditionalBlockDevices:            openStackMachineSpec.AdditionalBlockDevices,
+		HotAttachVolumes:                  openStackMachineSpec.HotAttachVolumes,
 		ConfigDrive:                       openStackMachineSpec.ConfigDrive,
 		Flavor:                            openStackMachineSpec.Flavor,
 		FlavorID:                          openStackMachineSpec.FlavorID,
+		FlavorSelector:                    openStackMachineSpec.FlavorSelector,
+		Accelerators:                      openStackMachineSpec.Accelerators,
+		AvailabilityZoneFallback:          openStackMachineSpec.AvailabilityZoneFallback,
 		IdentityRef:                       identityRef,
 		Image:                             openStackMachineSpec.Image,
 		RootVolume:                        openStackMachineSpec.RootVolume,
@@ -559,10 +789,31 @@ func openStackMachineSpecToOpenStackServerSpec(openStackMachineSpec *infrav1.Ope
 		openStackServerSpec.FloatingIPPoolRef = openStackMachineSpec.FloatingIPPoolRef
 	}
 
-	// If not ports are provided we create one.
-	// Ports must have a network so if none is provided we use the default network.
-	serverPorts := openStackMachineSpec.Ports
-	if len(openStackMachineSpec.Ports) == 0 {
+	if openStackCluster.Spec.VolumePolicy != nil && ptr.Deref(openStackCluster.Spec.VolumePolicy.RequireEncryption, false) {
+		openStackServerSpec.RequireEncryptedVolumes = ptr.To(true)
+	}
+
+	if openStackCluster.Spec.InstanceRemediation != nil {
+		openStackServerSpec.Remediation = openStackCluster.Spec.InstanceRemediation
+	}
+
+	serverPorts := defaultServerPorts(openStackMachineSpec.Ports, openStackCluster, defaultSecGroup)
+	if len(openStackMachineSpec.SecurityGroups) > 0 {
+		for i := range serverPorts {
+			serverPorts[i].SecurityGroups = append(serverPorts[i].SecurityGroups, openStackMachineSpec.SecurityGroups...)
+		}
+	}
+	openStackServerSpec.Ports = serverPorts
+
+	return openStackServerSpec, nil
+}
+
+// defaultServerPorts returns the ports of a server in the cluster. If no ports
+// are provided we create one. Ports without a network are attached to the
+// cluster network, and ports without security groups get defaultSecGroup.
+func defaultServerPorts(ports []infrav1.PortOpts, openStackCluster *infrav1.OpenStackCluster, defaultSecGroup *string) []infrav1.PortOpts {
+	serverPorts := ports
+	if len(ports) == 0 {
 		serverPorts = make([]infrav1.PortOpts, 1)
 	}
 
@@ -579,7 +830,7 @@ func openStackMachineSpecToOpenStackServerSpec(openStackMachineSpec *infrav1.Ope
 	for i := range serverPorts {
 		serverPort := &serverPorts[i]
 		// Only inject the default network when we actually have an ID.
-		if serverPort.Network == nil && defaultNetworkID != "" {
+		if serverPort.Network == nil && openStackCluster.Status.Network != nil && openStackCluster.Status.Network.ID != "" {
 			serverPort.Network = &infrav1.NetworkParam{
 				ID: &openStackCluster.Status.Network.ID,
 			}
@@ -595,13 +846,8 @@ func openStackMachineSpecToOpenStackServerSpec(openStackMachineSpec *infrav1.Ope
 				},
 			}
 		}
-		if len(openStackMachineSpec.SecurityGroups) > 0 {
-			serverPort.SecurityGroups = append(serverPort.SecurityGroups, openStackMachineSpec.SecurityGroups...)
-		}
 	}
-	openStackServerSpec.Ports = serverPorts
-
-	return openStackServerSpec, nil
+	return serverPorts
 }
 
 // reconcileMachineServer reconciles the OpenStackServer object for the OpenStackMachine.
@@ -654,11 +900,16 @@ func (r *OpenStackMachineReconciler) getOrCreateMachineServer(ctx context.Contex
 		if err != nil {
 			return nil, err
 		}
+		// The labels of the OpenStackMachine are propagated to its IPAddressClaims through the
+		// OpenStackServer, so that floating IP pool reservations can select them.
+		labels := maps.Clone(openStackMachine.Labels)
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[clusterv1.ClusterNameLabel] = openStackCluster.Labels[clusterv1.ClusterNameLabel]
 		machineServer = &infrav1alpha1.OpenStackServer{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: map[string]string{
-					clusterv1.ClusterNameLabel: openStackCluster.Labels[clusterv1.ClusterNameLabel],
-				},
+				Labels:    labels,
 				Name:      openStackMachine.Name,
 				Namespace: openStackMachine.Namespace,
 				OwnerReferences: []metav1.OwnerReference{
@@ -676,11 +927,36 @@ func (r *OpenStackMachineReconciler) getOrCreateMachineServer(ctx context.Contex
 		if err := r.Client.Create(ctx, machineServer); err != nil {
 			return nil, fmt.Errorf("failed to create machine server: %w", err)
 		}
+		return machineServer, nil
+	}
+
+	if err := r.reconcileMachineServerVolumes(ctx, openStackMachine, machineServer); err != nil {
+		return nil, err
 	}
 	return machineServer, nil
 }
 
-func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
+// reconcileMachineServerVolumes passes changes to the additional block devices
+// of a machine with hot-attached volumes to its existing OpenStackServer.
+func (r *OpenStackMachineReconciler) reconcileMachineServerVolumes(ctx context.Context, openStackMachine *infrav1.OpenStackMachine, machineServer *infrav1alpha1.OpenStackServer) error {
+	hotAttachVolumes := ptr.Deref(openStackMachine.Spec.HotAttachVolumes, false)
+	if hotAttachVolumes == ptr.Deref(machineServer.Spec.HotAttachVolumes, false) &&
+		(!hotAttachVolumes || apiequality.Semantic.DeepEqual(openStackMachine.Spec.AdditionalBlockDevices, machineServer.Spec.AdditionalBlockDevices)) {
+		return nil
+	}
+
+	patch := client.MergeFrom(machineServer.DeepCopy())
+	machineServer.Spec.HotAttachVolumes = openStackMachine.Spec.HotAttachVolumes
+	if hotAttachVolumes {
+		machineServer.Spec.AdditionalBlockDevices = openStackMachine.Spec.AdditionalBlockDevices
+	}
+	if err := r.Client.Patch(ctx, machineServer, patch); err != nil {
+		return fmt.Errorf("failed to update volumes of machine server: %w", err)
+	}
+	return nil
+}
+
+func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
 	scope.Logger().Info("Reconciling APIServerLoadBalancer")
 	computeService, err := compute.NewService(scope)
 	if err != nil {
@@ -693,7 +969,7 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 	}
 
 	if openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
-		err = r.reconcileLoadBalancerMember(scope, openStackCluster, openStackMachine, instanceNS, clusterResourceName)
+		err = r.reconcileLoadBalancerMember(scope, openStackCluster, machine, openStackMachine, instanceNS, clusterResourceName)
 		if err != nil {
 			v1beta1conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.LoadBalancerMemberErrorReason, clusterv1beta1.ConditionSeverityError, "Reconciling load balancer member failed: %v", err)
 			return fmt.Errorf("reconcile load balancer member: %w", err)
@@ -755,14 +1031,14 @@ func getManagedSecurityGroup(openStackCluster *infrav1.OpenStackCluster, machine
 	return nil
 }
 
-func (r *OpenStackMachineReconciler) reconcileLoadBalancerMember(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
+func (r *OpenStackMachineReconciler) reconcileLoadBalancerMember(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
 	ip := instanceNS.IP(openStackCluster.Status.Network.Name)
 	loadbalancerService, err := loadbalancer.NewService(scope)
 	if err != nil {
 		return err
 	}
 
-	return loadbalancerService.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, ip)
+	return loadbalancerService.ReconcileLoadBalancerMember(openStackCluster, machine, openStackMachine, clusterResourceName, ip)
 }
 
 // OpenStackClusterToOpenStackMachines is a handler.ToRequestsFunc to be used to enqeue requests for reconciliation
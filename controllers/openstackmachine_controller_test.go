@@ -20,27 +20,39 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/test/framework"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
 )
 
 const (
@@ -182,6 +194,15 @@ func TestOpenStackMachineSpecToOpenStackServerSpec(t *testing.T) {
 			},
 		},
 	}
+	openStackClusterWithVolumePolicy := openStackCluster.DeepCopy()
+	openStackClusterWithVolumePolicy.Spec.VolumePolicy = &infrav1.VolumePolicy{
+		RequireEncryption: ptr.To(true),
+	}
+	openStackClusterWithRemediation := openStackCluster.DeepCopy()
+	openStackClusterWithRemediation.Spec.InstanceRemediation = &infrav1.InstanceRemediation{
+		StartShutoff:    ptr.To(true),
+		MaxErrorReboots: ptr.To[int32](3),
+	}
 	image := infrav1.ImageParam{Filter: &infrav1.ImageFilter{Name: ptr.To("my-image")}}
 	tags := []string{"tag1", "tag2"}
 	userData := &corev1.LocalObjectReference{Name: "server-data-secret"}
@@ -289,6 +310,44 @@ func TestOpenStackMachineSpecToOpenStackServerSpec(t *testing.T) {
 				UserDataRef: userData,
 			},
 		},
+		{
+			name:    "Test an OpenStackMachineSpec to OpenStackServerSpec conversion with a cluster volume policy",
+			cluster: openStackClusterWithVolumePolicy,
+			spec: &infrav1.OpenStackMachineSpec{
+				Flavor:     ptr.To(flavorName),
+				Image:      image,
+				SSHKeyName: sshKeyName,
+			},
+			want: &infrav1alpha1.OpenStackServerSpec{
+				Flavor:                  ptr.To(flavorName),
+				IdentityRef:             identityRef,
+				Image:                   image,
+				SSHKeyName:              sshKeyName,
+				Ports:                   portOpts,
+				RequireEncryptedVolumes: ptr.To(true),
+				Tags:                    tags,
+				UserDataRef:             userData,
+			},
+		},
+		{
+			name:    "Test an OpenStackMachineSpec to OpenStackServerSpec conversion with a cluster instance remediation policy",
+			cluster: openStackClusterWithRemediation,
+			spec: &infrav1.OpenStackMachineSpec{
+				Flavor:     ptr.To(flavorName),
+				Image:      image,
+				SSHKeyName: sshKeyName,
+			},
+			want: &infrav1alpha1.OpenStackServerSpec{
+				Flavor:      ptr.To(flavorName),
+				IdentityRef: identityRef,
+				Image:       image,
+				SSHKeyName:  sshKeyName,
+				Ports:       portOpts,
+				Remediation: openStackClusterWithRemediation.Spec.InstanceRemediation,
+				Tags:        tags,
+				UserDataRef: userData,
+			},
+		},
 		{
 			name: "Cluster network nil, machine defines port network and overrides SG",
 			spec: &infrav1.OpenStackMachineSpec{
@@ -451,6 +510,7 @@ func TestReconcileMachineState(t *testing.T) {
 		name                            string
 		instanceState                   infrav1.InstanceState
 		machineHasNodeRef               bool
+		remediation                     *infrav1.InstanceRemediation
 		expectRequeue                   bool
 		expectedInstanceReadyCondition  *clusterv1beta1.Condition
 		expectedReadyCondition          *clusterv1beta1.Condition
@@ -490,6 +550,26 @@ func TestReconcileMachineState(t *testing.T) {
 			},
 			expectFailureSet: true,
 		},
+		{
+			name:              "Instance state ERROR being remediated does not set failure",
+			instanceState:     infrav1.InstanceStateError,
+			machineHasNodeRef: false,
+			remediation:       &infrav1.InstanceRemediation{MaxErrorReboots: ptr.To[int32](3)},
+			expectRequeue:     true,
+			expectedInstanceReadyCondition: &clusterv1beta1.Condition{
+				Type:     infrav1.InstanceReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1beta1.ConditionSeverityWarning,
+				Reason:   infrav1.InstanceStateErrorReason,
+			},
+			expectedReadyCondition: &clusterv1beta1.Condition{
+				Type:     clusterv1beta1.ReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1beta1.ConditionSeverityWarning,
+				Reason:   infrav1.InstanceStateErrorReason,
+			},
+			expectFailureSet: false,
+		},
 		{
 			name:              "Instance state ERROR with NodeRef does not set failure",
 			instanceState:     infrav1.InstanceStateError,
@@ -588,6 +668,9 @@ func TestReconcileMachineState(t *testing.T) {
 					Name:      openStackMachineName,
 					Namespace: namespace,
 				},
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Remediation: tt.remediation,
+				},
 				Status: infrav1alpha1.OpenStackServerStatus{
 					InstanceID:    ptr.To(testInstanceID),
 					InstanceState: ptr.To(tt.instanceState),
@@ -920,3 +1003,339 @@ var _ = Describe("OpenStackMachine controller", func() {
 		Expect(condition.Message).To(ContainSubstring("Failed to create OpenStack client scope"))
 	})
 })
+
+func TestReconcileConsoleLog(t *testing.T) {
+	const consoleLog = "cloud-init failed\n"
+
+	tests := []struct {
+		name              string
+		policy            *infrav1.ConsoleLogCapture
+		instanceState     infrav1.InstanceState
+		activeSince       time.Duration
+		machineHasNodeRef bool
+		alreadyCaptured   bool
+		consoleLogErr     error
+		wantCapture       bool
+		wantRequeue       time.Duration
+		wantCondition     *clusterv1beta1.Condition
+	}{
+		{
+			name:          "No policy",
+			instanceState: infrav1.InstanceStateError,
+		},
+		{
+			name:          "Instance in ERROR",
+			policy:        &infrav1.ConsoleLogCapture{},
+			instanceState: infrav1.InstanceStateError,
+			wantCapture:   true,
+			wantCondition: &clusterv1beta1.Condition{
+				Type:    infrav1.ConsoleLogCapturedCondition,
+				Status:  corev1.ConditionTrue,
+				Reason:  infrav1.InstanceErrorReason,
+				Message: "Console log of instance " + testInstanceID + " stored in Secret " + openStackMachineName + "-console-log",
+			},
+		},
+		{
+			name:            "Instance in ERROR already captured",
+			policy:          &infrav1.ConsoleLogCapture{},
+			instanceState:   infrav1.InstanceStateError,
+			alreadyCaptured: true,
+		},
+		{
+			name:          "ACTIVE instance without node timeout",
+			policy:        &infrav1.ConsoleLogCapture{},
+			instanceState: infrav1.InstanceStateActive,
+			activeSince:   time.Hour,
+		},
+		{
+			name:          "ACTIVE instance within node timeout",
+			policy:        &infrav1.ConsoleLogCapture{NodeTimeout: &metav1.Duration{Duration: 20 * time.Minute}},
+			instanceState: infrav1.InstanceStateActive,
+			activeSince:   15 * time.Minute,
+			wantRequeue:   5 * time.Minute,
+		},
+		{
+			name:              "ACTIVE instance which became a node",
+			policy:            &infrav1.ConsoleLogCapture{NodeTimeout: &metav1.Duration{Duration: 20 * time.Minute}},
+			instanceState:     infrav1.InstanceStateActive,
+			activeSince:       time.Hour,
+			machineHasNodeRef: true,
+		},
+		{
+			name:          "ACTIVE instance after node timeout",
+			policy:        &infrav1.ConsoleLogCapture{Lines: ptr.To[int32](100), NodeTimeout: &metav1.Duration{Duration: 20 * time.Minute}},
+			instanceState: infrav1.InstanceStateActive,
+			activeSince:   time.Hour,
+			wantCapture:   true,
+			wantCondition: &clusterv1beta1.Condition{
+				Type:    infrav1.ConsoleLogCapturedCondition,
+				Status:  corev1.ConditionTrue,
+				Reason:  infrav1.NodeTimeoutReason,
+				Message: "Console log of instance " + testInstanceID + " stored in Secret " + openStackMachineName + "-console-log",
+			},
+		},
+		{
+			name:          "Console log is not available",
+			policy:        &infrav1.ConsoleLogCapture{},
+			instanceState: infrav1.InstanceStateError,
+			consoleLogErr: fmt.Errorf("test error"),
+			wantRequeue:   waitForInstanceBecomeActiveToReconcile,
+			wantCondition: &clusterv1beta1.Condition{
+				Type:     infrav1.ConsoleLogCapturedCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1beta1.ConditionSeverityWarning,
+				Reason:   infrav1.ConsoleLogCaptureFailedReason,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+			if tt.wantCapture || tt.consoleLogErr != nil {
+				mockScopeFactory.ComputeClient.EXPECT().
+					GetConsoleOutput(testInstanceID, int(ptr.Deref(tt.policy.Lines, defaultConsoleLogLines))).
+					Return(consoleLog, tt.consoleLogErr)
+			}
+
+			computeService, err := compute.NewService(scopeWithLogger)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{ConsoleLogCapture: tt.policy},
+			}
+			openStackMachine := &infrav1.OpenStackMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      openStackMachineName,
+					Namespace: namespace,
+					UID:       "test-uid",
+				},
+			}
+			if tt.activeSince > 0 {
+				v1beta1conditions.Set(openStackMachine, &clusterv1beta1.Condition{
+					Type:               infrav1.InstanceReadyCondition,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-tt.activeSince)),
+				})
+			}
+			if tt.alreadyCaptured {
+				v1beta1conditions.MarkTrue(openStackMachine, infrav1.ConsoleLogCapturedCondition)
+			}
+			machine := &clusterv1.Machine{}
+			if tt.machineHasNodeRef {
+				machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "test-node"}
+			}
+			openStackServer := &infrav1alpha1.OpenStackServer{
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:    ptr.To(testInstanceID),
+					InstanceState: ptr.To(tt.instanceState),
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			reconciler := OpenStackMachineReconciler{
+				Client:   fakeClient,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			requeue := reconciler.reconcileConsoleLog(ctx, scopeWithLogger, computeService, openStackCluster, openStackMachine, machine, openStackServer)
+			g.Expect(requeue).To(BeNumerically("~", tt.wantRequeue, time.Second))
+
+			if tt.wantCondition != nil {
+				condition := v1beta1conditions.Get(openStackMachine, infrav1.ConsoleLogCapturedCondition)
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+				g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+				g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+				if tt.wantCondition.Message != "" {
+					g.Expect(condition.Message).To(Equal(tt.wantCondition.Message))
+				}
+			} else if !tt.alreadyCaptured {
+				g.Expect(v1beta1conditions.Has(openStackMachine, infrav1.ConsoleLogCapturedCondition)).To(BeFalse())
+			}
+
+			secret := &corev1.Secret{}
+			err = fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: openStackMachineName + "-console-log"}, secret)
+			if tt.wantCapture {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(secret.Data).To(Equal(map[string][]byte{consoleLogSecretKey: []byte(consoleLog)}))
+				g.Expect(secret.OwnerReferences).To(ConsistOf(HaveField("UID", openStackMachine.UID)))
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestStoreConsoleLogTruncates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := OpenStackMachineReconciler{Client: fakeClient}
+
+	openStackMachine := &infrav1.OpenStackMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      openStackMachineName,
+			Namespace: namespace,
+		},
+	}
+	line := strings.Repeat("x", 1023) + "\n"
+	consoleLog := "partial" + strings.Repeat(line, consoleLogMaxSize/len(line)+1)
+	g.Expect(reconciler.storeConsoleLog(ctx, openStackMachine, consoleLog)).To(Succeed())
+
+	secret := &corev1.Secret{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: consoleLogSecretName(openStackMachine)}, secret)).To(Succeed())
+	stored := string(secret.Data[consoleLogSecretKey])
+	g.Expect(len(stored)).To(BeNumerically("<=", consoleLogMaxSize))
+	g.Expect(stored).To(HavePrefix(line))
+	g.Expect(stored).To(HaveSuffix(line))
+}
+
+func Test_drainLoadBalancerMember(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+
+	const (
+		lbName     = "k8s-clusterapi-cluster-test-cluster-kubeapi"
+		poolName   = lbName + "-6443"
+		memberName = poolName + "-" + openStackMachineName
+	)
+
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	scope := scope.NewWithLogger(mockScopeFactory, testr.New(t))
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+				Enabled:            ptr.To(true),
+				MemberDrainTimeout: &metav1.Duration{Duration: time.Minute},
+			},
+			ControlPlaneEndpoint: &clusterv1beta1.APIEndpoint{Host: "api.example.com", Port: 6443},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""}},
+	}
+	openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: openStackMachineName}}
+
+	// The member was already drained, so it is only looked up on every reconcile
+	lbClient := mockScopeFactory.LbClient.EXPECT()
+	lbClient.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return([]loadbalancers.LoadBalancer{{ID: "lb-id", Name: lbName}}, nil).Times(3)
+	lbClient.ListPools(pools.ListOpts{LoadbalancerID: "lb-id", Name: poolName}).Return([]pools.Pool{{ID: "pool-id", Name: poolName}}, nil).Times(3)
+	lbClient.ListPoolMember("pool-id", pools.ListMembersOpts{Name: memberName}).Return([]pools.Member{{ID: "member-id", Name: memberName, Weight: 0, OperatingStatus: "DRAINING"}}, nil).Times(3)
+
+	// The drain starts
+	result, err := drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, "test-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(&ctrl.Result{RequeueAfter: loadBalancerMemberDrainRequeueDelay}))
+	g.Expect(v1beta1conditions.GetReason(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)).To(Equal(infrav1.LoadBalancerMemberDrainingReason))
+
+	// The drain waits for the timeout even though the member reports DRAINING
+	result, err = drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, "test-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).NotTo(BeNil())
+	g.Expect(v1beta1conditions.IsFalse(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)).To(BeTrue())
+
+	// The drain is complete once the timeout has expired
+	drainedCondition := v1beta1conditions.Get(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)
+	drainedCondition.LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	openStackMachine.SetConditions(clusterv1beta1.Conditions{*drainedCondition})
+	result, err = drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, "test-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(BeNil())
+	g.Expect(v1beta1conditions.IsTrue(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)).To(BeTrue())
+
+	// Later reconciles don't drain again
+	result, err = drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, "test-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(BeNil())
+
+	// The drain finishes before the timeout once the member is reported in error
+	openStackMachine.SetConditions(nil)
+	lbClient.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return([]loadbalancers.LoadBalancer{{ID: "lb-id", Name: lbName}}, nil)
+	lbClient.ListPools(pools.ListOpts{LoadbalancerID: "lb-id", Name: poolName}).Return([]pools.Pool{{ID: "pool-id", Name: poolName}}, nil)
+	lbClient.ListPoolMember("pool-id", pools.ListMembersOpts{Name: memberName}).Return([]pools.Member{{ID: "member-id", Name: memberName, Weight: 0, OperatingStatus: "ERROR"}}, nil)
+	result, err = drainLoadBalancerMember(scope, loadBalancerService, openStackCluster, machine, openStackMachine, "test-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(BeNil())
+	g.Expect(v1beta1conditions.IsTrue(openStackMachine, infrav1.LoadBalancerMemberDrainedCondition)).To(BeTrue())
+}
+
+func Test_reconcileMachineServerVolumes(t *testing.T) {
+	volume := func(name string) infrav1.AdditionalBlockDevice {
+		return infrav1.AdditionalBlockDevice{
+			Name:    name,
+			SizeGiB: 10,
+			Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		machineSpec      infrav1.OpenStackMachineSpec
+		serverSpec       infrav1alpha1.OpenStackServerSpec
+		wantHotAttach    optional.Bool
+		wantBlockDevices []infrav1.AdditionalBlockDevice
+	}{
+		{
+			name:             "Block devices are not changed without hotAttachVolumes",
+			machineSpec:      infrav1.OpenStackMachineSpec{AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volume("new")}},
+			serverSpec:       infrav1alpha1.OpenStackServerSpec{AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volume("old")}},
+			wantBlockDevices: []infrav1.AdditionalBlockDevice{volume("old")},
+		},
+		{
+			name: "Block devices are passed to the server with hotAttachVolumes",
+			machineSpec: infrav1.OpenStackMachineSpec{
+				HotAttachVolumes:       ptr.To(true),
+				AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volume("old"), volume("new")},
+			},
+			serverSpec:       infrav1alpha1.OpenStackServerSpec{AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volume("old")}},
+			wantHotAttach:    ptr.To(true),
+			wantBlockDevices: []infrav1.AdditionalBlockDevice{volume("old"), volume("new")},
+		},
+		{
+			name:          "Disabling hotAttachVolumes is passed to the server",
+			machineSpec:   infrav1.OpenStackMachineSpec{HotAttachVolumes: ptr.To(false)},
+			serverSpec:    infrav1alpha1.OpenStackServerSpec{HotAttachVolumes: ptr.To(true)},
+			wantHotAttach: ptr.To(false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.TODO()
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+			machineServer := &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{Name: openStackMachineName, Namespace: namespace},
+				Spec:       tt.serverSpec,
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machineServer).Build()
+
+			openStackMachine := &infrav1.OpenStackMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: openStackMachineName, Namespace: namespace},
+				Spec:       tt.machineSpec,
+			}
+			r := &OpenStackMachineReconciler{Client: fakeClient}
+			g.Expect(r.reconcileMachineServerVolumes(ctx, openStackMachine, machineServer)).To(Succeed())
+
+			updated := &infrav1alpha1.OpenStackServer{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machineServer), updated)).To(Succeed())
+			g.Expect(updated.Spec.HotAttachVolumes).To(Equal(tt.wantHotAttach))
+			g.Expect(updated.Spec.AdditionalBlockDevices).To(Equal(tt.wantBlockDevices))
+		})
+	}
+}
@@ -143,7 +143,7 @@ func (r *OpenStackMachineTemplateReconciler) reconcileNormal(ctx context.Context
 		return err
 	}
 
-	flavorID, err := computeService.GetFlavorID(openStackMachineTemplate.Spec.Template.Spec.FlavorID, openStackMachineTemplate.Spec.Template.Spec.Flavor)
+	flavorID, err := computeService.GetFlavorID(openStackMachineTemplate.Spec.Template.Spec.FlavorID, openStackMachineTemplate.Spec.Template.Spec.Flavor, openStackMachineTemplate.Spec.Template.Spec.FlavorSelector, openStackMachineTemplate.Spec.Template.Spec.Accelerators)
 	if err != nil {
 		return err
 	}
@@ -187,6 +187,20 @@ func (r *OpenStackMachineTemplateReconciler) reconcileNormal(ctx context.Context
 		openStackMachineTemplate.Status.Capacity[corev1.ResourceStorage] = *resource.NewQuantity(storageBytes, resource.BinarySI)
 	}
 
+	// PCI devices and placement resources, such as GPUs, are reported as extended resources
+	extraSpecs, err := computeService.GetFlavorExtraSpecs(flavorID)
+	if err != nil {
+		return err
+	}
+	if accelerators, err := compute.ParseFlavorAccelerators(extraSpecs); err != nil {
+		// Malformed extra specs must not prevent reporting the rest of the capacity
+		log.Error(err, "Skipping accelerators of flavor with invalid extra specs", "flavorID", flavorID)
+	} else {
+		for name, quantity := range accelerators.Capacity() {
+			openStackMachineTemplate.Status.Capacity[name] = quantity
+		}
+	}
+
 	imageID, err := computeService.GetImageID(ctx, r.Client, openStackMachineTemplate.Namespace, openStackMachineTemplate.Spec.Template.Spec.Image)
 	if err != nil {
 		return err
@@ -207,6 +207,11 @@ func TestOpenStackMachineTemplateReconciler_reconcileNormal(t *testing.T) {
 						VCPUs: 2, RAM: 1024, Disk: 5, Ephemeral: 1,
 					}, nil)
 
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavorExtraSpecs(flavorID).
+					Return(nil, nil)
+
 				mf.ImageClient.
 					EXPECT().
 					GetImage(imageID).
@@ -228,6 +233,11 @@ func TestOpenStackMachineTemplateReconciler_reconcileNormal(t *testing.T) {
 						Ephemeral: 10,
 					}, nil)
 
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavorExtraSpecs(flavorID).
+					Return(nil, nil)
+
 				mf.ImageClient.
 					EXPECT().
 					GetImage(imageID).
@@ -277,6 +287,11 @@ func TestOpenStackMachineTemplateReconciler_reconcileNormal(t *testing.T) {
 						Ephemeral: 10,
 					}, nil)
 
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavorExtraSpecs(flavorID).
+					Return(nil, nil)
+
 				mf.ImageClient.
 					EXPECT().
 					GetImage(imageID).
@@ -312,6 +327,59 @@ func TestOpenStackMachineTemplateReconciler_reconcileNormal(t *testing.T) {
 				g.Expect(tpl.Status.NodeInfo.OperatingSystem).To(Equal("linux"))
 			},
 		},
+		{
+			name: "accelerators",
+			tpl:  newOSMT("test-osmt", "test-cluster", false, false, true),
+			expect: func(mf *scope.MockScopeFactory) {
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavor(flavorID).
+					Return(&flavors.Flavor{VCPUs: 8, RAM: 16384, Disk: 80}, nil)
+
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavorExtraSpecs(flavorID).
+					Return(map[string]string{
+						"pci_passthrough:alias": "a100:2",
+						"resources:VGPU":        "1",
+					}, nil)
+
+				mf.ImageClient.
+					EXPECT().
+					GetImage(imageID).
+					Return(&images.Image{ID: imageID}, nil)
+			},
+			verify: func(g Gomega, tpl *infrav1.OpenStackMachineTemplate) {
+				g.Expect(tpl.Status.Capacity["pci.openstack.org/a100"]).To(Equal(*resource.NewQuantity(2, resource.DecimalSI)))
+				g.Expect(tpl.Status.Capacity["placement.openstack.org/VGPU"]).To(Equal(*resource.NewQuantity(1, resource.DecimalSI)))
+			},
+		},
+		{
+			name: "invalid accelerator extra specs",
+			tpl:  newOSMT("test-osmt", "test-cluster", false, false, true),
+			expect: func(mf *scope.MockScopeFactory) {
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavor(flavorID).
+					Return(&flavors.Flavor{VCPUs: 8, RAM: 16384, Disk: 80}, nil)
+
+				mf.ComputeClient.
+					EXPECT().
+					GetFlavorExtraSpecs(flavorID).
+					Return(map[string]string{
+						"pci_passthrough:alias": "a100:two",
+					}, nil)
+
+				mf.ImageClient.
+					EXPECT().
+					GetImage(imageID).
+					Return(&images.Image{ID: imageID}, nil)
+			},
+			verify: func(g Gomega, tpl *infrav1.OpenStackMachineTemplate) {
+				g.Expect(tpl.Status.Capacity[corev1.ResourceCPU]).To(Equal(*resource.NewQuantity(8, resource.DecimalSI)))
+				g.Expect(tpl.Status.Capacity).NotTo(HaveKey(corev1.ResourceName("pci.openstack.org/a100")))
+			},
+		},
 	}
 
 	for _, tt := range tests {
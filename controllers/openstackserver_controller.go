@@ -17,10 +17,14 @@ limitations under the License.
 package controllers
 
 import (
+	"cmp"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -58,12 +62,20 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
 	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
 const (
 	SpecHashAnnotation = "infrastructure.cluster.x-k8s.io/spec-hash"
+
+	defaultRemediationBackoff  = time.Minute
+	maxRemediationBackoffShift = 6
+
+	// instanceStateHardReboot is the state of an instance while it is being
+	// hard rebooted.
+	instanceStateHardReboot = infrav1.InstanceState("HARD_REBOOT")
 )
 
 // OpenStackServerReconciler reconciles a OpenStackServer object.
@@ -85,6 +97,10 @@ type OpenStackServerReconciler struct {
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=images,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackvolumesnapshotschedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackservergroups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackimages,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusters,verbs=get;list;watch
 
 func (r *OpenStackServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -138,6 +154,15 @@ func (r *OpenStackServerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
+	// Standalone servers of a cluster join its network and managed security
+	// groups unless their ports specify otherwise
+	if cluster != nil && isStandaloneServer(openStackServer) && openStackServer.DeletionTimestamp.IsZero() && openStackServer.Status.Resolved == nil {
+		joined, err := r.joinCluster(ctx, scope, cluster, openStackServer)
+		if err != nil || !joined {
+			return ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}, err
+		}
+	}
+
 	// Handle deleted servers
 	if !openStackServer.DeletionTimestamp.IsZero() {
 		// When moving a cluster, we need to populate the server status with the resources
@@ -148,6 +173,15 @@ func (r *OpenStackServerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 				return ctrl.Result{}, err
 			}
 		}
+
+		// Cinder doesn't delete volumes which have snapshots, so wait for
+		// snapshot schedules to delete the snapshots of the server's volumes.
+		waiting, err := r.waitForVolumeSnapshots(ctx, openStackServer)
+		if err != nil || waiting {
+			scope.Logger().Info("Waiting for volume snapshots of the server to be deleted")
+			return ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}, err
+		}
+
 		return reconcile.Result{}, r.reconcileDelete(scope, openStackServer)
 	}
 
@@ -155,6 +189,24 @@ func (r *OpenStackServerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return r.reconcileNormal(ctx, scope, openStackServer)
 }
 
+// waitForVolumeSnapshots returns true if an OpenStackVolumeSnapshotSchedule
+// still has snapshots of the volumes of the server.
+func (r *OpenStackServerReconciler) waitForVolumeSnapshots(ctx context.Context, openStackServer *infrav1alpha1.OpenStackServer) (bool, error) {
+	scheduleList := &infrav1alpha1.OpenStackVolumeSnapshotScheduleList{}
+	if err := r.Client.List(ctx, scheduleList, client.InNamespace(openStackServer.Namespace)); err != nil {
+		return false, fmt.Errorf("listing OpenStackVolumeSnapshotSchedules: %w", err)
+	}
+	for i := range scheduleList.Items {
+		schedule := &scheduleList.Items[i]
+		if schedule.Spec.ServerRef.Name == openStackServer.Name &&
+			schedule.Spec.Type != infrav1alpha1.VolumeSnapshotTypeBackup &&
+			len(schedule.Status.Snapshots) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func patchServer(ctx context.Context, patchHelper *patch.Helper, openStackServer *infrav1alpha1.OpenStackServer, options ...patch.Option) error {
 	// Always update the readyCondition by summarizing the state of other conditions.
 	applicableConditions := []clusterv1beta1.ConditionType{
@@ -200,6 +252,34 @@ func (r *OpenStackServerReconciler) SetupWithManager(ctx context.Context, mgr ct
 		return fmt.Errorf("adding servers by image index: %w", err)
 	}
 
+	// Index servers by referenced server group
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &infrav1alpha1.OpenStackServer{}, infrav1alpha1.OpenStackServerServerGroupIndex, func(obj client.Object) []string {
+		server, ok := obj.(*infrav1alpha1.OpenStackServer)
+		if !ok {
+			return nil
+		}
+		if server.Spec.ServerGroup == nil || server.Spec.ServerGroup.ServerGroupRef == nil {
+			return nil
+		}
+		return []string{server.Spec.ServerGroup.ServerGroupRef.Name}
+	}); err != nil {
+		return fmt.Errorf("adding servers by server group index: %w", err)
+	}
+
+	// Index servers by referenced OpenStackImage
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &infrav1alpha1.OpenStackServer{}, infrav1alpha1.OpenStackServerOpenStackImageIndex, func(obj client.Object) []string {
+		server, ok := obj.(*infrav1alpha1.OpenStackServer)
+		if !ok {
+			return nil
+		}
+		if server.Spec.Image.OpenStackImageRef == nil {
+			return nil
+		}
+		return []string{server.Spec.Image.OpenStackImageRef.Name}
+	}); err != nil {
+		return fmt.Errorf("adding servers by OpenStackImage index: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&infrav1alpha1.OpenStackServer{}).
@@ -226,6 +306,42 @@ func (r *OpenStackServerReconciler) SetupWithManager(ctx context.Context, mgr ct
 			}),
 			builder.WithPredicates(orcpredicates.NewBecameAvailable(mgr.GetLogger(), &orcv1alpha1.Image{})),
 		).
+		Watches(&infrav1alpha1.OpenStackServerGroup{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				log := log.WithValues("watch", "OpenStackServerGroup")
+
+				serverList := &infrav1alpha1.OpenStackServerList{}
+				if err := mgr.GetClient().List(ctx, serverList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{infrav1alpha1.OpenStackServerServerGroupIndex: obj.GetName()}); err != nil {
+					log.Error(err, "listing OpenStackServers")
+					return nil
+				}
+
+				requests := make([]reconcile.Request, len(serverList.Items))
+				for i := range serverList.Items {
+					requests[i].Name = serverList.Items[i].Name
+					requests[i].Namespace = serverList.Items[i].Namespace
+				}
+				return requests
+			}),
+		).
+		Watches(&infrav1alpha1.OpenStackImage{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				log := log.WithValues("watch", "OpenStackImage")
+
+				serverList := &infrav1alpha1.OpenStackServerList{}
+				if err := mgr.GetClient().List(ctx, serverList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{infrav1alpha1.OpenStackServerOpenStackImageIndex: obj.GetName()}); err != nil {
+					log.Error(err, "listing OpenStackServers")
+					return nil
+				}
+
+				requests := make([]reconcile.Request, len(serverList.Items))
+				for i := range serverList.Items {
+					requests[i].Name = serverList.Items[i].Name
+					requests[i].Namespace = serverList.Items[i].Namespace
+				}
+				return requests
+			}),
+		).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.requeueOpenStackServersForCluster(ctx)),
@@ -274,6 +390,17 @@ func (r *OpenStackServerReconciler) reconcileDelete(scope *scope.WithLogger, ope
 		}
 	}
 
+	// Volumes which were hot-attached to the server are not deleted with it.
+	if openStackServer.Status.Resources != nil && len(openStackServer.Status.Resources.Volumes) > 0 {
+		done, err := computeService.DeleteServerVolumes(openStackServer, openStackServer.Status.Resources.Volumes)
+		if err != nil {
+			return fmt.Errorf("delete server volumes: %w", err)
+		}
+		if !done {
+			return errors.New("waiting for server volumes to be deleted")
+		}
+	}
+
 	trunkSupported, err := networkingService.IsTrunkExtSupported()
 	if err != nil {
 		return err
@@ -299,11 +426,26 @@ func (r *OpenStackServerReconciler) reconcileDelete(scope *scope.WithLogger, ope
 
 func IsServerTerminalError(server *infrav1alpha1.OpenStackServer) bool {
 	if server.Status.InstanceState != nil && *server.Status.InstanceState == infrav1.InstanceStateError {
-		return true
+		return !canRebootErroredServer(server)
 	}
 	return false
 }
 
+// canRebootErroredServer returns true if the remediation policy of the server
+// allows hard rebooting its instance out of ERROR again. An instance which
+// failed to be created can't be rebooted.
+func canRebootErroredServer(server *infrav1alpha1.OpenStackServer) bool {
+	policy := server.Spec.Remediation
+	if policy == nil || policy.MaxErrorReboots == nil || server.Status.InstanceID == nil {
+		return false
+	}
+	var reboots int32
+	if server.Status.Remediation != nil {
+		reboots = server.Status.Remediation.ErrorReboots
+	}
+	return reboots < *policy.MaxErrorReboots
+}
+
 func (r *OpenStackServerReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, openStackServer *infrav1alpha1.OpenStackServer) (_ ctrl.Result, reterr error) {
 	// If the OpenStackServer is in an error state, return early.
 	if IsServerTerminalError(openStackServer) {
@@ -387,6 +529,48 @@ func (r *OpenStackServerReconciler) reconcileNormal(ctx context.Context, scope *
 	openStackServer.Status.InstanceID = ptr.To(instanceStatus.ID())
 	openStackServer.Status.InstanceState = &state
 
+	if instanceStatus.IsNoValidHost() {
+		fallback, err := reconcileAvailabilityZoneFallback(scope, openStackServer, computeService, instanceStatus, time.Now())
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile availability zone fallback: %w", err)
+		}
+		if fallback {
+			return ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}, nil
+		}
+	}
+
+	if openStackServer.Spec.Remediation != nil {
+		result, err := reconcileRemediation(scope, openStackServer, computeService, instanceStatus, time.Now())
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile remediation: %w", err)
+		}
+		if result != nil {
+			return *result, nil
+		}
+	}
+
+	if ptr.Deref(openStackServer.Spec.InPlaceRebuild, false) {
+		rebuilding, err := r.reconcileRebuild(ctx, scope, openStackServer, computeService, instanceStatus)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile rebuild: %w", err)
+		}
+		if rebuilding {
+			scope.Logger().Info("Waiting for instance to be rebuilt", "id", instanceStatus.ID(), "status", instanceStatus.State())
+			return ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}, nil
+		}
+	}
+
+	if ptr.Deref(openStackServer.Spec.InPlaceResize, false) {
+		resizing, err := reconcileResize(scope, openStackServer, computeService, instanceStatus)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile resize: %w", err)
+		}
+		if resizing {
+			scope.Logger().Info("Waiting for instance to be resized", "id", instanceStatus.ID(), "status", instanceStatus.State())
+			return ctrl.Result{RequeueAfter: waitForInstanceBecomeActiveToReconcile}, nil
+		}
+	}
+
 	switch instanceStatus.State() {
 	case infrav1.InstanceStateActive:
 		scope.Logger().Info("Server instance state is ACTIVE", "id", instanceStatus.ID())
@@ -413,10 +597,304 @@ func (r *OpenStackServerReconciler) reconcileNormal(ctx context.Context, scope *
 		return ctrl.Result{RequeueAfter: waitForInstanceBecomeActiveToReconcile}, nil
 	}
 
+	if ptr.Deref(openStackServer.Spec.HotAttachVolumes, false) {
+		instanceSpec := &compute.InstanceSpec{
+			Name:                    openStackServer.Name,
+			AdditionalBlockDevices:  openStackServer.Spec.AdditionalBlockDevices,
+			FailureDomain:           cmp.Or(openStackServer.Status.AvailabilityZone, ptr.Deref(openStackServer.Spec.AvailabilityZone, "")),
+			RequireEncryptedVolumes: ptr.Deref(openStackServer.Spec.RequireEncryptedVolumes, false),
+		}
+		if openStackServer.Status.Resolved != nil {
+			instanceSpec.Volumes = openStackServer.Status.Resolved.Volumes
+		}
+		inProgress, err := computeService.ReconcileVolumeAttachments(openStackServer, instanceSpec, instanceStatus.ID(), openStackServer.Status.Resources)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconcile volume attachments: %w", err)
+		}
+		if inProgress {
+			scope.Logger().Info("Waiting for volumes to be attached or detached", "id", instanceStatus.ID())
+			return ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}, nil
+		}
+	}
+
 	scope.Logger().Info("Reconciled Server create successfully")
 	return ctrl.Result{}, nil
 }
 
+// reconcileAvailabilityZoneFallback records that no valid host was found for
+// the server instance in its current availability zone. If a fallback
+// availability zone remains, the server instance is deleted so that it is
+// created again in that zone, and true is returned.
+func reconcileAvailabilityZoneFallback(scope *scope.WithLogger, openStackServer *infrav1alpha1.OpenStackServer, computeService *compute.Service, instanceStatus *compute.InstanceStatus, now time.Time) (bool, error) {
+	zones := append([]string{ptr.Deref(openStackServer.Spec.AvailabilityZone, "")}, openStackServer.Spec.AvailabilityZoneFallback...)
+	attempts := len(openStackServer.Status.AvailabilityZoneAttempts)
+	if attempts >= len(zones) {
+		// The failure in the last zone has already been recorded
+		return false, nil
+	}
+
+	openStackServer.Status.AvailabilityZoneAttempts = append(openStackServer.Status.AvailabilityZoneAttempts, infrav1.AvailabilityZoneAttempt{
+		Zone:    zones[attempts],
+		Message: instanceStatus.FaultMessage(),
+		Time:    metav1.NewTime(now),
+	})
+	if attempts+1 == len(zones) {
+		return false, nil
+	}
+
+	nextZone := zones[attempts+1]
+	scope.Logger().Info("No valid host found for instance, retrying in fallback availability zone", "id", instanceStatus.ID(), "zone", zones[attempts], "nextZone", nextZone)
+	if err := computeService.DeleteInstance(openStackServer, instanceStatus); err != nil {
+		return false, err
+	}
+	// Volumes created for the instance may be in the previous zone
+	if err := computeService.DeleteVolumes(openStackServer.Name, openStackServer.Spec.RootVolume, openStackServer.Spec.AdditionalBlockDevices); err != nil {
+		return false, fmt.Errorf("delete volumes: %w", err)
+	}
+
+	openStackServer.Status.AvailabilityZone = nextZone
+	openStackServer.Status.InstanceID = nil
+	openStackServer.Status.InstanceState = nil
+	v1beta1conditions.MarkFalse(openStackServer, infrav1.InstanceReadyCondition, infrav1alpha1.InstanceAvailabilityZoneFallbackReason, clusterv1beta1.ConditionSeverityWarning, "No valid host found in availability zone %q, creating instance in availability zone %q", zones[attempts], nextZone)
+	return true, nil
+}
+
+// reconcileRemediation recovers the server instance according to the
+// remediation policy of the server when it is SHUTOFF, PAUSED or in ERROR.
+// It returns a non-nil result when the instance is being recovered.
+func reconcileRemediation(scope *scope.WithLogger, openStackServer *infrav1alpha1.OpenStackServer, computeService *compute.Service, instanceStatus *compute.InstanceStatus, now time.Time) (*ctrl.Result, error) {
+	policy := openStackServer.Spec.Remediation
+	status := openStackServer.Status.Remediation
+
+	var action infrav1alpha1.ServerRemediationAction
+	switch instanceStatus.State() {
+	case infrav1.InstanceStateActive:
+		if status != nil && status.Attempts > 0 {
+			status.Attempts = 0
+			v1beta1conditions.MarkTrue(openStackServer, infrav1alpha1.InstanceRemediatedCondition)
+		}
+		return nil, nil
+	case infrav1.InstanceStateShutoff:
+		if !ptr.Deref(policy.StartShutoff, false) {
+			return nil, nil
+		}
+		action = infrav1alpha1.ServerRemediationActionStart
+	case infrav1.InstanceStatePaused:
+		if !ptr.Deref(policy.Unpause, false) {
+			return nil, nil
+		}
+		action = infrav1alpha1.ServerRemediationActionUnpause
+	case infrav1.InstanceStateError:
+		if policy.MaxErrorReboots == nil {
+			return nil, nil
+		}
+		if !canRebootErroredServer(openStackServer) {
+			v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceRemediatedCondition, infrav1alpha1.InstanceRemediationFailedReason, clusterv1beta1.ConditionSeverityError, "Instance is still in ERROR after %d reboots", *policy.MaxErrorReboots)
+			return nil, nil
+		}
+		action = infrav1alpha1.ServerRemediationActionReboot
+	default:
+		return nil, nil
+	}
+
+	if status == nil {
+		status = &infrav1alpha1.ServerRemediationStatus{}
+		openStackServer.Status.Remediation = status
+	}
+
+	if status.Attempts > 0 && status.LastActionTime != nil {
+		next := status.LastActionTime.Add(remediationBackoff(policy, status.Attempts))
+		if now.Before(next) {
+			scope.Logger().Info("Waiting before remediating instance", "id", instanceStatus.ID(), "status", instanceStatus.State(), "action", action)
+			return &ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+		}
+	}
+
+	scope.Logger().Info("Remediating instance", "id", instanceStatus.ID(), "status", instanceStatus.State(), "action", action)
+	var err error
+	switch action {
+	case infrav1alpha1.ServerRemediationActionStart:
+		err = computeService.StartInstance(openStackServer, instanceStatus)
+	case infrav1alpha1.ServerRemediationActionUnpause:
+		err = computeService.UnpauseInstance(openStackServer, instanceStatus)
+	case infrav1alpha1.ServerRemediationActionReboot:
+		err = computeService.HardRebootInstance(openStackServer, instanceStatus)
+		if err == nil {
+			status.ErrorReboots++
+			// Forget the ERROR state until the instance is observed again
+			// after the reboot, so that the last allowed reboot is not
+			// considered to have failed before it had a chance to recover.
+			openStackServer.Status.InstanceState = ptr.To(instanceStateHardReboot)
+		}
+	}
+	if err != nil {
+		v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceRemediatedCondition, infrav1alpha1.InstanceRemediationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s of instance in %s failed: %v", action, instanceStatus.State(), err)
+		return nil, err
+	}
+
+	status.Attempts++
+	status.LastAction = action
+	status.LastActionTime = &metav1.Time{Time: now}
+	v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceRemediatedCondition, infrav1alpha1.InstanceRemediatingReason, clusterv1beta1.ConditionSeverityInfo, "%s of instance in %s", action, instanceStatus.State())
+	return &ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}, nil
+}
+
+// remediationBackoff returns the time to wait after the given number of
+// consecutive remediation actions before taking another one.
+func remediationBackoff(policy *infrav1.InstanceRemediation, attempts int32) time.Duration {
+	backoff := defaultRemediationBackoff
+	if policy.Backoff != nil {
+		backoff = policy.Backoff.Duration
+	}
+	return backoff << min(attempts-1, maxRemediationBackoffShift)
+}
+
+// reconcileRebuild rebuilds the server instance if its image differs from the
+// image in the spec. It returns true while a rebuild is in progress.
+func (r *OpenStackServerReconciler) reconcileRebuild(ctx context.Context, scope *scope.WithLogger, openStackServer *infrav1alpha1.OpenStackServer, computeService *compute.Service, instanceStatus *compute.InstanceStatus) (bool, error) {
+	resolved := openStackServer.Status.Resolved
+	rebuild := openStackServer.Status.Rebuild
+
+	switch instanceStatus.State() {
+	case infrav1.InstanceStateRebuild:
+		return true, nil
+	case infrav1.InstanceStateError:
+		if rebuild != nil && !rebuild.Failed {
+			rebuild.Failed = true
+			v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceRebuiltCondition, infrav1alpha1.InstanceRebuildFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to rebuild instance with image %s", rebuild.ImageID)
+		}
+		return false, nil
+	case infrav1.InstanceStateActive:
+	default:
+		return false, nil
+	}
+
+	if rebuild != nil && !rebuild.Failed {
+		// Nova doesn't report the image of volume-backed instances
+		if imageID := instanceStatus.ImageID(); imageID != "" && imageID != rebuild.ImageID {
+			rebuild.Failed = true
+			v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceRebuiltCondition, infrav1alpha1.InstanceRebuildFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to rebuild instance with image %s", rebuild.ImageID)
+			return false, nil
+		}
+
+		resolved.ImageID = rebuild.ImageID
+		openStackServer.Status.Rebuild = nil
+		v1beta1conditions.MarkTrue(openStackServer, infrav1alpha1.InstanceRebuiltCondition)
+		return false, nil
+	}
+
+	imageID, err := computeService.GetImageID(ctx, r.Client, openStackServer.Namespace, openStackServer.Spec.Image)
+	if err != nil {
+		return false, err
+	}
+	// We're waiting on the image to become available
+	if imageID == nil {
+		return false, nil
+	}
+
+	if *imageID == resolved.ImageID {
+		openStackServer.Status.Rebuild = nil
+		return false, nil
+	}
+
+	// Don't retry a failed rebuild until the requested image changes
+	if rebuild != nil && rebuild.ImageID == *imageID {
+		return false, nil
+	}
+
+	instanceSpec, err := r.serverToInstanceSpec(ctx, openStackServer)
+	if err != nil {
+		return false, err
+	}
+
+	scope.Logger().Info("Rebuilding instance", "id", instanceStatus.ID(), "imageID", *imageID)
+	if err := computeService.RebuildInstance(openStackServer, instanceStatus, instanceSpec, *imageID); err != nil {
+		return false, err
+	}
+	openStackServer.Status.Rebuild = &infrav1alpha1.ServerRebuildStatus{ImageID: *imageID}
+	v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceRebuiltCondition, infrav1alpha1.InstanceRebuildingReason, clusterv1beta1.ConditionSeverityInfo, "Rebuilding instance with image %s", *imageID)
+	return true, nil
+}
+
+// reconcileResize resizes the server instance in place if its flavor differs
+// from the flavor in the spec. It returns true while a resize is in progress.
+func reconcileResize(scope *scope.WithLogger, openStackServer *infrav1alpha1.OpenStackServer, computeService *compute.Service, instanceStatus *compute.InstanceStatus) (bool, error) {
+	// A flavor chosen by a selector is kept for the lifetime of the server
+	if openStackServer.Spec.FlavorID == nil && openStackServer.Spec.Flavor == nil {
+		return false, nil
+	}
+
+	flavorID, err := computeService.GetFlavorID(openStackServer.Spec.FlavorID, openStackServer.Spec.Flavor, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch instanceStatus.State() {
+	case infrav1.InstanceStateResize, infrav1.InstanceStateRevertResize:
+		return true, nil
+	case infrav1.InstanceStateVerifyResize:
+		// The flavor may have changed again while the instance was being
+		// resized, in which case we revert and resize again once ACTIVE
+		if instanceStatus.FlavorID() != flavorID {
+			if err := computeService.RevertResizeInstance(openStackServer, instanceStatus); err != nil {
+				return false, err
+			}
+			openStackServer.Status.Resize = nil
+			v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceResizedCondition, infrav1alpha1.InstanceResizeRevertedReason, clusterv1beta1.ConditionSeverityWarning, "Resize to flavor %s was reverted as flavor %s was requested", instanceStatus.FlavorID(), flavorID)
+			return true, nil
+		}
+
+		if err := computeService.ConfirmResizeInstance(openStackServer, instanceStatus); err != nil {
+			return false, err
+		}
+		finishResize(openStackServer, flavorID)
+		return true, nil
+	case infrav1.InstanceStateActive:
+	default:
+		return false, nil
+	}
+
+	resize := openStackServer.Status.Resize
+	if resize != nil && !resize.Failed && resize.FlavorID == flavorID {
+		// Nova may be configured to confirm resizes automatically
+		if instanceStatus.FlavorID() == flavorID {
+			finishResize(openStackServer, flavorID)
+			return false, nil
+		}
+
+		resize.Failed = true
+		v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceResizedCondition, infrav1alpha1.InstanceResizeFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to resize instance to flavor %s", flavorID)
+		return false, nil
+	}
+
+	if instanceStatus.FlavorID() == flavorID {
+		openStackServer.Status.Resize = nil
+		return false, nil
+	}
+
+	// Don't retry a failed resize until the requested flavor changes
+	if resize != nil && resize.Failed && resize.FlavorID == flavorID {
+		return false, nil
+	}
+
+	scope.Logger().Info("Resizing instance", "id", instanceStatus.ID(), "flavorID", flavorID)
+	if err := computeService.ResizeInstance(openStackServer, instanceStatus, flavorID); err != nil {
+		return false, err
+	}
+	openStackServer.Status.Resize = &infrav1alpha1.ServerResizeStatus{FlavorID: flavorID}
+	v1beta1conditions.MarkFalse(openStackServer, infrav1alpha1.InstanceResizedCondition, infrav1alpha1.InstanceResizingReason, clusterv1beta1.ConditionSeverityInfo, "Resizing instance to flavor %s", flavorID)
+	return true, nil
+}
+
+// finishResize records a successful resize of the server instance to the given flavor.
+func finishResize(openStackServer *infrav1alpha1.OpenStackServer, flavorID string) {
+	if openStackServer.Status.Resolved != nil {
+		openStackServer.Status.Resolved.FlavorID = flavorID
+	}
+	openStackServer.Status.Resize = nil
+	v1beta1conditions.MarkTrue(openStackServer, infrav1alpha1.InstanceResizedCondition)
+}
+
 // adoptServerResources adopts the OpenStack resources for the server.
 func adoptServerResources(scope *scope.WithLogger, openStackServer *infrav1alpha1.OpenStackServer) error {
 	resources := openStackServer.Status.Resources
@@ -542,6 +1020,8 @@ func (r *OpenStackServerReconciler) serverToInstanceSpec(ctx context.Context, op
 		Tags:                          openStackServer.Spec.Tags,
 		Trunk:                         openStackServer.Spec.Trunk != nil && *openStackServer.Spec.Trunk,
 		SchedulerAdditionalProperties: openStackServer.Spec.SchedulerHintAdditionalProperties,
+		Volumes:                       resolved.Volumes,
+		RequireEncryptedVolumes:       ptr.Deref(openStackServer.Spec.RequireEncryptedVolumes, false),
 	}
 
 	if openStackServer.Spec.UserDataRef != nil {
@@ -552,7 +1032,9 @@ func (r *OpenStackServerReconciler) serverToInstanceSpec(ctx context.Context, op
 		instanceSpec.UserData = userData
 	}
 
-	if openStackServer.Spec.AvailabilityZone != nil {
+	if openStackServer.Status.AvailabilityZone != "" {
+		instanceSpec.FailureDomain = openStackServer.Status.AvailabilityZone
+	} else if openStackServer.Spec.AvailabilityZone != nil {
 		instanceSpec.FailureDomain = *openStackServer.Spec.AvailabilityZone
 	}
 
@@ -566,6 +1048,48 @@ func getServerStatus(openStackServer *infrav1alpha1.OpenStackServer, computeServ
 	return computeService.GetInstanceStatusByName(openStackServer, openStackServer.Name)
 }
 
+// isStandaloneServer returns true if the OpenStackServer belongs to a cluster,
+// but is neither the server of an OpenStackMachine nor the bastion.
+func isStandaloneServer(openStackServer *infrav1alpha1.OpenStackServer) bool {
+	clusterName := openStackServer.Labels[clusterv1.ClusterNameLabel]
+	if clusterName == "" || openStackServer.Name == bastionName(clusterName) {
+		return false
+	}
+	for _, ref := range openStackServer.OwnerReferences {
+		if ref.Kind == "OpenStackMachine" || ref.Kind == "OpenStackCluster" {
+			return false
+		}
+	}
+	return true
+}
+
+// joinCluster attaches the ports of a standalone server without a network to
+// the cluster network, and sets the managed worker security group on ports
+// without security groups. It returns false if the cluster network or
+// security groups are not ready yet.
+func (r *OpenStackServerReconciler) joinCluster(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackServer *infrav1alpha1.OpenStackServer) (bool, error) {
+	openStackCluster, err := controllers.GetInfraCluster(ctx, r.Client, cluster)
+	if err != nil {
+		return false, fmt.Errorf("getting OpenStackCluster of cluster %s: %w", cluster.Name, err)
+	}
+
+	needsNetwork := len(openStackServer.Spec.Ports) == 0 || slices.ContainsFunc(openStackServer.Spec.Ports, func(port infrav1.PortOpts) bool { return port.Network == nil })
+	networkReady := openStackCluster.Status.Network != nil && openStackCluster.Status.Network.ID != ""
+	securityGroupReady := openStackCluster.Spec.ManagedSecurityGroups == nil || openStackCluster.Status.WorkerSecurityGroup != nil
+	if (needsNetwork && !networkReady) || !securityGroupReady {
+		scope.Logger().Info("Waiting for the network and security groups of the cluster", "openStackCluster", openStackCluster.Name)
+		v1beta1conditions.MarkFalse(openStackServer, infrav1.InstanceReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1beta1.ConditionSeverityInfo, "Waiting for the network and security groups of OpenStackCluster %s", openStackCluster.Name)
+		return false, nil
+	}
+
+	var workerSecurityGroup *string
+	if openStackCluster.Spec.ManagedSecurityGroups != nil {
+		workerSecurityGroup = &openStackCluster.Status.WorkerSecurityGroup.ID
+	}
+	openStackServer.Spec.Ports = defaultServerPorts(openStackServer.Spec.Ports, openStackCluster, workerSecurityGroup)
+	return true, nil
+}
+
 // getClusterFromMetadata returns the Cluster object (if present) using the object metadata.
 // This function was copied from the cluster-api project but manages errors differently.
 func getClusterFromMetadata(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*clusterv1.Cluster, error) {
@@ -627,7 +1151,10 @@ func (r *OpenStackServerReconciler) getOrCreateIPAddressClaimForFloatingAddress(
 				},
 			},
 			Finalizers: []string{infrav1.IPClaimMachineFinalizer},
-			Labels:     map[string]string{},
+			// The labels of the OpenStackServer, which include the labels of its OpenStackMachine,
+			// allow floating IP pool reservations to select the claim. The ClusterNameLabel is
+			// useful for garbage collection of IPAddressClaims when a Cluster is deleted.
+			Labels: maps.Clone(openStackServer.Labels),
 		},
 		Spec: ipamv1.IPAddressClaimSpec{
 			PoolRef: ipamv1.IPPoolReference{
@@ -638,12 +1165,6 @@ func (r *OpenStackServerReconciler) getOrCreateIPAddressClaimForFloatingAddress(
 		},
 	}
 
-	// If the OpenStackServer has a ClusterNameLabel, set it on the IPAddressClaim as well.
-	// This is useful for garbage collection of IPAddressClaims when a Cluster is deleted.
-	if openStackServer.Labels[clusterv1.ClusterNameLabel] != "" {
-		claim.Labels[clusterv1.ClusterNameLabel] = openStackServer.Labels[clusterv1.ClusterNameLabel]
-	}
-
 	if err := r.Client.Create(ctx, claim); err != nil {
 		return nil, err
 	}
@@ -18,9 +18,12 @@ package controllers
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/gophercloud/gophercloud/v2"
@@ -43,12 +46,14 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -480,6 +485,32 @@ func TestOpenStackServer_serverToInstanceSpec(t *testing.T) {
 				Trunk:         true,
 			},
 		},
+		{
+			name: "Test serverToInstanceSpec with fallback availability zone",
+			openStackServer: &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					AvailabilityZone:         ptr.To("failure-domain"),
+					AvailabilityZoneFallback: []string{"fallback"},
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					Resolved: &infrav1alpha1.ResolvedServerSpec{
+						FlavorID: "xyz",
+						ImageID:  "123",
+					},
+					AvailabilityZone: "fallback",
+				},
+			},
+			want: &compute.InstanceSpec{
+				FailureDomain: "fallback",
+				FlavorID:      "xyz",
+				ImageID:       "123",
+				Metadata:      map[string]string{},
+				Name:          "test",
+			},
+		},
 	}
 	for i := range tests {
 		tt := tests[i]
@@ -1145,3 +1176,929 @@ var _ = Describe("OpenStackServer controller", func() {
 		Expect(condition.Message).To(ContainSubstring("Failed to create OpenStack client scope"))
 	})
 })
+
+func Test_reconcileResize(t *testing.T) {
+	const newFlavorUUID = "a9c2c8e1-7a45-4c5e-8c2f-0d3e0b8f4a61"
+
+	tests := []struct {
+		name           string
+		state          infrav1.InstanceState
+		instanceFlavor string
+		resize         *infrav1alpha1.ServerResizeStatus
+		expect         func(r *recorders)
+		wantResizing   bool
+		wantResize     *infrav1alpha1.ServerResizeStatus
+		wantFlavorID   string
+		wantCondition  *clusterv1beta1.Condition
+	}{
+		{
+			name:           "Flavor unchanged",
+			state:          infrav1.InstanceStateActive,
+			instanceFlavor: newFlavorUUID,
+			wantFlavorID:   flavorUUID,
+		},
+		{
+			name:           "Flavor changed starts resize",
+			state:          infrav1.InstanceStateActive,
+			instanceFlavor: flavorUUID,
+			expect: func(r *recorders) {
+				r.compute.ResizeServer(instanceUUID, servers.ResizeOpts{FlavorRef: newFlavorUUID}).Return(nil)
+			},
+			wantResizing: true,
+			wantResize:   &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID},
+			wantFlavorID: flavorUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceResizingReason,
+			},
+		},
+		{
+			name:           "Waiting for resize",
+			state:          infrav1.InstanceStateResize,
+			instanceFlavor: flavorUUID,
+			resize:         &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID},
+			wantResizing:   true,
+			wantResize:     &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID},
+			wantFlavorID:   flavorUUID,
+		},
+		{
+			name:           "Resize confirmed",
+			state:          infrav1.InstanceStateVerifyResize,
+			instanceFlavor: newFlavorUUID,
+			resize:         &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID},
+			expect: func(r *recorders) {
+				r.compute.ConfirmResizeServer(instanceUUID).Return(nil)
+			},
+			wantResizing: true,
+			wantFlavorID: newFlavorUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionTrue,
+			},
+		},
+		{
+			name:           "Resize to a stale flavor is reverted",
+			state:          infrav1.InstanceStateVerifyResize,
+			instanceFlavor: "f1e6a5b4-2c3d-4e5f-9a8b-7c6d5e4f3a2b",
+			resize:         &infrav1alpha1.ServerResizeStatus{FlavorID: "f1e6a5b4-2c3d-4e5f-9a8b-7c6d5e4f3a2b"},
+			expect: func(r *recorders) {
+				r.compute.RevertResizeServer(instanceUUID).Return(nil)
+			},
+			wantResizing: true,
+			wantFlavorID: flavorUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceResizeRevertedReason,
+			},
+		},
+		{
+			name:           "Resize confirmed automatically",
+			state:          infrav1.InstanceStateActive,
+			instanceFlavor: newFlavorUUID,
+			resize:         &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID},
+			wantFlavorID:   newFlavorUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionTrue,
+			},
+		},
+		{
+			name:           "Resize failed",
+			state:          infrav1.InstanceStateActive,
+			instanceFlavor: flavorUUID,
+			resize:         &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID},
+			wantResize:     &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID, Failed: true},
+			wantFlavorID:   flavorUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceResizeFailedReason,
+			},
+		},
+		{
+			name:           "Failed resize is not retried",
+			state:          infrav1.InstanceStateActive,
+			instanceFlavor: flavorUUID,
+			resize:         &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID, Failed: true},
+			wantResize:     &infrav1alpha1.ServerResizeStatus{FlavorID: newFlavorUUID, Failed: true},
+			wantFlavorID:   flavorUUID,
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(&recorders{compute: mockScopeFactory.ComputeClient.EXPECT()})
+			}
+			scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+			computeService, err := compute.NewService(scopeWithLogger)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			osServer := &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{Name: openStackServerName},
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					FlavorID:      ptr.To(newFlavorUUID),
+					InPlaceResize: ptr.To(true),
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					Resolved: &infrav1alpha1.ResolvedServerSpec{FlavorID: flavorUUID},
+					Resize:   tt.resize,
+				},
+			}
+			instanceStatus := compute.NewInstanceStatusFromServer(&servers.Server{
+				ID:     instanceUUID,
+				Name:   openStackServerName,
+				Status: string(tt.state),
+				Flavor: map[string]any{"id": tt.instanceFlavor},
+			}, log)
+
+			resizing, err := reconcileResize(scopeWithLogger, osServer, computeService, instanceStatus)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(resizing).To(Equal(tt.wantResizing))
+			g.Expect(osServer.Status.Resize).To(Equal(tt.wantResize))
+			g.Expect(osServer.Status.Resolved.FlavorID).To(Equal(tt.wantFlavorID))
+
+			condition := v1beta1conditions.Get(osServer, infrav1alpha1.InstanceResizedCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			}
+		})
+	}
+}
+
+func Test_reconcileRebuild(t *testing.T) {
+	const newImageUUID = "3f1b2d9e-5c4a-4e7b-8d6f-2a9c1e0b7d54"
+
+	tests := []struct {
+		name            string
+		state           infrav1.InstanceState
+		instanceImage   string
+		resolvedImageID string
+		rebuild         *infrav1alpha1.ServerRebuildStatus
+		expect          func(r *recorders)
+		wantRebuilding  bool
+		wantRebuild     *infrav1alpha1.ServerRebuildStatus
+		wantImageID     string
+		wantCondition   *clusterv1beta1.Condition
+	}{
+		{
+			name:            "Image unchanged",
+			state:           infrav1.InstanceStateActive,
+			instanceImage:   newImageUUID,
+			resolvedImageID: newImageUUID,
+			wantImageID:     newImageUUID,
+		},
+		{
+			name:          "Image changed starts rebuild",
+			state:         infrav1.InstanceStateActive,
+			instanceImage: imageUUID,
+			expect: func(r *recorders) {
+				r.compute.RebuildServer(instanceUUID, gomock.Any()).Return(&servers.Server{}, nil)
+			},
+			wantRebuilding: true,
+			wantRebuild:    &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID},
+			wantImageID:    imageUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRebuildingReason,
+			},
+		},
+		{
+			name:           "Waiting for rebuild",
+			state:          infrav1.InstanceStateRebuild,
+			instanceImage:  newImageUUID,
+			rebuild:        &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID},
+			wantRebuilding: true,
+			wantRebuild:    &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID},
+			wantImageID:    imageUUID,
+		},
+		{
+			name:          "Rebuild complete",
+			state:         infrav1.InstanceStateActive,
+			instanceImage: newImageUUID,
+			rebuild:       &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID},
+			wantImageID:   newImageUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionTrue,
+			},
+		},
+		{
+			name:          "Rebuild failed",
+			state:         infrav1.InstanceStateError,
+			instanceImage: newImageUUID,
+			rebuild:       &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID},
+			wantRebuild:   &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID, Failed: true},
+			wantImageID:   imageUUID,
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRebuildFailedReason,
+			},
+		},
+		{
+			name:          "Failed rebuild is not retried",
+			state:         infrav1.InstanceStateActive,
+			instanceImage: imageUUID,
+			rebuild:       &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID, Failed: true},
+			wantRebuild:   &infrav1alpha1.ServerRebuildStatus{ImageID: newImageUUID, Failed: true},
+			wantImageID:   imageUUID,
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(&recorders{compute: mockScopeFactory.ComputeClient.EXPECT()})
+			}
+			scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+			computeService, err := compute.NewService(scopeWithLogger)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			resolvedImageID := imageUUID
+			if tt.resolvedImageID != "" {
+				resolvedImageID = tt.resolvedImageID
+			}
+			osServer := &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{Name: openStackServerName},
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Image:          infrav1.ImageParam{ID: ptr.To(newImageUUID)},
+					InPlaceRebuild: ptr.To(true),
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					Resolved: &infrav1alpha1.ResolvedServerSpec{ImageID: resolvedImageID},
+					Rebuild:  tt.rebuild,
+				},
+			}
+			instanceStatus := compute.NewInstanceStatusFromServer(&servers.Server{
+				ID:     instanceUUID,
+				Name:   openStackServerName,
+				Status: string(tt.state),
+				Image:  map[string]any{"id": tt.instanceImage},
+			}, log)
+
+			reconciler := OpenStackServerReconciler{}
+			rebuilding, err := reconciler.reconcileRebuild(ctx, scopeWithLogger, osServer, computeService, instanceStatus)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(rebuilding).To(Equal(tt.wantRebuilding))
+			g.Expect(osServer.Status.Rebuild).To(Equal(tt.wantRebuild))
+			g.Expect(osServer.Status.Resolved.ImageID).To(Equal(tt.wantImageID))
+
+			condition := v1beta1conditions.Get(osServer, infrav1alpha1.InstanceRebuiltCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			}
+		})
+	}
+}
+
+func Test_reconcileRebuildUserData(t *testing.T) {
+	const newImageUUID = "3f1b2d9e-5c4a-4e7b-8d6f-2a9c1e0b7d54"
+	userData := []byte("#cloud-config\nruncmd: []\n")
+
+	g := NewGomegaWithT(t)
+	log := testr.New(t)
+
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	computeRecorder := mockScopeFactory.ComputeClient.EXPECT()
+	computeRecorder.WithMicroversion(clients.NovaRebuildUserData).Return(mockScopeFactory.ComputeClient, nil)
+	computeRecorder.RebuildServer(instanceUUID, gomock.Any()).DoAndReturn(func(_ string, opts servers.RebuildOptsBuilder) (*servers.Server, error) {
+		body, err := opts.ToServerRebuildMap()
+		g.Expect(err).ToNot(HaveOccurred())
+		// The user data is sent exactly as it is when the server is created
+		g.Expect(body).To(Equal(map[string]any{"rebuild": map[string]any{
+			"imageRef":  newImageUUID,
+			"user_data": base64.StdEncoding.EncodeToString(userData),
+		}}))
+		return &servers.Server{}, nil
+	})
+	scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+	computeService, err := compute.NewService(scopeWithLogger)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-data", Namespace: "test-ns"},
+		Data:       map[string][]byte{"value": userData},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	osServer := &infrav1alpha1.OpenStackServer{
+		ObjectMeta: metav1.ObjectMeta{Name: openStackServerName, Namespace: "test-ns"},
+		Spec: infrav1alpha1.OpenStackServerSpec{
+			Image:          infrav1.ImageParam{ID: ptr.To(newImageUUID)},
+			InPlaceRebuild: ptr.To(true),
+			UserDataRef:    &corev1.LocalObjectReference{Name: "user-data"},
+		},
+		Status: infrav1alpha1.OpenStackServerStatus{
+			Resolved: &infrav1alpha1.ResolvedServerSpec{ImageID: imageUUID},
+		},
+	}
+	instanceStatus := compute.NewInstanceStatusFromServer(&servers.Server{
+		ID:     instanceUUID,
+		Name:   openStackServerName,
+		Status: string(infrav1.InstanceStateActive),
+		Image:  map[string]any{"id": imageUUID},
+	}, log)
+
+	reconciler := OpenStackServerReconciler{Client: fakeClient}
+	rebuilding, err := reconciler.reconcileRebuild(ctx, scopeWithLogger, osServer, computeService, instanceStatus)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rebuilding).To(BeTrue())
+}
+
+func Test_reconcileRemediation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy := &infrav1.InstanceRemediation{
+		StartShutoff:    ptr.To(true),
+		Unpause:         ptr.To(true),
+		MaxErrorReboots: ptr.To[int32](2),
+	}
+
+	tests := []struct {
+		name            string
+		state           infrav1.InstanceState
+		policy          *infrav1.InstanceRemediation
+		remediation     *infrav1alpha1.ServerRemediationStatus
+		expect          func(r *recorders)
+		wantResult      *ctrl.Result
+		wantErr         bool
+		wantRemediation *infrav1alpha1.ServerRemediationStatus
+		wantCondition   *clusterv1beta1.Condition
+		wantTerminal    *bool
+	}{
+		{
+			name:   "Active instance is not remediated",
+			state:  infrav1.InstanceStateActive,
+			policy: policy,
+		},
+		{
+			name:   "Shutoff instance is started",
+			state:  infrav1.InstanceStateShutoff,
+			policy: policy,
+			expect: func(r *recorders) {
+				r.compute.StartServer(instanceUUID).Return(nil)
+			},
+			wantResult: &ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				Attempts:       1,
+				LastAction:     infrav1alpha1.ServerRemediationActionStart,
+				LastActionTime: &metav1.Time{Time: now},
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRemediatingReason,
+			},
+		},
+		{
+			name:   "Shutoff instance is not started without policy",
+			state:  infrav1.InstanceStateShutoff,
+			policy: &infrav1.InstanceRemediation{Unpause: ptr.To(true)},
+		},
+		{
+			name:   "Paused instance is unpaused",
+			state:  infrav1.InstanceStatePaused,
+			policy: policy,
+			expect: func(r *recorders) {
+				r.compute.UnpauseServer(instanceUUID).Return(nil)
+			},
+			wantResult: &ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				Attempts:       1,
+				LastAction:     infrav1alpha1.ServerRemediationActionUnpause,
+				LastActionTime: &metav1.Time{Time: now},
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRemediatingReason,
+			},
+		},
+		{
+			name:   "Instance in error is hard rebooted",
+			state:  infrav1.InstanceStateError,
+			policy: policy,
+			expect: func(r *recorders) {
+				r.compute.RebootServer(instanceUUID, servers.RebootOpts{Type: servers.HardReboot}).Return(nil)
+			},
+			wantResult: &ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots:   1,
+				Attempts:       1,
+				LastAction:     infrav1alpha1.ServerRemediationActionReboot,
+				LastActionTime: &metav1.Time{Time: now},
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRemediatingReason,
+			},
+		},
+		{
+			name:   "Instance in error is hard rebooted for the last time",
+			state:  infrav1.InstanceStateError,
+			policy: policy,
+			remediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots: 1,
+			},
+			expect: func(r *recorders) {
+				r.compute.RebootServer(instanceUUID, servers.RebootOpts{Type: servers.HardReboot}).Return(nil)
+			},
+			wantResult: &ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots:   2,
+				Attempts:       1,
+				LastAction:     infrav1alpha1.ServerRemediationActionReboot,
+				LastActionTime: &metav1.Time{Time: now},
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRemediatingReason,
+			},
+			wantTerminal: ptr.To(false),
+		},
+		{
+			name:   "Instance in error is not rebooted more than the maximum",
+			state:  infrav1.InstanceStateError,
+			policy: policy,
+			remediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots: 2,
+			},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots: 2,
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRemediationFailedReason,
+			}, wantTerminal: ptr.To(true),
+		},
+		{
+			name:   "Instance recovered by the last reboot is remediated",
+			state:  infrav1.InstanceStateActive,
+			policy: policy,
+			remediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots:   2,
+				Attempts:       1,
+				LastAction:     infrav1alpha1.ServerRemediationActionReboot,
+				LastActionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots:   2,
+				LastAction:     infrav1alpha1.ServerRemediationActionReboot,
+				LastActionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionTrue,
+			},
+			wantTerminal: ptr.To(false),
+		},
+		{
+			name:   "Remediation waits for backoff",
+			state:  infrav1.InstanceStateShutoff,
+			policy: policy,
+			remediation: &infrav1alpha1.ServerRemediationStatus{
+				Attempts:       2,
+				LastAction:     infrav1alpha1.ServerRemediationActionStart,
+				LastActionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			wantResult: &ctrl.Result{RequeueAfter: time.Minute},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				Attempts:       2,
+				LastAction:     infrav1alpha1.ServerRemediationActionStart,
+				LastActionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+		},
+		{
+			name:   "Remediation failure is reported",
+			state:  infrav1.InstanceStateShutoff,
+			policy: policy,
+			expect: func(r *recorders) {
+				r.compute.StartServer(instanceUUID).Return(fmt.Errorf("conflict"))
+			},
+			wantErr:         true,
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1alpha1.InstanceRemediationFailedReason,
+			},
+		},
+		{
+			name:   "Attempts are reset once active",
+			state:  infrav1.InstanceStateActive,
+			policy: policy,
+			remediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots:   1,
+				Attempts:       1,
+				LastAction:     infrav1alpha1.ServerRemediationActionReboot,
+				LastActionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			wantRemediation: &infrav1alpha1.ServerRemediationStatus{
+				ErrorReboots:   1,
+				LastAction:     infrav1alpha1.ServerRemediationActionReboot,
+				LastActionTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			wantCondition: &clusterv1beta1.Condition{
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(&recorders{compute: mockScopeFactory.ComputeClient.EXPECT()})
+			}
+			scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+			computeService, err := compute.NewService(scopeWithLogger)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			osServer := &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{Name: openStackServerName},
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Remediation: tt.policy,
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:    ptr.To(instanceUUID),
+					InstanceState: ptr.To(tt.state),
+					Remediation:   tt.remediation,
+				},
+			}
+			instanceStatus := compute.NewInstanceStatusFromServer(&servers.Server{
+				ID:     instanceUUID,
+				Name:   openStackServerName,
+				Status: string(tt.state),
+			}, log)
+
+			result, err := reconcileRemediation(scopeWithLogger, osServer, computeService, instanceStatus, now)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(result).To(Equal(tt.wantResult))
+			g.Expect(osServer.Status.Remediation).To(Equal(tt.wantRemediation))
+
+			condition := v1beta1conditions.Get(osServer, infrav1alpha1.InstanceRemediatedCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			}
+			if tt.wantTerminal != nil {
+				g.Expect(IsServerTerminalError(osServer)).To(Equal(*tt.wantTerminal))
+			}
+		})
+	}
+}
+
+func Test_reconcileAvailabilityZoneFallback(t *testing.T) {
+	const noValidHost = "No valid host was found. There are not enough hosts available."
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+
+	tests := []struct {
+		name                 string
+		attempts             []infrav1.AvailabilityZoneAttempt
+		expect               func(r *recorders)
+		want                 bool
+		wantErr              bool
+		wantAvailabilityZone string
+		wantAttempts         []infrav1.AvailabilityZoneAttempt
+	}{
+		{
+			name: "Instance is deleted and created in the first fallback zone",
+			expect: func(r *recorders) {
+				r.compute.DeleteServer(instanceUUID).Return(nil)
+				r.compute.GetServer(instanceUUID).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+			},
+			want:                 true,
+			wantAvailabilityZone: "az-2",
+			wantAttempts: []infrav1.AvailabilityZoneAttempt{
+				{Zone: "az-1", Message: noValidHost, Time: metav1.NewTime(now)},
+			},
+		},
+		{
+			name: "Failure in the last zone is recorded",
+			attempts: []infrav1.AvailabilityZoneAttempt{
+				{Zone: "az-1", Message: noValidHost, Time: earlier},
+				{Zone: "az-2", Message: noValidHost, Time: earlier},
+			},
+			wantAttempts: []infrav1.AvailabilityZoneAttempt{
+				{Zone: "az-1", Message: noValidHost, Time: earlier},
+				{Zone: "az-2", Message: noValidHost, Time: earlier},
+				{Zone: "az-3", Message: noValidHost, Time: metav1.NewTime(now)},
+			},
+		},
+		{
+			name: "Failure in the last zone is only recorded once",
+			attempts: []infrav1.AvailabilityZoneAttempt{
+				{Zone: "az-1", Message: noValidHost, Time: earlier},
+				{Zone: "az-2", Message: noValidHost, Time: earlier},
+				{Zone: "az-3", Message: noValidHost, Time: earlier},
+			},
+			wantAttempts: []infrav1.AvailabilityZoneAttempt{
+				{Zone: "az-1", Message: noValidHost, Time: earlier},
+				{Zone: "az-2", Message: noValidHost, Time: earlier},
+				{Zone: "az-3", Message: noValidHost, Time: earlier},
+			},
+		},
+		{
+			name: "Failure to delete the instance is returned",
+			expect: func(r *recorders) {
+				r.compute.DeleteServer(instanceUUID).Return(errors.New("test error"))
+			},
+			wantErr: true,
+			wantAttempts: []infrav1.AvailabilityZoneAttempt{
+				{Zone: "az-1", Message: noValidHost, Time: metav1.NewTime(now)},
+			},
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(&recorders{compute: mockScopeFactory.ComputeClient.EXPECT()})
+			}
+			scopeWithLogger := scope.NewWithLogger(mockScopeFactory, log)
+
+			computeService, err := compute.NewService(scopeWithLogger)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			osServer := &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{Name: openStackServerName},
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					AvailabilityZone:         ptr.To("az-1"),
+					AvailabilityZoneFallback: []string{"az-2", "az-3"},
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:               ptr.To(instanceUUID),
+					InstanceState:            &infrav1.InstanceStateError,
+					AvailabilityZoneAttempts: tt.attempts,
+				},
+			}
+			instanceStatus := compute.NewInstanceStatusFromServer(&servers.Server{
+				ID:     instanceUUID,
+				Name:   openStackServerName,
+				Status: string(infrav1.InstanceStateError),
+				Fault:  servers.Fault{Code: 500, Message: noValidHost},
+			}, log)
+			g.Expect(instanceStatus.IsNoValidHost()).To(BeTrue())
+
+			fallback, err := reconcileAvailabilityZoneFallback(scopeWithLogger, osServer, computeService, instanceStatus, now)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(fallback).To(Equal(tt.want))
+			g.Expect(osServer.Status.AvailabilityZoneAttempts).To(Equal(tt.wantAttempts))
+			g.Expect(osServer.Status.AvailabilityZone).To(Equal(tt.wantAvailabilityZone))
+			if tt.want {
+				g.Expect(osServer.Status.InstanceID).To(BeNil())
+				g.Expect(osServer.Status.InstanceState).To(BeNil())
+				condition := v1beta1conditions.Get(osServer, infrav1.InstanceReadyCondition)
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Reason).To(Equal(infrav1alpha1.InstanceAvailabilityZoneFallbackReason))
+			}
+		})
+	}
+}
+
+func TestIsServerTerminalError(t *testing.T) {
+	tests := []struct {
+		name   string
+		server *infrav1alpha1.OpenStackServer
+		want   bool
+	}{
+		{
+			name: "Active server",
+			server: &infrav1alpha1.OpenStackServer{
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:    ptr.To(instanceUUID),
+					InstanceState: ptr.To(infrav1.InstanceStateActive),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Server in error without remediation",
+			server: &infrav1alpha1.OpenStackServer{
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:    ptr.To(instanceUUID),
+					InstanceState: ptr.To(infrav1.InstanceStateError),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Server in error with reboots left",
+			server: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Remediation: &infrav1.InstanceRemediation{MaxErrorReboots: ptr.To[int32](1)},
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:    ptr.To(instanceUUID),
+					InstanceState: ptr.To(infrav1.InstanceStateError),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Server in error without reboots left",
+			server: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Remediation: &infrav1.InstanceRemediation{MaxErrorReboots: ptr.To[int32](1)},
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceID:    ptr.To(instanceUUID),
+					InstanceState: ptr.To(infrav1.InstanceStateError),
+					Remediation:   &infrav1alpha1.ServerRemediationStatus{ErrorReboots: 1},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Server which failed to be created",
+			server: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Remediation: &infrav1.InstanceRemediation{MaxErrorReboots: ptr.To[int32](1)},
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					InstanceState: ptr.To(infrav1.InstanceStateError),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(IsServerTerminalError(tt.server)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_isStandaloneServer(t *testing.T) {
+	newServer := func(name string, labels map[string]string, ownerKind string) *infrav1alpha1.OpenStackServer {
+		server := &infrav1alpha1.OpenStackServer{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		if ownerKind != "" {
+			server.OwnerReferences = []metav1.OwnerReference{{Kind: ownerKind, Name: "owner"}}
+		}
+		return server
+	}
+	clusterLabels := map[string]string{clusterv1.ClusterNameLabel: "test-cluster"}
+
+	tests := []struct {
+		name   string
+		server *infrav1alpha1.OpenStackServer
+		want   bool
+	}{
+		{
+			name:   "Server without cluster label",
+			server: newServer("server", nil, ""),
+			want:   false,
+		},
+		{
+			name:   "Server of a machine",
+			server: newServer("server", clusterLabels, "OpenStackMachine"),
+			want:   false,
+		},
+		{
+			name:   "Bastion",
+			server: newServer(bastionName("test-cluster"), clusterLabels, "OpenStackCluster"),
+			want:   false,
+		},
+		{
+			name:   "Standalone server",
+			server: newServer("server", clusterLabels, ""),
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(isStandaloneServer(tt.server)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_joinCluster(t *testing.T) {
+	const (
+		networkID         = "a42211a2-4d2c-426f-9413-830e4b4abbbc"
+		securityGroupID   = "60ed83f1-8886-41c6-a1c7-fcfbdf3f04c2"
+		userSecurityGroup = "a82d2bba-d62a-4a3c-ab46-2ed6cd4cdcb5"
+	)
+
+	newOpenStackCluster := func(networkReady bool) *infrav1.OpenStackCluster {
+		openStackCluster := &infrav1.OpenStackCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-openstack-cluster", Namespace: "test-namespace"},
+			Spec: infrav1.OpenStackClusterSpec{
+				ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{},
+			},
+		}
+		if networkReady {
+			openStackCluster.Status.Network = &infrav1.NetworkStatusWithSubnets{NetworkStatus: infrav1.NetworkStatus{ID: networkID}}
+			openStackCluster.Status.WorkerSecurityGroup = &infrav1.SecurityGroupStatus{ID: securityGroupID}
+		}
+		return openStackCluster
+	}
+
+	tests := []struct {
+		name       string
+		ports      []infrav1.PortOpts
+		ready      bool
+		wantJoined bool
+		wantPorts  []infrav1.PortOpts
+	}{
+		{
+			name:       "Waits for the cluster network",
+			ready:      false,
+			wantJoined: false,
+		},
+		{
+			name:       "Joins the cluster network and security group",
+			ready:      true,
+			wantJoined: true,
+			wantPorts: []infrav1.PortOpts{{
+				Network:        &infrav1.NetworkParam{ID: ptr.To(networkID)},
+				SecurityGroups: []infrav1.SecurityGroupParam{{ID: ptr.To(securityGroupID)}},
+			}},
+		},
+		{
+			name: "Keeps the security groups of the ports",
+			ports: []infrav1.PortOpts{{
+				SecurityGroups: []infrav1.SecurityGroupParam{{ID: ptr.To(userSecurityGroup)}},
+			}},
+			ready:      true,
+			wantJoined: true,
+			wantPorts: []infrav1.PortOpts{{
+				Network:        &infrav1.NetworkParam{ID: ptr.To(networkID)},
+				SecurityGroups: []infrav1.SecurityGroupParam{{ID: ptr.To(userSecurityGroup)}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newOpenStackCluster(tt.ready)).Build()
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{Name: "test-openstack-cluster"},
+				},
+			}
+			openStackServer := &infrav1alpha1.OpenStackServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "helper",
+					Namespace: "test-namespace",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+				},
+				Spec: infrav1alpha1.OpenStackServerSpec{Ports: tt.ports},
+			}
+			scope := scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t))
+
+			r := &OpenStackServerReconciler{Client: fakeClient}
+			joined, err := r.joinCluster(context.TODO(), scope, cluster, openStackServer)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(joined).To(Equal(tt.wantJoined))
+			g.Expect(openStackServer.Spec.Ports).To(Equal(tt.wantPorts))
+			if !tt.wantJoined {
+				g.Expect(v1beta1conditions.GetReason(openStackServer, infrav1.InstanceReadyCondition)).To(Equal(infrav1.WaitingForClusterInfrastructureReason))
+			}
+		})
+	}
+}
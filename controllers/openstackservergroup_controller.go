@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const (
+	// waitForServerGroupMembersToReconcile is the requeue interval while the server group is still referenced.
+	waitForServerGroupMembersToReconcile = 10 * time.Second
+
+	// serverGroupResyncPeriod is the interval at which the members of a server group are refreshed.
+	serverGroupResyncPeriod = 5 * time.Minute
+)
+
+// OpenStackServerGroupReconciler reconciles a OpenStackServerGroup object.
+type OpenStackServerGroupReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	Scheme           *runtime.Scheme
+	CaCertificates   []byte // PEM encoded ca certificates.
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackservergroups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackservergroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackservers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+
+func (r *OpenStackServerGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	serverGroup := &infrav1alpha1.OpenStackServerGroup{}
+	if err := r.Client.Get(ctx, req.NamespacedName, serverGroup); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patchHelper, err := patch.NewHelper(serverGroup, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, serverGroup); err != nil {
+			if reterr == nil {
+				reterr = fmt.Errorf("error patching OpenStackServerGroup %s/%s: %w", serverGroup.Namespace, serverGroup.Name, err)
+			}
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromObject(ctx, r.Client, r.CaCertificates, log, serverGroup)
+	if err != nil {
+		v1beta1conditions.MarkFalse(serverGroup, infrav1.OpenStackAuthenticationSucceeded, infrav1.OpenStackAuthenticationFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to create OpenStack client scope: %v", err)
+		return reconcile.Result{}, err
+	}
+	v1beta1conditions.MarkTrue(serverGroup, infrav1.OpenStackAuthenticationSucceeded)
+	scope := scope.NewWithLogger(clientScope, log)
+
+	if !serverGroup.DeletionTimestamp.IsZero() {
+		serverList := &infrav1alpha1.OpenStackServerList{}
+		if err := r.Client.List(ctx, serverList, client.InNamespace(serverGroup.Namespace), client.MatchingFields{infrav1alpha1.OpenStackServerServerGroupIndex: serverGroup.Name}); err != nil {
+			return ctrl.Result{}, err
+		}
+		templates, err := r.getReferencingTemplates(ctx, serverGroup)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.reconcileDelete(scope, serverGroup, len(serverList.Items), len(templates))
+	}
+
+	if controllerutil.AddFinalizer(serverGroup, infrav1alpha1.OpenStackServerGroupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.setOwnerReferences(ctx, serverGroup); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return r.reconcileNormal(scope, serverGroup)
+}
+
+// getReferencingTemplates returns the OpenStackMachineTemplates which
+// reference the server group and are not being deleted.
+func (r *OpenStackServerGroupReconciler) getReferencingTemplates(ctx context.Context, serverGroup *infrav1alpha1.OpenStackServerGroup) ([]*infrav1.OpenStackMachineTemplate, error) {
+	templateList := &infrav1.OpenStackMachineTemplateList{}
+	if err := r.Client.List(ctx, templateList, client.InNamespace(serverGroup.Namespace), client.MatchingFields{infrav1alpha1.OpenStackMachineTemplateServerGroupIndex: serverGroup.Name}); err != nil {
+		return nil, err
+	}
+
+	var templates []*infrav1.OpenStackMachineTemplate
+	for i := range templateList.Items {
+		if templateList.Items[i].DeletionTimestamp.IsZero() {
+			templates = append(templates, &templateList.Items[i])
+		}
+	}
+	return templates, nil
+}
+
+// setOwnerReferences makes the cluster of the server group and every
+// OpenStackMachineTemplate which references it owners of the server group,
+// so that it is garbage collected once the last of them is deleted.
+func (r *OpenStackServerGroupReconciler) setOwnerReferences(ctx context.Context, serverGroup *infrav1alpha1.OpenStackServerGroup) error {
+	cluster, err := getClusterFromMetadata(ctx, r.Client, serverGroup.ObjectMeta)
+	if err != nil {
+		return err
+	}
+	if cluster != nil && cluster.DeletionTimestamp.IsZero() {
+		if err := controllerutil.SetOwnerReference(cluster, serverGroup, r.Scheme); err != nil {
+			return err
+		}
+	}
+
+	templates, err := r.getReferencingTemplates(ctx, serverGroup)
+	if err != nil {
+		return err
+	}
+	for _, template := range templates {
+		if err := controllerutil.SetOwnerReference(template, serverGroup, r.Scheme); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *OpenStackServerGroupReconciler) reconcileNormal(scope *scope.WithLogger, serverGroup *infrav1alpha1.OpenStackServerGroup) (ctrl.Result, error) {
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if serverGroup.Status.ID != "" {
+		sg, err := computeService.GetServerGroup(serverGroup.Status.ID)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if sg != nil {
+			serverGroup.Status.Members = sg.Members
+			v1beta1conditions.MarkTrue(serverGroup, infrav1alpha1.ServerGroupReadyCondition)
+			return ctrl.Result{RequeueAfter: serverGroupResyncPeriod}, nil
+		}
+
+		// The server group was deleted outside of CAPO, create it again
+		scope.Logger().Info("Server group not found, recreating it", "id", serverGroup.Status.ID)
+		serverGroup.Status.Ready = false
+		serverGroup.Status.ID = ""
+		serverGroup.Status.Members = nil
+	}
+
+	sg, err := computeService.GetOrCreateServerGroup(serverGroup, getServerGroupName(serverGroup), serverGroup.Spec.Policy, serverGroup.Spec.MaxServerPerHost)
+	if err != nil {
+		v1beta1conditions.MarkFalse(serverGroup, infrav1alpha1.ServerGroupReadyCondition, infrav1alpha1.ServerGroupCreateFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to create server group: %v", err)
+		return ctrl.Result{}, err
+	}
+
+	serverGroup.Status.Ready = true
+	serverGroup.Status.ID = sg.ID
+	serverGroup.Status.Members = sg.Members
+	v1beta1conditions.MarkTrue(serverGroup, infrav1alpha1.ServerGroupReadyCondition)
+	return ctrl.Result{RequeueAfter: serverGroupResyncPeriod}, nil
+}
+
+func (r *OpenStackServerGroupReconciler) reconcileDelete(scope *scope.WithLogger, serverGroup *infrav1alpha1.OpenStackServerGroup, referencingServers, referencingTemplates int) (ctrl.Result, error) {
+	scope.Logger().Info("Reconciling OpenStackServerGroup delete")
+
+	// Nova allows deleting a server group with members, but the servers
+	// would silently lose their scheduling policy. Machines created from
+	// templates which reference the server group would never be created.
+	if referencingServers > 0 || referencingTemplates > 0 {
+		v1beta1conditions.MarkFalse(serverGroup, infrav1alpha1.ServerGroupReadyCondition, infrav1alpha1.ServerGroupInUseReason, clusterv1beta1.ConditionSeverityInfo, "Waiting for %d OpenStackServers and %d OpenStackMachineTemplates referencing the server group to be deleted", referencingServers, referencingTemplates)
+		return ctrl.Result{RequeueAfter: waitForServerGroupMembersToReconcile}, nil
+	}
+
+	if serverGroup.Status.ID != "" {
+		computeService, err := compute.NewService(scope)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := computeService.DeleteServerGroup(serverGroup, serverGroup.Status.ID); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(serverGroup, infrav1alpha1.OpenStackServerGroupFinalizer)
+	scope.Logger().Info("Reconciled OpenStackServerGroup deleted successfully")
+	return ctrl.Result{}, nil
+}
+
+// getServerGroupName returns the name of the server group in OpenStack. It
+// includes the namespace as server group names are not unique in OpenStack.
+func getServerGroupName(serverGroup *infrav1alpha1.OpenStackServerGroup) string {
+	return fmt.Sprintf("%s-%s", serverGroup.Namespace, serverGroup.Name)
+}
+
+func (r *OpenStackServerGroupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &infrav1.OpenStackMachineTemplate{}, infrav1alpha1.OpenStackMachineTemplateServerGroupIndex, func(obj client.Object) []string {
+		template, ok := obj.(*infrav1.OpenStackMachineTemplate)
+		if !ok {
+			return nil
+		}
+		serverGroup := template.Spec.Template.Spec.ServerGroup
+		if serverGroup == nil || serverGroup.ServerGroupRef == nil {
+			return nil
+		}
+		return []string{serverGroup.ServerGroupRef.Name}
+	}); err != nil {
+		return fmt.Errorf("adding machine templates by server group index: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1alpha1.OpenStackServerGroup{}).
+		Watches(&infrav1.OpenStackMachineTemplate{},
+			handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+				template, ok := obj.(*infrav1.OpenStackMachineTemplate)
+				if !ok {
+					log.Info("Unexpected object in OpenStackMachineTemplate watch", "object", obj)
+					return nil
+				}
+				serverGroup := template.Spec.Template.Spec.ServerGroup
+				if serverGroup == nil || serverGroup.ServerGroupRef == nil {
+					return nil
+				}
+				return []reconcile.Request{{NamespacedName: client.ObjectKey{
+					Namespace: template.Namespace,
+					Name:      serverGroup.ServerGroupRef.Name,
+				}}}
+			}),
+		).
+		Watches(&infrav1alpha1.OpenStackServer{},
+			handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+				server, ok := obj.(*infrav1alpha1.OpenStackServer)
+				if !ok {
+					log.Info("Unexpected object in OpenStackServer watch", "object", obj)
+					return nil
+				}
+				if server.Spec.ServerGroup == nil || server.Spec.ServerGroup.ServerGroupRef == nil {
+					return nil
+				}
+				return []reconcile.Request{{NamespacedName: client.ObjectKey{
+					Namespace: server.Namespace,
+					Name:      server.Spec.ServerGroup.ServerGroupRef.Name,
+				}}}
+			}),
+		).
+		Complete(r)
+}
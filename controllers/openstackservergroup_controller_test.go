@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	. "github.com/onsi/gomega" //nolint:revive
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const (
+	serverGroupID   = "b7f2c4a1-6d3e-4f8a-9b0c-1d2e3f4a5b6c"
+	serverGroupName = "test-ns-test-server-group"
+)
+
+func TestOpenStackServerGroupReconciler_reconcileNormal(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        infrav1alpha1.OpenStackServerGroupStatus
+		expect        func(m *mock.MockComputeClientMockRecorder)
+		wantStatus    infrav1alpha1.OpenStackServerGroupStatus
+		wantErr       bool
+		wantCondition bool
+	}{
+		{
+			name: "Creates the server group",
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.ListServerGroups().Return(nil, nil)
+				m.CreateServerGroup(servergroups.CreateOpts{
+					Name:     serverGroupName,
+					Policies: []string{"anti-affinity"},
+				}).Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName}, nil)
+			},
+			wantStatus:    infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			wantCondition: true,
+		},
+		{
+			name: "Adopts an existing server group with the same name",
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.ListServerGroups().Return([]servergroups.ServerGroup{
+					{ID: "other", Name: "other"},
+					{ID: serverGroupID, Name: serverGroupName, Members: []string{instanceUUID}},
+				}, nil)
+			},
+			wantStatus:    infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID, Members: []string{instanceUUID}},
+			wantCondition: true,
+		},
+		{
+			name:   "Refreshes the members of the server group",
+			status: infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetServerGroup(serverGroupID).Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName, Members: []string{instanceUUID}}, nil)
+			},
+			wantStatus:    infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID, Members: []string{instanceUUID}},
+			wantCondition: true,
+		},
+		{
+			name:   "Recreates a server group deleted outside of CAPO",
+			status: infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: "deleted", Members: []string{instanceUUID}},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetServerGroup("deleted").Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+				m.ListServerGroups().Return(nil, nil)
+				m.CreateServerGroup(servergroups.CreateOpts{
+					Name:     serverGroupName,
+					Policies: []string{"anti-affinity"},
+				}).Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName}, nil)
+			},
+			wantStatus:    infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			wantCondition: true,
+		},
+		{
+			name: "Fails to create the server group",
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.ListServerGroups().Return(nil, nil)
+				m.CreateServerGroup(gomock.Any()).Return(nil, errors.New("quota exceeded"))
+			},
+			wantErr: true,
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockScopeFactory.ComputeClient.EXPECT())
+
+			serverGroup := &infrav1alpha1.OpenStackServerGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-server-group", Namespace: "test-ns"},
+				Spec:       infrav1alpha1.OpenStackServerGroupSpec{Policy: infrav1alpha1.ServerGroupPolicyAntiAffinity},
+				Status:     tt.status,
+			}
+
+			reconciler := OpenStackServerGroupReconciler{}
+			res, err := reconciler.reconcileNormal(scope.NewWithLogger(mockScopeFactory, log), serverGroup)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(v1beta1conditions.GetReason(serverGroup, infrav1alpha1.ServerGroupReadyCondition)).To(Equal(infrav1alpha1.ServerGroupCreateFailedReason))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(serverGroupResyncPeriod))
+
+			g.Expect(v1beta1conditions.IsTrue(serverGroup, infrav1alpha1.ServerGroupReadyCondition)).To(Equal(tt.wantCondition))
+			serverGroup.Status.Conditions = nil
+			g.Expect(serverGroup.Status).To(Equal(tt.wantStatus))
+		})
+	}
+}
+
+func TestOpenStackServerGroupReconciler_reconcileDelete(t *testing.T) {
+	tests := []struct {
+		name                 string
+		status               infrav1alpha1.OpenStackServerGroupStatus
+		referencingServers   int
+		referencingTemplates int
+		expect               func(m *mock.MockComputeClientMockRecorder)
+		wantRequeue          time.Duration
+		wantFinalizer        bool
+	}{
+		{
+			name:               "Waits for the servers referencing the server group",
+			status:             infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			referencingServers: 2,
+			wantRequeue:        waitForServerGroupMembersToReconcile,
+			wantFinalizer:      true,
+		},
+		{
+			name:                 "Waits for the machine templates referencing the server group",
+			status:               infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			referencingTemplates: 1,
+			wantRequeue:          waitForServerGroupMembersToReconcile,
+			wantFinalizer:        true,
+		},
+		{
+			name:   "Deletes the server group",
+			status: infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.DeleteServerGroup(serverGroupID).Return(nil)
+			},
+		},
+		{
+			name:   "Server group already deleted",
+			status: infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.DeleteServerGroup(serverGroupID).Return(gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+			},
+		},
+		{
+			name: "Server group was never created",
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(mockScopeFactory.ComputeClient.EXPECT())
+			}
+
+			serverGroup := &infrav1alpha1.OpenStackServerGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-server-group",
+					Namespace:  "test-ns",
+					Finalizers: []string{infrav1alpha1.OpenStackServerGroupFinalizer},
+				},
+				Spec:   infrav1alpha1.OpenStackServerGroupSpec{Policy: infrav1alpha1.ServerGroupPolicyAntiAffinity},
+				Status: tt.status,
+			}
+
+			reconciler := OpenStackServerGroupReconciler{}
+			res, err := reconciler.reconcileDelete(scope.NewWithLogger(mockScopeFactory, log), serverGroup, tt.referencingServers, tt.referencingTemplates)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantRequeue))
+			g.Expect(controllerutil.ContainsFinalizer(serverGroup, infrav1alpha1.OpenStackServerGroupFinalizer)).To(Equal(tt.wantFinalizer))
+		})
+	}
+}
+
+func TestOpenStackServerGroupReconciler_setOwnerReferences(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-ns", UID: "cluster-uid"},
+	}
+	template := func(name, serverGroupName string) *infrav1.OpenStackMachineTemplate {
+		return &infrav1.OpenStackMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns", UID: types.UID(name + "-uid")},
+			Spec: infrav1.OpenStackMachineTemplateSpec{
+				Template: infrav1.OpenStackMachineTemplateResource{
+					Spec: infrav1.OpenStackMachineSpec{
+						ServerGroup: &infrav1.ServerGroupParam{ServerGroupRef: &infrav1.ResourceReference{Name: serverGroupName}},
+					},
+				},
+			},
+		}
+	}
+	serverGroup := &infrav1alpha1.OpenStackServerGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server-group",
+			Namespace: "test-ns",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(cluster, template("md-0", "test-server-group"), template("md-1", "other-server-group")).
+		WithIndex(&infrav1.OpenStackMachineTemplate{}, infrav1alpha1.OpenStackMachineTemplateServerGroupIndex, func(o client.Object) []string {
+			return []string{o.(*infrav1.OpenStackMachineTemplate).Spec.Template.Spec.ServerGroup.ServerGroupRef.Name}
+		}).
+		Build()
+
+	reconciler := OpenStackServerGroupReconciler{Client: fakeClient, Scheme: scheme}
+	g.Expect(reconciler.setOwnerReferences(ctx, serverGroup)).To(Succeed())
+
+	var owners []string
+	for _, ref := range serverGroup.OwnerReferences {
+		owners = append(owners, ref.Kind+"/"+ref.Name)
+	}
+	g.Expect(owners).To(ConsistOf("Cluster/test-cluster", "OpenStackMachineTemplate/md-0"))
+}
@@ -0,0 +1,433 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const (
+	// waitForVolumeSnapshotsToReconcile is the requeue interval while snapshots are being created or deleted.
+	waitForVolumeSnapshotsToReconcile = 10 * time.Second
+
+	volumeSnapshotStatusAvailable = "available"
+	volumeSnapshotStatusError     = "error"
+	volumeSnapshotStatusDeleting  = "deleting"
+)
+
+// OpenStackVolumeSnapshotScheduleReconciler reconciles a OpenStackVolumeSnapshotSchedule object.
+type OpenStackVolumeSnapshotScheduleReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackvolumesnapshotschedules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackvolumesnapshotschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackservers,verbs=get;list;watch
+
+func (r *OpenStackVolumeSnapshotScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	schedule := &infrav1alpha1.OpenStackVolumeSnapshotSchedule{}
+	if err := r.Client.Get(ctx, req.NamespacedName, schedule); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patchHelper, err := patch.NewHelper(schedule, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, schedule); err != nil {
+			if reterr == nil {
+				reterr = fmt.Errorf("error patching OpenStackVolumeSnapshotSchedule %s/%s: %w", schedule.Namespace, schedule.Name, err)
+			}
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromObject(ctx, r.Client, r.CaCertificates, log, schedule)
+	if err != nil {
+		v1beta1conditions.MarkFalse(schedule, infrav1.OpenStackAuthenticationSucceeded, infrav1.OpenStackAuthenticationFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to create OpenStack client scope: %v", err)
+		return reconcile.Result{}, err
+	}
+	v1beta1conditions.MarkTrue(schedule, infrav1.OpenStackAuthenticationSucceeded)
+	scope := scope.NewWithLogger(clientScope, log)
+
+	if !schedule.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(scope, schedule)
+	}
+
+	if controllerutil.AddFinalizer(schedule, infrav1alpha1.OpenStackVolumeSnapshotScheduleFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	server := &infrav1alpha1.OpenStackServer{}
+	err = r.Client.Get(ctx, client.ObjectKey{Namespace: schedule.Namespace, Name: schedule.Spec.ServerRef.Name}, server)
+	if client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	}
+	if apierrors.IsNotFound(err) {
+		server = nil
+	}
+
+	return r.reconcileNormal(scope, schedule, server, time.Now())
+}
+
+func (r *OpenStackVolumeSnapshotScheduleReconciler) reconcileNormal(scope *scope.WithLogger, schedule *infrav1alpha1.OpenStackVolumeSnapshotSchedule, server *infrav1alpha1.OpenStackServer, now time.Time) (ctrl.Result, error) {
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	inProgress, err := refreshVolumeSnapshots(computeService, schedule)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if server == nil || !server.DeletionTimestamp.IsZero() {
+		v1beta1conditions.MarkFalse(schedule, infrav1alpha1.VolumeSnapshotsReadyCondition, infrav1alpha1.ServerNotFoundReason, clusterv1beta1.ConditionSeverityWarning, "OpenStackServer %s not found or being deleted", schedule.Spec.ServerRef.Name)
+
+		// Snapshots prevent the deletion of the volumes of the server
+		if schedule.Spec.Type != infrav1alpha1.VolumeSnapshotTypeBackup {
+			if err := deleteVolumeSnapshots(computeService, schedule, allVolumeSnapshots(schedule.Status.Snapshots)); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if len(schedule.Status.Snapshots) > 0 {
+			return ctrl.Result{RequeueAfter: waitForVolumeSnapshotsToReconcile}, nil
+		}
+		return ctrl.Result{RequeueAfter: schedule.Spec.Interval.Duration}, nil
+	}
+
+	if err := deleteVolumeSnapshots(computeService, schedule, expiredVolumeSnapshots(schedule.Status.Snapshots, &schedule.Spec.Retention, now)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	interval := schedule.Spec.Interval.Duration
+	scheduleTime := now.Truncate(interval)
+	if !schedule.Spec.Suspend && (schedule.Status.LastScheduleTime == nil || schedule.Status.LastScheduleTime.Time.Before(scheduleTime)) {
+		if err := r.takeVolumeSnapshots(scope, computeService, schedule, server, scheduleTime); err != nil {
+			v1beta1conditions.MarkFalse(schedule, infrav1alpha1.VolumeSnapshotsReadyCondition, infrav1alpha1.VolumeSnapshotFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to take volume snapshots: %v", err)
+			return ctrl.Result{}, err
+		}
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: scheduleTime}
+		inProgress = true
+	}
+
+	if slices.ContainsFunc(schedule.Status.Snapshots, func(s infrav1alpha1.VolumeSnapshotStatus) bool { return s.Status == volumeSnapshotStatusError }) {
+		v1beta1conditions.MarkFalse(schedule, infrav1alpha1.VolumeSnapshotsReadyCondition, infrav1alpha1.VolumeSnapshotErrorReason, clusterv1beta1.ConditionSeverityWarning, "One or more volume snapshots are in error state")
+	} else {
+		v1beta1conditions.MarkTrue(schedule, infrav1alpha1.VolumeSnapshotsReadyCondition)
+	}
+
+	requeueAfter := scheduleTime.Add(interval).Sub(now)
+	if inProgress && requeueAfter > waitForVolumeSnapshotsToReconcile {
+		requeueAfter = waitForVolumeSnapshotsToReconcile
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *OpenStackVolumeSnapshotScheduleReconciler) reconcileDelete(scope *scope.WithLogger, schedule *infrav1alpha1.OpenStackVolumeSnapshotSchedule) (ctrl.Result, error) {
+	scope.Logger().Info("Reconciling OpenStackVolumeSnapshotSchedule delete")
+
+	// Backups don't depend on the volume and are kept, but snapshots would
+	// prevent the deletion of the volumes of the server.
+	if schedule.Spec.Type != infrav1alpha1.VolumeSnapshotTypeBackup {
+		computeService, err := compute.NewService(scope)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if _, err := refreshVolumeSnapshots(computeService, schedule); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := deleteVolumeSnapshots(computeService, schedule, allVolumeSnapshots(schedule.Status.Snapshots)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(schedule.Status.Snapshots) > 0 {
+			scope.Logger().Info("Waiting for volume snapshots to be deleted")
+			return ctrl.Result{RequeueAfter: waitForVolumeSnapshotsToReconcile}, nil
+		}
+	}
+
+	controllerutil.RemoveFinalizer(schedule, infrav1alpha1.OpenStackVolumeSnapshotScheduleFinalizer)
+	scope.Logger().Info("Reconciled OpenStackVolumeSnapshotSchedule deleted successfully")
+	return ctrl.Result{}, nil
+}
+
+// takeVolumeSnapshots takes a snapshot of each volume of the schedule. The
+// names of the snapshots are derived from the schedule time so that a
+// snapshot which was created but not written to the status is found again.
+func (r *OpenStackVolumeSnapshotScheduleReconciler) takeVolumeSnapshots(scope *scope.WithLogger, computeService *compute.Service, schedule *infrav1alpha1.OpenStackVolumeSnapshotSchedule, server *infrav1alpha1.OpenStackServer, scheduleTime time.Time) error {
+	metadata := map[string]string{
+		infrav1alpha1.VolumeSnapshotScheduleMetadataKey: fmt.Sprintf("%s/%s", schedule.Namespace, schedule.Name),
+	}
+
+	for _, name := range getScheduleVolumes(schedule, server) {
+		volume, err := computeService.GetServerVolume(server.Name, name)
+		if err != nil {
+			return err
+		}
+		if volume == nil {
+			scope.Logger().Info("Volume not found, skipping snapshot", "volume", name)
+			continue
+		}
+
+		incremental := schedule.Spec.Incremental && continuesVolumeBackupChain(schedule.Status.Snapshots, &schedule.Spec.Retention, name, volume.ID, scheduleTime)
+		snapshotName := fmt.Sprintf("%s-%s-%s", server.Name, name, scheduleTime.UTC().Format("20060102150405"))
+		snapshotStatus, err := computeService.GetOrCreateVolumeSnapshot(schedule, schedule.Spec.Type, volume, snapshotName, metadata, incremental)
+		if err != nil {
+			return err
+		}
+		snapshotStatus.Volume = name
+
+		if !slices.ContainsFunc(schedule.Status.Snapshots, func(s infrav1alpha1.VolumeSnapshotStatus) bool { return s.ID == snapshotStatus.ID }) {
+			schedule.Status.Snapshots = append(schedule.Status.Snapshots, *snapshotStatus)
+		}
+	}
+	return nil
+}
+
+// getScheduleVolumes returns the names of the volumes of the server to snapshot.
+func getScheduleVolumes(schedule *infrav1alpha1.OpenStackVolumeSnapshotSchedule, server *infrav1alpha1.OpenStackServer) []string {
+	if len(schedule.Spec.Volumes) > 0 {
+		return schedule.Spec.Volumes
+	}
+
+	var names []string
+	if server.Spec.RootVolume != nil && server.Spec.RootVolume.SizeGiB > 0 {
+		names = append(names, infrav1alpha1.RootVolumeName)
+	}
+	for i := range server.Spec.AdditionalBlockDevices {
+		blockDevice := &server.Spec.AdditionalBlockDevices[i]
+		if blockDevice.Storage.Type == infrav1.VolumeBlockDevice {
+			names = append(names, blockDevice.Name)
+		}
+	}
+	return names
+}
+
+// refreshVolumeSnapshots updates the status of the snapshots of the schedule
+// and removes the snapshots which no longer exist. It returns true if any
+// snapshot is still being created or deleted.
+func refreshVolumeSnapshots(computeService *compute.Service, schedule *infrav1alpha1.OpenStackVolumeSnapshotSchedule) (bool, error) {
+	inProgress := false
+	snapshots := make([]infrav1alpha1.VolumeSnapshotStatus, 0, len(schedule.Status.Snapshots))
+	for _, snapshot := range schedule.Status.Snapshots {
+		status, err := computeService.GetVolumeSnapshotStatus(schedule.Spec.Type, snapshot.ID)
+		if err != nil {
+			return false, err
+		}
+		if status == "" {
+			continue
+		}
+		if status != volumeSnapshotStatusAvailable && status != volumeSnapshotStatusError {
+			inProgress = true
+		}
+		snapshot.Status = status
+		snapshots = append(snapshots, snapshot)
+	}
+	schedule.Status.Snapshots = snapshots
+	return inProgress, nil
+}
+
+// deleteVolumeSnapshots deletes the snapshots at the given indexes in the
+// status of the schedule. They are removed from the status once they are gone.
+func deleteVolumeSnapshots(computeService *compute.Service, schedule *infrav1alpha1.OpenStackVolumeSnapshotSchedule, indexes []int) error {
+	for _, i := range indexes {
+		snapshot := &schedule.Status.Snapshots[i]
+		if snapshot.Status == volumeSnapshotStatusDeleting {
+			continue
+		}
+		if err := computeService.DeleteVolumeSnapshot(schedule, schedule.Spec.Type, snapshot); err != nil {
+			v1beta1conditions.MarkFalse(schedule, infrav1alpha1.VolumeSnapshotsReadyCondition, infrav1alpha1.VolumeSnapshotFailedReason, clusterv1beta1.ConditionSeverityError, "Failed to delete volume snapshot %s: %v", snapshot.Name, err)
+			return err
+		}
+		snapshot.Status = volumeSnapshotStatusDeleting
+	}
+	return nil
+}
+
+func allVolumeSnapshots(snapshots []infrav1alpha1.VolumeSnapshotStatus) []int {
+	indexes := make([]int, len(snapshots))
+	for i := range snapshots {
+		indexes[i] = i
+	}
+	return indexes
+}
+
+// expiredVolumeSnapshots returns the indexes of the snapshots which are not
+// kept by the retention policy. Incremental backups depend on the previous
+// backups of their chain, so a chain only expires once none of its backups is
+// kept, and its backups are deleted one at a time, newest first.
+func expiredVolumeSnapshots(snapshots []infrav1alpha1.VolumeSnapshotStatus, retention *infrav1alpha1.VolumeSnapshotRetention, now time.Time) []int {
+	byVolume := make(map[string][]int)
+	for i := range snapshots {
+		byVolume[snapshots[i].Volume] = append(byVolume[snapshots[i].Volume], i)
+	}
+
+	var expired []int
+	for _, indexes := range byVolume {
+		chains := volumeSnapshotChains(snapshots, indexes)
+
+		// Newest first, not counting the snapshots being deleted
+		n := 0
+		for c := len(chains) - 1; c >= 0; c-- {
+			chain := chains[c]
+			kept, deleting := false, false
+			for j := len(chain) - 1; j >= 0; j-- {
+				snapshot := &snapshots[chain[j]]
+				if snapshot.Status == volumeSnapshotStatusDeleting {
+					deleting = true
+					continue
+				}
+				if !isVolumeSnapshotExpired(snapshot, n, retention, now) {
+					kept = true
+				}
+				n++
+			}
+			if !kept && !deleting {
+				expired = append(expired, chain[len(chain)-1])
+			}
+		}
+	}
+	sort.Ints(expired)
+	return expired
+}
+
+func isVolumeSnapshotExpired(snapshot *infrav1alpha1.VolumeSnapshotStatus, n int, retention *infrav1alpha1.VolumeSnapshotRetention, now time.Time) bool {
+	switch {
+	case retention.MaxCount != nil && n >= int(*retention.MaxCount):
+		return true
+	case retention.MaxAge != nil && now.Sub(snapshot.CreatedAt.Time) > retention.MaxAge.Duration:
+		return true
+	}
+	return false
+}
+
+// volumeSnapshotChains returns the indexes of the snapshots of a volume
+// grouped into chains, oldest first. Each snapshot or full backup starts a new
+// chain, which contains the incremental backups depending on it.
+func volumeSnapshotChains(snapshots []infrav1alpha1.VolumeSnapshotStatus, indexes []int) [][]int {
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return snapshots[indexes[a]].CreatedAt.Before(&snapshots[indexes[b]].CreatedAt)
+	})
+
+	var chains [][]int
+	for _, i := range indexes {
+		if len(chains) == 0 || !snapshots[i].Incremental {
+			chains = append(chains, []int{i})
+			continue
+		}
+		chains[len(chains)-1] = append(chains[len(chains)-1], i)
+	}
+	return chains
+}
+
+// continuesVolumeBackupChain returns true if the next backup of a volume can be
+// incremental. All of the backups of the last chain of the volume must be
+// available, and the chain must not outgrow the retention policy, so that it
+// can expire as a whole. Otherwise a full backup starts a new chain.
+func continuesVolumeBackupChain(snapshots []infrav1alpha1.VolumeSnapshotStatus, retention *infrav1alpha1.VolumeSnapshotRetention, volume, volumeID string, now time.Time) bool {
+	var indexes []int
+	for i := range snapshots {
+		if snapshots[i].Volume == volume {
+			indexes = append(indexes, i)
+		}
+	}
+	chains := volumeSnapshotChains(snapshots, indexes)
+	if len(chains) == 0 {
+		return false
+	}
+
+	chain := chains[len(chains)-1]
+	for _, i := range chain {
+		if snapshots[i].Status != volumeSnapshotStatusAvailable || snapshots[i].VolumeID != volumeID {
+			return false
+		}
+	}
+	if retention.MaxCount != nil && len(chain) >= int(*retention.MaxCount) {
+		return false
+	}
+	if retention.MaxAge != nil && now.Sub(snapshots[chain[0]].CreatedAt.Time) >= retention.MaxAge.Duration {
+		return false
+	}
+	return true
+}
+
+func (r *OpenStackVolumeSnapshotScheduleReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &infrav1alpha1.OpenStackVolumeSnapshotSchedule{}, infrav1alpha1.OpenStackVolumeSnapshotScheduleServerIndex, func(obj client.Object) []string {
+		schedule, ok := obj.(*infrav1alpha1.OpenStackVolumeSnapshotSchedule)
+		if !ok {
+			return nil
+		}
+		return []string{schedule.Spec.ServerRef.Name}
+	}); err != nil {
+		return fmt.Errorf("adding schedules by server index: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1alpha1.OpenStackVolumeSnapshotSchedule{}).
+		Watches(&infrav1alpha1.OpenStackServer{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				log := log.WithValues("watch", "OpenStackServer")
+
+				scheduleList := &infrav1alpha1.OpenStackVolumeSnapshotScheduleList{}
+				if err := r.Client.List(ctx, scheduleList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{infrav1alpha1.OpenStackVolumeSnapshotScheduleServerIndex: obj.GetName()}); err != nil {
+					log.Error(err, "listing OpenStackVolumeSnapshotSchedules")
+					return nil
+				}
+
+				requests := make([]reconcile.Request, len(scheduleList.Items))
+				for i := range scheduleList.Items {
+					requests[i].Name = scheduleList.Items[i].Name
+					requests[i].Namespace = scheduleList.Items[i].Namespace
+				}
+				return requests
+			}),
+		).
+		Complete(r)
+}
@@ -0,0 +1,470 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	. "github.com/onsi/gomega" //nolint:revive
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_expiredVolumeSnapshots(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	snapshotAt := func(volume string, age time.Duration) infrav1alpha1.VolumeSnapshotStatus {
+		return infrav1alpha1.VolumeSnapshotStatus{
+			Volume:    volume,
+			CreatedAt: metav1.NewTime(now.Add(-age)),
+			Status:    volumeSnapshotStatusAvailable,
+		}
+	}
+	deleting := snapshotAt("root", 10*time.Hour)
+	deleting.Status = volumeSnapshotStatusDeleting
+
+	snapshots := []infrav1alpha1.VolumeSnapshotStatus{
+		snapshotAt("root", 3*time.Hour),
+		snapshotAt("root", 1*time.Hour),
+		snapshotAt("etcd", 3*time.Hour),
+		snapshotAt("root", 2*time.Hour),
+		deleting,
+	}
+
+	tests := []struct {
+		name      string
+		retention infrav1alpha1.VolumeSnapshotRetention
+		want      []int
+	}{
+		{
+			name: "No retention policy",
+		},
+		{
+			name:      "Max count per volume",
+			retention: infrav1alpha1.VolumeSnapshotRetention{MaxCount: ptr.To[int32](2)},
+			want:      []int{0},
+		},
+		{
+			name:      "Max age",
+			retention: infrav1alpha1.VolumeSnapshotRetention{MaxAge: &metav1.Duration{Duration: 150 * time.Minute}},
+			want:      []int{0, 2},
+		},
+		{
+			name: "Max count and max age",
+			retention: infrav1alpha1.VolumeSnapshotRetention{
+				MaxCount: ptr.To[int32](1),
+				MaxAge:   &metav1.Duration{Duration: 150 * time.Minute},
+			},
+			want: []int{0, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(expiredVolumeSnapshots(snapshots, &tt.retention, now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_expiredVolumeSnapshots_incremental(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	backupAt := func(age time.Duration, incremental bool) infrav1alpha1.VolumeSnapshotStatus {
+		return infrav1alpha1.VolumeSnapshotStatus{
+			Volume:      "root",
+			CreatedAt:   metav1.NewTime(now.Add(-age)),
+			Status:      volumeSnapshotStatusAvailable,
+			Incremental: incremental,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		snapshots []infrav1alpha1.VolumeSnapshotStatus
+		want      []int
+	}{
+		{
+			name: "Chain with a kept backup is kept",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+				backupAt(4*time.Hour, false),
+				backupAt(3*time.Hour, true),
+				backupAt(2*time.Hour, false),
+				backupAt(1*time.Hour, true),
+			},
+		},
+		{
+			name: "Newest backup of an expired chain is deleted first",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+				backupAt(5*time.Hour, false),
+				backupAt(4*time.Hour, true),
+				backupAt(3*time.Hour, false),
+				backupAt(2*time.Hour, true),
+				backupAt(1*time.Hour, true),
+			},
+			want: []int{1},
+		},
+		{
+			name: "Expired chain waits for a backup being deleted",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+				backupAt(5*time.Hour, false),
+				func() infrav1alpha1.VolumeSnapshotStatus {
+					backup := backupAt(4*time.Hour, true)
+					backup.Status = volumeSnapshotStatusDeleting
+					return backup
+				}(),
+				backupAt(3*time.Hour, false),
+				backupAt(2*time.Hour, true),
+				backupAt(1*time.Hour, true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			retention := &infrav1alpha1.VolumeSnapshotRetention{MaxCount: ptr.To[int32](3)}
+			g.Expect(expiredVolumeSnapshots(tt.snapshots, retention, now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_continuesVolumeBackupChain(t *testing.T) {
+	const volumeID = "3ae1b1d6-3d5e-4f0a-b0b8-4c2a1d9e6a10"
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	backupAt := func(age time.Duration, incremental bool) infrav1alpha1.VolumeSnapshotStatus {
+		return infrav1alpha1.VolumeSnapshotStatus{
+			Volume:      "root",
+			VolumeID:    volumeID,
+			CreatedAt:   metav1.NewTime(now.Add(-age)),
+			Status:      volumeSnapshotStatusAvailable,
+			Incremental: incremental,
+		}
+	}
+	creating := backupAt(time.Hour, true)
+	creating.Status = "creating"
+	otherVolume := backupAt(time.Hour, false)
+	otherVolume.Volume = "etcd"
+
+	tests := []struct {
+		name      string
+		snapshots []infrav1alpha1.VolumeSnapshotStatus
+		retention infrav1alpha1.VolumeSnapshotRetention
+		want      bool
+	}{
+		{
+			name:      "First backup is full",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{otherVolume},
+			want:      false,
+		},
+		{
+			name:      "Backup following an available backup is incremental",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{backupAt(2*time.Hour, false), backupAt(time.Hour, true)},
+			want:      true,
+		},
+		{
+			name:      "Backup following a backup which is not available is full",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{backupAt(2*time.Hour, false), creating},
+			want:      false,
+		},
+		{
+			name:      "Chain as long as the max count is not continued",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{backupAt(2*time.Hour, false), backupAt(time.Hour, true)},
+			retention: infrav1alpha1.VolumeSnapshotRetention{MaxCount: ptr.To[int32](2)},
+			want:      false,
+		},
+		{
+			name:      "Chain as old as the max age is not continued",
+			snapshots: []infrav1alpha1.VolumeSnapshotStatus{backupAt(2*time.Hour, false), backupAt(time.Hour, true)},
+			retention: infrav1alpha1.VolumeSnapshotRetention{MaxAge: &metav1.Duration{Duration: 2 * time.Hour}},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(continuesVolumeBackupChain(tt.snapshots, &tt.retention, "root", volumeID, now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestOpenStackVolumeSnapshotScheduleReconciler_reconcileNormal(t *testing.T) {
+	const (
+		serverName   = "test-server"
+		rootVolumeID = "3ae1b1d6-3d5e-4f0a-b0b8-4c2a1d9e6a10"
+		etcdVolumeID = "8c1f9d2a-7b6e-4c3d-9a8b-1e2f3a4b5c6d"
+		snapshotID   = "f2b5e7a1-9c3d-4e6f-8a0b-2c4d6e8f0a1b"
+		oldSnapshot  = "5d7e9f1a-3b5c-4d7e-9f1a-3b5c7d9e1f3a"
+	)
+
+	now := time.Date(2026, 1, 10, 12, 30, 0, 0, time.UTC)
+	scheduleTime := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	server := &infrav1alpha1.OpenStackServer{
+		ObjectMeta: metav1.ObjectMeta{Name: serverName},
+		Spec: infrav1alpha1.OpenStackServerSpec{
+			RootVolume: &infrav1.RootVolume{SizeGiB: 50},
+			AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{
+				{
+					Name:    "etcd",
+					SizeGiB: 10,
+					Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice},
+				},
+				{
+					Name:    "ephemeral",
+					SizeGiB: 1,
+					Storage: infrav1.BlockDeviceStorage{Type: infrav1.LocalBlockDevice},
+				},
+			},
+		},
+	}
+	deletedServer := server.DeepCopy()
+	deletedServer.DeletionTimestamp = &metav1.Time{Time: now}
+
+	tests := []struct {
+		name          string
+		spec          infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec
+		status        infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus
+		server        *infrav1alpha1.OpenStackServer
+		expect        func(m *mock.MockVolumeClientMockRecorder)
+		wantStatus    infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus
+		wantRequeue   time.Duration
+		wantErr       bool
+		wantCondition bool
+	}{
+		{
+			name: "Takes snapshots of the selected volumes",
+			spec: infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec{
+				Type:     infrav1alpha1.VolumeSnapshotTypeSnapshot,
+				Interval: metav1.Duration{Duration: time.Hour},
+				Volumes:  []string{"etcd"},
+			},
+			server: server,
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumes(volumes.ListOpts{Name: serverName + "-etcd"}).
+					Return([]volumes.Volume{{ID: etcdVolumeID, Name: serverName + "-etcd"}}, nil)
+				m.ListSnapshots(snapshots.ListOpts{Name: serverName + "-etcd-20260110120000", VolumeID: etcdVolumeID}).Return(nil, nil)
+				m.CreateSnapshot(snapshots.CreateOpts{
+					VolumeID:    etcdVolumeID,
+					Force:       true,
+					Name:        serverName + "-etcd-20260110120000",
+					Description: "Snapshot of volume " + serverName + "-etcd",
+					Metadata:    map[string]string{infrav1alpha1.VolumeSnapshotScheduleMetadataKey: "test-ns/test-schedule"},
+				}).Return(&snapshots.Snapshot{ID: snapshotID, CreatedAt: now, Status: "creating"}, nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{
+						ID:        snapshotID,
+						Name:      serverName + "-etcd-20260110120000",
+						Volume:    "etcd",
+						VolumeID:  etcdVolumeID,
+						CreatedAt: metav1.NewTime(now),
+						Status:    "creating",
+					},
+				},
+			},
+			wantRequeue:   waitForVolumeSnapshotsToReconcile,
+			wantCondition: true,
+		},
+		{
+			name: "Takes backups of all volumes and enforces retention",
+			spec: infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec{
+				Type:        infrav1alpha1.VolumeSnapshotTypeBackup,
+				Interval:    metav1.Duration{Duration: time.Hour},
+				Incremental: true,
+				Retention:   infrav1alpha1.VolumeSnapshotRetention{MaxCount: ptr.To[int32](1)},
+			},
+			status: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime.Add(-time.Hour)},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{ID: oldSnapshot, Name: "old", Volume: "root", VolumeID: rootVolumeID, CreatedAt: metav1.NewTime(scheduleTime.Add(-2 * time.Hour))},
+					{ID: snapshotID, Name: "previous", Volume: "root", VolumeID: rootVolumeID, CreatedAt: metav1.NewTime(scheduleTime.Add(-time.Hour))},
+				},
+			},
+			server: server,
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.GetBackup(oldSnapshot).Return(&backups.Backup{ID: oldSnapshot, Status: "available"}, nil)
+				m.GetBackup(snapshotID).Return(&backups.Backup{ID: snapshotID, Status: "available"}, nil)
+				m.DeleteBackup(oldSnapshot).Return(nil)
+				m.ListVolumes(volumes.ListOpts{Name: serverName + "-root"}).
+					Return([]volumes.Volume{{ID: rootVolumeID, Name: serverName + "-root"}}, nil)
+				m.ListBackups(backups.ListOpts{Name: serverName + "-root-20260110120000", VolumeID: rootVolumeID}).
+					Return([]backups.Backup{{ID: "new-backup"}}, nil)
+				m.GetBackup("new-backup").Return(&backups.Backup{ID: "new-backup", CreatedAt: scheduleTime, Status: "creating"}, nil)
+				m.ListVolumes(volumes.ListOpts{Name: serverName + "-etcd"}).Return(nil, nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{ID: oldSnapshot, Name: "old", Volume: "root", VolumeID: rootVolumeID, CreatedAt: metav1.NewTime(scheduleTime.Add(-2 * time.Hour)), Status: volumeSnapshotStatusDeleting},
+					{ID: snapshotID, Name: "previous", Volume: "root", VolumeID: rootVolumeID, CreatedAt: metav1.NewTime(scheduleTime.Add(-time.Hour)), Status: "available"},
+					{ID: "new-backup", Name: serverName + "-root-20260110120000", Volume: "root", VolumeID: rootVolumeID, CreatedAt: metav1.NewTime(scheduleTime), Status: "creating"},
+				},
+			},
+			wantRequeue:   waitForVolumeSnapshotsToReconcile,
+			wantCondition: true,
+		},
+		{
+			name: "Takes a full backup before incremental ones",
+			spec: infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec{
+				Type:        infrav1alpha1.VolumeSnapshotTypeBackup,
+				Interval:    metav1.Duration{Duration: time.Hour},
+				Incremental: true,
+				Volumes:     []string{"etcd"},
+			},
+			server: server,
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumes(volumes.ListOpts{Name: serverName + "-etcd"}).
+					Return([]volumes.Volume{{ID: etcdVolumeID, Name: serverName + "-etcd"}}, nil)
+				m.ListBackups(backups.ListOpts{Name: serverName + "-etcd-20260110120000", VolumeID: etcdVolumeID}).Return(nil, nil)
+				m.CreateBackup(backups.CreateOpts{
+					VolumeID:    etcdVolumeID,
+					Force:       true,
+					Name:        serverName + "-etcd-20260110120000",
+					Description: "Backup of volume " + serverName + "-etcd",
+					Metadata:    map[string]string{infrav1alpha1.VolumeSnapshotScheduleMetadataKey: "test-ns/test-schedule"},
+					Incremental: false,
+				}).Return(&backups.Backup{ID: snapshotID, CreatedAt: now, Status: "creating"}, nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{
+						ID:        snapshotID,
+						Name:      serverName + "-etcd-20260110120000",
+						Volume:    "etcd",
+						VolumeID:  etcdVolumeID,
+						CreatedAt: metav1.NewTime(now),
+						Status:    "creating",
+					},
+				},
+			},
+			wantRequeue:   waitForVolumeSnapshotsToReconcile,
+			wantCondition: true,
+		},
+		{
+			name: "Waits for the next schedule time",
+			spec: infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec{
+				Type:     infrav1alpha1.VolumeSnapshotTypeSnapshot,
+				Interval: metav1.Duration{Duration: time.Hour},
+			},
+			status: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{ID: snapshotID, Volume: "root", Status: "creating"},
+				},
+			},
+			server: server,
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.GetSnapshot(snapshotID).Return(&snapshots.Snapshot{ID: snapshotID, Status: "available"}, nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{ID: snapshotID, Volume: "root", Status: "available"},
+				},
+			},
+			wantRequeue:   30 * time.Minute,
+			wantCondition: true,
+		},
+		{
+			name: "Deletes snapshots of a deleted server",
+			spec: infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec{
+				Type:     infrav1alpha1.VolumeSnapshotTypeSnapshot,
+				Interval: metav1.Duration{Duration: time.Hour},
+			},
+			status: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{ID: snapshotID, Volume: "root", Status: "available"},
+					{ID: oldSnapshot, Volume: "root", Status: "available"},
+				},
+			},
+			server: deletedServer,
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.GetSnapshot(snapshotID).Return(&snapshots.Snapshot{ID: snapshotID, Status: "available"}, nil)
+				m.GetSnapshot(oldSnapshot).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+				m.DeleteSnapshot(snapshotID).Return(nil)
+			},
+			wantStatus: infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: scheduleTime},
+				Snapshots: []infrav1alpha1.VolumeSnapshotStatus{
+					{ID: snapshotID, Volume: "root", Status: volumeSnapshotStatusDeleting},
+				},
+			},
+			wantRequeue:   waitForVolumeSnapshotsToReconcile,
+			wantCondition: false,
+		},
+		{
+			name: "Suspended schedule",
+			spec: infrav1alpha1.OpenStackVolumeSnapshotScheduleSpec{
+				Type:     infrav1alpha1.VolumeSnapshotTypeSnapshot,
+				Interval: metav1.Duration{Duration: time.Hour},
+				Suspend:  true,
+			},
+			server:        server,
+			wantStatus:    infrav1alpha1.OpenStackVolumeSnapshotScheduleStatus{Snapshots: []infrav1alpha1.VolumeSnapshotStatus{}},
+			wantRequeue:   30 * time.Minute,
+			wantCondition: true,
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			if tt.expect != nil {
+				tt.expect(mockScopeFactory.VolumeClient.EXPECT())
+			}
+
+			schedule := &infrav1alpha1.OpenStackVolumeSnapshotSchedule{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-schedule", Namespace: "test-ns"},
+				Spec:       tt.spec,
+				Status:     tt.status,
+			}
+			schedule.Spec.ServerRef = corev1.LocalObjectReference{Name: serverName}
+
+			reconciler := OpenStackVolumeSnapshotScheduleReconciler{}
+			res, err := reconciler.reconcileNormal(scope.NewWithLogger(mockScopeFactory, log), schedule, tt.server, now)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(tt.wantRequeue))
+
+			g.Expect(v1beta1conditions.IsTrue(schedule, infrav1alpha1.VolumeSnapshotsReadyCondition)).To(Equal(tt.wantCondition))
+			schedule.Status.Conditions = nil
+			g.Expect(schedule.Status).To(Equal(tt.wantStatus))
+		})
+	}
+}
@@ -97,6 +97,7 @@ var (
 	healthAddr                          string
 	lbProvider                          string
 	caCertsPath                         string
+	glanceHashingAlgorithm              string
 	showVersion                         bool
 	scopeCacheMaxSize                   int
 	skipCRDMigrationPhases              []string
@@ -114,6 +115,7 @@ func init() {
 	// +kubebuilder:scaffold:scheme
 
 	metrics.RegisterAPIPrometheusMetrics()
+	metrics.RegisterFloatingIPPoolPrometheusMetrics()
 }
 
 // InitFlags initializes the flags.
@@ -176,6 +178,9 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	fs.StringVar(&caCertsPath, "ca-certs", "", "The path to a PEM-encoded CA Certificate file to supply as default for each request.")
 
+	fs.StringVar(&glanceHashingAlgorithm, "glance-hashing-algorithm", string(infrav1alpha1.ImageChecksumAlgorithmSHA512),
+		"The hashing_algorithm configured in Glance. OpenStackImages are only downloaded by Glance if their checksum uses this algorithm.")
+
 	fs.IntVar(&scopeCacheMaxSize, "scope-cache-max-size", 10, "The maximum credentials count the operator should keep in cache. Setting this value to 0 means no cache.")
 
 	fs.StringArrayVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
@@ -349,6 +354,15 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte) {
 		&infrav1alpha1.OpenStackClusterIdentity{}: {
 			UseCache: true,
 		},
+		&infrav1alpha1.OpenStackVolumeSnapshotSchedule{}: {
+			UseCache: true,
+		},
+		&infrav1alpha1.OpenStackServerGroup{}: {
+			UseCache: true,
+		},
+		&infrav1alpha1.OpenStackImage{}: {
+			UseCache: true,
+		},
 	}
 	crdMigratorSkipPhases := make([]crdmigrator.Phase, 0, len(skipCRDMigrationPhases))
 	for _, p := range skipCRDMigrationPhases {
@@ -406,6 +420,39 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte) {
 		setupLog.Error(err, "unable to create controller", "controller", "OpenStackServer")
 		os.Exit(1)
 	}
+	if err := (&controllers.OpenStackVolumeSnapshotScheduleReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("openstackvolumesnapshotschedule-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		CaCertificates:   caCerts,
+	}).SetupWithManager(ctx, mgr, concurrency(1)); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackVolumeSnapshotSchedule")
+		os.Exit(1)
+	}
+	if err := (&controllers.OpenStackServerGroupReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("openstackservergroup-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		Scheme:           mgr.GetScheme(),
+		CaCertificates:   caCerts,
+	}).SetupWithManager(ctx, mgr, concurrency(1)); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackServerGroup")
+		os.Exit(1)
+	}
+	if err := (&controllers.OpenStackImageReconciler{
+		Client:                 mgr.GetClient(),
+		Recorder:               mgr.GetEventRecorderFor("openstackimage-controller"),
+		WatchFilterValue:       watchFilterValue,
+		ScopeFactory:           scopeFactory,
+		Scheme:                 mgr.GetScheme(),
+		CaCertificates:         caCerts,
+		GlanceHashingAlgorithm: infrav1alpha1.ImageChecksumAlgorithm(glanceHashingAlgorithm),
+	}).SetupWithManager(ctx, mgr, concurrency(1)); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackImage")
+		os.Exit(1)
+	}
 
 	if feature.Gates.Enabled(feature.AutoScaleFromZero) {
 		if err := (&controllers.OpenStackMachineTemplateReconciler{
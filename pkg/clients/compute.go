@@ -27,6 +27,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/volumeattach"
 	"github.com/gophercloud/utils/v2/openstack/clientconfig"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
@@ -47,12 +48,25 @@ We round up to 2.53 here since that takes us to the maximum in Pike.
 
 CAPO supports multiattach volume types, which were added in microversion 2.60.
 
+CAPO rebuilds servers with new user data, which was added in microversion 2.57,
+and rebuilds volume-backed servers, which was added in microversion 2.93.
+
+CAPO selects flavors by their extra specs, which are included in flavor details
+from microversion 2.61.
+
+CAPO creates server groups with a max-server-per-host rule, which was added in
+microversion 2.64.
+
 2.38 was chosen as a base level since it is reasonably old, but not too old.
 */
 const (
 	MinimumNovaMicroversion = "2.38"
 	NovaTagging             = "2.53"
 	NovaMultiAttachVolume   = "2.60"
+	NovaRebuildUserData     = "2.57"
+	NovaRebuildVolumeBacked = "2.93"
+	NovaFlavorExtraSpecs    = "2.61"
+	NovaServerGroupRules    = "2.64"
 )
 
 type ComputeClient interface {
@@ -60,18 +74,34 @@ type ComputeClient interface {
 
 	ListFlavors() ([]flavors.Flavor, error)
 	GetFlavor(flavorID string) (*flavors.Flavor, error)
+	GetFlavorExtraSpecs(flavorID string) (map[string]string, error)
 
 	CreateServer(createOpts servers.CreateOptsBuilder, schedulerHints servers.SchedulerHintOptsBuilder) (*servers.Server, error)
 	DeleteServer(serverID string) error
 	GetServer(serverID string) (*servers.Server, error)
 	ListServers(listOpts servers.ListOptsBuilder) ([]servers.Server, error)
+	RebuildServer(serverID string, rebuildOpts servers.RebuildOptsBuilder) (*servers.Server, error)
+	ResizeServer(serverID string, resizeOpts servers.ResizeOptsBuilder) error
+	ConfirmResizeServer(serverID string) error
+	RevertResizeServer(serverID string) error
+	StartServer(serverID string) error
+	StopServer(serverID string) error
+	RebootServer(serverID string, rebootOpts servers.RebootOptsBuilder) error
+	UnpauseServer(serverID string) error
 
 	ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error)
 	AttachInterface(serverID string, createOpts attachinterfaces.CreateOpts) (*attachinterfaces.Interface, error)
 	DeleteAttachedInterface(serverID, portID string) error
 
+	ListVolumeAttachments(serverID string) ([]volumeattach.VolumeAttachment, error)
+	CreateVolumeAttachment(serverID string, createOpts volumeattach.CreateOptsBuilder) (*volumeattach.VolumeAttachment, error)
+	DeleteVolumeAttachment(serverID, volumeID string) error
+
 	ListServerGroups() ([]servergroups.ServerGroup, error)
-	GetConsoleOutput(serverID string) (string, error)
+	CreateServerGroup(createOpts servergroups.CreateOptsBuilder) (*servergroups.ServerGroup, error)
+	GetServerGroup(serverGroupID string) (*servergroups.ServerGroup, error)
+	DeleteServerGroup(serverGroupID string) error
+	GetConsoleOutput(serverID string, length int) (string, error)
 	WithMicroversion(required string) (ComputeClient, error)
 }
 
@@ -138,6 +168,15 @@ func (c computeClient) GetFlavor(flavorID string) (*flavors.Flavor, error) {
 	return flavor, nil
 }
 
+func (c computeClient) GetFlavorExtraSpecs(flavorID string) (map[string]string, error) {
+	mc := metrics.NewMetricPrometheusContext("flavor_extra_specs", "list")
+	extraSpecs, err := flavors.ListExtraSpecs(context.TODO(), c.client, flavorID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return extraSpecs, nil
+}
+
 func (c computeClient) CreateServer(createOpts servers.CreateOptsBuilder, schedulerHints servers.SchedulerHintOptsBuilder) (*servers.Server, error) {
 	mc := metrics.NewMetricPrometheusContext("server", "create")
 	server, err := servers.Create(context.TODO(), c.client, createOpts, schedulerHints).Extract()
@@ -174,6 +213,57 @@ func (c computeClient) ListServers(listOpts servers.ListOptsBuilder) ([]servers.
 	return serverList, err
 }
 
+func (c computeClient) RebuildServer(serverID string, rebuildOpts servers.RebuildOptsBuilder) (*servers.Server, error) {
+	mc := metrics.NewMetricPrometheusContext("server", "rebuild")
+	server, err := servers.Rebuild(context.TODO(), c.client, serverID, rebuildOpts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+func (c computeClient) ResizeServer(serverID string, resizeOpts servers.ResizeOptsBuilder) error {
+	mc := metrics.NewMetricPrometheusContext("server", "resize")
+	err := servers.Resize(context.TODO(), c.client, serverID, resizeOpts).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) ConfirmResizeServer(serverID string) error {
+	mc := metrics.NewMetricPrometheusContext("server", "confirm_resize")
+	err := servers.ConfirmResize(context.TODO(), c.client, serverID).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) RevertResizeServer(serverID string) error {
+	mc := metrics.NewMetricPrometheusContext("server", "revert_resize")
+	err := servers.RevertResize(context.TODO(), c.client, serverID).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) StartServer(serverID string) error {
+	mc := metrics.NewMetricPrometheusContext("server", "start")
+	err := servers.Start(context.TODO(), c.client, serverID).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) StopServer(serverID string) error {
+	mc := metrics.NewMetricPrometheusContext("server", "stop")
+	err := servers.Stop(context.TODO(), c.client, serverID).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) RebootServer(serverID string, rebootOpts servers.RebootOptsBuilder) error {
+	mc := metrics.NewMetricPrometheusContext("server", "reboot")
+	err := servers.Reboot(context.TODO(), c.client, serverID, rebootOpts).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) UnpauseServer(serverID string) error {
+	mc := metrics.NewMetricPrometheusContext("server", "unpause")
+	err := servers.Unpause(context.TODO(), c.client, serverID).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
 func (c computeClient) ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error) {
 	mc := metrics.NewMetricPrometheusContext("server_os_interface", "list")
 	interfaces, err := attachinterfaces.List(c.client, serverID).AllPages(context.TODO())
@@ -198,6 +288,30 @@ func (c computeClient) DeleteAttachedInterface(serverID, portID string) error {
 	return mc.ObserveRequestIgnoreNotFoundorConflict(err)
 }
 
+func (c computeClient) ListVolumeAttachments(serverID string) ([]volumeattach.VolumeAttachment, error) {
+	mc := metrics.NewMetricPrometheusContext("server_os_volume_attachment", "list")
+	allPages, err := volumeattach.List(c.client, serverID).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return volumeattach.ExtractVolumeAttachments(allPages)
+}
+
+func (c computeClient) CreateVolumeAttachment(serverID string, createOpts volumeattach.CreateOptsBuilder) (*volumeattach.VolumeAttachment, error) {
+	mc := metrics.NewMetricPrometheusContext("server_os_volume_attachment", "create")
+	attachment, err := volumeattach.Create(context.TODO(), c.client, serverID, createOpts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (c computeClient) DeleteVolumeAttachment(serverID, volumeID string) error {
+	mc := metrics.NewMetricPrometheusContext("server_os_volume_attachment", "delete")
+	err := volumeattach.Delete(context.TODO(), c.client, serverID, volumeID).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
 func (c computeClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
 	mc := metrics.NewMetricPrometheusContext("server_group", "list")
 	opts := servergroups.ListOpts{}
@@ -208,8 +322,32 @@ func (c computeClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
 	return servergroups.ExtractServerGroups(allPages)
 }
 
-func (c computeClient) GetConsoleOutput(serverID string) (string, error) {
-	opts := servers.ShowConsoleOutputOpts{}
+func (c computeClient) CreateServerGroup(createOpts servergroups.CreateOptsBuilder) (*servergroups.ServerGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("server_group", "create")
+	serverGroup, err := servergroups.Create(context.TODO(), c.client, createOpts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return serverGroup, nil
+}
+
+func (c computeClient) GetServerGroup(serverGroupID string) (*servergroups.ServerGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("server_group", "get")
+	serverGroup, err := servergroups.Get(context.TODO(), c.client, serverGroupID).Extract()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return serverGroup, nil
+}
+
+func (c computeClient) DeleteServerGroup(serverGroupID string) error {
+	mc := metrics.NewMetricPrometheusContext("server_group", "delete")
+	err := servergroups.Delete(context.TODO(), c.client, serverGroupID).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c computeClient) GetConsoleOutput(serverID string, length int) (string, error) {
+	opts := servers.ShowConsoleOutputOpts{Length: length}
 	return servers.ShowConsoleOutput(context.TODO(), c.client, serverID, opts).Extract()
 }
 
@@ -247,6 +385,10 @@ func (e computeErrorClient) GetFlavor(_ string) (*flavors.Flavor, error) {
 	return nil, e.error
 }
 
+func (e computeErrorClient) GetFlavorExtraSpecs(_ string) (map[string]string, error) {
+	return nil, e.error
+}
+
 func (e computeErrorClient) CreateServer(_ servers.CreateOptsBuilder, _ servers.SchedulerHintOptsBuilder) (*servers.Server, error) {
 	return nil, e.error
 }
@@ -263,6 +405,38 @@ func (e computeErrorClient) ListServers(_ servers.ListOptsBuilder) ([]servers.Se
 	return nil, e.error
 }
 
+func (e computeErrorClient) RebuildServer(_ string, _ servers.RebuildOptsBuilder) (*servers.Server, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) ResizeServer(_ string, _ servers.ResizeOptsBuilder) error {
+	return e.error
+}
+
+func (e computeErrorClient) ConfirmResizeServer(_ string) error {
+	return e.error
+}
+
+func (e computeErrorClient) RevertResizeServer(_ string) error {
+	return e.error
+}
+
+func (e computeErrorClient) StartServer(_ string) error {
+	return e.error
+}
+
+func (e computeErrorClient) StopServer(_ string) error {
+	return e.error
+}
+
+func (e computeErrorClient) RebootServer(_ string, _ servers.RebootOptsBuilder) error {
+	return e.error
+}
+
+func (e computeErrorClient) UnpauseServer(_ string) error {
+	return e.error
+}
+
 func (e computeErrorClient) ListAttachedInterfaces(_ string) ([]attachinterfaces.Interface, error) {
 	return nil, e.error
 }
@@ -275,11 +449,35 @@ func (e computeErrorClient) DeleteAttachedInterface(_, _ string) error {
 	return e.error
 }
 
+func (e computeErrorClient) ListVolumeAttachments(_ string) ([]volumeattach.VolumeAttachment, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) CreateVolumeAttachment(_ string, _ volumeattach.CreateOptsBuilder) (*volumeattach.VolumeAttachment, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) DeleteVolumeAttachment(_, _ string) error {
+	return e.error
+}
+
 func (e computeErrorClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
 	return nil, e.error
 }
 
-func (e computeErrorClient) GetConsoleOutput(_ string) (string, error) {
+func (e computeErrorClient) CreateServerGroup(_ servergroups.CreateOptsBuilder) (*servergroups.ServerGroup, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) GetServerGroup(_ string) (*servergroups.ServerGroup, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) DeleteServerGroup(_ string) error {
+	return e.error
+}
+
+func (e computeErrorClient) GetConsoleOutput(_ string, _ int) (string, error) {
 	return "", e.error
 }
 
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+type KeyManagerClient interface {
+	CreateSecret(opts secrets.CreateOptsBuilder) (*secrets.Secret, error)
+	DeleteSecret(id string) error
+	CreateContainer(opts containers.CreateOptsBuilder) (*containers.Container, error)
+	ListContainers(opts containers.ListOptsBuilder) ([]containers.Container, error)
+	GetContainer(id string) (*containers.Container, error)
+	DeleteContainer(id string) error
+}
+
+type keyManagerClient struct {
+	serviceClient *gophercloud.ServiceClient
+}
+
+// NewKeyManagerClient returns a new barbican client.
+func NewKeyManagerClient(providerClient *gophercloud.ProviderClient, providerClientOpts *clientconfig.ClientOpts) (KeyManagerClient, error) {
+	keyManager, err := openstack.NewKeyManagerV1(providerClient, gophercloud.EndpointOpts{
+		Region:       providerClientOpts.RegionName,
+		Availability: clientconfig.GetEndpointType(providerClientOpts.EndpointType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key manager service client: %v", err)
+	}
+
+	return &keyManagerClient{keyManager}, nil
+}
+
+func (k keyManagerClient) CreateSecret(opts secrets.CreateOptsBuilder) (*secrets.Secret, error) {
+	mc := metrics.NewMetricPrometheusContext("keymanager_secret", "create")
+	secret, err := secrets.Create(context.TODO(), k.serviceClient, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (k keyManagerClient) DeleteSecret(id string) error {
+	mc := metrics.NewMetricPrometheusContext("keymanager_secret", "delete")
+	err := secrets.Delete(context.TODO(), k.serviceClient, id).ExtractErr()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting key manager secret %s: %v", id, err)
+	}
+	return nil
+}
+
+func (k keyManagerClient) CreateContainer(opts containers.CreateOptsBuilder) (*containers.Container, error) {
+	mc := metrics.NewMetricPrometheusContext("keymanager_container", "create")
+	container, err := containers.Create(context.TODO(), k.serviceClient, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return container, nil
+}
+
+func (k keyManagerClient) ListContainers(opts containers.ListOptsBuilder) ([]containers.Container, error) {
+	mc := metrics.NewMetricPrometheusContext("keymanager_container", "list")
+	allPages, err := containers.List(k.serviceClient, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return containers.ExtractContainers(allPages)
+}
+
+func (k keyManagerClient) GetContainer(id string) (*containers.Container, error) {
+	mc := metrics.NewMetricPrometheusContext("keymanager_container", "get")
+	container, err := containers.Get(context.TODO(), k.serviceClient, id).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return container, nil
+}
+
+func (k keyManagerClient) DeleteContainer(id string) error {
+	mc := metrics.NewMetricPrometheusContext("keymanager_container", "delete")
+	err := containers.Delete(context.TODO(), k.serviceClient, id).ExtractErr()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting key manager container %s: %v", id, err)
+	}
+	return nil
+}
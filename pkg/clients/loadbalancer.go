@@ -24,6 +24,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/apiversions"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
@@ -52,11 +53,22 @@ type LbClient interface {
 	CreatePoolMember(poolID string, opts pools.CreateMemberOptsBuilder) (*pools.Member, error)
 	ListPoolMember(poolID string, opts pools.ListMembersOptsBuilder) ([]pools.Member, error)
 	GetPoolMember(poolID string, lbMemberID string) (*pools.Member, error)
+	UpdatePoolMember(poolID string, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error)
 	DeletePoolMember(poolID string, lbMemberID string) error
 	CreateMonitor(opts monitors.CreateOptsBuilder) (*monitors.Monitor, error)
 	ListMonitors(opts monitors.ListOptsBuilder) ([]monitors.Monitor, error)
 	UpdateMonitor(id string, opts monitors.UpdateOptsBuilder) (*monitors.Monitor, error)
 	DeleteMonitor(id string) error
+	CreateL7Policy(opts l7policies.CreateOptsBuilder) (*l7policies.L7Policy, error)
+	ListL7Policies(opts l7policies.ListOptsBuilder) ([]l7policies.L7Policy, error)
+	GetL7Policy(id string) (*l7policies.L7Policy, error)
+	UpdateL7Policy(id string, opts l7policies.UpdateOptsBuilder) (*l7policies.L7Policy, error)
+	DeleteL7Policy(id string) error
+	CreateL7Rule(policyID string, opts l7policies.CreateRuleOptsBuilder) (*l7policies.Rule, error)
+	ListL7Rules(policyID string, opts l7policies.ListRulesOptsBuilder) ([]l7policies.Rule, error)
+	GetL7Rule(policyID string, ruleID string) (*l7policies.Rule, error)
+	UpdateL7Rule(policyID string, ruleID string, opts l7policies.UpdateRuleOptsBuilder) (*l7policies.Rule, error)
+	DeleteL7Rule(policyID string, ruleID string) error
 	ListLoadBalancerProviders() ([]providers.Provider, error)
 	ListOctaviaVersions() ([]apiversions.APIVersion, error)
 	ListLoadBalancerFlavors() ([]flavors.Flavor, error)
@@ -223,6 +235,15 @@ func (l lbClient) GetPoolMember(poolID string, lbMemberID string) (*pools.Member
 	return member, nil
 }
 
+func (l lbClient) UpdatePoolMember(poolID string, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_member", "update")
+	member, err := pools.UpdateMember(context.TODO(), l.serviceClient, poolID, lbMemberID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, fmt.Errorf("error updating lbmember: %s", err)
+	}
+	return member, nil
+}
+
 func (l lbClient) DeletePoolMember(poolID string, lbMemberID string) error {
 	mc := metrics.NewMetricPrometheusContext("loadbalancer_member", "delete")
 	err := pools.DeleteMember(context.TODO(), l.serviceClient, poolID, lbMemberID).ExtractErr()
@@ -268,6 +289,96 @@ func (l lbClient) DeleteMonitor(id string) error {
 	return nil
 }
 
+func (l lbClient) CreateL7Policy(opts l7policies.CreateOptsBuilder) (*l7policies.L7Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7policy", "create")
+	policy, err := l7policies.Create(context.TODO(), l.serviceClient, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (l lbClient) ListL7Policies(opts l7policies.ListOptsBuilder) ([]l7policies.L7Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7policy", "list")
+	allPages, err := l7policies.List(l.serviceClient, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return l7policies.ExtractL7Policies(allPages)
+}
+
+func (l lbClient) GetL7Policy(id string) (*l7policies.L7Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7policy", "get")
+	policy, err := l7policies.Get(context.TODO(), l.serviceClient, id).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (l lbClient) UpdateL7Policy(id string, opts l7policies.UpdateOptsBuilder) (*l7policies.L7Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7policy", "update")
+	policy, err := l7policies.Update(context.TODO(), l.serviceClient, id, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (l lbClient) DeleteL7Policy(id string) error {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7policy", "delete")
+	err := l7policies.Delete(context.TODO(), l.serviceClient, id).ExtractErr()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting lbaas l7 policy %s: %v", id, err)
+	}
+	return nil
+}
+
+func (l lbClient) CreateL7Rule(policyID string, opts l7policies.CreateRuleOptsBuilder) (*l7policies.Rule, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7rule", "create")
+	rule, err := l7policies.CreateRule(context.TODO(), l.serviceClient, policyID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (l lbClient) ListL7Rules(policyID string, opts l7policies.ListRulesOptsBuilder) ([]l7policies.Rule, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7rule", "list")
+	allPages, err := l7policies.ListRules(l.serviceClient, policyID, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return l7policies.ExtractRules(allPages)
+}
+
+func (l lbClient) GetL7Rule(policyID string, ruleID string) (*l7policies.Rule, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7rule", "get")
+	rule, err := l7policies.GetRule(context.TODO(), l.serviceClient, policyID, ruleID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (l lbClient) UpdateL7Rule(policyID string, ruleID string, opts l7policies.UpdateRuleOptsBuilder) (*l7policies.Rule, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7rule", "update")
+	rule, err := l7policies.UpdateRule(context.TODO(), l.serviceClient, policyID, ruleID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (l lbClient) DeleteL7Rule(policyID string, ruleID string) error {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_l7rule", "delete")
+	err := l7policies.DeleteRule(context.TODO(), l.serviceClient, policyID, ruleID).ExtractErr()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting lbaas l7 rule %s: %v", ruleID, err)
+	}
+	return nil
+}
+
 func (l lbClient) ListLoadBalancerProviders() ([]providers.Provider, error) {
 	allPages, err := providers.List(l.serviceClient, providers.ListOpts{}).AllPages(context.TODO())
 	if err != nil {
@@ -32,6 +32,7 @@ import (
 	flavors "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	servergroups "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	servers "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	volumeattach "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/volumeattach"
 	gomock "go.uber.org/mock/gomock"
 	clients "sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 )
@@ -75,6 +76,20 @@ func (mr *MockComputeClientMockRecorder) AttachInterface(serverID, createOpts an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachInterface", reflect.TypeOf((*MockComputeClient)(nil).AttachInterface), serverID, createOpts)
 }
 
+// ConfirmResizeServer mocks base method.
+func (m *MockComputeClient) ConfirmResizeServer(serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmResizeServer", serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmResizeServer indicates an expected call of ConfirmResizeServer.
+func (mr *MockComputeClientMockRecorder) ConfirmResizeServer(serverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmResizeServer", reflect.TypeOf((*MockComputeClient)(nil).ConfirmResizeServer), serverID)
+}
+
 // CreateServer mocks base method.
 func (m *MockComputeClient) CreateServer(createOpts servers.CreateOptsBuilder, schedulerHints servers.SchedulerHintOptsBuilder) (*servers.Server, error) {
 	m.ctrl.T.Helper()
@@ -90,6 +105,36 @@ func (mr *MockComputeClientMockRecorder) CreateServer(createOpts, schedulerHints
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServer", reflect.TypeOf((*MockComputeClient)(nil).CreateServer), createOpts, schedulerHints)
 }
 
+// CreateServerGroup mocks base method.
+func (m *MockComputeClient) CreateServerGroup(createOpts servergroups.CreateOptsBuilder) (*servergroups.ServerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServerGroup", createOpts)
+	ret0, _ := ret[0].(*servergroups.ServerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServerGroup indicates an expected call of CreateServerGroup.
+func (mr *MockComputeClientMockRecorder) CreateServerGroup(createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServerGroup", reflect.TypeOf((*MockComputeClient)(nil).CreateServerGroup), createOpts)
+}
+
+// CreateVolumeAttachment mocks base method.
+func (m *MockComputeClient) CreateVolumeAttachment(serverID string, createOpts volumeattach.CreateOptsBuilder) (*volumeattach.VolumeAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeAttachment", serverID, createOpts)
+	ret0, _ := ret[0].(*volumeattach.VolumeAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeAttachment indicates an expected call of CreateVolumeAttachment.
+func (mr *MockComputeClientMockRecorder) CreateVolumeAttachment(serverID, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeAttachment", reflect.TypeOf((*MockComputeClient)(nil).CreateVolumeAttachment), serverID, createOpts)
+}
+
 // DeleteAttachedInterface mocks base method.
 func (m *MockComputeClient) DeleteAttachedInterface(serverID, portID string) error {
 	m.ctrl.T.Helper()
@@ -118,19 +163,47 @@ func (mr *MockComputeClientMockRecorder) DeleteServer(serverID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServer", reflect.TypeOf((*MockComputeClient)(nil).DeleteServer), serverID)
 }
 
+// DeleteServerGroup mocks base method.
+func (m *MockComputeClient) DeleteServerGroup(serverGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServerGroup", serverGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServerGroup indicates an expected call of DeleteServerGroup.
+func (mr *MockComputeClientMockRecorder) DeleteServerGroup(serverGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServerGroup", reflect.TypeOf((*MockComputeClient)(nil).DeleteServerGroup), serverGroupID)
+}
+
+// DeleteVolumeAttachment mocks base method.
+func (m *MockComputeClient) DeleteVolumeAttachment(serverID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeAttachment", serverID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolumeAttachment indicates an expected call of DeleteVolumeAttachment.
+func (mr *MockComputeClientMockRecorder) DeleteVolumeAttachment(serverID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeAttachment", reflect.TypeOf((*MockComputeClient)(nil).DeleteVolumeAttachment), serverID, volumeID)
+}
+
 // GetConsoleOutput mocks base method.
-func (m *MockComputeClient) GetConsoleOutput(serverID string) (string, error) {
+func (m *MockComputeClient) GetConsoleOutput(serverID string, length int) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConsoleOutput", serverID)
+	ret := m.ctrl.Call(m, "GetConsoleOutput", serverID, length)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetConsoleOutput indicates an expected call of GetConsoleOutput.
-func (mr *MockComputeClientMockRecorder) GetConsoleOutput(serverID any) *gomock.Call {
+func (mr *MockComputeClientMockRecorder) GetConsoleOutput(serverID, length any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleOutput", reflect.TypeOf((*MockComputeClient)(nil).GetConsoleOutput), serverID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleOutput", reflect.TypeOf((*MockComputeClient)(nil).GetConsoleOutput), serverID, length)
 }
 
 // GetFlavor mocks base method.
@@ -148,6 +221,21 @@ func (mr *MockComputeClientMockRecorder) GetFlavor(flavorID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlavor", reflect.TypeOf((*MockComputeClient)(nil).GetFlavor), flavorID)
 }
 
+// GetFlavorExtraSpecs mocks base method.
+func (m *MockComputeClient) GetFlavorExtraSpecs(flavorID string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlavorExtraSpecs", flavorID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlavorExtraSpecs indicates an expected call of GetFlavorExtraSpecs.
+func (mr *MockComputeClientMockRecorder) GetFlavorExtraSpecs(flavorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlavorExtraSpecs", reflect.TypeOf((*MockComputeClient)(nil).GetFlavorExtraSpecs), flavorID)
+}
+
 // GetServer mocks base method.
 func (m *MockComputeClient) GetServer(serverID string) (*servers.Server, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +251,21 @@ func (mr *MockComputeClientMockRecorder) GetServer(serverID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServer", reflect.TypeOf((*MockComputeClient)(nil).GetServer), serverID)
 }
 
+// GetServerGroup mocks base method.
+func (m *MockComputeClient) GetServerGroup(serverGroupID string) (*servergroups.ServerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerGroup", serverGroupID)
+	ret0, _ := ret[0].(*servergroups.ServerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerGroup indicates an expected call of GetServerGroup.
+func (mr *MockComputeClientMockRecorder) GetServerGroup(serverGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerGroup", reflect.TypeOf((*MockComputeClient)(nil).GetServerGroup), serverGroupID)
+}
+
 // ListAttachedInterfaces mocks base method.
 func (m *MockComputeClient) ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +341,120 @@ func (mr *MockComputeClientMockRecorder) ListServers(listOpts any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServers", reflect.TypeOf((*MockComputeClient)(nil).ListServers), listOpts)
 }
 
+// ListVolumeAttachments mocks base method.
+func (m *MockComputeClient) ListVolumeAttachments(serverID string) ([]volumeattach.VolumeAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeAttachments", serverID)
+	ret0, _ := ret[0].([]volumeattach.VolumeAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeAttachments indicates an expected call of ListVolumeAttachments.
+func (mr *MockComputeClientMockRecorder) ListVolumeAttachments(serverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeAttachments", reflect.TypeOf((*MockComputeClient)(nil).ListVolumeAttachments), serverID)
+}
+
+// RebootServer mocks base method.
+func (m *MockComputeClient) RebootServer(serverID string, rebootOpts servers.RebootOptsBuilder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootServer", serverID, rebootOpts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebootServer indicates an expected call of RebootServer.
+func (mr *MockComputeClientMockRecorder) RebootServer(serverID, rebootOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootServer", reflect.TypeOf((*MockComputeClient)(nil).RebootServer), serverID, rebootOpts)
+}
+
+// RebuildServer mocks base method.
+func (m *MockComputeClient) RebuildServer(serverID string, rebuildOpts servers.RebuildOptsBuilder) (*servers.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildServer", serverID, rebuildOpts)
+	ret0, _ := ret[0].(*servers.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildServer indicates an expected call of RebuildServer.
+func (mr *MockComputeClientMockRecorder) RebuildServer(serverID, rebuildOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildServer", reflect.TypeOf((*MockComputeClient)(nil).RebuildServer), serverID, rebuildOpts)
+}
+
+// ResizeServer mocks base method.
+func (m *MockComputeClient) ResizeServer(serverID string, resizeOpts servers.ResizeOptsBuilder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeServer", serverID, resizeOpts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeServer indicates an expected call of ResizeServer.
+func (mr *MockComputeClientMockRecorder) ResizeServer(serverID, resizeOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeServer", reflect.TypeOf((*MockComputeClient)(nil).ResizeServer), serverID, resizeOpts)
+}
+
+// RevertResizeServer mocks base method.
+func (m *MockComputeClient) RevertResizeServer(serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertResizeServer", serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevertResizeServer indicates an expected call of RevertResizeServer.
+func (mr *MockComputeClientMockRecorder) RevertResizeServer(serverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertResizeServer", reflect.TypeOf((*MockComputeClient)(nil).RevertResizeServer), serverID)
+}
+
+// StartServer mocks base method.
+func (m *MockComputeClient) StartServer(serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartServer", serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartServer indicates an expected call of StartServer.
+func (mr *MockComputeClientMockRecorder) StartServer(serverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartServer", reflect.TypeOf((*MockComputeClient)(nil).StartServer), serverID)
+}
+
+// StopServer mocks base method.
+func (m *MockComputeClient) StopServer(serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopServer", serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopServer indicates an expected call of StopServer.
+func (mr *MockComputeClientMockRecorder) StopServer(serverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopServer", reflect.TypeOf((*MockComputeClient)(nil).StopServer), serverID)
+}
+
+// UnpauseServer mocks base method.
+func (m *MockComputeClient) UnpauseServer(serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseServer", serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseServer indicates an expected call of UnpauseServer.
+func (mr *MockComputeClientMockRecorder) UnpauseServer(serverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseServer", reflect.TypeOf((*MockComputeClient)(nil).UnpauseServer), serverID)
+}
+
 // WithMicroversion mocks base method.
 func (m *MockComputeClient) WithMicroversion(required string) (clients.ComputeClient, error) {
 	m.ctrl.T.Helper()
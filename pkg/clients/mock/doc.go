@@ -28,6 +28,9 @@ import (
 //go:generate mockgen -package mock -destination=image.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients ImageClient
 //go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt image.go > _image.go && mv _image.go image.go"
 
+//go:generate mockgen -package mock -destination=keymanager.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients KeyManagerClient
+//go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt keymanager.go > _keymanager.go && mv _keymanager.go keymanager.go"
+
 //go:generate mockgen -package mock -destination=loadbalancer.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients LbClient
 //go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt loadbalancer.go > _loadbalancer.go && mv _loadbalancer.go loadbalancer.go"
 
@@ -29,6 +29,7 @@ import (
 
 	apiversions "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/apiversions"
 	flavors "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/flavors"
+	l7policies "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
 	listeners "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	loadbalancers "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	monitors "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
@@ -61,6 +62,36 @@ func (m *MockLbClient) EXPECT() *MockLbClientMockRecorder {
 	return m.recorder
 }
 
+// CreateL7Policy mocks base method.
+func (m *MockLbClient) CreateL7Policy(opts l7policies.CreateOptsBuilder) (*l7policies.L7Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateL7Policy", opts)
+	ret0, _ := ret[0].(*l7policies.L7Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateL7Policy indicates an expected call of CreateL7Policy.
+func (mr *MockLbClientMockRecorder) CreateL7Policy(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateL7Policy", reflect.TypeOf((*MockLbClient)(nil).CreateL7Policy), opts)
+}
+
+// CreateL7Rule mocks base method.
+func (m *MockLbClient) CreateL7Rule(policyID string, opts l7policies.CreateRuleOptsBuilder) (*l7policies.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateL7Rule", policyID, opts)
+	ret0, _ := ret[0].(*l7policies.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateL7Rule indicates an expected call of CreateL7Rule.
+func (mr *MockLbClientMockRecorder) CreateL7Rule(policyID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateL7Rule", reflect.TypeOf((*MockLbClient)(nil).CreateL7Rule), policyID, opts)
+}
+
 // CreateListener mocks base method.
 func (m *MockLbClient) CreateListener(opts listeners.CreateOptsBuilder) (*listeners.Listener, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +167,34 @@ func (mr *MockLbClientMockRecorder) CreatePoolMember(poolID, opts any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePoolMember", reflect.TypeOf((*MockLbClient)(nil).CreatePoolMember), poolID, opts)
 }
 
+// DeleteL7Policy mocks base method.
+func (m *MockLbClient) DeleteL7Policy(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteL7Policy", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteL7Policy indicates an expected call of DeleteL7Policy.
+func (mr *MockLbClientMockRecorder) DeleteL7Policy(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteL7Policy", reflect.TypeOf((*MockLbClient)(nil).DeleteL7Policy), id)
+}
+
+// DeleteL7Rule mocks base method.
+func (m *MockLbClient) DeleteL7Rule(policyID, ruleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteL7Rule", policyID, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteL7Rule indicates an expected call of DeleteL7Rule.
+func (mr *MockLbClientMockRecorder) DeleteL7Rule(policyID, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteL7Rule", reflect.TypeOf((*MockLbClient)(nil).DeleteL7Rule), policyID, ruleID)
+}
+
 // DeleteListener mocks base method.
 func (m *MockLbClient) DeleteListener(id string) error {
 	m.ctrl.T.Helper()
@@ -206,6 +265,36 @@ func (mr *MockLbClientMockRecorder) DeletePoolMember(poolID, lbMemberID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoolMember", reflect.TypeOf((*MockLbClient)(nil).DeletePoolMember), poolID, lbMemberID)
 }
 
+// GetL7Policy mocks base method.
+func (m *MockLbClient) GetL7Policy(id string) (*l7policies.L7Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetL7Policy", id)
+	ret0, _ := ret[0].(*l7policies.L7Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetL7Policy indicates an expected call of GetL7Policy.
+func (mr *MockLbClientMockRecorder) GetL7Policy(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetL7Policy", reflect.TypeOf((*MockLbClient)(nil).GetL7Policy), id)
+}
+
+// GetL7Rule mocks base method.
+func (m *MockLbClient) GetL7Rule(policyID, ruleID string) (*l7policies.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetL7Rule", policyID, ruleID)
+	ret0, _ := ret[0].(*l7policies.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetL7Rule indicates an expected call of GetL7Rule.
+func (mr *MockLbClientMockRecorder) GetL7Rule(policyID, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetL7Rule", reflect.TypeOf((*MockLbClient)(nil).GetL7Rule), policyID, ruleID)
+}
+
 // GetListener mocks base method.
 func (m *MockLbClient) GetListener(id string) (*listeners.Listener, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +355,36 @@ func (mr *MockLbClientMockRecorder) GetPoolMember(poolID, lbMemberID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolMember", reflect.TypeOf((*MockLbClient)(nil).GetPoolMember), poolID, lbMemberID)
 }
 
+// ListL7Policies mocks base method.
+func (m *MockLbClient) ListL7Policies(opts l7policies.ListOptsBuilder) ([]l7policies.L7Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListL7Policies", opts)
+	ret0, _ := ret[0].([]l7policies.L7Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListL7Policies indicates an expected call of ListL7Policies.
+func (mr *MockLbClientMockRecorder) ListL7Policies(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListL7Policies", reflect.TypeOf((*MockLbClient)(nil).ListL7Policies), opts)
+}
+
+// ListL7Rules mocks base method.
+func (m *MockLbClient) ListL7Rules(policyID string, opts l7policies.ListRulesOptsBuilder) ([]l7policies.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListL7Rules", policyID, opts)
+	ret0, _ := ret[0].([]l7policies.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListL7Rules indicates an expected call of ListL7Rules.
+func (mr *MockLbClientMockRecorder) ListL7Rules(policyID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListL7Rules", reflect.TypeOf((*MockLbClient)(nil).ListL7Rules), policyID, opts)
+}
+
 // ListListeners mocks base method.
 func (m *MockLbClient) ListListeners(opts listeners.ListOptsBuilder) ([]listeners.Listener, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +505,36 @@ func (mr *MockLbClientMockRecorder) ListPools(opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPools", reflect.TypeOf((*MockLbClient)(nil).ListPools), opts)
 }
 
+// UpdateL7Policy mocks base method.
+func (m *MockLbClient) UpdateL7Policy(id string, opts l7policies.UpdateOptsBuilder) (*l7policies.L7Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateL7Policy", id, opts)
+	ret0, _ := ret[0].(*l7policies.L7Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateL7Policy indicates an expected call of UpdateL7Policy.
+func (mr *MockLbClientMockRecorder) UpdateL7Policy(id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateL7Policy", reflect.TypeOf((*MockLbClient)(nil).UpdateL7Policy), id, opts)
+}
+
+// UpdateL7Rule mocks base method.
+func (m *MockLbClient) UpdateL7Rule(policyID, ruleID string, opts l7policies.UpdateRuleOptsBuilder) (*l7policies.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateL7Rule", policyID, ruleID, opts)
+	ret0, _ := ret[0].(*l7policies.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateL7Rule indicates an expected call of UpdateL7Rule.
+func (mr *MockLbClientMockRecorder) UpdateL7Rule(policyID, ruleID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateL7Rule", reflect.TypeOf((*MockLbClient)(nil).UpdateL7Rule), policyID, ruleID, opts)
+}
+
 // UpdateListener mocks base method.
 func (m *MockLbClient) UpdateListener(id string, opts listeners.UpdateOpts) (*listeners.Listener, error) {
 	m.ctrl.T.Helper()
@@ -415,3 +564,18 @@ func (mr *MockLbClientMockRecorder) UpdateMonitor(id, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMonitor", reflect.TypeOf((*MockLbClient)(nil).UpdateMonitor), id, opts)
 }
+
+// UpdatePoolMember mocks base method.
+func (m *MockLbClient) UpdatePoolMember(poolID, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePoolMember", poolID, lbMemberID, opts)
+	ret0, _ := ret[0].(*pools.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoolMember indicates an expected call of UpdatePoolMember.
+func (mr *MockLbClientMockRecorder) UpdatePoolMember(poolID, lbMemberID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoolMember", reflect.TypeOf((*MockLbClient)(nil).UpdatePoolMember), poolID, lbMemberID, opts)
+}
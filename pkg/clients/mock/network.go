@@ -31,6 +31,8 @@ import (
 	attributestags "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	floatingips "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	routers "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	policies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	addressgroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/addressgroups"
 	groups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	rules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	trunks "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
@@ -64,6 +66,35 @@ func (m *MockNetworkClient) EXPECT() *MockNetworkClientMockRecorder {
 	return m.recorder
 }
 
+// AddAddressGroupAddresses mocks base method.
+func (m *MockNetworkClient) AddAddressGroupAddresses(id string, opts addressgroups.UpdateAddressesBuilder) (*addressgroups.AddressGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAddressGroupAddresses", id, opts)
+	ret0, _ := ret[0].(*addressgroups.AddressGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAddressGroupAddresses indicates an expected call of AddAddressGroupAddresses.
+func (mr *MockNetworkClientMockRecorder) AddAddressGroupAddresses(id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressGroupAddresses", reflect.TypeOf((*MockNetworkClient)(nil).AddAddressGroupAddresses), id, opts)
+}
+
+// AddAttributesTag mocks base method.
+func (m *MockNetworkClient) AddAttributesTag(resourceType, resourceID, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttributesTag", resourceType, resourceID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAttributesTag indicates an expected call of AddAttributesTag.
+func (mr *MockNetworkClientMockRecorder) AddAttributesTag(resourceType, resourceID, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttributesTag", reflect.TypeOf((*MockNetworkClient)(nil).AddAttributesTag), resourceType, resourceID, tag)
+}
+
 // AddRouterInterface mocks base method.
 func (m *MockNetworkClient) AddRouterInterface(id string, opts routers.AddInterfaceOptsBuilder) (*routers.InterfaceInfo, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +110,21 @@ func (mr *MockNetworkClientMockRecorder) AddRouterInterface(id, opts any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRouterInterface", reflect.TypeOf((*MockNetworkClient)(nil).AddRouterInterface), id, opts)
 }
 
+// CreateAddressGroup mocks base method.
+func (m *MockNetworkClient) CreateAddressGroup(opts addressgroups.CreateOptsBuilder) (*addressgroups.AddressGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAddressGroup", opts)
+	ret0, _ := ret[0].(*addressgroups.AddressGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAddressGroup indicates an expected call of CreateAddressGroup.
+func (mr *MockNetworkClientMockRecorder) CreateAddressGroup(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAddressGroup", reflect.TypeOf((*MockNetworkClient)(nil).CreateAddressGroup), opts)
+}
+
 // CreateFloatingIP mocks base method.
 func (m *MockNetworkClient) CreateFloatingIP(opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +245,34 @@ func (mr *MockNetworkClientMockRecorder) CreateTrunk(opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrunk", reflect.TypeOf((*MockNetworkClient)(nil).CreateTrunk), opts)
 }
 
+// DeleteAddressGroup mocks base method.
+func (m *MockNetworkClient) DeleteAddressGroup(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddressGroup", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddressGroup indicates an expected call of DeleteAddressGroup.
+func (mr *MockNetworkClientMockRecorder) DeleteAddressGroup(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddressGroup", reflect.TypeOf((*MockNetworkClient)(nil).DeleteAddressGroup), id)
+}
+
+// DeleteAttributesTag mocks base method.
+func (m *MockNetworkClient) DeleteAttributesTag(resourceType, resourceID, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributesTag", resourceType, resourceID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttributesTag indicates an expected call of DeleteAttributesTag.
+func (mr *MockNetworkClientMockRecorder) DeleteAttributesTag(resourceType, resourceID, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributesTag", reflect.TypeOf((*MockNetworkClient)(nil).DeleteAttributesTag), resourceType, resourceID, tag)
+}
+
 // DeleteFloatingIP mocks base method.
 func (m *MockNetworkClient) DeleteFloatingIP(id string) error {
 	m.ctrl.T.Helper()
@@ -311,6 +385,21 @@ func (mr *MockNetworkClientMockRecorder) DeleteTrunk(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrunk", reflect.TypeOf((*MockNetworkClient)(nil).DeleteTrunk), id)
 }
 
+// GetAddressGroup mocks base method.
+func (m *MockNetworkClient) GetAddressGroup(id string) (*addressgroups.AddressGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressGroup", id)
+	ret0, _ := ret[0].(*addressgroups.AddressGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressGroup indicates an expected call of GetAddressGroup.
+func (mr *MockNetworkClientMockRecorder) GetAddressGroup(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressGroup", reflect.TypeOf((*MockNetworkClient)(nil).GetAddressGroup), id)
+}
+
 // GetFloatingIP mocks base method.
 func (m *MockNetworkClient) GetFloatingIP(id string) (*floatingips.FloatingIP, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +445,21 @@ func (mr *MockNetworkClientMockRecorder) GetPort(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockNetworkClient)(nil).GetPort), id)
 }
 
+// GetQoSPolicy mocks base method.
+func (m *MockNetworkClient) GetQoSPolicy(id string) (*policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQoSPolicy", id)
+	ret0, _ := ret[0].(*policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQoSPolicy indicates an expected call of GetQoSPolicy.
+func (mr *MockNetworkClientMockRecorder) GetQoSPolicy(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQoSPolicy", reflect.TypeOf((*MockNetworkClient)(nil).GetQoSPolicy), id)
+}
+
 // GetRouter mocks base method.
 func (m *MockNetworkClient) GetRouter(id string) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +520,21 @@ func (mr *MockNetworkClientMockRecorder) GetSubnet(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnet", reflect.TypeOf((*MockNetworkClient)(nil).GetSubnet), id)
 }
 
+// ListAddressGroup mocks base method.
+func (m *MockNetworkClient) ListAddressGroup(opts addressgroups.ListOptsBuilder) ([]addressgroups.AddressGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddressGroup", opts)
+	ret0, _ := ret[0].([]addressgroups.AddressGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddressGroup indicates an expected call of ListAddressGroup.
+func (mr *MockNetworkClientMockRecorder) ListAddressGroup(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddressGroup", reflect.TypeOf((*MockNetworkClient)(nil).ListAddressGroup), opts)
+}
+
 // ListExtensions mocks base method.
 func (m *MockNetworkClient) ListExtensions() ([]extensions.Extension, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +595,21 @@ func (mr *MockNetworkClientMockRecorder) ListPort(opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPort", reflect.TypeOf((*MockNetworkClient)(nil).ListPort), opts)
 }
 
+// ListQoSPolicy mocks base method.
+func (m *MockNetworkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQoSPolicy", opts)
+	ret0, _ := ret[0].([]policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQoSPolicy indicates an expected call of ListQoSPolicy.
+func (mr *MockNetworkClientMockRecorder) ListQoSPolicy(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQoSPolicy", reflect.TypeOf((*MockNetworkClient)(nil).ListQoSPolicy), opts)
+}
+
 // ListRouter mocks base method.
 func (m *MockNetworkClient) ListRouter(opts routers.ListOpts) ([]routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -566,6 +700,21 @@ func (mr *MockNetworkClientMockRecorder) ListTrunkSubports(trunkID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrunkSubports", reflect.TypeOf((*MockNetworkClient)(nil).ListTrunkSubports), trunkID)
 }
 
+// RemoveAddressGroupAddresses mocks base method.
+func (m *MockNetworkClient) RemoveAddressGroupAddresses(id string, opts addressgroups.UpdateAddressesBuilder) (*addressgroups.AddressGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAddressGroupAddresses", id, opts)
+	ret0, _ := ret[0].(*addressgroups.AddressGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAddressGroupAddresses indicates an expected call of RemoveAddressGroupAddresses.
+func (mr *MockNetworkClientMockRecorder) RemoveAddressGroupAddresses(id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAddressGroupAddresses", reflect.TypeOf((*MockNetworkClient)(nil).RemoveAddressGroupAddresses), id, opts)
+}
+
 // RemoveRouterInterface mocks base method.
 func (m *MockNetworkClient) RemoveRouterInterface(id string, opts routers.RemoveInterfaceOptsBuilder) (*routers.InterfaceInfo, error) {
 	m.ctrl.T.Helper()
@@ -27,7 +27,10 @@ package mock
 import (
 	reflect "reflect"
 
+	backups "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	snapshots "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
 	volumes "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	volumetypes "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,19 +58,77 @@ func (m *MockVolumeClient) EXPECT() *MockVolumeClientMockRecorder {
 	return m.recorder
 }
 
+// CreateBackup mocks base method.
+func (m *MockVolumeClient) CreateBackup(opts backups.CreateOptsBuilder) (*backups.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackup", opts)
+	ret0, _ := ret[0].(*backups.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackup indicates an expected call of CreateBackup.
+func (mr *MockVolumeClientMockRecorder) CreateBackup(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackup", reflect.TypeOf((*MockVolumeClient)(nil).CreateBackup), opts)
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockVolumeClient) CreateSnapshot(opts snapshots.CreateOptsBuilder) (*snapshots.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", opts)
+	ret0, _ := ret[0].(*snapshots.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockVolumeClientMockRecorder) CreateSnapshot(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockVolumeClient)(nil).CreateSnapshot), opts)
+}
+
 // CreateVolume mocks base method.
-func (m *MockVolumeClient) CreateVolume(opts volumes.CreateOptsBuilder) (*volumes.Volume, error) {
+func (m *MockVolumeClient) CreateVolume(opts volumes.CreateOptsBuilder, hintOpts volumes.SchedulerHintOptsBuilder) (*volumes.Volume, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVolume", opts)
+	ret := m.ctrl.Call(m, "CreateVolume", opts, hintOpts)
 	ret0, _ := ret[0].(*volumes.Volume)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVolume indicates an expected call of CreateVolume.
-func (mr *MockVolumeClientMockRecorder) CreateVolume(opts any) *gomock.Call {
+func (mr *MockVolumeClientMockRecorder) CreateVolume(opts, hintOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVolumeClient)(nil).CreateVolume), opts, hintOpts)
+}
+
+// DeleteBackup mocks base method.
+func (m *MockVolumeClient) DeleteBackup(backupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackup", backupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBackup indicates an expected call of DeleteBackup.
+func (mr *MockVolumeClientMockRecorder) DeleteBackup(backupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackup", reflect.TypeOf((*MockVolumeClient)(nil).DeleteBackup), backupID)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockVolumeClient) DeleteSnapshot(snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockVolumeClientMockRecorder) DeleteSnapshot(snapshotID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVolumeClient)(nil).CreateVolume), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockVolumeClient)(nil).DeleteSnapshot), snapshotID)
 }
 
 // DeleteVolume mocks base method.
@@ -84,6 +145,36 @@ func (mr *MockVolumeClientMockRecorder) DeleteVolume(volumeID, opts any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeClient)(nil).DeleteVolume), volumeID, opts)
 }
 
+// GetBackup mocks base method.
+func (m *MockVolumeClient) GetBackup(backupID string) (*backups.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackup", backupID)
+	ret0, _ := ret[0].(*backups.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackup indicates an expected call of GetBackup.
+func (mr *MockVolumeClientMockRecorder) GetBackup(backupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackup", reflect.TypeOf((*MockVolumeClient)(nil).GetBackup), backupID)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockVolumeClient) GetSnapshot(snapshotID string) (*snapshots.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", snapshotID)
+	ret0, _ := ret[0].(*snapshots.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockVolumeClientMockRecorder) GetSnapshot(snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockVolumeClient)(nil).GetSnapshot), snapshotID)
+}
+
 // GetVolume mocks base method.
 func (m *MockVolumeClient) GetVolume(volumeID string) (*volumes.Volume, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +190,51 @@ func (mr *MockVolumeClientMockRecorder) GetVolume(volumeID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockVolumeClient)(nil).GetVolume), volumeID)
 }
 
+// ListBackups mocks base method.
+func (m *MockVolumeClient) ListBackups(opts backups.ListOptsBuilder) ([]backups.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackups", opts)
+	ret0, _ := ret[0].([]backups.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackups indicates an expected call of ListBackups.
+func (mr *MockVolumeClientMockRecorder) ListBackups(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockVolumeClient)(nil).ListBackups), opts)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockVolumeClient) ListSnapshots(opts snapshots.ListOptsBuilder) ([]snapshots.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", opts)
+	ret0, _ := ret[0].([]snapshots.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockVolumeClientMockRecorder) ListSnapshots(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockVolumeClient)(nil).ListSnapshots), opts)
+}
+
+// ListVolumeTypes mocks base method.
+func (m *MockVolumeClient) ListVolumeTypes(opts volumetypes.ListOptsBuilder) ([]volumetypes.VolumeType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeTypes", opts)
+	ret0, _ := ret[0].([]volumetypes.VolumeType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeTypes indicates an expected call of ListVolumeTypes.
+func (mr *MockVolumeClientMockRecorder) ListVolumeTypes(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeTypes", reflect.TypeOf((*MockVolumeClient)(nil).ListVolumeTypes), opts)
+}
+
 // ListVolumes mocks base method.
 func (m *MockVolumeClient) ListVolumes(opts volumes.ListOptsBuilder) ([]volumes.Volume, error) {
 	m.ctrl.T.Helper()
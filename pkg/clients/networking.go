@@ -26,6 +26,8 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/addressgroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
@@ -76,6 +78,13 @@ type NetworkClient interface {
 	DeleteSecGroupRule(id string) error
 	GetSecGroupRule(id string) (*rules.SecGroupRule, error)
 
+	ListAddressGroup(opts addressgroups.ListOptsBuilder) ([]addressgroups.AddressGroup, error)
+	CreateAddressGroup(opts addressgroups.CreateOptsBuilder) (*addressgroups.AddressGroup, error)
+	DeleteAddressGroup(id string) error
+	GetAddressGroup(id string) (*addressgroups.AddressGroup, error)
+	AddAddressGroupAddresses(id string, opts addressgroups.UpdateAddressesBuilder) (*addressgroups.AddressGroup, error)
+	RemoveAddressGroupAddresses(id string, opts addressgroups.UpdateAddressesBuilder) (*addressgroups.AddressGroup, error)
+
 	ListNetwork(opts networks.ListOptsBuilder) ([]networks.Network, error)
 	CreateNetwork(opts networks.CreateOptsBuilder) (*networks.Network, error)
 	DeleteNetwork(id string) error
@@ -88,9 +97,14 @@ type NetworkClient interface {
 	GetSubnet(id string) (*subnets.Subnet, error)
 	UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (*subnets.Subnet, error)
 
+	ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error)
+	GetQoSPolicy(id string) (*policies.Policy, error)
+
 	ListExtensions() ([]extensions.Extension, error)
 
 	ReplaceAllAttributesTags(resourceType string, resourceID string, opts attributestags.ReplaceAllOptsBuilder) ([]string, error)
+	AddAttributesTag(resourceType string, resourceID string, tag string) error
+	DeleteAttributesTag(resourceType string, resourceID string, tag string) error
 }
 
 type networkClient struct {
@@ -137,6 +151,16 @@ func (c networkClient) ReplaceAllAttributesTags(resourceType string, resourceID
 	return tags, nil
 }
 
+func (c networkClient) AddAttributesTag(resourceType string, resourceID string, tag string) error {
+	mc := metrics.NewMetricPrometheusContext("attributes_tags", "add")
+	return mc.ObserveRequest(attributestags.Add(context.TODO(), c.serviceClient, resourceType, resourceID, tag).ExtractErr())
+}
+
+func (c networkClient) DeleteAttributesTag(resourceType string, resourceID string, tag string) error {
+	mc := metrics.NewMetricPrometheusContext("attributes_tags", "delete")
+	return mc.ObserveRequestIgnoreNotFound(attributestags.Delete(context.TODO(), c.serviceClient, resourceType, resourceID, tag).ExtractErr())
+}
+
 func (c networkClient) ListRouter(opts routers.ListOpts) ([]routers.Router, error) {
 	mc := metrics.NewMetricPrometheusContext("router", "list")
 	allPages, err := routers.List(c.serviceClient, opts).AllPages(context.TODO())
@@ -374,6 +398,56 @@ func (c networkClient) GetSecGroupRule(id string) (*rules.SecGroupRule, error) {
 	return rule, nil
 }
 
+func (c networkClient) ListAddressGroup(opts addressgroups.ListOptsBuilder) ([]addressgroups.AddressGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("address_group", "list")
+	allPages, err := addressgroups.List(c.serviceClient, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return addressgroups.ExtractGroups(allPages)
+}
+
+func (c networkClient) CreateAddressGroup(opts addressgroups.CreateOptsBuilder) (*addressgroups.AddressGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("address_group", "create")
+	group, err := addressgroups.Create(context.TODO(), c.serviceClient, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (c networkClient) DeleteAddressGroup(id string) error {
+	mc := metrics.NewMetricPrometheusContext("address_group", "delete")
+	return mc.ObserveRequestIgnoreNotFound(addressgroups.Delete(context.TODO(), c.serviceClient, id).ExtractErr())
+}
+
+func (c networkClient) GetAddressGroup(id string) (*addressgroups.AddressGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("address_group", "get")
+	group, err := addressgroups.Get(context.TODO(), c.serviceClient, id).Extract()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (c networkClient) AddAddressGroupAddresses(id string, opts addressgroups.UpdateAddressesBuilder) (*addressgroups.AddressGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("address_group", "add_addresses")
+	group, err := addressgroups.AddAddresses(context.TODO(), c.serviceClient, id, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (c networkClient) RemoveAddressGroupAddresses(id string, opts addressgroups.UpdateAddressesBuilder) (*addressgroups.AddressGroup, error) {
+	mc := metrics.NewMetricPrometheusContext("address_group", "remove_addresses")
+	group, err := addressgroups.RemoveAddresses(context.TODO(), c.serviceClient, id, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
 func (c networkClient) ListNetwork(opts networks.ListOptsBuilder) ([]networks.Network, error) {
 	mc := metrics.NewMetricPrometheusContext("network", "list")
 	allPages, err := networks.List(c.serviceClient, opts).AllPages(context.TODO())
@@ -456,6 +530,24 @@ func (c networkClient) UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (
 	return subnet, nil
 }
 
+func (c networkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("qos_policy", "list")
+	allPages, err := policies.List(c.serviceClient, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return policies.ExtractPolicies(allPages)
+}
+
+func (c networkClient) GetQoSPolicy(id string) (*policies.Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("qos_policy", "get")
+	policy, err := policies.Get(context.TODO(), c.serviceClient, id).Extract()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
 func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
 	mc := metrics.NewMetricPrometheusContext("network_extension", "list")
 	allPages, err := extensions.List(c.serviceClient).AllPages(context.TODO())
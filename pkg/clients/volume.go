@@ -22,7 +22,10 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
 	"github.com/gophercloud/utils/v2/openstack/clientconfig"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
@@ -30,9 +33,21 @@ import (
 
 type VolumeClient interface {
 	ListVolumes(opts volumes.ListOptsBuilder) ([]volumes.Volume, error)
-	CreateVolume(opts volumes.CreateOptsBuilder) (*volumes.Volume, error)
+	CreateVolume(opts volumes.CreateOptsBuilder, hintOpts volumes.SchedulerHintOptsBuilder) (*volumes.Volume, error)
 	DeleteVolume(volumeID string, opts volumes.DeleteOptsBuilder) error
 	GetVolume(volumeID string) (*volumes.Volume, error)
+
+	ListSnapshots(opts snapshots.ListOptsBuilder) ([]snapshots.Snapshot, error)
+	CreateSnapshot(opts snapshots.CreateOptsBuilder) (*snapshots.Snapshot, error)
+	DeleteSnapshot(snapshotID string) error
+	GetSnapshot(snapshotID string) (*snapshots.Snapshot, error)
+
+	ListBackups(opts backups.ListOptsBuilder) ([]backups.Backup, error)
+	CreateBackup(opts backups.CreateOptsBuilder) (*backups.Backup, error)
+	DeleteBackup(backupID string) error
+	GetBackup(backupID string) (*backups.Backup, error)
+
+	ListVolumeTypes(opts volumetypes.ListOptsBuilder) ([]volumetypes.VolumeType, error)
 }
 
 type volumeClient struct{ client *gophercloud.ServiceClient }
@@ -59,9 +74,9 @@ func (c volumeClient) ListVolumes(opts volumes.ListOptsBuilder) ([]volumes.Volum
 	return volumes.ExtractVolumes(pages)
 }
 
-func (c volumeClient) CreateVolume(opts volumes.CreateOptsBuilder) (*volumes.Volume, error) {
+func (c volumeClient) CreateVolume(opts volumes.CreateOptsBuilder, hintOpts volumes.SchedulerHintOptsBuilder) (*volumes.Volume, error) {
 	mc := metrics.NewMetricPrometheusContext("volume", "create")
-	volume, err := volumes.Create(context.TODO(), c.client, opts, nil).Extract()
+	volume, err := volumes.Create(context.TODO(), c.client, opts, hintOpts).Extract()
 	return volume, mc.ObserveRequest(err)
 }
 
@@ -77,6 +92,69 @@ func (c volumeClient) GetVolume(volumeID string) (*volumes.Volume, error) {
 	return volume, mc.ObserveRequestIgnoreNotFound(err)
 }
 
+func (c volumeClient) ListSnapshots(opts snapshots.ListOptsBuilder) ([]snapshots.Snapshot, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_snapshot", "list")
+	pages, err := snapshots.List(c.client, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return snapshots.ExtractSnapshots(pages)
+}
+
+func (c volumeClient) CreateSnapshot(opts snapshots.CreateOptsBuilder) (*snapshots.Snapshot, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_snapshot", "create")
+	snapshot, err := snapshots.Create(context.TODO(), c.client, opts).Extract()
+	return snapshot, mc.ObserveRequest(err)
+}
+
+func (c volumeClient) DeleteSnapshot(snapshotID string) error {
+	mc := metrics.NewMetricPrometheusContext("volume_snapshot", "delete")
+	err := snapshots.Delete(context.TODO(), c.client, snapshotID).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c volumeClient) GetSnapshot(snapshotID string) (*snapshots.Snapshot, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_snapshot", "get")
+	snapshot, err := snapshots.Get(context.TODO(), c.client, snapshotID).Extract()
+	return snapshot, mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c volumeClient) ListBackups(opts backups.ListOptsBuilder) ([]backups.Backup, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_backup", "list")
+	pages, err := backups.List(c.client, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return backups.ExtractBackups(pages)
+}
+
+func (c volumeClient) CreateBackup(opts backups.CreateOptsBuilder) (*backups.Backup, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_backup", "create")
+	backup, err := backups.Create(context.TODO(), c.client, opts).Extract()
+	return backup, mc.ObserveRequest(err)
+}
+
+func (c volumeClient) DeleteBackup(backupID string) error {
+	mc := metrics.NewMetricPrometheusContext("volume_backup", "delete")
+	err := backups.Delete(context.TODO(), c.client, backupID).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c volumeClient) GetBackup(backupID string) (*backups.Backup, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_backup", "get")
+	backup, err := backups.Get(context.TODO(), c.client, backupID).Extract()
+	return backup, mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c volumeClient) ListVolumeTypes(opts volumetypes.ListOptsBuilder) ([]volumetypes.VolumeType, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_type", "list")
+	pages, err := volumetypes.List(c.client, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return volumetypes.ExtractVolumeTypes(pages)
+}
+
 type volumeErrorClient struct{ error }
 
 // NewVolumeErrorClient returns a VolumeClient in which every method returns the given error.
@@ -88,7 +166,7 @@ func (e volumeErrorClient) ListVolumes(_ volumes.ListOptsBuilder) ([]volumes.Vol
 	return nil, e.error
 }
 
-func (e volumeErrorClient) CreateVolume(_ volumes.CreateOptsBuilder) (*volumes.Volume, error) {
+func (e volumeErrorClient) CreateVolume(_ volumes.CreateOptsBuilder, _ volumes.SchedulerHintOptsBuilder) (*volumes.Volume, error) {
 	return nil, e.error
 }
 
@@ -99,3 +177,39 @@ func (e volumeErrorClient) DeleteVolume(_ string, _ volumes.DeleteOptsBuilder) e
 func (e volumeErrorClient) GetVolume(_ string) (*volumes.Volume, error) {
 	return nil, e.error
 }
+
+func (e volumeErrorClient) ListSnapshots(_ snapshots.ListOptsBuilder) ([]snapshots.Snapshot, error) {
+	return nil, e.error
+}
+
+func (e volumeErrorClient) CreateSnapshot(_ snapshots.CreateOptsBuilder) (*snapshots.Snapshot, error) {
+	return nil, e.error
+}
+
+func (e volumeErrorClient) DeleteSnapshot(_ string) error {
+	return e.error
+}
+
+func (e volumeErrorClient) GetSnapshot(_ string) (*snapshots.Snapshot, error) {
+	return nil, e.error
+}
+
+func (e volumeErrorClient) ListBackups(_ backups.ListOptsBuilder) ([]backups.Backup, error) {
+	return nil, e.error
+}
+
+func (e volumeErrorClient) CreateBackup(_ backups.CreateOptsBuilder) (*backups.Backup, error) {
+	return nil, e.error
+}
+
+func (e volumeErrorClient) DeleteBackup(_ string) error {
+	return e.error
+}
+
+func (e volumeErrorClient) GetBackup(_ string) (*backups.Backup, error) {
+	return nil, e.error
+}
+
+func (e volumeErrorClient) ListVolumeTypes(_ volumetypes.ListOptsBuilder) ([]volumetypes.VolumeType, error) {
+	return nil, e.error
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+const (
+	// flavorPCIAliasExtraSpec requests PCI devices as a comma separated list
+	// of <alias>[:<count>].
+	flavorPCIAliasExtraSpec = "pci_passthrough:alias"
+
+	// flavorResourcesExtraSpecPrefix prefixes extra specs of the form
+	// resources[<group>]:<class>=<amount> which request placement resources.
+	flavorResourcesExtraSpecPrefix = "resources"
+)
+
+// FlavorAccelerators are the PCI devices and placement resources provided by
+// a flavor.
+type FlavorAccelerators struct {
+	// PCIAliases is the number of devices of each PCI passthrough alias.
+	PCIAliases map[string]int64
+	// Resources is the amount of each placement resource class.
+	Resources map[string]int64
+}
+
+// ParseFlavorAccelerators parses the PCI passthrough aliases and placement
+// resources requested by the extra specs of a flavor.
+func ParseFlavorAccelerators(extraSpecs map[string]string) (*FlavorAccelerators, error) {
+	accelerators := &FlavorAccelerators{
+		PCIAliases: map[string]int64{},
+		Resources:  map[string]int64{},
+	}
+
+	for key, value := range extraSpecs {
+		if key == flavorPCIAliasExtraSpec {
+			for _, alias := range strings.Split(value, ",") {
+				name, countStr, found := strings.Cut(strings.TrimSpace(alias), ":")
+				if name == "" {
+					continue
+				}
+				count := int64(1)
+				if found {
+					var err error
+					count, err = strconv.ParseInt(strings.TrimSpace(countStr), 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf("invalid count for PCI alias %s in extra spec %s: %w", name, key, err)
+					}
+				}
+				accelerators.PCIAliases[name] += count
+			}
+			continue
+		}
+
+		// Granular resource groups add a suffix to the prefix, e.g. resources1:VGPU
+		prefix, class, found := strings.Cut(key, ":")
+		if !found || !strings.HasPrefix(prefix, flavorResourcesExtraSpecPrefix) || infrav1.StandardResourceClasses[class] {
+			continue
+		}
+		amount, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount in extra spec %s: %w", key, err)
+		}
+		if amount > 0 {
+			accelerators.Resources[class] += amount
+		}
+	}
+
+	return accelerators, nil
+}
+
+// Provides returns an error describing the PCI devices and placement
+// resources which are required but not provided.
+func (a *FlavorAccelerators) Provides(requirements *infrav1.AcceleratorRequirements) error {
+	var missing []string
+	for _, alias := range requirements.PCIAliases {
+		if a.PCIAliases[alias.Name] < int64(alias.Count) {
+			missing = append(missing, fmt.Sprintf("%d of PCI alias %s", alias.Count, alias.Name))
+		}
+	}
+	for _, res := range requirements.Resources {
+		if a.Resources[res.ResourceClass] < int64(res.Count) {
+			missing = append(missing, fmt.Sprintf("%d of resource class %s", res.Count, res.ResourceClass))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("flavor does not provide %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Capacity returns the PCI devices and placement resources as extended
+// resources.
+func (a *FlavorAccelerators) Capacity() corev1.ResourceList {
+	capacity := corev1.ResourceList{}
+	for name, count := range a.PCIAliases {
+		capacity[corev1.ResourceName(infrav1.PCIAliasResourcePrefix+name)] = *resource.NewQuantity(count, resource.DecimalSI)
+	}
+	for class, amount := range a.Resources {
+		capacity[corev1.ResourceName(infrav1.PlacementResourcePrefix+class)] = *resource.NewQuantity(amount, resource.DecimalSI)
+	}
+	return capacity
+}
+
+// GetFlavorExtraSpecs returns the extra specs of the flavor with the given ID.
+func (s *Service) GetFlavorExtraSpecs(flavorID string) (map[string]string, error) {
+	extraSpecs, err := s.getComputeClient().GetFlavorExtraSpecs(flavorID)
+	if err != nil {
+		return nil, fmt.Errorf("getting extra specs of flavor %s: %w", flavorID, err)
+	}
+	return extraSpecs, nil
+}
+
+// GetFlavorAccelerators returns the PCI devices and placement resources
+// provided by the flavor with the given ID.
+func (s *Service) GetFlavorAccelerators(flavorID string) (*FlavorAccelerators, error) {
+	extraSpecs, err := s.GetFlavorExtraSpecs(flavorID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFlavorAccelerators(extraSpecs)
+}
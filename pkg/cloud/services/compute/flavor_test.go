@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func TestParseFlavorAccelerators(t *testing.T) {
+	tests := []struct {
+		name       string
+		extraSpecs map[string]string
+		want       *FlavorAccelerators
+		wantErr    bool
+	}{
+		{
+			name: "No accelerators",
+			extraSpecs: map[string]string{
+				"hw:cpu_policy":  "dedicated",
+				"resources:VCPU": "0",
+				"resources:PCPU": "4",
+			},
+			want: &FlavorAccelerators{PCIAliases: map[string]int64{}, Resources: map[string]int64{}},
+		},
+		{
+			name: "PCI aliases and resources",
+			extraSpecs: map[string]string{
+				"pci_passthrough:alias":  "a100:2, nic",
+				"resources:VGPU":         "1",
+				"resources1:CUSTOM_FPGA": "1",
+				"resources2:CUSTOM_FPGA": "2",
+				"resources:CUSTOM_ZERO":  "0",
+			},
+			want: &FlavorAccelerators{
+				PCIAliases: map[string]int64{"a100": 2, "nic": 1},
+				Resources:  map[string]int64{"VGPU": 1, "CUSTOM_FPGA": 3},
+			},
+		},
+		{
+			name:       "Invalid PCI alias count",
+			extraSpecs: map[string]string{"pci_passthrough:alias": "a100:two"},
+			wantErr:    true,
+		},
+		{
+			name:       "Invalid resource amount",
+			extraSpecs: map[string]string{"resources:VGPU": "one"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := ParseFlavorAccelerators(tt.extraSpecs)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestFlavorAccelerators(t *testing.T) {
+	g := NewWithT(t)
+	accelerators := &FlavorAccelerators{
+		PCIAliases: map[string]int64{"a100": 2},
+		Resources:  map[string]int64{"VGPU": 1},
+	}
+
+	g.Expect(accelerators.Capacity()).To(Equal(corev1.ResourceList{
+		"pci.openstack.org/a100":       *resource.NewQuantity(2, resource.DecimalSI),
+		"placement.openstack.org/VGPU": *resource.NewQuantity(1, resource.DecimalSI),
+	}))
+
+	g.Expect(accelerators.Provides(&infrav1.AcceleratorRequirements{
+		PCIAliases: []infrav1.PCIAliasRequirement{{Name: "a100", Count: 2}},
+		Resources:  []infrav1.PlacementResourceRequirement{{ResourceClass: "VGPU", Count: 1}},
+	})).To(Succeed())
+	g.Expect(accelerators.Provides(&infrav1.AcceleratorRequirements{
+		PCIAliases: []infrav1.PCIAliasRequirement{{Name: "a100", Count: 4}},
+	})).NotTo(Succeed())
+	g.Expect(accelerators.Provides(&infrav1.AcceleratorRequirements{
+		Resources: []infrav1.PlacementResourceRequirement{{ResourceClass: "CUSTOM_FPGA", Count: 1}},
+	})).NotTo(Succeed())
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imageimport"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// GetOrCreateImage returns the image with the given tag, creating an empty
+// image with the given name and tags if it does not exist. The first tag
+// identifies the image.
+func (s *Service) GetOrCreateImage(ctx context.Context, eventObject runtime.Object, name string, tags []string, diskFormat, containerFormat string) (*images.Image, error) {
+	imageList, err := s.getImageClient().ListImages(images.ListOpts{Tags: tags[:1]})
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+	if len(imageList) > 1 {
+		return nil, fmt.Errorf("expected to find a single image tagged %s; found %d", tags[0], len(imageList))
+	}
+	if len(imageList) == 1 {
+		s.scope.Logger().V(3).Info("Using existing image", "name", name, "id", imageList[0].ID)
+		return &imageList[0], nil
+	}
+
+	image, err := s.getImageClient().CreateImage(ctx, images.CreateOpts{
+		Name:            name,
+		Tags:            tags,
+		DiskFormat:      diskFormat,
+		ContainerFormat: containerFormat,
+	})
+	if err != nil {
+		record.Warnf(eventObject, "FailedCreateImage", "Failed to create image %s: %v", name, err)
+		return nil, err
+	}
+
+	record.Eventf(eventObject, "SuccessfulCreateImage", "Created image %s with id %s", name, image.ID)
+	return image, nil
+}
+
+// GetImage returns the image with the given ID, or nil if it does not exist.
+func (s *Service) GetImage(imageID string) (*images.Image, error) {
+	image, err := s.getImageClient().GetImage(imageID)
+	if capoerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return image, err
+}
+
+// IsWebDownloadSupported returns true if Glance supports the web-download
+// import method.
+func (s *Service) IsWebDownloadSupported(ctx context.Context) (bool, error) {
+	importInfo, err := s.getImageClient().GetImportInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(importInfo.ImportMethods.Value, string(imageimport.WebDownloadMethod)), nil
+}
+
+// ImportImageFromURL lets Glance download the data of an image from the
+// given URL. The import is asynchronous.
+func (s *Service) ImportImageFromURL(ctx context.Context, eventObject runtime.Object, imageID, url string) error {
+	err := s.getImageClient().CreateImport(ctx, imageID, imageimport.CreateOpts{
+		Name: imageimport.WebDownloadMethod,
+		URI:  url,
+	})
+	if err != nil {
+		record.Warnf(eventObject, "FailedImportImage", "Failed to import image %s from %s: %v", imageID, url, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulImportImage", "Started import of image %s from %s", imageID, url)
+	return nil
+}
+
+// UploadImage uploads the data of an image.
+func (s *Service) UploadImage(ctx context.Context, eventObject runtime.Object, imageID string, data io.Reader) error {
+	if err := s.getImageClient().UploadData(ctx, imageID, data); err != nil {
+		record.Warnf(eventObject, "FailedUploadImage", "Failed to upload image %s: %v", imageID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulUploadImage", "Uploaded image %s", imageID)
+	return nil
+}
+
+// DeleteImage deletes the image with the given ID.
+func (s *Service) DeleteImage(ctx context.Context, eventObject runtime.Object, imageID string) error {
+	err := s.getImageClient().DeleteImage(ctx, imageID)
+	if err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(eventObject, "FailedDeleteImage", "Failed to delete image %s: %v", imageID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulDeleteImage", "Deleted image %s", imageID)
+	return nil
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+const (
+	ociManifestMediaTypes = "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json"
+
+	// Properties set by Glance once it has the data of an image.
+	imageHashAlgorithmProperty = "os_hash_algo"
+	imageHashValueProperty     = "os_hash_value"
+
+	// imageFailedImportProperty lists the import methods which failed.
+	imageFailedImportProperty = "os_glance_failed_import"
+)
+
+// NewImageSourceHTTPClient returns a client to download image data with. The
+// transfer of the data can take a long time, so rather than the duration of
+// requests it limits connecting and waiting for the response. Stalled
+// transfers are aborted with NewIdleTimeoutReader.
+func NewImageSourceHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: time.Minute,
+			IdleConnTimeout:       90 * time.Second,
+		},
+	}
+}
+
+// IdleTimeoutReader calls a cancel function if no data is read from it for
+// longer than a timeout.
+type IdleTimeoutReader struct {
+	r       io.Reader
+	timer   *time.Timer
+	timeout time.Duration
+}
+
+// NewIdleTimeoutReader returns a reader which calls cancel if no data is read
+// from r for longer than timeout, starting now.
+func NewIdleTimeoutReader(r io.Reader, timeout time.Duration, cancel func()) *IdleTimeoutReader {
+	return &IdleTimeoutReader{
+		r:       r,
+		timer:   time.AfterFunc(timeout, cancel),
+		timeout: timeout,
+	}
+}
+
+func (r *IdleTimeoutReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.timer.Reset(r.timeout)
+	}
+	if err != nil {
+		r.timer.Stop()
+	}
+	return n, err
+}
+
+// OpenImageSource opens the data of an image from its source.
+func OpenImageSource(ctx context.Context, httpClient *http.Client, source *infrav1alpha1.ImageSource) (io.ReadCloser, error) {
+	switch {
+	case source.URL != "":
+		resp, err := httpGet(ctx, httpClient, source.URL, "", "")
+		if err != nil {
+			return nil, err
+		}
+		return resp.Body, nil
+	case source.OCI != "":
+		return openOCIArtifact(ctx, httpClient, source.OCI)
+	default:
+		// Should have been caught by validation
+		return nil, errors.New("image source url and oci are both empty")
+	}
+}
+
+// ImageChecksumVerifier computes the checksum of the data read through it.
+type ImageChecksumVerifier struct {
+	io.Reader
+	hash     hash.Hash
+	expected string
+}
+
+// NewImageChecksumVerifier returns a reader which computes the checksum of the
+// data read from r.
+func NewImageChecksumVerifier(r io.Reader, checksum *infrav1alpha1.ImageChecksum) (*ImageChecksumVerifier, error) {
+	h, err := newImageHash(checksum.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageChecksumVerifier{
+		Reader:   io.TeeReader(r, h),
+		hash:     h,
+		expected: strings.ToLower(checksum.Value),
+	}, nil
+}
+
+// Verify returns an error if the checksum of the data read so far does not
+// match the expected checksum.
+func (v *ImageChecksumVerifier) Verify() error {
+	if actual := hex.EncodeToString(v.hash.Sum(nil)); actual != v.expected {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", v.expected, actual)
+	}
+	return nil
+}
+
+// VerifyImageChecksum compares the hash Glance computed for the data of an
+// image with the expected checksum. It returns an error if they do not match
+// or if Glance computes hashes with a different algorithm.
+func VerifyImageChecksum(image *images.Image, checksum *infrav1alpha1.ImageChecksum) error {
+	algorithm, _ := image.Properties[imageHashAlgorithmProperty].(string)
+	value, _ := image.Properties[imageHashValueProperty].(string)
+	if algorithm != string(checksum.Algorithm) {
+		return fmt.Errorf("cannot verify checksum: Glance computed a %q hash instead of %s", algorithm, checksum.Algorithm)
+	}
+	if !strings.EqualFold(value, checksum.Value) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", strings.ToLower(checksum.Value), value)
+	}
+	return nil
+}
+
+// GetImageFailedImport returns the import methods which failed for an image.
+func GetImageFailedImport(image *images.Image) string {
+	failed, _ := image.Properties[imageFailedImportProperty].(string)
+	return failed
+}
+
+func newImageHash(algorithm infrav1alpha1.ImageChecksumAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case infrav1alpha1.ImageChecksumAlgorithmSHA256:
+		return sha256.New(), nil
+	case infrav1alpha1.ImageChecksumAlgorithmSHA512:
+		return sha512.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+// openOCIArtifact opens the only layer of an OCI artifact. Registries which
+// require a bearer token are supported as long as they issue anonymous
+// tokens.
+func openOCIArtifact(ctx context.Context, httpClient *http.Client, ref string) (io.ReadCloser, error) {
+	registry, repository, reference, err := parseOCIReference(ref)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := fmt.Sprintf("https://%s/v2/%s", registry, repository)
+
+	token := ""
+	resp, err := ociGet(ctx, httpClient, baseURL+"/manifests/"+reference, ociManifestMediaTypes, &token)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	manifest := ociManifest{}
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("decoding manifest of %s: %w", ref, err)
+	}
+	if len(manifest.Layers) != 1 {
+		return nil, fmt.Errorf("expected OCI artifact %s to have a single layer; found %d", ref, len(manifest.Layers))
+	}
+
+	resp, err = ociGet(ctx, httpClient, baseURL+"/blobs/"+manifest.Layers[0].Digest, "", &token)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// parseOCIReference splits a reference of the form registry/repository:tag or
+// registry/repository@digest. The tag defaults to latest.
+func parseOCIReference(ref string) (string, string, string, error) {
+	registry, path, found := strings.Cut(strings.TrimPrefix(ref, "oci://"), "/")
+	if !found || registry == "" || path == "" {
+		return "", "", "", fmt.Errorf("invalid OCI reference %q", ref)
+	}
+
+	if repository, digest, found := strings.Cut(path, "@"); found {
+		return registry, repository, digest, nil
+	}
+	if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		return registry, path[:i], path[i+1:], nil
+	}
+	return registry, path, "latest", nil
+}
+
+// ociGet gets a URL from a registry, requesting an anonymous bearer token if
+// the registry asks for one.
+func ociGet(ctx context.Context, httpClient *http.Client, u, accept string, token *string) (*http.Response, error) {
+	resp, err := httpGet(ctx, httpClient, u, accept, *token)
+	if err == nil || *token != "" {
+		return resp, err
+	}
+
+	var statusErr *httpStatusError
+	if !errors.As(err, &statusErr) || statusErr.statusCode != http.StatusUnauthorized {
+		return nil, err
+	}
+	*token, err = getOCIToken(ctx, httpClient, statusErr.authenticate)
+	if err != nil {
+		return nil, err
+	}
+	return httpGet(ctx, httpClient, u, accept, *token)
+}
+
+// getOCIToken requests an anonymous token as described by a
+// WWW-Authenticate header of the form
+// Bearer realm="...",service="...",scope="...".
+func getOCIToken(ctx context.Context, httpClient *http.Client, authenticate string) (string, error) {
+	params, found := strings.CutPrefix(authenticate, "Bearer ")
+	if !found {
+		return "", fmt.Errorf("unsupported registry authentication %q", authenticate)
+	}
+
+	query := url.Values{}
+	realm := ""
+	for _, param := range strings.Split(params, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		value = strings.Trim(value, `"`)
+		if key == "realm" {
+			realm = value
+		} else {
+			query.Set(key, value)
+		}
+	}
+	if realm == "" {
+		return "", fmt.Errorf("registry authentication %q has no realm", authenticate)
+	}
+
+	resp, err := httpGet(ctx, httpClient, realm+"?"+query.Encode(), "", "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	tokenResponse := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("decoding registry token: %w", err)
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	return tokenResponse.AccessToken, nil
+}
+
+type httpStatusError struct {
+	url          string
+	statusCode   int
+	authenticate string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("GET %s: unexpected status %d", e.url, e.statusCode)
+}
+
+func httpGet(ctx context.Context, httpClient *http.Client, u, accept, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &httpStatusError{url: u, statusCode: resp.StatusCode, authenticate: resp.Header.Get("Www-Authenticate")}
+	}
+	return resp, nil
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	. "github.com/onsi/gomega" //nolint:revive
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+func Test_parseOCIReference(t *testing.T) {
+	tests := []struct {
+		ref            string
+		wantRegistry   string
+		wantRepository string
+		wantReference  string
+		wantErr        bool
+	}{
+		{
+			ref:            "registry.example.com/images/ubuntu:24.04",
+			wantRegistry:   "registry.example.com",
+			wantRepository: "images/ubuntu",
+			wantReference:  "24.04",
+		},
+		{
+			ref:            "oci://registry.example.com:5000/ubuntu",
+			wantRegistry:   "registry.example.com:5000",
+			wantRepository: "ubuntu",
+			wantReference:  "latest",
+		},
+		{
+			ref:            "registry.example.com/ubuntu@sha256:abcd",
+			wantRegistry:   "registry.example.com",
+			wantRepository: "ubuntu",
+			wantReference:  "sha256:abcd",
+		},
+		{
+			ref:     "ubuntu",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			g := NewWithT(t)
+			registry, repository, reference, err := parseOCIReference(tt.ref)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(registry).To(Equal(tt.wantRegistry))
+			g.Expect(repository).To(Equal(tt.wantRepository))
+			g.Expect(reference).To(Equal(tt.wantReference))
+		})
+	}
+}
+
+func TestOpenImageSource(t *testing.T) {
+	const (
+		imageData   = "image data"
+		layerDigest = "sha256:0123"
+		token       = "anonymous-token"
+	)
+
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/image.qcow2", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, imageData)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:images/ubuntu:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"token": %q}`, token)
+	})
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("Www-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:images/ubuntu:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("/v2/images/ubuntu/manifests/24.04", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			_, _ = fmt.Fprintf(w, `{"layers": [{"mediaType": "application/vnd.oci.image.layer.v1.tar", "digest": %q}]}`, layerDigest)
+		}
+	})
+	mux.HandleFunc("/v2/images/ubuntu/manifests/multi", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			_, _ = io.WriteString(w, `{"layers": [{"digest": "sha256:1"}, {"digest": "sha256:2"}]}`)
+		}
+	})
+	mux.HandleFunc("/v2/images/ubuntu/blobs/"+layerDigest, func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			_, _ = io.WriteString(w, imageData)
+		}
+	})
+
+	registry := strings.TrimPrefix(server.URL, "https://")
+
+	tests := []struct {
+		name    string
+		source  infrav1alpha1.ImageSource
+		wantErr bool
+	}{
+		{
+			name:   "URL",
+			source: infrav1alpha1.ImageSource{URL: server.URL + "/image.qcow2"},
+		},
+		{
+			name:    "URL not found",
+			source:  infrav1alpha1.ImageSource{URL: server.URL + "/missing.qcow2"},
+			wantErr: true,
+		},
+		{
+			name:   "OCI artifact with anonymous token",
+			source: infrav1alpha1.ImageSource{OCI: registry + "/images/ubuntu:24.04"},
+		},
+		{
+			name:    "OCI artifact with multiple layers",
+			source:  infrav1alpha1.ImageSource{OCI: registry + "/images/ubuntu:multi"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			data, err := OpenImageSource(context.TODO(), server.Client(), &tt.source)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			defer data.Close()
+			g.Expect(io.ReadAll(data)).To(Equal([]byte(imageData)))
+		})
+	}
+}
+
+func TestImageChecksumVerifier(t *testing.T) {
+	g := NewWithT(t)
+
+	sum := sha256.Sum256([]byte("image data"))
+	checksum := &infrav1alpha1.ImageChecksum{
+		Algorithm: infrav1alpha1.ImageChecksumAlgorithmSHA256,
+		Value:     strings.ToUpper(hex.EncodeToString(sum[:])),
+	}
+
+	verifier, err := NewImageChecksumVerifier(strings.NewReader("image data"), checksum)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = io.Copy(io.Discard, verifier)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(verifier.Verify()).To(Succeed())
+
+	verifier, err = NewImageChecksumVerifier(strings.NewReader("other data"), checksum)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = io.Copy(io.Discard, verifier)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(verifier.Verify()).NotTo(Succeed())
+}
+
+func TestIdleTimeoutReader(t *testing.T) {
+	g := NewWithT(t)
+
+	// Data which is read in time doesn't cancel the transfer
+	canceled := make(chan struct{})
+	reader := NewIdleTimeoutReader(strings.NewReader("image data"), time.Minute, func() { close(canceled) })
+	data, err := io.ReadAll(reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(data)).To(Equal("image data"))
+	g.Expect(canceled).NotTo(BeClosed())
+
+	// A stalled transfer is canceled
+	pr, pw := io.Pipe()
+	reader = NewIdleTimeoutReader(pr, 10*time.Millisecond, func() { pw.CloseWithError(context.Canceled) })
+	_, err = io.ReadAll(reader)
+	g.Expect(err).To(MatchError(context.Canceled))
+}
+
+func TestVerifyImageChecksum(t *testing.T) {
+	checksum := &infrav1alpha1.ImageChecksum{
+		Algorithm: infrav1alpha1.ImageChecksumAlgorithmSHA512,
+		Value:     "ABCDEF",
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]any
+		wantErr    bool
+	}{
+		{
+			name:       "Matching checksum",
+			properties: map[string]any{"os_hash_algo": "sha512", "os_hash_value": "abcdef"},
+		},
+		{
+			name:       "Mismatching checksum",
+			properties: map[string]any{"os_hash_algo": "sha512", "os_hash_value": "012345"},
+			wantErr:    true,
+		},
+		{
+			name:       "Different algorithm",
+			properties: map[string]any{"os_hash_algo": "sha256", "os_hash_value": "abcdef"},
+			wantErr:    true,
+		},
+		{
+			name:    "No hash",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := VerifyImageChecksum(&images.Image{Properties: tt.properties}, checksum)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package compute
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"time"
 
@@ -37,6 +39,7 @@ import (
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
@@ -116,7 +119,7 @@ func (s *Service) createInstanceImpl(eventObject runtime.Object, instanceSpec *I
 		compute = computeWithTags
 	}
 
-	multiattachRequired, err := s.requiresMultiattach(blockDevices)
+	multiattachRequired, err := s.requiresMultiattach(instanceSpec, blockDevices)
 	if err != nil {
 		return nil, fmt.Errorf("error checking if multiattach is required: %w", err)
 	}
@@ -176,30 +179,30 @@ func (s *Service) getVolumeByName(name string) (*volumes.Volume, error) {
 
 // getOrCreateVolume gets or creates a volume with the given options. It returns the volume that already exists or the
 // newly created one. It returns an error if the volume creation failed or if the expected volume size is different from
-// the one that already exists.
-func (s *Service) getOrCreateVolume(eventObject runtime.Object, opts volumes.CreateOpts) (*volumes.Volume, error) {
+// the one that already exists. It also returns true if the volume was created.
+func (s *Service) getOrCreateVolume(eventObject runtime.Object, opts volumes.CreateOpts, hintOpts volumes.SchedulerHintOptsBuilder) (*volumes.Volume, bool, error) {
 	existingVolume, err := s.getVolumeByName(opts.Name)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	if existingVolume != nil {
 		// TODO(emilien): Improve the checks here, there is an ongoing discussion in the community about how to do this
 		// which would involve adding metadata to the volume.
 		if existingVolume.Size != opts.Size {
-			return nil, fmt.Errorf("expected to find volume %s with size %d; found size %d", opts.Name, opts.Size, existingVolume.Size)
+			return nil, false, fmt.Errorf("expected to find volume %s with size %d; found size %d", opts.Name, opts.Size, existingVolume.Size)
 		}
 
 		s.scope.Logger().V(3).Info("Using existing volume", "name", opts.Name, "id", existingVolume.ID)
-		return existingVolume, nil
+		return existingVolume, false, nil
 	}
 
-	createdVolume, err := s.getVolumeClient().CreateVolume(opts)
+	createdVolume, err := s.getVolumeClient().CreateVolume(opts, hintOpts)
 	if err != nil {
 		record.Eventf(eventObject, "FailedCreateVolume", "Failed to create volume; name=%s size=%d err=%v", opts.Name, opts.Size, err)
-		return nil, err
+		return nil, false, err
 	}
 	record.Eventf(eventObject, "SuccessfulCreateVolume", "Created volume; id=%s", createdVolume.ID)
-	return createdVolume, err
+	return createdVolume, true, err
 }
 
 func (s *Service) waitForVolume(volumeID string, timeout time.Duration, retryInterval time.Duration) error {
@@ -225,7 +228,7 @@ func (s *Service) waitForVolume(volumeID string, timeout time.Duration, retryInt
 
 // getOrCreateVolumeBuilder gets or creates a volume with the given options. It returns the volume that already exists or the newly created one.
 // It returns an error if the volume creation failed or if the expected volume is different from the one that already exists.
-func (s *Service) getOrCreateVolumeBuilder(eventObject runtime.Object, instanceSpec *InstanceSpec, blockDeviceSpec *infrav1.AdditionalBlockDevice, imageID string, description string) (*volumes.Volume, error) {
+func (s *Service) getOrCreateVolumeBuilder(eventObject runtime.Object, instanceSpec *InstanceSpec, blockDeviceSpec *infrav1.AdditionalBlockDevice, imageID string, description string) (*volumes.Volume, bool, error) {
 	availabilityZone, volType := resolveVolumeOpts(instanceSpec, blockDeviceSpec.Storage.Volume)
 
 	createOpts := volumes.CreateOpts{
@@ -237,7 +240,41 @@ func (s *Service) getOrCreateVolumeBuilder(eventObject runtime.Object, instanceS
 		VolumeType:       volType,
 	}
 
-	return s.getOrCreateVolume(eventObject, createOpts)
+	if volumeOpts := blockDeviceSpec.Storage.Volume; volumeOpts != nil && len(volumeOpts.Metadata) > 0 {
+		createOpts.Metadata = make(map[string]string, len(volumeOpts.Metadata))
+		for _, m := range volumeOpts.Metadata {
+			createOpts.Metadata[m.Key] = m.Value
+		}
+	}
+
+	var hintOpts volumes.SchedulerHintOptsBuilder
+	if resolved := getResolvedVolume(instanceSpec, blockDeviceSpec.Name); resolved != nil {
+		// A volume created from a snapshot or another volume already contains
+		// its data, so it is not created from the image.
+		if resolved.SnapshotID != "" || resolved.VolumeID != "" {
+			createOpts.ImageID = ""
+			createOpts.SnapshotID = resolved.SnapshotID
+			createOpts.SourceVolID = resolved.VolumeID
+		}
+
+		if len(resolved.SameHostVolumeIDs) > 0 || len(resolved.DifferentHostVolumeIDs) > 0 {
+			hintOpts = volumes.SchedulerHintOpts{
+				SameHost:      resolved.SameHostVolumeIDs,
+				DifferentHost: resolved.DifferentHostVolumeIDs,
+			}
+		}
+	}
+
+	return s.getOrCreateVolume(eventObject, createOpts, hintOpts)
+}
+
+func getResolvedVolume(instanceSpec *InstanceSpec, name string) *infrav1alpha1.ResolvedVolumeSpec {
+	for i := range instanceSpec.Volumes {
+		if instanceSpec.Volumes[i].Name == name {
+			return &instanceSpec.Volumes[i]
+		}
+	}
+	return nil
 }
 
 func resolveVolumeOpts(instanceSpec *InstanceSpec, volumeOpts *infrav1.BlockDeviceVolume) (az, volType string) {
@@ -278,10 +315,13 @@ func (s *Service) getBlockDevices(eventObject runtime.Object, instanceSpec *Inst
 				Volume: &instanceSpec.RootVolume.BlockDeviceVolume,
 			},
 		}
-		rootVolume, err := s.getOrCreateVolumeBuilder(eventObject, instanceSpec, &rootVolumeToBlockDevice, imageID, fmt.Sprintf("Root volume for %s", instanceSpec.Name))
+		rootVolume, _, err := s.getOrCreateVolumeBuilder(eventObject, instanceSpec, &rootVolumeToBlockDevice, imageID, fmt.Sprintf("Root volume for %s", instanceSpec.Name))
 		if err != nil {
 			return nil, err
 		}
+		if err := checkVolumeEncryption(instanceSpec, rootVolumeToBlockDevice.Name, rootVolume); err != nil {
+			return nil, err
+		}
 		blockDevices = append(blockDevices, servers.BlockDevice{
 			SourceType:          servers.SourceVolume,
 			DestinationType:     servers.DestinationVolume,
@@ -314,10 +354,13 @@ func (s *Service) getBlockDevices(eventObject runtime.Object, instanceSpec *Inst
 
 		switch blockDeviceSpec.Storage.Type {
 		case infrav1.VolumeBlockDevice:
-			blockDevice, err := s.getOrCreateVolumeBuilder(eventObject, instanceSpec, &blockDeviceSpec, "", fmt.Sprintf("Additional block device for %s", instanceSpec.Name))
+			blockDevice, _, err := s.getOrCreateVolumeBuilder(eventObject, instanceSpec, &blockDeviceSpec, "", fmt.Sprintf("Additional block device for %s", instanceSpec.Name))
 			if err != nil {
 				return nil, err
 			}
+			if err := checkVolumeEncryption(instanceSpec, blockDeviceSpec.Name, blockDevice); err != nil {
+				return nil, err
+			}
 			bdUUID = blockDevice.ID
 			sourceType = servers.SourceVolume
 			destinationType = servers.DestinationVolume
@@ -363,9 +406,11 @@ func (s *Service) GetImageID(ctx context.Context, k8sClient client.Client, names
 		return s.getImageIDByFilter(image.Filter)
 	case image.ImageRef != nil:
 		return s.getImageIDByReference(ctx, k8sClient, namespace, image.ImageRef)
+	case image.OpenStackImageRef != nil:
+		return getImageIDByOpenStackImageReference(ctx, k8sClient, namespace, image.OpenStackImageRef)
 	default:
 		// Should have been caught by validation
-		return nil, errors.New("image id, filter, and reference are all nil")
+		return nil, errors.New("image id, filter, and references are all nil")
 	}
 }
 
@@ -426,14 +471,39 @@ func (s *Service) getImageIDByReference(ctx context.Context, k8sClient client.Cl
 	return nil, nil
 }
 
+func getImageIDByOpenStackImageReference(ctx context.Context, k8sClient client.Client, namespace string, ref *infrav1.ResourceReference) (*string, error) {
+	openStackImage := &infrav1alpha1.OpenStackImage{}
+	err := k8sClient.Get(ctx, client.ObjectKey{
+		Namespace: namespace,
+		Name:      ref.Name,
+	}, openStackImage)
+	if err != nil {
+		// Not an error if it doesn't exist yet
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !openStackImage.Status.Ready || openStackImage.Status.ID == "" {
+		return nil, nil
+	}
+
+	return &openStackImage.Status.ID, nil
+}
+
 // Helper to resolve a flavor ID.
 // TODO: needs a breaking CRD change so it works like images.
-func (s *Service) GetFlavorID(flavorID, flavorName *string) (string, error) {
+func (s *Service) GetFlavorID(flavorID, flavorName *string, flavorSelector *infrav1.FlavorSelector, accelerators *infrav1.AcceleratorRequirements) (string, error) {
 	if flavorID != nil {
 		return *flavorID, nil
 	}
 
 	if flavorName == nil {
+		if flavorSelector != nil {
+			return s.GetFlavorIDBySelector(flavorSelector, accelerators)
+		}
 		return "", fmt.Errorf("no flavors were found: no name set")
 	}
 
@@ -451,6 +521,79 @@ func (s *Service) GetFlavorID(flavorID, flavorName *string) (string, error) {
 	return "", fmt.Errorf("no flavors were found: name=%v", *flavorName)
 }
 
+// GetFlavorIDBySelector returns the ID of the flavor which satisfies the
+// requirements of the selector, provides the given accelerators if any, and
+// comes first in the preference order of the selector.
+func (s *Service) GetFlavorIDBySelector(flavorSelector *infrav1.FlavorSelector, accelerators *infrav1.AcceleratorRequirements) (string, error) {
+	compute := s.getComputeClient()
+	if len(flavorSelector.ExtraSpecs) > 0 || accelerators != nil {
+		// Flavor details only include extra specs from microversion 2.61
+		computeWithExtraSpecs, err := compute.WithMicroversion(clients.NovaFlavorExtraSpecs)
+		if err != nil {
+			return "", fmt.Errorf("selecting flavors by extra specs: %w", err)
+		}
+		compute = computeWithExtraSpecs
+	}
+
+	allFlavors, err := compute.ListFlavors()
+	if err != nil {
+		return "", err
+	}
+
+	matching := make([]flavors.Flavor, 0, len(allFlavors))
+	for _, flavor := range allFlavors {
+		if !flavorMatchesSelector(&flavor, flavorSelector) {
+			continue
+		}
+		if accelerators != nil {
+			flavorAccelerators, err := ParseFlavorAccelerators(flavor.ExtraSpecs)
+			if err != nil || flavorAccelerators.Provides(accelerators) != nil {
+				continue
+			}
+		}
+		matching = append(matching, flavor)
+	}
+	if len(matching) == 0 {
+		return "", fmt.Errorf("no flavors were found matching the flavor selector")
+	}
+
+	slices.SortFunc(matching, func(a, b flavors.Flavor) int {
+		c := cmp.Or(
+			cmp.Compare(a.VCPUs, b.VCPUs),
+			cmp.Compare(a.RAM, b.RAM),
+			cmp.Compare(a.Disk, b.Disk),
+		)
+		if flavorSelector.Preference == infrav1.FlavorPreferenceLargestMatching {
+			c = -c
+		}
+		return cmp.Or(c, cmp.Compare(a.Name, b.Name))
+	})
+
+	s.scope.Logger().V(3).Info("Selected flavor", "name", matching[0].Name, "id", matching[0].ID)
+	return matching[0].ID, nil
+}
+
+func flavorMatchesSelector(flavor *flavors.Flavor, flavorSelector *infrav1.FlavorSelector) bool {
+	if flavorSelector.MinVCPUs != nil && flavor.VCPUs < int(*flavorSelector.MinVCPUs) {
+		return false
+	}
+	if flavorSelector.MinRAMMiB != nil && flavor.RAM < int(*flavorSelector.MinRAMMiB) {
+		return false
+	}
+	if flavorSelector.MaxRAMMiB != nil && flavor.RAM > int(*flavorSelector.MaxRAMMiB) {
+		return false
+	}
+	if flavorSelector.MinDiskGiB != nil && flavor.Disk < int(*flavorSelector.MinDiskGiB) {
+		return false
+	}
+	for _, extraSpec := range flavorSelector.ExtraSpecs {
+		if value, ok := flavor.ExtraSpecs[extraSpec.Key]; !ok || value != extraSpec.Value {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *Service) GetFlavor(flavorID string) (*flavors.Flavor, error) {
 	return s.getComputeClient().GetFlavor(flavorID)
 }
@@ -620,6 +763,15 @@ func (s *Service) GetInstanceStatusByName(eventObject runtime.Object, name strin
 	return nil, nil
 }
 
+// GetInstanceConsoleLog returns the last lines of the console log of an instance.
+func (s *Service) GetInstanceConsoleLog(instanceID string, lines int) (string, error) {
+	consoleLog, err := s.getComputeClient().GetConsoleOutput(instanceID, lines)
+	if err != nil {
+		return "", fmt.Errorf("get console log of server %q failed: %w", instanceID, err)
+	}
+	return consoleLog, nil
+}
+
 func getTimeout(name string, timeout int, unit time.Duration) time.Duration {
 	if v := os.Getenv(name); v != "" {
 		timeout, err := strconv.Atoi(v)
@@ -641,9 +793,16 @@ func requiresTagging(instanceSpec *InstanceSpec) bool {
 }
 
 // requiresMultiattach checks if there is any volume in the blockDevices that requires multiattach.
+// Volumes whose resolved volume type supports multiattach require it without further checks.
 // Note that we are not checking the default volume type or the volume type configured in the
 // image metadata. We assume that these are NOT multiattach.
-func (s *Service) requiresMultiattach(blockDevices []servers.BlockDevice) (bool, error) {
+func (s *Service) requiresMultiattach(instanceSpec *InstanceSpec, blockDevices []servers.BlockDevice) (bool, error) {
+	for i := range instanceSpec.Volumes {
+		if instanceSpec.Volumes[i].Multiattach {
+			return true, nil
+		}
+	}
+
 	for _, blockDevice := range blockDevices {
 		// Only check volumes
 		if blockDevice.SourceType == servers.SourceVolume {
@@ -28,6 +28,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/keypairs"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
@@ -40,6 +41,7 @@ import (
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
@@ -293,6 +295,109 @@ func TestService_getImageID(t *testing.T) {
 	}
 }
 
+func TestService_GetFlavorIDBySelector(t *testing.T) {
+	allFlavors := []flavors.Flavor{
+		{ID: "m1.small", Name: "m1.small", VCPUs: 2, RAM: 4096, Disk: 20},
+		{ID: "m1.medium", Name: "m1.medium", VCPUs: 4, RAM: 8192, Disk: 40},
+		{ID: "m1.medium-hm", Name: "m1.medium-hm", VCPUs: 4, RAM: 16384, Disk: 40},
+		{ID: "m1.large", Name: "m1.large", VCPUs: 8, RAM: 16384, Disk: 80},
+		{ID: "g1.large", Name: "g1.large", VCPUs: 8, RAM: 16384, Disk: 80, ExtraSpecs: map[string]string{"pci_passthrough:alias": "gpu:1"}},
+		{ID: "v1.large", Name: "v1.large", VCPUs: 8, RAM: 16384, Disk: 80, ExtraSpecs: map[string]string{"resources:VGPU": "1"}},
+	}
+
+	tests := []struct {
+		testName       string
+		selector       infrav1.FlavorSelector
+		accelerators   *infrav1.AcceleratorRequirements
+		withExtraSpecs bool
+		want           string
+		wantErr        bool
+	}{
+		{
+			testName: "Smallest matching",
+			selector: infrav1.FlavorSelector{MinVCPUs: ptr.To[int32](4)},
+			want:     "m1.medium",
+		},
+		{
+			testName: "Largest matching",
+			selector: infrav1.FlavorSelector{
+				MaxRAMMiB:  ptr.To[int32](16384),
+				Preference: infrav1.FlavorPreferenceLargestMatching,
+			},
+			want: "g1.large",
+		},
+		{
+			testName: "RAM and disk",
+			selector: infrav1.FlavorSelector{MinRAMMiB: ptr.To[int32](16384), MinDiskGiB: ptr.To[int32](80)},
+			want:     "g1.large",
+		},
+		{
+			testName: "Extra specs",
+			selector: infrav1.FlavorSelector{
+				ExtraSpecs: []infrav1.FlavorExtraSpec{{Key: "pci_passthrough:alias", Value: "gpu:1"}},
+			},
+			withExtraSpecs: true,
+			want:           "g1.large",
+		},
+		{
+			testName: "PCI alias",
+			selector: infrav1.FlavorSelector{MinVCPUs: ptr.To[int32](4)},
+			accelerators: &infrav1.AcceleratorRequirements{
+				PCIAliases: []infrav1.PCIAliasRequirement{{Name: "gpu", Count: 1}},
+			},
+			withExtraSpecs: true,
+			want:           "g1.large",
+		},
+		{
+			testName: "Placement resource",
+			selector: infrav1.FlavorSelector{},
+			accelerators: &infrav1.AcceleratorRequirements{
+				Resources: []infrav1.PlacementResourceRequirement{{ResourceClass: "VGPU", Count: 1}},
+			},
+			withExtraSpecs: true,
+			want:           "v1.large",
+		},
+		{
+			testName: "Not enough PCI devices",
+			selector: infrav1.FlavorSelector{},
+			accelerators: &infrav1.AcceleratorRequirements{
+				PCIAliases: []infrav1.PCIAliasRequirement{{Name: "gpu", Count: 2}},
+			},
+			withExtraSpecs: true,
+			wantErr:        true,
+		},
+		{
+			testName: "No match",
+			selector: infrav1.FlavorSelector{MinVCPUs: ptr.To[int32](16)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			computeRecorder := mockScopeFactory.ComputeClient.EXPECT()
+			if tt.withExtraSpecs {
+				computeRecorder.WithMicroversion(clients.NovaFlavorExtraSpecs).Return(mockScopeFactory.ComputeClient, nil)
+			}
+			computeRecorder.ListFlavors().Return(allFlavors, nil)
+
+			got, err := s.GetFlavorIDBySelector(&tt.selector, tt.accelerators)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
 var portUUIDs = []string{"e7b7f3d1-0a81-40b1-bfa6-a22a31b17816"}
 
 const (
@@ -470,7 +575,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-root", openStackMachineName),
 					ImageID:     imageUUID,
-				}).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, rootVolumeUUID)
 				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
 
@@ -515,7 +620,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description:      fmt.Sprintf("Root volume for %s", openStackMachineName),
 					Name:             fmt.Sprintf("%s-root", openStackMachineName),
 					ImageID:          imageUUID,
-				}).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, rootVolumeUUID)
 				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
 
@@ -559,9 +664,78 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description:      fmt.Sprintf("Root volume for %s", openStackMachineName),
 					Name:             fmt.Sprintf("%s-root", openStackMachineName),
 					ImageID:          imageUUID,
-				}).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				expectVolumePollSuccess(r.volume, rootVolumeUUID)
+				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
+
+				createOpts := getDefaultServerCreateOpts()
+				createOpts.ImageRef = ""
+				createOpts.BlockDevice = []servers.BlockDevice{
+					{
+						SourceType:          "volume",
+						UUID:                rootVolumeUUID,
+						BootIndex:           0,
+						DeleteOnTermination: true,
+						DestinationType:     "volume",
+					},
+				}
+				expectCreateServer(g, r.compute, withSSHKey(createOpts), getDefaultSchedulerHintOpts(), factory.ComputeClient, false)
+
+				// Don't delete ports because the server is created: DeleteInstance will do it
+			},
+			wantErr: false,
+		},
+		{
+			name: "Volumes created from snapshot and volume",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.RootVolume = &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						SnapshotRef: &infrav1.VolumeSnapshotParam{ID: ptr.To("snapshot-uuid")},
+					},
+				}
+				s.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+					{
+						Name:    "etcd",
+						SizeGiB: 50,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: "Volume",
+							Volume: &infrav1.BlockDeviceVolume{
+								VolumeRef: &infrav1.VolumeParam{ID: ptr.To("source-volume-uuid")},
+							},
+						},
+					},
+				}
+				s.Volumes = []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", SnapshotID: "snapshot-uuid"},
+					{Name: "etcd", VolumeID: "source-volume-uuid"},
+				}
+				return s
+			},
+			expect: func(g Gomega, r *recorders, factory *scope.MockScopeFactory) {
+				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-root", openStackMachineName)}).
+					Return([]volumes.Volume{}, nil)
+				r.volume.CreateVolume(volumes.CreateOpts{
+					Size:        50,
+					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
+					Name:        fmt.Sprintf("%s-root", openStackMachineName),
+					SnapshotID:  "snapshot-uuid",
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, rootVolumeUUID)
+
+				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-etcd", openStackMachineName)}).
+					Return([]volumes.Volume{}, nil)
+				r.volume.CreateVolume(volumes.CreateOpts{
+					Size:        50,
+					Description: fmt.Sprintf("Additional block device for %s", openStackMachineName),
+					Name:        fmt.Sprintf("%s-etcd", openStackMachineName),
+					SourceVolID: "source-volume-uuid",
+				}, nil).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
+				expectVolumePollSuccess(r.volume, additionalBlockDeviceVolumeUUID)
+
 				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
+				expectVolumeRequiresMultiattachCheck(r.volume, additionalBlockDeviceVolumeUUID, false)
 
 				createOpts := getDefaultServerCreateOpts()
 				createOpts.ImageRef = ""
@@ -573,6 +747,14 @@ func TestService_ReconcileInstance(t *testing.T) {
 						DeleteOnTermination: true,
 						DestinationType:     "volume",
 					},
+					{
+						SourceType:          "volume",
+						UUID:                additionalBlockDeviceVolumeUUID,
+						BootIndex:           -1,
+						DeleteOnTermination: true,
+						DestinationType:     "volume",
+						Tag:                 "etcd",
+					},
 				}
 				expectCreateServer(g, r.compute, withSSHKey(createOpts), getDefaultSchedulerHintOpts(), factory.ComputeClient, false)
 
@@ -580,6 +762,69 @@ func TestService_ReconcileInstance(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Boot from encrypted volume when encryption is required",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.RootVolume = &infrav1.RootVolume{
+					SizeGiB: 50,
+				}
+				s.RootVolume.Type = "encrypted-type"
+				s.RequireEncryptedVolumes = true
+				return s
+			},
+			expect: func(g Gomega, r *recorders, factory *scope.MockScopeFactory) {
+				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-root", openStackMachineName)}).
+					Return([]volumes.Volume{}, nil)
+				r.volume.CreateVolume(volumes.CreateOpts{
+					Size:        50,
+					VolumeType:  "encrypted-type",
+					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
+					Name:        fmt.Sprintf("%s-root", openStackMachineName),
+					ImageID:     imageUUID,
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID, Encrypted: true}, nil)
+				expectVolumePollSuccess(r.volume, rootVolumeUUID)
+				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
+
+				createOpts := getDefaultServerCreateOpts()
+				createOpts.ImageRef = ""
+				createOpts.BlockDevice = []servers.BlockDevice{
+					{
+						SourceType:          "volume",
+						UUID:                rootVolumeUUID,
+						BootIndex:           0,
+						DeleteOnTermination: true,
+						DestinationType:     "volume",
+					},
+				}
+				expectCreateServer(g, r.compute, withSSHKey(createOpts), getDefaultSchedulerHintOpts(), factory.ComputeClient, false)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Boot from unencrypted volume when encryption is required",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.RootVolume = &infrav1.RootVolume{
+					SizeGiB: 50,
+				}
+				s.RootVolume.Type = "plain-type"
+				s.RequireEncryptedVolumes = true
+				return s
+			},
+			expect: func(_ Gomega, r *recorders, _ *scope.MockScopeFactory) {
+				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-root", openStackMachineName)}).
+					Return([]volumes.Volume{}, nil)
+				r.volume.CreateVolume(volumes.CreateOpts{
+					Size:        50,
+					VolumeType:  "plain-type",
+					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
+					Name:        fmt.Sprintf("%s-root", openStackMachineName),
+					ImageID:     imageUUID,
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID, VolumeType: "plain-type"}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "Boot from volume failure cleans up ports",
 			getInstanceSpec: func() *InstanceSpec {
@@ -597,7 +842,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-root", openStackMachineName),
 					ImageID:     imageUUID,
-				}).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
 				expectVolumePoll(r.volume, rootVolumeUUID, []string{"creating", "error"})
 			},
 			wantErr: true,
@@ -638,7 +883,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-root", openStackMachineName),
 					ImageID:     imageUUID,
-				}).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, rootVolumeUUID)
 
 				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-etcd", openStackMachineName)}).
@@ -648,7 +893,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Additional block device for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-etcd", openStackMachineName),
 					VolumeType:  "test-volume-type",
-				}).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, additionalBlockDeviceVolumeUUID)
 
 				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
@@ -716,7 +961,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Root volume for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-root", openStackMachineName),
 					ImageID:     imageUUID,
-				}).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: rootVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, rootVolumeUUID)
 
 				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-data", openStackMachineName)}).
@@ -726,7 +971,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Additional block device for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-data", openStackMachineName),
 					VolumeType:  "multiattach-volume-type",
-				}).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID, Multiattach: true}, nil)
+				}, nil).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID, Multiattach: true}, nil)
 				expectVolumePollSuccess(r.volume, additionalBlockDeviceVolumeUUID)
 
 				expectVolumeRequiresMultiattachCheck(r.volume, rootVolumeUUID, false)
@@ -757,6 +1002,80 @@ func TestService_ReconcileInstance(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Volume with metadata, scheduler hints and explicit multiattach",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+					{
+						Name:    "data",
+						SizeGiB: 50,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: "Volume",
+							Volume: &infrav1.BlockDeviceVolume{
+								Type:        "multiattach-volume-type",
+								Multiattach: ptr.To(true),
+								Metadata: []infrav1.VolumeMetadata{
+									{Key: "tier", Value: "gold"},
+								},
+								SchedulerHints: &infrav1.VolumeSchedulerHints{
+									SameHost: []infrav1.VolumeParam{{ID: ptr.To("c4a3e1b0-1d0e-4c8e-9a7f-3e2b1c0d9f8e")}},
+								},
+							},
+						},
+					},
+				}
+				s.Volumes = []infrav1alpha1.ResolvedVolumeSpec{
+					{
+						Name:              "data",
+						VolumeTypeID:      "multiattach-volume-type-uuid",
+						Multiattach:       true,
+						SameHostVolumeIDs: []string{"c4a3e1b0-1d0e-4c8e-9a7f-3e2b1c0d9f8e"},
+					},
+				}
+				return s
+			},
+			expect: func(g Gomega, r *recorders, factory *scope.MockScopeFactory) {
+				r.volume.ListVolumes(volumes.ListOpts{Name: fmt.Sprintf("%s-data", openStackMachineName)}).
+					Return([]volumes.Volume{}, nil)
+				r.volume.CreateVolume(volumes.CreateOpts{
+					Size:        50,
+					Description: fmt.Sprintf("Additional block device for %s", openStackMachineName),
+					Name:        fmt.Sprintf("%s-data", openStackMachineName),
+					VolumeType:  "multiattach-volume-type",
+					Metadata:    map[string]string{"tier": "gold"},
+				}, volumes.SchedulerHintOpts{
+					SameHost: []string{"c4a3e1b0-1d0e-4c8e-9a7f-3e2b1c0d9f8e"},
+				}).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID, Multiattach: true}, nil)
+				expectVolumePollSuccess(r.volume, additionalBlockDeviceVolumeUUID)
+
+				// The resolved volume type requires multiattach, so the volumes aren't checked
+				r.compute.WithMicroversion(clients.NovaMultiAttachVolume).Return(factory.ComputeClient, nil)
+
+				createOpts := getDefaultServerCreateOpts()
+				createOpts.BlockDevice = []servers.BlockDevice{
+					{
+						SourceType:          "image",
+						UUID:                imageUUID,
+						BootIndex:           0,
+						DeleteOnTermination: true,
+						DestinationType:     "local",
+					},
+					{
+						SourceType:          "volume",
+						UUID:                additionalBlockDeviceVolumeUUID,
+						BootIndex:           -1,
+						DeleteOnTermination: true,
+						DestinationType:     "volume",
+						Tag:                 "data",
+					},
+				}
+				expectCreateServer(g, r.compute, withSSHKey(createOpts), getDefaultSchedulerHintOpts(), factory.ComputeClient, false)
+
+				// Don't delete ports because the server is created: DeleteInstance will do it
+			},
+			wantErr: false,
+		},
 		{
 			name: "Additional block devices success",
 			getInstanceSpec: func() *InstanceSpec {
@@ -790,7 +1109,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description: fmt.Sprintf("Additional block device for %s", openStackMachineName),
 					Name:        fmt.Sprintf("%s-etcd", openStackMachineName),
 					VolumeType:  "test-volume-type",
-				}).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, additionalBlockDeviceVolumeUUID)
 				expectVolumeRequiresMultiattachCheck(r.volume, additionalBlockDeviceVolumeUUID, false)
 
@@ -857,7 +1176,7 @@ func TestService_ReconcileInstance(t *testing.T) {
 					Description:      fmt.Sprintf("Additional block device for %s", openStackMachineName),
 					Name:             fmt.Sprintf("%s-etcd", openStackMachineName),
 					VolumeType:       "test-volume-type",
-				}).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
+				}, nil).Return(&volumes.Volume{ID: additionalBlockDeviceVolumeUUID}, nil)
 				expectVolumePollSuccess(r.volume, additionalBlockDeviceVolumeUUID)
 				expectVolumeRequiresMultiattachCheck(r.volume, additionalBlockDeviceVolumeUUID, false)
 
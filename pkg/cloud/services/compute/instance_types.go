@@ -20,14 +20,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	corev1 "k8s.io/api/core/v1"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 )
 
+// noValidHostFault is the fault message of an instance for which the Nova
+// scheduler could not find a host.
+const noValidHostFault = "No valid host was found"
+
 // InstanceSpec defines the fields which can be set on a new OpenStack instance.
 type InstanceSpec struct {
 	Name                          string
@@ -44,6 +50,8 @@ type InstanceSpec struct {
 	Trunk                         bool
 	Tags                          []string
 	SchedulerAdditionalProperties []infrav1.SchedulerHintAdditionalProperty
+	Volumes                       []infrav1alpha1.ResolvedVolumeSpec
+	RequireEncryptedVolumes       bool
 }
 
 // InstanceIdentifier describes an instance which has not necessarily been fetched.
@@ -87,6 +95,20 @@ func (is *InstanceStatus) State() infrav1.InstanceState {
 	return infrav1.InstanceState(is.server.Status)
 }
 
+// FlavorID returns the ID of the flavor of the instance. It relies on the
+// flavor ID being returned by Nova, which is the case before microversion 2.47.
+func (is *InstanceStatus) FlavorID() string {
+	flavorID, _ := is.server.Flavor["id"].(string)
+	return flavorID
+}
+
+// ImageID returns the ID of the image of the instance. It is empty for
+// instances booted from volume.
+func (is *InstanceStatus) ImageID() string {
+	imageID, _ := is.server.Image["id"].(string)
+	return imageID
+}
+
 func (is *InstanceStatus) SSHKeyName() string {
 	return is.server.KeyName
 }
@@ -95,6 +117,18 @@ func (is *InstanceStatus) AvailabilityZone() string {
 	return is.server.AvailabilityZone
 }
 
+// FaultMessage returns the message of the fault reported by Nova for an
+// instance in ERROR.
+func (is *InstanceStatus) FaultMessage() string {
+	return is.server.Fault.Message
+}
+
+// IsNoValidHost returns true if the instance is in ERROR because the Nova
+// scheduler could not find a host for it.
+func (is *InstanceStatus) IsNoValidHost() bool {
+	return is.State() == infrav1.InstanceStateError && strings.Contains(is.server.Fault.Message, noValidHostFault)
+}
+
 // BastionStatus updates BastionStatus in openStackCluster.
 func (is *InstanceStatus) UpdateBastionStatus(openStackCluster *infrav1.OpenStackCluster) {
 	if openStackCluster.Status.Bastion == nil {
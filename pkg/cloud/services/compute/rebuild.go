@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// rebuildOpts extends servers.RebuildOpts with the user data of the server,
+// which gophercloud does not support on rebuild.
+type rebuildOpts struct {
+	servers.RebuildOpts
+
+	// UserData replaces the user data of the server. It is base64 encoded,
+	// and requires Nova microversion 2.57.
+	UserData string
+}
+
+func (opts rebuildOpts) ToServerRebuildMap() (map[string]any, error) {
+	b, err := opts.RebuildOpts.ToServerRebuildMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.UserData) > 0 {
+		rebuild, ok := b["rebuild"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected rebuild request body")
+		}
+		rebuild["user_data"] = opts.UserData
+	}
+
+	return b, nil
+}
+
+// RebuildInstance starts rebuilding an instance with the given image. The
+// ports, floating IPs and volumes of the instance are preserved by Nova, and
+// the user data of the instance spec is injected again.
+func (s *Service) RebuildInstance(eventObject runtime.Object, instanceStatus *InstanceStatus, instanceSpec *InstanceSpec, imageID string) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	compute := s.getComputeClient()
+	var microversion string
+	switch {
+	case hasRootVolume(instanceSpec):
+		microversion = clients.NovaRebuildVolumeBacked
+	case instanceSpec.UserData != "":
+		microversion = clients.NovaRebuildUserData
+	}
+	if microversion != "" {
+		computeWithMicroversion, err := compute.WithMicroversion(microversion)
+		if err != nil {
+			return fmt.Errorf("rebuilding this instance is not supported by the server: %w", err)
+		}
+		compute = computeWithMicroversion
+	}
+
+	_, err := compute.RebuildServer(instance.ID, rebuildOpts{
+		RebuildOpts: servers.RebuildOpts{ImageRef: imageID},
+		UserData:    instanceSpec.UserData,
+	})
+	if err != nil {
+		record.Warnf(eventObject, "FailedRebuildServer", "Failed to rebuild server %s with id %s with image %s: %v", instance.Name, instance.ID, imageID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulRebuildServer", "Started rebuilding server %s with id %s with image %s", instance.Name, instance.ID, imageID)
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	. "github.com/onsi/gomega" //nolint:revive
+	"go.uber.org/mock/gomock"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func TestService_RebuildInstance(t *testing.T) {
+	const (
+		serverID = "ce96e584-7ebc-46d6-9e55-987d72e3806c"
+		imageID  = "3f1b2d9e-5c4a-4e7b-8d6f-2a9c1e0b7d54"
+	)
+
+	tests := []struct {
+		name         string
+		instanceSpec InstanceSpec
+		microversion string
+		wantBody     map[string]any
+		wantErr      bool
+	}{
+		{
+			name:     "Rebuilds without user data",
+			wantBody: map[string]any{"rebuild": map[string]any{"imageRef": imageID}},
+		},
+		{
+			name:         "Rebuilds with user data",
+			instanceSpec: InstanceSpec{UserData: "dXNlci1kYXRh"},
+			microversion: clients.NovaRebuildUserData,
+			wantBody:     map[string]any{"rebuild": map[string]any{"imageRef": imageID, "user_data": "dXNlci1kYXRh"}},
+		},
+		{
+			name:         "Rebuilds a volume-backed instance",
+			instanceSpec: InstanceSpec{UserData: "dXNlci1kYXRh", RootVolume: &infrav1.RootVolume{SizeGiB: 10}},
+			microversion: clients.NovaRebuildVolumeBacked,
+			wantBody:     map[string]any{"rebuild": map[string]any{"imageRef": imageID, "user_data": "dXNlci1kYXRh"}},
+		},
+		{
+			name:         "Microversion not supported",
+			instanceSpec: InstanceSpec{RootVolume: &infrav1.RootVolume{SizeGiB: 10}},
+			microversion: clients.NovaRebuildVolumeBacked,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			log := testr.New(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			computeRecorder := mockScopeFactory.ComputeClient.EXPECT()
+
+			if tt.microversion != "" {
+				if tt.wantErr {
+					computeRecorder.WithMicroversion(tt.microversion).Return(nil, errors.New("microversion not supported"))
+				} else {
+					computeRecorder.WithMicroversion(tt.microversion).Return(mockScopeFactory.ComputeClient, nil)
+				}
+			}
+			if !tt.wantErr {
+				computeRecorder.RebuildServer(serverID, gomock.Any()).
+					DoAndReturn(func(_ string, opts servers.RebuildOptsBuilder) (*servers.Server, error) {
+						body, err := opts.ToServerRebuildMap()
+						g.Expect(err).NotTo(HaveOccurred())
+						g.Expect(body).To(Equal(tt.wantBody))
+						return &servers.Server{ID: serverID}, nil
+					})
+			}
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			instanceStatus := NewInstanceStatusFromServer(&servers.Server{ID: serverID, Name: "test-server"}, log)
+			err = s.RebuildInstance(&infrav1alpha1.OpenStackServer{}, instanceStatus, &tt.instanceSpec, imageID)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
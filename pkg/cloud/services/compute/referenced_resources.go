@@ -30,6 +30,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 // ResolveServerSpec is responsible for populating a ResolvedServerSpec from
@@ -73,11 +74,17 @@ func ResolveServerSpec(ctx context.Context, scope *scope.WithLogger, k8sClient c
 		if spec.ServerGroup == nil || resolved.ServerGroupID != "" {
 			return true, false, nil
 		}
-		serverGroupID, err := computeService.GetServerGroupID(spec.ServerGroup)
+		serverGroupID, err := computeService.GetServerGroupID(ctx, k8sClient, openStackServer.Namespace, spec.ServerGroup)
 		if err != nil {
 			return false, false, err
 		}
-		resolved.ServerGroupID = serverGroupID
+
+		// If we didn't get a serverGroupID it means we're waiting on a dependency.
+		// Wait to be called again.
+		if serverGroupID == nil {
+			return false, false, nil
+		}
+		resolved.ServerGroupID = *serverGroupID
 		return true, true, nil
 	}
 
@@ -105,11 +112,22 @@ func ResolveServerSpec(ctx context.Context, scope *scope.WithLogger, k8sClient c
 			return true, false, nil
 		}
 
-		flavorID, err := computeService.GetFlavorID(spec.FlavorID, spec.Flavor)
+		flavorID, err := computeService.GetFlavorID(spec.FlavorID, spec.Flavor, spec.FlavorSelector, spec.Accelerators)
 		if err != nil {
 			return false, false, err
 		}
 
+		// A flavor chosen by a selector already provides the accelerators
+		if spec.Accelerators != nil && (spec.FlavorID != nil || spec.Flavor != nil) {
+			flavorAccelerators, err := computeService.GetFlavorAccelerators(flavorID)
+			if err != nil {
+				return false, false, err
+			}
+			if err := flavorAccelerators.Provides(spec.Accelerators); err != nil {
+				return false, false, capoerrors.Terminal(infrav1.InvalidMachineSpecReason, fmt.Sprintf("flavor %s: %v", flavorID, err))
+			}
+		}
+
 		resolved.FlavorID = flavorID
 		return true, true, nil
 	}
@@ -140,11 +158,51 @@ func ResolveServerSpec(ctx context.Context, scope *scope.WithLogger, k8sClient c
 		return true, true, nil
 	}
 
+	// Volumes are resolved individually so that the volumes of additional
+	// block devices which are hot-attached later are also resolved.
+	volumes := func() (bool, bool, error) {
+		blockDevices := make(map[string]*infrav1.BlockDeviceVolume, 1+len(spec.AdditionalBlockDevices))
+		names := make([]string, 0, 1+len(spec.AdditionalBlockDevices))
+		if spec.RootVolume != nil {
+			blockDevices[infrav1alpha1.RootVolumeName] = &spec.RootVolume.BlockDeviceVolume
+			names = append(names, infrav1alpha1.RootVolumeName)
+		}
+		for i := range spec.AdditionalBlockDevices {
+			blockDevice := &spec.AdditionalBlockDevices[i]
+			if blockDevice.Storage.Type == infrav1.VolumeBlockDevice {
+				blockDevices[blockDevice.Name] = blockDevice.Storage.Volume
+				names = append(names, blockDevice.Name)
+			}
+		}
+		requireEncryption := ptr.Deref(spec.RequireEncryptedVolumes, false)
+
+		changed := false
+		var errs []error
+		for _, name := range names {
+			if slices.ContainsFunc(resolved.Volumes, func(volume infrav1alpha1.ResolvedVolumeSpec) bool {
+				return volume.Name == name
+			}) {
+				continue
+			}
+
+			volume, err := computeService.ResolveVolumeSpec(name, blockDevices[name], requireEncryption)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if volume != nil {
+				resolved.Volumes = append(resolved.Volumes, *volume)
+				changed = true
+			}
+		}
+		return len(errs) == 0, changed, errors.Join(errs...)
+	}
+
 	// Execute all setters and collate their return values
 	var errs []error
 	changed := false
 	done := true
-	for _, setter := range []setterFn{serverGroup, imageID, flavorID, ports} {
+	for _, setter := range []setterFn{serverGroup, imageID, flavorID, ports, volumes} {
 		thisDone, thisChanged, err := setter()
 		changed = changed || thisChanged
 		done = done && thisDone
@@ -24,6 +24,9 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
@@ -48,6 +51,9 @@ func Test_ResolveServerSpec(t *testing.T) {
 		networkID2     = "cc8f75ce-6ce4-4b8a-836e-e5dac91cc9c8"
 		subnetID       = "32dc0e7f-34b6-4544-a69b-248955618736"
 		flavorID       = "661c21bc-be52-44e3-9d2e-8d1e11623b59"
+		snapshotID     = "5bb5e7a1-4f4b-4a1a-9e0c-5a3f2c1a6f1e"
+		volumeID       = "8a5fd0e4-3c9a-4f2d-b1b5-2f0ad7c6e0a4"
+		volumeTypeID   = "0b6d1f0c-86a8-4c3f-9d1f-7f3e8a1f3a52"
 	)
 
 	defaultPortSpec := []infrav1.ResolvedPortSpec{
@@ -73,6 +79,7 @@ func Test_ResolveServerSpec(t *testing.T) {
 		expectComputeMock    func(m *mock.MockComputeClientMockRecorder)
 		expectImageMock      func(m *mock.MockImageClientMockRecorder)
 		expectNetworkMock    func(m *mock.MockNetworkClientMockRecorder)
+		expectVolumeMock     func(m *mock.MockVolumeClientMockRecorder)
 		before               *infrav1alpha1.ResolvedServerSpec
 		want                 *infrav1alpha1.ResolvedServerSpec
 		wantErr              bool
@@ -135,6 +142,44 @@ func Test_ResolveServerSpec(t *testing.T) {
 			want:    &infrav1alpha1.ResolvedServerSpec{},
 			wantErr: true,
 		},
+		{
+			testName: "Flavor by selector",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:          infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorSelector: &infrav1.FlavorSelector{MinVCPUs: ptr.To[int32](4)},
+				Ports:          defaultPortOpts,
+			},
+			expectComputeMock: func(m *mock.MockComputeClientMockRecorder) {
+				m.ListFlavors().Return([]flavors.Flavor{
+					{ID: "small", VCPUs: 2},
+					{ID: flavorID, VCPUs: 4},
+					{ID: "large", VCPUs: 8},
+				}, nil)
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID:  imageID1,
+				FlavorID: flavorID,
+				Ports:    defaultPortSpec,
+			},
+		},
+		{
+			testName: "Flavor without required accelerators",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Accelerators: &infrav1.AcceleratorRequirements{
+					PCIAliases: []infrav1.PCIAliasRequirement{{Name: "a100", Count: 1}},
+				},
+				Ports: defaultPortOpts,
+			},
+			expectComputeMock: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetFlavorExtraSpecs(flavorID).Return(map[string]string{"pci_passthrough:alias": "t4:1"}, nil)
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID: imageID1,
+			},
+			wantErr: true,
+		},
 		{
 			testName: "Flavor by Name not found",
 			spec: infrav1alpha1.OpenStackServerSpec{
@@ -147,6 +192,240 @@ func Test_ResolveServerSpec(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			testName: "Volume sources",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						SnapshotRef: &infrav1.VolumeSnapshotParam{
+							Filter: &infrav1.VolumeSnapshotFilter{Name: ptr.To("golden")},
+						},
+					},
+				},
+				AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{
+					{
+						Name:    "etcd",
+						SizeGiB: 10,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: infrav1.VolumeBlockDevice,
+							Volume: &infrav1.BlockDeviceVolume{
+								VolumeRef: &infrav1.VolumeParam{
+									Filter: &infrav1.VolumeFilter{Name: ptr.To("etcd-seed")},
+								},
+							},
+						},
+					},
+					{
+						Name:    "data",
+						SizeGiB: 10,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: infrav1.VolumeBlockDevice,
+						},
+					},
+				},
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListSnapshots(snapshots.ListOpts{Name: "golden"}).Return([]snapshots.Snapshot{{ID: snapshotID}}, nil)
+				m.ListVolumes(volumes.ListOpts{Name: "etcd-seed"}).Return([]volumes.Volume{{ID: volumeID}}, nil)
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID:  imageID1,
+				FlavorID: flavorID,
+				Ports:    defaultPortSpec,
+				Volumes: []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", SnapshotID: snapshotID},
+					{Name: "etcd", VolumeID: volumeID},
+				},
+			},
+		},
+		{
+			testName: "Volume sources only resolved once",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						SnapshotRef: &infrav1.VolumeSnapshotParam{
+							Filter: &infrav1.VolumeSnapshotFilter{Name: ptr.To("golden")},
+						},
+					},
+				},
+			},
+			before: &infrav1alpha1.ResolvedServerSpec{
+				Volumes: []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", SnapshotID: snapshotID},
+				},
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID:  imageID1,
+				FlavorID: flavorID,
+				Ports:    defaultPortSpec,
+				Volumes: []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", SnapshotID: snapshotID},
+				},
+			},
+		},
+		{
+			testName: "Volume type resolved",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						Type:        "multiattach-type",
+						Multiattach: ptr.To(true),
+					},
+				},
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumeTypes(volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: volumeTypeID, Name: "multiattach-type", ExtraSpecs: map[string]string{"multiattach": "<is> True"}},
+				}, nil)
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID:  imageID1,
+				FlavorID: flavorID,
+				Ports:    defaultPortSpec,
+				Volumes: []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", VolumeTypeID: volumeTypeID, Multiattach: true},
+				},
+			},
+		},
+		{
+			testName: "Volume type does not support multiattach",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						Type:        "single-attach-type",
+						Multiattach: ptr.To(true),
+					},
+				},
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumeTypes(volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: volumeTypeID, Name: "single-attach-type"},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			testName: "Volume type not found",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						Type: "missing-type",
+					},
+				},
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumeTypes(volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			testName: "Volume type resolved when encryption is required",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						Type: "encrypted-type",
+					},
+				},
+				RequireEncryptedVolumes: ptr.To(true),
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumeTypes(volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: volumeTypeID, Name: "encrypted-type"},
+				}, nil)
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID:  imageID1,
+				FlavorID: flavorID,
+				Ports:    defaultPortSpec,
+				Volumes: []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", VolumeTypeID: volumeTypeID},
+				},
+			},
+		},
+		{
+			testName: "Volume scheduler hints",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						SchedulerHints: &infrav1.VolumeSchedulerHints{
+							DifferentHost: []infrav1.VolumeParam{
+								{Filter: &infrav1.VolumeFilter{Name: ptr.To("other-root")}},
+							},
+						},
+					},
+				},
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListVolumes(volumes.ListOpts{Name: "other-root"}).Return([]volumes.Volume{{ID: volumeID}}, nil)
+			},
+			want: &infrav1alpha1.ResolvedServerSpec{
+				ImageID:  imageID1,
+				FlavorID: flavorID,
+				Ports:    defaultPortSpec,
+				Volumes: []infrav1alpha1.ResolvedVolumeSpec{
+					{Name: "root", DifferentHostVolumeIDs: []string{volumeID}},
+				},
+			},
+		},
+		{
+			testName: "Volume type required when encryption is required",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:                   infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID:                ptr.To(flavorID),
+				Ports:                   defaultPortOpts,
+				RootVolume:              &infrav1.RootVolume{SizeGiB: 50},
+				RequireEncryptedVolumes: ptr.To(true),
+			},
+			wantErr: true,
+		},
+		{
+			testName: "Volume snapshot by Name not found",
+			spec: infrav1alpha1.OpenStackServerSpec{
+				Image:    infrav1.ImageParam{ID: ptr.To(imageID1)},
+				FlavorID: ptr.To(flavorID),
+				Ports:    defaultPortOpts,
+				RootVolume: &infrav1.RootVolume{
+					SizeGiB: 50,
+					BlockDeviceVolume: infrav1.BlockDeviceVolume{
+						SnapshotRef: &infrav1.VolumeSnapshotParam{
+							Filter: &infrav1.VolumeSnapshotFilter{Name: ptr.To("golden")},
+						},
+					},
+				},
+			},
+			expectVolumeMock: func(m *mock.MockVolumeClientMockRecorder) {
+				m.ListSnapshots(snapshots.ListOpts{Name: "golden"}).Return([]snapshots.Snapshot{}, nil)
+			},
+			wantErr: true,
+		},
 	}
 	for i, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -165,6 +444,9 @@ func Test_ResolveServerSpec(t *testing.T) {
 			if tt.expectNetworkMock != nil {
 				tt.expectNetworkMock(mockScopeFactory.NetworkClient.EXPECT())
 			}
+			if tt.expectVolumeMock != nil {
+				tt.expectVolumeMock(mockScopeFactory.VolumeClient.EXPECT())
+			}
 
 			resources := tt.before
 			if resources == nil {
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// StartInstance starts a SHUTOFF instance.
+func (s *Service) StartInstance(eventObject runtime.Object, instanceStatus *InstanceStatus) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	err := s.getComputeClient().StartServer(instance.ID)
+	if err != nil {
+		record.Warnf(eventObject, "FailedStartServer", "Failed to start server %s with id %s: %v", instance.Name, instance.ID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulStartServer", "Started server %s with id %s", instance.Name, instance.ID)
+	return nil
+}
+
+// HardRebootInstance hard reboots an instance. This is the only way to
+// recover an instance in ERROR.
+func (s *Service) HardRebootInstance(eventObject runtime.Object, instanceStatus *InstanceStatus) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	err := s.getComputeClient().RebootServer(instance.ID, servers.RebootOpts{Type: servers.HardReboot})
+	if err != nil {
+		record.Warnf(eventObject, "FailedRebootServer", "Failed to hard reboot server %s with id %s: %v", instance.Name, instance.ID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulRebootServer", "Hard rebooted server %s with id %s", instance.Name, instance.ID)
+	return nil
+}
+
+// UnpauseInstance unpauses a PAUSED instance.
+func (s *Service) UnpauseInstance(eventObject runtime.Object, instanceStatus *InstanceStatus) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	err := s.getComputeClient().UnpauseServer(instance.ID)
+	if err != nil {
+		record.Warnf(eventObject, "FailedUnpauseServer", "Failed to unpause server %s with id %s: %v", instance.Name, instance.ID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulUnpauseServer", "Unpaused server %s with id %s", instance.Name, instance.ID)
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// ResizeInstance starts resizing an instance to the given flavor. Nova moves
+// the instance to VERIFY_RESIZE once it has been resized, after which the
+// resize must be confirmed or reverted.
+func (s *Service) ResizeInstance(eventObject runtime.Object, instanceStatus *InstanceStatus, flavorID string) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	err := s.getComputeClient().ResizeServer(instance.ID, servers.ResizeOpts{FlavorRef: flavorID})
+	if err != nil {
+		record.Warnf(eventObject, "FailedResizeServer", "Failed to resize server %s with id %s to flavor %s: %v", instance.Name, instance.ID, flavorID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulResizeServer", "Started resizing server %s with id %s to flavor %s", instance.Name, instance.ID, flavorID)
+	return nil
+}
+
+// ConfirmResizeInstance confirms the resize of an instance in VERIFY_RESIZE.
+func (s *Service) ConfirmResizeInstance(eventObject runtime.Object, instanceStatus *InstanceStatus) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	err := s.getComputeClient().ConfirmResizeServer(instance.ID)
+	if err != nil {
+		record.Warnf(eventObject, "FailedConfirmResizeServer", "Failed to confirm resize of server %s with id %s: %v", instance.Name, instance.ID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulConfirmResizeServer", "Confirmed resize of server %s with id %s to flavor %s", instance.Name, instance.ID, instanceStatus.FlavorID())
+	return nil
+}
+
+// RevertResizeInstance reverts the resize of an instance in VERIFY_RESIZE to
+// its previous flavor.
+func (s *Service) RevertResizeInstance(eventObject runtime.Object, instanceStatus *InstanceStatus) error {
+	instance := instanceStatus.InstanceIdentifier()
+
+	err := s.getComputeClient().RevertResizeServer(instance.ID)
+	if err != nil {
+		record.Warnf(eventObject, "FailedRevertResizeServer", "Failed to revert resize of server %s with id %s: %v", instance.Name, instance.ID, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulRevertResizeServer", "Reverted resize of server %s with id %s", instance.Name, instance.ID)
+	return nil
+}
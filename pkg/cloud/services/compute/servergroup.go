@@ -17,33 +17,132 @@ limitations under the License.
 package compute
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
-// GetServerGroupID looks up a server group using the passed filter and returns
-// its ID. It'll return an error when server group is not found or there are multiple.
-func (s *Service) GetServerGroupID(serverGroupParam *infrav1.ServerGroupParam) (string, error) {
+// GetServerGroupID looks up a server group using the passed filter or
+// reference and returns its ID. It'll return an error when server group is not
+// found or there are multiple. It returns nil if the referenced
+// OpenStackServerGroup is not ready yet.
+func (s *Service) GetServerGroupID(ctx context.Context, k8sClient client.Client, namespace string, serverGroupParam *infrav1.ServerGroupParam) (*string, error) {
 	if serverGroupParam.ID != nil {
-		return *serverGroupParam.ID, nil
+		return serverGroupParam.ID, nil
+	}
+
+	if serverGroupParam.ServerGroupRef != nil {
+		return getServerGroupIDByReference(ctx, k8sClient, namespace, serverGroupParam.ServerGroupRef)
 	}
 
 	if serverGroupParam.Filter == nil || serverGroupParam.Filter.Name == nil {
 		// Should have been caught by validation
-		return "", errors.New("server group param is empty")
+		return nil, errors.New("server group param is empty")
 	}
 
 	// otherwise fallback to looking up by name, which is slower
 	serverGroup, err := s.getServerGroupByName(*serverGroupParam.Filter.Name)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return &serverGroup.ID, nil
+}
+
+func getServerGroupIDByReference(ctx context.Context, k8sClient client.Client, namespace string, ref *infrav1.ResourceReference) (*string, error) {
+	serverGroup := &infrav1alpha1.OpenStackServerGroup{}
+	err := k8sClient.Get(ctx, client.ObjectKey{
+		Namespace: namespace,
+		Name:      ref.Name,
+	}, serverGroup)
+	if err != nil {
+		// Not an error if it doesn't exist yet
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !serverGroup.DeletionTimestamp.IsZero() {
+		return nil, capoerrors.Terminal(infrav1.DependencyFailedReason, "OpenStackServerGroup "+serverGroup.Namespace+"/"+serverGroup.Name+" is being deleted")
+	}
+
+	if !serverGroup.Status.Ready || serverGroup.Status.ID == "" {
+		return nil, nil
+	}
+
+	return &serverGroup.Status.ID, nil
+}
+
+// GetOrCreateServerGroup returns the server group with the given name,
+// creating it with the given policy if it does not exist.
+func (s *Service) GetOrCreateServerGroup(eventObject runtime.Object, name string, policy infrav1alpha1.ServerGroupPolicy, maxServerPerHost *int32) (*servergroups.ServerGroup, error) {
+	allServerGroups, err := s.getComputeClient().ListServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	for i := range allServerGroups {
+		if allServerGroups[i].Name == name {
+			return &allServerGroups[i], nil
+		}
+	}
+
+	compute := s.getComputeClient()
+	createOpts := servergroups.CreateOpts{Name: name}
+	if maxServerPerHost != nil {
+		computeWithRules, err := compute.WithMicroversion(clients.NovaServerGroupRules)
+		if err != nil {
+			return nil, fmt.Errorf("server group rules are not supported by the server: %w", err)
+		}
+		compute = computeWithRules
+		createOpts.Policy = string(policy)
+		createOpts.Rules = &servergroups.Rules{MaxServerPerHost: int(*maxServerPerHost)}
+	} else {
+		createOpts.Policies = []string{string(policy)}
+	}
+
+	serverGroup, err := compute.CreateServerGroup(createOpts)
+	if err != nil {
+		record.Warnf(eventObject, "FailedCreateServerGroup", "Failed to create server group %s: %v", name, err)
+		return nil, err
+	}
+
+	record.Eventf(eventObject, "SuccessfulCreateServerGroup", "Created server group %s with id %s", name, serverGroup.ID)
+	return serverGroup, nil
+}
+
+// GetServerGroup returns the server group with the given ID, or nil if it
+// does not exist.
+func (s *Service) GetServerGroup(serverGroupID string) (*servergroups.ServerGroup, error) {
+	serverGroup, err := s.getComputeClient().GetServerGroup(serverGroupID)
+	if capoerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return serverGroup, err
+}
+
+// DeleteServerGroup deletes the server group with the given ID.
+func (s *Service) DeleteServerGroup(eventObject runtime.Object, serverGroupID string) error {
+	err := s.getComputeClient().DeleteServerGroup(serverGroupID)
+	if err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(eventObject, "FailedDeleteServerGroup", "Failed to delete server group %s: %v", serverGroupID, err)
+		return err
 	}
 
-	return serverGroup.ID, nil
+	record.Eventf(eventObject, "SuccessfulDeleteServerGroup", "Deleted server group %s", serverGroupID)
+	return nil
 }
 
 func (s *Service) getServerGroupByName(serverGroupName string) (*servergroups.ServerGroup, error) {
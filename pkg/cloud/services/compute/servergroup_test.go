@@ -17,15 +17,24 @@ limitations under the License.
 package compute
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	. "github.com/onsi/gomega" //nolint:revive
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 )
@@ -34,9 +43,18 @@ func TestService_GetServerGroupID(t *testing.T) {
 	const serverGroupID1 = "ce96e584-7ebc-46d6-9e55-987d72e3806c"
 	const serverGroupID2 = "8f536889-5198-42d7-8314-cb78f4f4755c"
 
+	readyServerGroup := &infrav1alpha1.OpenStackServerGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "test-namespace"},
+		Status:     infrav1alpha1.OpenStackServerGroupStatus{Ready: true, ID: serverGroupID1},
+	}
+	pendingServerGroup := &infrav1alpha1.OpenStackServerGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "test-namespace"},
+	}
+
 	tests := []struct {
 		testName         string
 		serverGroupParam *infrav1.ServerGroupParam
+		fakeObjects      []client.Object
 		expect           func(m *mock.MockComputeClientMockRecorder)
 		want             string
 		wantErr          bool
@@ -48,6 +66,29 @@ func TestService_GetServerGroupID(t *testing.T) {
 			want:             serverGroupID1,
 			wantErr:          false,
 		},
+		{
+			testName:         "Return server group ID from a ready reference",
+			serverGroupParam: &infrav1.ServerGroupParam{ServerGroupRef: &infrav1.ResourceReference{Name: "ready"}},
+			fakeObjects:      []client.Object{readyServerGroup, pendingServerGroup},
+			expect:           func(*mock.MockComputeClientMockRecorder) {},
+			want:             serverGroupID1,
+			wantErr:          false,
+		},
+		{
+			testName:         "Wait for a reference which is not ready",
+			serverGroupParam: &infrav1.ServerGroupParam{ServerGroupRef: &infrav1.ResourceReference{Name: "pending"}},
+			fakeObjects:      []client.Object{readyServerGroup, pendingServerGroup},
+			expect:           func(*mock.MockComputeClientMockRecorder) {},
+			want:             "",
+			wantErr:          false,
+		},
+		{
+			testName:         "Wait for a reference which does not exist",
+			serverGroupParam: &infrav1.ServerGroupParam{ServerGroupRef: &infrav1.ResourceReference{Name: "missing"}},
+			expect:           func(*mock.MockComputeClientMockRecorder) {},
+			want:             "",
+			wantErr:          false,
+		},
 		{
 			testName:         "Return error if empty filter is given",
 			serverGroupParam: &infrav1.ServerGroupParam{},
@@ -115,14 +156,96 @@ func TestService_GetServerGroupID(t *testing.T) {
 			}
 			tt.expect(mockScopeFactory.ComputeClient.EXPECT())
 
-			got, err := s.GetServerGroupID(tt.serverGroupParam)
+			scheme := runtime.NewScheme()
+			_ = infrav1alpha1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.fakeObjects...).Build()
+
+			got, err := s.GetServerGroupID(context.TODO(), fakeClient, "test-namespace", tt.serverGroupParam)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.getServerGroupID() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
+			if ptr.Deref(got, "") != tt.want {
 				t.Errorf("Service.getServerGroupID() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestService_GetOrCreateServerGroup(t *testing.T) {
+	const (
+		serverGroupID   = "ce96e584-7ebc-46d6-9e55-987d72e3806c"
+		serverGroupName = "test-namespace-test-server-group"
+	)
+
+	tests := []struct {
+		name             string
+		policy           infrav1alpha1.ServerGroupPolicy
+		maxServerPerHost *int32
+		expect           func(r *mock.MockComputeClientMockRecorder, m *mock.MockComputeClient)
+		wantErr          bool
+	}{
+		{
+			name:   "Adopts an existing server group",
+			policy: infrav1alpha1.ServerGroupPolicyAntiAffinity,
+			expect: func(r *mock.MockComputeClientMockRecorder, _ *mock.MockComputeClient) {
+				r.ListServerGroups().Return([]servergroups.ServerGroup{{ID: serverGroupID, Name: serverGroupName}}, nil)
+			},
+		},
+		{
+			name:   "Creates a server group with a policy",
+			policy: infrav1alpha1.ServerGroupPolicySoftAntiAffinity,
+			expect: func(r *mock.MockComputeClientMockRecorder, _ *mock.MockComputeClient) {
+				r.ListServerGroups().Return(nil, nil)
+				r.CreateServerGroup(servergroups.CreateOpts{
+					Name:     serverGroupName,
+					Policies: []string{"soft-anti-affinity"},
+				}).Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName}, nil)
+			},
+		},
+		{
+			name:             "Creates a server group with a max server per host rule",
+			policy:           infrav1alpha1.ServerGroupPolicyAntiAffinity,
+			maxServerPerHost: ptr.To[int32](2),
+			expect: func(r *mock.MockComputeClientMockRecorder, m *mock.MockComputeClient) {
+				r.ListServerGroups().Return(nil, nil)
+				r.WithMicroversion(clients.NovaServerGroupRules).Return(m, nil)
+				r.CreateServerGroup(servergroups.CreateOpts{
+					Name:   serverGroupName,
+					Policy: "anti-affinity",
+					Rules:  &servergroups.Rules{MaxServerPerHost: 2},
+				}).Return(&servergroups.ServerGroup{ID: serverGroupID, Name: serverGroupName}, nil)
+			},
+		},
+		{
+			name:             "Max server per host rule is not supported",
+			policy:           infrav1alpha1.ServerGroupPolicyAntiAffinity,
+			maxServerPerHost: ptr.To[int32](2),
+			expect: func(r *mock.MockComputeClientMockRecorder, _ *mock.MockComputeClient) {
+				r.ListServerGroups().Return(nil, nil)
+				r.WithMicroversion(clients.NovaServerGroupRules).Return(nil, errors.New("microversion not supported"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			log := testr.New(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockScopeFactory.ComputeClient.EXPECT(), mockScopeFactory.ComputeClient)
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			serverGroup, err := s.GetOrCreateServerGroup(&infrav1alpha1.OpenStackServerGroup{}, serverGroupName, tt.policy, tt.maxServerPerHost)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(serverGroup.ID).To(Equal(serverGroupID))
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	"k8s.io/utils/ptr"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// GetVolumeSnapshotID looks up a volume snapshot using the passed param and
+// returns its ID. It'll return an error when the snapshot is not found or
+// there are multiple.
+func (s *Service) GetVolumeSnapshotID(snapshotParam *infrav1.VolumeSnapshotParam) (string, error) {
+	if snapshotParam.ID != nil {
+		return *snapshotParam.ID, nil
+	}
+
+	if snapshotParam.Filter.IsZero() {
+		// Should have been caught by validation
+		return "", errors.New("volume snapshot param is empty")
+	}
+
+	listOpts := snapshots.ListOpts{
+		Name:     ptr.Deref(snapshotParam.Filter.Name, ""),
+		VolumeID: ptr.Deref(snapshotParam.Filter.VolumeID, ""),
+	}
+	snapshotList, err := s.getVolumeClient().ListSnapshots(listOpts)
+	if err != nil {
+		return "", fmt.Errorf("error listing snapshots: %w", err)
+	}
+
+	switch len(snapshotList) {
+	case 0:
+		return "", fmt.Errorf("no volume snapshot matching filter %+v could be found", listOpts)
+	case 1:
+		return snapshotList[0].ID, nil
+	default:
+		return "", fmt.Errorf("too many volume snapshots matching filter %+v were found", listOpts)
+	}
+}
+
+// GetVolumeID looks up a volume using the passed param and returns its ID.
+// It'll return an error when the volume is not found or there are multiple.
+func (s *Service) GetVolumeID(volumeParam *infrav1.VolumeParam) (string, error) {
+	if volumeParam.ID != nil {
+		return *volumeParam.ID, nil
+	}
+
+	if volumeParam.Filter.IsZero() {
+		// Should have been caught by validation
+		return "", errors.New("volume param is empty")
+	}
+
+	listOpts := volumes.ListOpts{
+		Name: ptr.Deref(volumeParam.Filter.Name, ""),
+	}
+	volumeList, err := s.getVolumeClient().ListVolumes(listOpts)
+	if err != nil {
+		return "", fmt.Errorf("error listing volumes: %w", err)
+	}
+
+	switch len(volumeList) {
+	case 0:
+		return "", fmt.Errorf("no volume matching filter %+v could be found", listOpts)
+	case 1:
+		return volumeList[0].ID, nil
+	default:
+		return "", fmt.Errorf("too many volumes matching filter %+v were found", listOpts)
+	}
+}
+
+// ResolveVolumeSpec resolves the references of a volume. It returns nil if
+// the volume has no references to resolve. Volume types which don't exist or
+// don't match the multiattach requirement of the volume are terminal errors.
+// Whether the volume type is encrypted is only checked once the volume
+// exists, see checkVolumeEncryption.
+func (s *Service) ResolveVolumeSpec(name string, volumeOpts *infrav1.BlockDeviceVolume, requireEncryption bool) (*infrav1alpha1.ResolvedVolumeSpec, error) {
+	if volumeOpts == nil {
+		volumeOpts = &infrav1.BlockDeviceVolume{}
+	}
+
+	if requireEncryption && volumeOpts.Type == "" {
+		return nil, capoerrors.Terminal(infrav1.InvalidMachineSpecReason, fmt.Sprintf("volume %s must set a volume type because encrypted volumes are required", name))
+	}
+
+	resolved := &infrav1alpha1.ResolvedVolumeSpec{Name: name}
+
+	switch {
+	case volumeOpts.SnapshotRef != nil:
+		snapshotID, err := s.GetVolumeSnapshotID(volumeOpts.SnapshotRef)
+		if err != nil {
+			return nil, fmt.Errorf("resolving snapshot of volume %s: %w", name, err)
+		}
+		resolved.SnapshotID = snapshotID
+	case volumeOpts.VolumeRef != nil:
+		volumeID, err := s.GetVolumeID(volumeOpts.VolumeRef)
+		if err != nil {
+			return nil, fmt.Errorf("resolving source volume of volume %s: %w", name, err)
+		}
+		resolved.VolumeID = volumeID
+	}
+
+	if volumeOpts.Type != "" {
+		volumeType, err := s.getVolumeType(volumeOpts.Type)
+		if err != nil {
+			return nil, fmt.Errorf("resolving volume type of volume %s: %w", name, err)
+		}
+		resolved.VolumeTypeID = volumeType.ID
+		resolved.Multiattach = volumeTypeSupportsMultiattach(volumeType)
+
+		if volumeOpts.Multiattach != nil && *volumeOpts.Multiattach != resolved.Multiattach {
+			return nil, capoerrors.Terminal(infrav1.InvalidMachineSpecReason, fmt.Sprintf("volume %s requires multiattach=%t but volume type %s has multiattach=%t", name, *volumeOpts.Multiattach, volumeOpts.Type, resolved.Multiattach))
+		}
+	}
+
+	if hints := volumeOpts.SchedulerHints; hints != nil {
+		for i := range hints.SameHost {
+			volumeID, err := s.GetVolumeID(&hints.SameHost[i])
+			if err != nil {
+				return nil, fmt.Errorf("resolving same host volume of volume %s: %w", name, err)
+			}
+			resolved.SameHostVolumeIDs = append(resolved.SameHostVolumeIDs, volumeID)
+		}
+		for i := range hints.DifferentHost {
+			volumeID, err := s.GetVolumeID(&hints.DifferentHost[i])
+			if err != nil {
+				return nil, fmt.Errorf("resolving different host volume of volume %s: %w", name, err)
+			}
+			resolved.DifferentHostVolumeIDs = append(resolved.DifferentHostVolumeIDs, volumeID)
+		}
+	}
+
+	if reflect.DeepEqual(resolved, &infrav1alpha1.ResolvedVolumeSpec{Name: name}) {
+		return nil, nil
+	}
+	return resolved, nil
+}
+
+// getVolumeType returns the volume type with the given name or ID.
+func (s *Service) getVolumeType(nameOrID string) (*volumetypes.VolumeType, error) {
+	volumeTypes, err := s.getVolumeClient().ListVolumeTypes(volumetypes.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing volume types: %w", err)
+	}
+
+	for i := range volumeTypes {
+		if volumeTypes[i].ID == nameOrID || volumeTypes[i].Name == nameOrID {
+			return &volumeTypes[i], nil
+		}
+	}
+	return nil, capoerrors.Terminal(infrav1.InvalidMachineSpecReason, fmt.Sprintf("volume type %s does not exist", nameOrID))
+}
+
+// volumeTypeSupportsMultiattach returns true if volumes of the volume type can
+// be attached to multiple servers.
+func volumeTypeSupportsMultiattach(volumeType *volumetypes.VolumeType) bool {
+	return strings.EqualFold(strings.TrimSpace(volumeType.ExtraSpecs["multiattach"]), "<is> True")
+}
+
+// checkVolumeEncryption returns a terminal error if the instance requires
+// encrypted volumes but the volume of the block device is not encrypted.
+// Cinder sets the encrypted attribute of a volume from its volume type. Unlike
+// the encryption type of a volume type, it can be read without the admin role.
+func checkVolumeEncryption(instanceSpec *InstanceSpec, name string, volume *volumes.Volume) error {
+	if !instanceSpec.RequireEncryptedVolumes || volume.Encrypted {
+		return nil
+	}
+	return capoerrors.Terminal(infrav1.InvalidMachineSpecReason, fmt.Sprintf("volume %s must use an encrypted volume type but volume %s of type %s is not encrypted", name, volume.ID, volume.VolumeType))
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/volumeattach"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// ReconcileVolumeAttachments attaches the volumes of the additional block
+// devices of type Volume in instanceSpec to the running server instance, and
+// detaches the volumes of block devices which were removed from it. Only
+// volumes recorded in resources.Volumes are ever detached, so volumes attached
+// to the server by other means are left alone, and of those only the volumes
+// which were created to be attached are deleted once detached.
+// It returns true if any volume is still being attached or detached.
+func (s *Service) ReconcileVolumeAttachments(eventObject runtime.Object, instanceSpec *InstanceSpec, instanceID string, resources *infrav1alpha1.ServerResources) (bool, error) {
+	attachmentList, err := s.getComputeClient().ListVolumeAttachments(instanceID)
+	if err != nil {
+		return false, fmt.Errorf("listing volume attachments of server %s: %w", instanceID, err)
+	}
+	attachments := make(map[string]*volumeattach.VolumeAttachment, len(attachmentList))
+	for i := range attachmentList {
+		attachments[attachmentList[i].VolumeID] = &attachmentList[i]
+	}
+
+	inProgress := false
+	var errs []error
+	volumeStatuses := make([]infrav1alpha1.ServerVolumeStatus, 0, len(instanceSpec.AdditionalBlockDevices))
+	wanted := make(map[string]struct{}, len(instanceSpec.AdditionalBlockDevices))
+	previous := make(map[string]*infrav1alpha1.ServerVolumeStatus, len(resources.Volumes))
+	for i := range resources.Volumes {
+		previous[resources.Volumes[i].Name] = &resources.Volumes[i]
+	}
+
+	for i := range instanceSpec.AdditionalBlockDevices {
+		blockDeviceSpec := &instanceSpec.AdditionalBlockDevices[i]
+		if blockDeviceSpec.Storage.Type != infrav1.VolumeBlockDevice {
+			continue
+		}
+		wanted[blockDeviceSpec.Name] = struct{}{}
+
+		volumeStatus, err := s.reconcileVolumeAttachment(eventObject, instanceSpec, blockDeviceSpec, instanceID, attachments, previous[blockDeviceSpec.Name])
+		if err != nil {
+			// A volume which can't be attached is still recorded so that it
+			// is deleted with the server if it was created here.
+			if volumeStatus == nil {
+				return false, err
+			}
+			errs = append(errs, err)
+		}
+		if volumeStatus.State != infrav1alpha1.VolumeAttachmentStateAttached {
+			inProgress = true
+		}
+		volumeStatuses = append(volumeStatuses, *volumeStatus)
+	}
+
+	for i := range resources.Volumes {
+		volumeStatus := resources.Volumes[i]
+		if _, ok := wanted[volumeStatus.Name]; ok {
+			continue
+		}
+
+		done, err := s.reconcileVolumeDetachment(eventObject, instanceID, &volumeStatus, attachments)
+		if err != nil {
+			return false, err
+		}
+		if !done {
+			inProgress = true
+			volumeStatuses = append(volumeStatuses, volumeStatus)
+		}
+	}
+
+	resources.Volumes = volumeStatuses
+	return inProgress, errors.Join(errs...)
+}
+
+// reconcileVolumeAttachment creates the volume of a block device if it
+// doesn't exist and attaches it to the server once it is available. A volume
+// is only recorded as created if it was created here, or previously recorded
+// as such. A volume which is not encrypted although the instance requires
+// encrypted volumes is returned together with an error and is not attached.
+func (s *Service) reconcileVolumeAttachment(eventObject runtime.Object, instanceSpec *InstanceSpec, blockDeviceSpec *infrav1.AdditionalBlockDevice, instanceID string, attachments map[string]*volumeattach.VolumeAttachment, previous *infrav1alpha1.ServerVolumeStatus) (*infrav1alpha1.ServerVolumeStatus, error) {
+	description := fmt.Sprintf("Additional block device for %s", instanceSpec.Name)
+	volume, created, err := s.getOrCreateVolumeBuilder(eventObject, instanceSpec, blockDeviceSpec, "", description)
+	if err != nil {
+		return nil, fmt.Errorf("error creating volume %s: %w", blockDeviceSpec.Name, err)
+	}
+
+	volumeStatus := &infrav1alpha1.ServerVolumeStatus{
+		Name:     blockDeviceSpec.Name,
+		VolumeID: volume.ID,
+		State:    infrav1alpha1.VolumeAttachmentStateAttaching,
+		Created:  created || (previous != nil && previous.VolumeID == volume.ID && previous.Created),
+	}
+
+	if attachment, ok := attachments[volume.ID]; ok {
+		volumeStatus.Device = attachment.Device
+		volumeStatus.State = infrav1alpha1.VolumeAttachmentStateAttached
+		return volumeStatus, nil
+	}
+
+	if err := checkVolumeEncryption(instanceSpec, blockDeviceSpec.Name, volume); err != nil {
+		return volumeStatus, err
+	}
+
+	switch volume.Status {
+	case "available":
+	case "error":
+		return nil, fmt.Errorf("volume %s is in error state", volume.ID)
+	default:
+		// The volume is still being created, or the attachment is in progress.
+		return volumeStatus, nil
+	}
+
+	s.scope.Logger().Info("Attaching volume to server", "name", volume.Name, "volumeID", volume.ID, "serverID", instanceID)
+	_, err = s.getComputeClient().CreateVolumeAttachment(instanceID, volumeattach.CreateOpts{VolumeID: volume.ID})
+	if err != nil {
+		record.Warnf(eventObject, "FailedAttachVolume", "Failed to attach volume %s with id %s: %v", volume.Name, volume.ID, err)
+		return nil, err
+	}
+	record.Eventf(eventObject, "SuccessfulAttachVolume", "Attached volume %s with id %s", volume.Name, volume.ID)
+
+	return volumeStatus, nil
+}
+
+// reconcileVolumeDetachment detaches the volume of a block device which was
+// removed from the spec, and deletes it once it is detached if it was created
+// to be attached. It returns true when the volume is detached, and deleted if
+// it is deleted.
+func (s *Service) reconcileVolumeDetachment(eventObject runtime.Object, instanceID string, volumeStatus *infrav1alpha1.ServerVolumeStatus, attachments map[string]*volumeattach.VolumeAttachment) (bool, error) {
+	if _, ok := attachments[volumeStatus.VolumeID]; ok {
+		if volumeStatus.State != infrav1alpha1.VolumeAttachmentStateDetaching {
+			s.scope.Logger().Info("Detaching volume from server", "name", volumeStatus.Name, "volumeID", volumeStatus.VolumeID, "serverID", instanceID)
+			if err := s.getComputeClient().DeleteVolumeAttachment(instanceID, volumeStatus.VolumeID); err != nil {
+				record.Warnf(eventObject, "FailedDetachVolume", "Failed to detach volume %s with id %s: %v", volumeStatus.Name, volumeStatus.VolumeID, err)
+				return false, err
+			}
+			record.Eventf(eventObject, "SuccessfulDetachVolume", "Detached volume %s with id %s", volumeStatus.Name, volumeStatus.VolumeID)
+		}
+		volumeStatus.State = infrav1alpha1.VolumeAttachmentStateDetaching
+		volumeStatus.Device = ""
+		return false, nil
+	}
+
+	if !volumeStatus.Created {
+		return true, nil
+	}
+
+	volumeStatus.State = infrav1alpha1.VolumeAttachmentStateDetaching
+	volumeStatus.Device = ""
+	return s.deleteServerVolume(eventObject, volumeStatus)
+}
+
+// deleteServerVolume deletes a detached volume. It returns true when the
+// volume is gone, and false if it is still in use or being deleted.
+func (s *Service) deleteServerVolume(eventObject runtime.Object, volumeStatus *infrav1alpha1.ServerVolumeStatus) (bool, error) {
+	volume, err := s.getVolumeClient().GetVolume(volumeStatus.VolumeID)
+	if err != nil {
+		if capoerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	switch volume.Status {
+	case "available", "error":
+	default:
+		// The volume is still being detached, or is already being deleted.
+		return false, nil
+	}
+
+	s.scope.Logger().Info("Deleting volume", "name", volume.Name, "volumeID", volume.ID)
+	if err := s.getVolumeClient().DeleteVolume(volume.ID, volumes.DeleteOpts{}); err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(eventObject, "FailedDeleteVolume", "Failed to delete volume %s with id %s: %v", volume.Name, volume.ID, err)
+		return false, err
+	}
+	record.Eventf(eventObject, "SuccessfulDeleteVolume", "Deleted volume %s with id %s", volume.Name, volume.ID)
+	return false, nil
+}
+
+// DeleteServerVolumes deletes the hot-attached volumes of a server which has
+// been deleted. Unlike the volumes attached when the server was created, they
+// are not deleted together with the server. Volumes which were not created to
+// be attached are left alone. It returns true once all of the created volumes
+// are gone.
+func (s *Service) DeleteServerVolumes(eventObject runtime.Object, volumeStatuses []infrav1alpha1.ServerVolumeStatus) (bool, error) {
+	done := true
+	for i := range volumeStatuses {
+		if !volumeStatuses[i].Created {
+			continue
+		}
+		deleted, err := s.deleteServerVolume(eventObject, &volumeStatuses[i])
+		if err != nil {
+			return false, err
+		}
+		if !deleted {
+			done = false
+		}
+	}
+	return done, nil
+}
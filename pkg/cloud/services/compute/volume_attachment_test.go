@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/volumeattach"
+	. "github.com/onsi/gomega" //nolint:revive
+	"go.uber.org/mock/gomock"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func TestService_ReconcileVolumeAttachments(t *testing.T) {
+	const (
+		serverID   = "ce96e584-7ebc-46d6-9e55-987d72e3806c"
+		serverName = "test-server"
+		volumeID   = "2d8a7bb6-aa0a-4a37-a6f0-4b0a3b6e2c58"
+		oldVolume  = "9a1b3f4e-6c2d-4e8f-a0b1-c2d3e4f5a6b7"
+	)
+
+	dataBlockDevice := infrav1.AdditionalBlockDevice{
+		Name:    "data",
+		SizeGiB: 10,
+		Storage: infrav1.BlockDeviceStorage{
+			Type: infrav1.VolumeBlockDevice,
+		},
+	}
+	localBlockDevice := infrav1.AdditionalBlockDevice{
+		Name:    "ephemeral",
+		SizeGiB: 1,
+		Storage: infrav1.BlockDeviceStorage{
+			Type: infrav1.LocalBlockDevice,
+		},
+	}
+
+	tests := []struct {
+		name              string
+		blockDevices      []infrav1.AdditionalBlockDevice
+		requireEncryption bool
+		volumes           []infrav1alpha1.ServerVolumeStatus
+		expect            func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder)
+		wantInProgress    bool
+		wantVolumes       []infrav1alpha1.ServerVolumeStatus
+		wantErr           bool
+	}{
+		{
+			name:         "Creates and attaches a new volume",
+			blockDevices: []infrav1.AdditionalBlockDevice{dataBlockDevice, localBlockDevice},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).Return(nil, nil)
+				volume.CreateVolume(volumes.CreateOpts{
+					Name:        serverName + "-data",
+					Description: "Additional block device for " + serverName,
+					Size:        10,
+				}, nil).Return(&volumes.Volume{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "creating"}, nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching, Created: true},
+			},
+		},
+		{
+			name:         "Attaches an available volume",
+			blockDevices: []infrav1.AdditionalBlockDevice{dataBlockDevice},
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching, Created: true},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).
+					Return([]volumes.Volume{{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "available"}}, nil)
+				compute.CreateVolumeAttachment(serverID, volumeattach.CreateOpts{VolumeID: volumeID}).
+					Return(&volumeattach.VolumeAttachment{VolumeID: volumeID, ServerID: serverID}, nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching, Created: true},
+			},
+		},
+		{
+			name:         "Reports an attached volume",
+			blockDevices: []infrav1.AdditionalBlockDevice{dataBlockDevice},
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).
+					Return([]volumeattach.VolumeAttachment{{VolumeID: volumeID, ServerID: serverID, Device: "/dev/vdb"}}, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).
+					Return([]volumes.Volume{{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "in-use"}}, nil)
+			},
+			wantInProgress: false,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, Device: "/dev/vdb", State: infrav1alpha1.VolumeAttachmentStateAttached},
+			},
+		},
+		{
+			name: "Detaches a removed volume",
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, Device: "/dev/vdc", State: infrav1alpha1.VolumeAttachmentStateAttached},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, _ *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).
+					Return([]volumeattach.VolumeAttachment{{VolumeID: oldVolume, ServerID: serverID, Device: "/dev/vdc"}}, nil)
+				compute.DeleteVolumeAttachment(serverID, oldVolume).Return(nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching},
+			},
+		},
+		{
+			name: "Waits for a removed volume to be detached",
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching, Created: true},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.GetVolume(oldVolume).Return(&volumes.Volume{ID: oldVolume, Status: "detaching"}, nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching, Created: true},
+			},
+		},
+		{
+			name: "Deletes a detached volume",
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching, Created: true},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.GetVolume(oldVolume).Return(&volumes.Volume{ID: oldVolume, Status: "available"}, nil)
+				volume.DeleteVolume(oldVolume, volumes.DeleteOpts{}).Return(nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching, Created: true},
+			},
+		},
+		{
+			name: "Removes a deleted volume from the status",
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching, Created: true},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.GetVolume(oldVolume).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+			},
+			wantInProgress: false,
+			wantVolumes:    []infrav1alpha1.ServerVolumeStatus{},
+		},
+		{
+			name: "Does not delete a detached volume which was not created",
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "logs", VolumeID: oldVolume, State: infrav1alpha1.VolumeAttachmentStateDetaching},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, _ *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+			},
+			wantInProgress: false,
+			wantVolumes:    []infrav1alpha1.ServerVolumeStatus{},
+		},
+		{
+			name:         "Does not record an existing volume as created",
+			blockDevices: []infrav1.AdditionalBlockDevice{dataBlockDevice},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).
+					Return([]volumes.Volume{{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "creating"}}, nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching},
+			},
+		},
+		{
+			name:         "Volume in error state",
+			blockDevices: []infrav1.AdditionalBlockDevice{dataBlockDevice},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).
+					Return([]volumes.Volume{{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "error"}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Records but does not attach an unencrypted volume when encryption is required",
+			blockDevices:      []infrav1.AdditionalBlockDevice{dataBlockDevice},
+			requireEncryption: true,
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).Return(nil, nil)
+				volume.CreateVolume(volumes.CreateOpts{
+					Name:        serverName + "-data",
+					Description: "Additional block device for " + serverName,
+					Size:        10,
+				}, nil).Return(&volumes.Volume{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "available"}, nil)
+			},
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching, Created: true},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Attaches an encrypted volume when encryption is required",
+			blockDevices:      []infrav1.AdditionalBlockDevice{dataBlockDevice},
+			requireEncryption: true,
+			volumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching, Created: true},
+			},
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.ListVolumeAttachments(serverID).Return(nil, nil)
+				volume.ListVolumes(volumes.ListOpts{Name: serverName + "-data"}).
+					Return([]volumes.Volume{{ID: volumeID, Name: serverName + "-data", Size: 10, Status: "available", Encrypted: true}}, nil)
+				compute.CreateVolumeAttachment(serverID, volumeattach.CreateOpts{VolumeID: volumeID}).
+					Return(&volumeattach.VolumeAttachment{VolumeID: volumeID, ServerID: serverID}, nil)
+			},
+			wantInProgress: true,
+			wantVolumes: []infrav1alpha1.ServerVolumeStatus{
+				{Name: "data", VolumeID: volumeID, State: infrav1alpha1.VolumeAttachmentStateAttaching, Created: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			log := testr.New(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			tt.expect(mockScopeFactory.ComputeClient.EXPECT(), mockScopeFactory.VolumeClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			instanceSpec := &InstanceSpec{
+				Name:                    serverName,
+				AdditionalBlockDevices:  tt.blockDevices,
+				RequireEncryptedVolumes: tt.requireEncryption,
+			}
+			resources := &infrav1alpha1.ServerResources{Volumes: tt.volumes}
+
+			inProgress, err := s.ReconcileVolumeAttachments(&infrav1alpha1.OpenStackServer{}, instanceSpec, serverID, resources)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				if tt.wantVolumes != nil {
+					g.Expect(resources.Volumes).To(Equal(tt.wantVolumes))
+				}
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(inProgress).To(Equal(tt.wantInProgress))
+			g.Expect(resources.Volumes).To(Equal(tt.wantVolumes))
+		})
+	}
+}
+
+func TestService_DeleteServerVolumes(t *testing.T) {
+	const (
+		volumeID1 = "2d8a7bb6-aa0a-4a37-a6f0-4b0a3b6e2c58"
+		volumeID2 = "9a1b3f4e-6c2d-4e8f-a0b1-c2d3e4f5a6b7"
+		volumeID3 = "0f5e8c1d-3b7a-4d2e-9c6f-1a2b3c4d5e6f"
+	)
+
+	// The volume which was not created to be attached is never looked up.
+	volumeStatuses := []infrav1alpha1.ServerVolumeStatus{
+		{Name: "data", VolumeID: volumeID1, State: infrav1alpha1.VolumeAttachmentStateAttached, Created: true},
+		{Name: "logs", VolumeID: volumeID2, State: infrav1alpha1.VolumeAttachmentStateAttached, Created: true},
+		{Name: "shared", VolumeID: volumeID3, State: infrav1alpha1.VolumeAttachmentStateAttached},
+	}
+
+	tests := []struct {
+		name     string
+		expect   func(m *mock.MockVolumeClientMockRecorder)
+		wantDone bool
+		wantErr  bool
+	}{
+		{
+			name: "Volumes are gone",
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.GetVolume(volumeID1).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+				m.GetVolume(volumeID2).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+			},
+			wantDone: true,
+		},
+		{
+			name: "Deletes available volumes",
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.GetVolume(volumeID1).Return(&volumes.Volume{ID: volumeID1, Status: "available"}, nil)
+				m.DeleteVolume(volumeID1, volumes.DeleteOpts{}).Return(nil)
+				m.GetVolume(volumeID2).Return(&volumes.Volume{ID: volumeID2, Status: "deleting"}, nil)
+			},
+			wantDone: false,
+		},
+		{
+			name: "Delete API returns error",
+			expect: func(m *mock.MockVolumeClientMockRecorder) {
+				m.GetVolume(volumeID1).Return(&volumes.Volume{ID: volumeID1, Status: "available"}, nil)
+				m.DeleteVolume(volumeID1, volumes.DeleteOpts{}).Return(gophercloud.ErrUnexpectedResponseCode{Actual: 500})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			log := testr.New(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			tt.expect(mockScopeFactory.VolumeClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			done, err := s.DeleteServerVolumes(&infrav1alpha1.OpenStackServer{}, volumeStatuses)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(done).To(Equal(tt.wantDone))
+		})
+	}
+}
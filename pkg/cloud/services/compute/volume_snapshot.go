@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// GetServerVolume returns the volume created for the root volume or an
+// additional block device of a server, or nil if it doesn't exist.
+func (s *Service) GetServerVolume(instanceName string, nameSuffix string) (*volumes.Volume, error) {
+	return s.getVolumeByName(volumeName(instanceName, nameSuffix))
+}
+
+// GetOrCreateVolumeSnapshot returns the snapshot or backup of a volume with
+// the given name, creating it if it doesn't exist. Volumes attached to a
+// server are copied while in use, so the copy is crash-consistent. Cinder
+// bases an incremental backup on the last available backup of the volume, so
+// it must only be requested if such a backup exists.
+func (s *Service) GetOrCreateVolumeSnapshot(eventObject runtime.Object, snapshotType infrav1alpha1.VolumeSnapshotType, volume *volumes.Volume, name string, metadata map[string]string, incremental bool) (*infrav1alpha1.VolumeSnapshotStatus, error) {
+	if snapshotType == infrav1alpha1.VolumeSnapshotTypeBackup {
+		return s.getOrCreateVolumeBackup(eventObject, volume, name, metadata, incremental)
+	}
+
+	snapshotList, err := s.getVolumeClient().ListSnapshots(snapshots.ListOpts{
+		Name:     name,
+		VolumeID: volume.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing snapshots: %w", err)
+	}
+	if len(snapshotList) > 1 {
+		return nil, fmt.Errorf("expected to find a single snapshot called %s; found %d", name, len(snapshotList))
+	}
+
+	var snapshot *snapshots.Snapshot
+	if len(snapshotList) == 1 {
+		snapshot = &snapshotList[0]
+		s.scope.Logger().V(3).Info("Using existing snapshot", "name", name, "id", snapshot.ID)
+	} else {
+		snapshot, err = s.getVolumeClient().CreateSnapshot(snapshots.CreateOpts{
+			VolumeID:    volume.ID,
+			Force:       true,
+			Name:        name,
+			Description: fmt.Sprintf("Snapshot of volume %s", volume.Name),
+			Metadata:    metadata,
+		})
+		if err != nil {
+			record.Warnf(eventObject, "FailedCreateSnapshot", "Failed to create snapshot %s of volume %s: %v", name, volume.ID, err)
+			return nil, err
+		}
+		record.Eventf(eventObject, "SuccessfulCreateSnapshot", "Created snapshot %s with id %s of volume %s", name, snapshot.ID, volume.ID)
+	}
+
+	return &infrav1alpha1.VolumeSnapshotStatus{
+		ID:        snapshot.ID,
+		Name:      name,
+		VolumeID:  volume.ID,
+		CreatedAt: metav1.NewTime(snapshot.CreatedAt),
+		Status:    snapshot.Status,
+	}, nil
+}
+
+func (s *Service) getOrCreateVolumeBackup(eventObject runtime.Object, volume *volumes.Volume, name string, metadata map[string]string, incremental bool) (*infrav1alpha1.VolumeSnapshotStatus, error) {
+	backupList, err := s.getVolumeClient().ListBackups(backups.ListOpts{
+		Name:     name,
+		VolumeID: volume.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing backups: %w", err)
+	}
+	if len(backupList) > 1 {
+		return nil, fmt.Errorf("expected to find a single backup called %s; found %d", name, len(backupList))
+	}
+
+	var backup *backups.Backup
+	if len(backupList) == 1 {
+		// The list only returns a summary of each backup
+		backup, err = s.getVolumeClient().GetBackup(backupList[0].ID)
+		if err != nil {
+			return nil, err
+		}
+		incremental = backup.IsIncremental
+		s.scope.Logger().V(3).Info("Using existing backup", "name", name, "id", backup.ID)
+	} else {
+		backup, err = s.getVolumeClient().CreateBackup(backups.CreateOpts{
+			VolumeID:    volume.ID,
+			Force:       true,
+			Name:        name,
+			Description: fmt.Sprintf("Backup of volume %s", volume.Name),
+			Metadata:    metadata,
+			Incremental: incremental,
+		})
+		if err != nil {
+			record.Warnf(eventObject, "FailedCreateBackup", "Failed to create backup %s of volume %s: %v", name, volume.ID, err)
+			return nil, err
+		}
+		record.Eventf(eventObject, "SuccessfulCreateBackup", "Created backup %s with id %s of volume %s", name, backup.ID, volume.ID)
+	}
+
+	return &infrav1alpha1.VolumeSnapshotStatus{
+		ID:          backup.ID,
+		Name:        name,
+		VolumeID:    volume.ID,
+		CreatedAt:   metav1.NewTime(backup.CreatedAt),
+		Status:      backup.Status,
+		Incremental: incremental,
+	}, nil
+}
+
+// GetVolumeSnapshotStatus returns the status of a snapshot or backup as
+// reported by Cinder, or an empty string if it doesn't exist.
+func (s *Service) GetVolumeSnapshotStatus(snapshotType infrav1alpha1.VolumeSnapshotType, id string) (string, error) {
+	var status string
+	var err error
+	if snapshotType == infrav1alpha1.VolumeSnapshotTypeBackup {
+		var backup *backups.Backup
+		backup, err = s.getVolumeClient().GetBackup(id)
+		if err == nil {
+			status = backup.Status
+		}
+	} else {
+		var snapshot *snapshots.Snapshot
+		snapshot, err = s.getVolumeClient().GetSnapshot(id)
+		if err == nil {
+			status = snapshot.Status
+		}
+	}
+	if capoerrors.IsNotFound(err) {
+		return "", nil
+	}
+	return status, err
+}
+
+// DeleteVolumeSnapshot deletes a snapshot or backup.
+func (s *Service) DeleteVolumeSnapshot(eventObject runtime.Object, snapshotType infrav1alpha1.VolumeSnapshotType, snapshotStatus *infrav1alpha1.VolumeSnapshotStatus) error {
+	if snapshotType == infrav1alpha1.VolumeSnapshotTypeBackup {
+		if err := s.getVolumeClient().DeleteBackup(snapshotStatus.ID); err != nil && !capoerrors.IsNotFound(err) {
+			record.Warnf(eventObject, "FailedDeleteBackup", "Failed to delete backup %s with id %s: %v", snapshotStatus.Name, snapshotStatus.ID, err)
+			return err
+		}
+		record.Eventf(eventObject, "SuccessfulDeleteBackup", "Deleted backup %s with id %s", snapshotStatus.Name, snapshotStatus.ID)
+		return nil
+	}
+
+	if err := s.getVolumeClient().DeleteSnapshot(snapshotStatus.ID); err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(eventObject, "FailedDeleteSnapshot", "Failed to delete snapshot %s with id %s: %v", snapshotStatus.Name, snapshotStatus.ID, err)
+		return err
+	}
+	record.Eventf(eventObject, "SuccessfulDeleteSnapshot", "Deleted snapshot %s with id %s", snapshotStatus.Name, snapshotStatus.ID)
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
+)
+
+// isAdoptedLoadBalancer returns true if the API server load balancer is an
+// existing load balancer referenced by the spec rather than one created by CAPO.
+func isAdoptedLoadBalancer(openStackCluster *infrav1.OpenStackCluster) bool {
+	return openStackCluster.Spec.APIServerLoadBalancer != nil && openStackCluster.Spec.APIServerLoadBalancer.LoadBalancerRef != nil
+}
+
+// getLoadBalancerObjectTags returns the tags of the listeners, pools, members
+// and L7 policies CAPO creates on the API server load balancer. Objects on an
+// adopted load balancer are additionally tagged with the owning
+// OpenStackCluster so that they can be told apart from the objects managed by
+// the owner of the load balancer.
+func getLoadBalancerObjectTags(openStackCluster *infrav1.OpenStackCluster) []string {
+	if !isAdoptedLoadBalancer(openStackCluster) {
+		return openStackCluster.Spec.Tags
+	}
+
+	tags := make([]string, 0, len(openStackCluster.Spec.Tags)+1)
+	tags = append(tags, openStackCluster.Spec.Tags...)
+	return append(tags, getAdoptedLoadBalancerOwnerTag(openStackCluster))
+}
+
+func getAdoptedLoadBalancerOwnerTag(openStackCluster *infrav1.OpenStackCluster) string {
+	return fmt.Sprintf("cluster-api-provider-openstack:%s/%s", openStackCluster.Namespace, openStackCluster.Name)
+}
+
+// getAdoptedAPILoadBalancer returns the load balancer referenced by the spec.
+// Once it has been adopted, the load balancer is looked up by the ID in the
+// status so that a filter which later matches another load balancer doesn't
+// move the cluster to it.
+func (s *Service) getAdoptedAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster) (*loadbalancers.LoadBalancer, error) {
+	lbStatus := openStackCluster.Status.APIServerLoadBalancer
+	if lbStatus != nil && lbStatus.Adopted && lbStatus.ID != "" {
+		return s.loadbalancerClient.GetLoadBalancer(lbStatus.ID)
+	}
+
+	param := openStackCluster.Spec.APIServerLoadBalancer.LoadBalancerRef
+	if param.ID != nil {
+		lb, err := s.loadbalancerClient.GetLoadBalancer(*param.ID)
+		if capoerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("load balancer %s: %w", *param.ID, capoerrors.ErrNoMatches)
+		}
+		return lb, err
+	}
+
+	if param.Filter == nil {
+		// Should have been caught by validation
+		return nil, errors.New("load balancer ref: both id and filter are nil")
+	}
+
+	lbList, err := s.loadbalancerClient.ListLoadBalancers(filterconvert.LoadBalancerFilterToListOpts(param.Filter))
+	if err != nil {
+		return nil, err
+	}
+	if len(lbList) == 0 {
+		return nil, fmt.Errorf("load balancer ref: %w", capoerrors.ErrNoMatches)
+	}
+	if len(lbList) > 1 {
+		return nil, fmt.Errorf("load balancer ref: %w", capoerrors.ErrMultipleMatches)
+	}
+	return &lbList[0], nil
+}
+
+// getAPILoadBalancer returns the API server load balancer of the cluster, or
+// nil if it doesn't exist.
+func (s *Service) getAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, loadBalancerName string) (*loadbalancers.LoadBalancer, error) {
+	if !isAdoptedLoadBalancer(openStackCluster) {
+		return s.checkIfLbExists(loadBalancerName)
+	}
+
+	lbStatus := openStackCluster.Status.APIServerLoadBalancer
+	if lbStatus == nil || lbStatus.ID == "" {
+		// The load balancer was never adopted.
+		return nil, nil
+	}
+	lb, err := s.loadbalancerClient.GetLoadBalancer(lbStatus.ID)
+	if capoerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return lb, err
+}
+
+// reconcileAdoptedLoadBalancerNetwork sets the network of the adopted load
+// balancer in the status from its VIP, as it can't be taken from the spec.
+func (s *Service) reconcileAdoptedLoadBalancerNetwork(openStackCluster *infrav1.OpenStackCluster, lb *loadbalancers.LoadBalancer) error {
+	lbStatus := openStackCluster.Status.APIServerLoadBalancer
+	if lbStatus.LoadBalancerNetwork != nil && lbStatus.LoadBalancerNetwork.ID == lb.VipNetworkID {
+		return nil
+	}
+
+	network, err := s.networkingService.GetNetworkByID(lb.VipNetworkID)
+	if err != nil {
+		return fmt.Errorf("failed to get VIP network of load balancer %s: %w", lb.ID, err)
+	}
+	subnet, err := s.networkingService.GetSubnetByParam(&infrav1.SubnetParam{ID: &lb.VipSubnetID})
+	if err != nil {
+		return fmt.Errorf("failed to get VIP subnet of load balancer %s: %w", lb.ID, err)
+	}
+
+	lbStatus.LoadBalancerNetwork = &infrav1.NetworkStatusWithSubnets{
+		NetworkStatus: infrav1.NetworkStatus{
+			Name: network.Name,
+			ID:   network.ID,
+			Tags: network.Tags,
+		},
+		Subnets: []infrav1.Subnet{
+			{
+				Name: subnet.Name,
+				ID:   subnet.ID,
+				CIDR: subnet.CIDR,
+				Tags: subnet.Tags,
+			},
+		},
+	}
+	return nil
+}
+
+// getAdoptedLoadBalancerFloatingIP returns the floating IP of the VIP port of
+// an adopted load balancer. CAPO never manages the floating IP of an adopted
+// load balancer, so it is empty if the VIP has none.
+func (s *Service) getAdoptedLoadBalancerFloatingIP(lb *loadbalancers.LoadBalancer) (string, error) {
+	if lb.VipPortID == "" {
+		return "", nil
+	}
+	fip, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
+	if err != nil || fip == nil {
+		return "", err
+	}
+	return fip.FloatingIP, nil
+}
+
+// deleteAdoptedLoadBalancerObjects deletes the listeners and pools CAPO
+// created on an adopted load balancer, which are found by the owner tag of the
+// cluster. Their L7 policies, members and health monitors are deleted by
+// Octavia together with them. The load balancer itself is left in place.
+func (s *Service) deleteAdoptedLoadBalancerObjects(openStackCluster *infrav1.OpenStackCluster, loadBalancerName string) error {
+	lb, err := s.getAPILoadBalancer(openStackCluster, loadBalancerName)
+	if err != nil || lb == nil {
+		return err
+	}
+
+	ownerTags := []string{getAdoptedLoadBalancerOwnerTag(openStackCluster)}
+
+	listenerList, err := s.loadbalancerClient.ListListeners(listeners.ListOpts{LoadbalancerID: lb.ID, Tags: ownerTags})
+	if err != nil {
+		return err
+	}
+	for i := range listenerList {
+		listener := &listenerList[i]
+		s.scope.Logger().Info("Deleting load balancer listener", "name", listener.Name, "id", listener.ID)
+		if _, err := s.waitForLoadBalancerActive(lb.ID); err != nil {
+			return err
+		}
+		if err := s.loadbalancerClient.DeleteListener(listener.ID); err != nil && !capoerrors.IsNotFound(err) {
+			record.Warnf(openStackCluster, "FailedDeleteListener", "Failed to delete listener %s with id %s: %v", listener.Name, listener.ID, err)
+			return err
+		}
+		record.Eventf(openStackCluster, "SuccessfulDeleteListener", "Deleted listener %s with id %s", listener.Name, listener.ID)
+	}
+
+	poolList, err := s.loadbalancerClient.ListPools(pools.ListOpts{LoadbalancerID: lb.ID, Tags: ownerTags})
+	if err != nil {
+		return err
+	}
+	for i := range poolList {
+		pool := &poolList[i]
+		s.scope.Logger().Info("Deleting load balancer pool", "name", pool.Name, "id", pool.ID)
+		if _, err := s.waitForLoadBalancerActive(lb.ID); err != nil {
+			return err
+		}
+		if err := s.loadbalancerClient.DeletePool(pool.ID); err != nil && !capoerrors.IsNotFound(err) {
+			record.Warnf(openStackCluster, "FailedDeletePool", "Failed to delete pool %s with id %s: %v", pool.Name, pool.ID, err)
+			return err
+		}
+		record.Eventf(openStackCluster, "SuccessfulDeletePool", "Deleted pool %s with id %s", pool.Name, pool.ID)
+	}
+
+	_, err = s.waitForLoadBalancerActive(lb.ID)
+	return err
+}
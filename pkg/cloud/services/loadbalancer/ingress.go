@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+const ingressLBSuffix string = "ingress"
+
+// defaultIngressPorts are the ports forwarded to the worker nodes if none are
+// given in the spec, sync with the doc comment of IngressLoadBalancerExtensionsSpec.Ports.
+var defaultIngressPorts = []int{80, 443}
+
+// ReconcileIngressLoadBalancer reconciles the load balancer which forwards
+// ingress traffic to the worker nodes of the cluster, and records its VIP and
+// floating IP in the given status.
+func (s *Service) ReconcileIngressLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, ingressStatus *infrav1.ClusterVIPStatus) error {
+	if openStackCluster.Status.Network == nil || len(openStackCluster.Status.Network.Subnets) == 0 {
+		return errors.New("network is not yet available in OpenStackCluster.Status")
+	}
+
+	loadBalancerName := getIngressLoadBalancerName(clusterResourceName)
+	s.scope.Logger().Info("Reconciling ingress load balancer", "name", loadBalancerName)
+
+	lb, err := s.getOrCreateIngressLoadBalancer(openStackCluster, clusterResourceName)
+	if err != nil {
+		return err
+	}
+	ingressStatus.VIP = lb.VipAddress
+
+	if lb.ProvisioningStatus != loadBalancerProvisioningStatusActive {
+		lbID := lb.ID
+		lb, err = s.waitForLoadBalancerActive(lbID)
+		if err != nil {
+			return fmt.Errorf("load balancer %q with id %s is not active after timeout: %v", loadBalancerName, lbID, err)
+		}
+	}
+
+	// Clusters without an external network only get the internal VIP.
+	if openStackCluster.Status.ExternalNetwork != nil {
+		var floatingIPAddress *string
+		if ingressStatus.FloatingIP != "" {
+			floatingIPAddress = &ingressStatus.FloatingIP
+		}
+
+		fp, err := s.networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIPAddress)
+		if err != nil {
+			return err
+		}
+
+		// Write the floating IP to the status immediately so we won't
+		// create a new floating IP on the next reconcile if something
+		// fails below.
+		ingressStatus.FloatingIP = fp.FloatingIP
+
+		if err = s.networkingService.AssociateFloatingIP(openStackCluster, fp, lb.VipPortID); err != nil {
+			return err
+		}
+	}
+
+	for _, port := range getIngressPorts(openStackCluster) {
+		if err := s.reconcileIngressLoadBalancerListener(lb, openStackCluster, loadBalancerName, port); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getOrCreateIngressLoadBalancer returns the existing ingress load balancer if it already exists,
+// or creates a new one on the first subnet of the cluster network if it does not.
+func (s *Service) getOrCreateIngressLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*loadbalancers.LoadBalancer, error) {
+	loadBalancerName := getIngressLoadBalancerName(clusterResourceName)
+	lb, err := s.checkIfLbExists(loadBalancerName)
+	if err != nil {
+		return nil, err
+	}
+	if lb != nil {
+		return lb, nil
+	}
+
+	vipSubnetID := openStackCluster.Status.Network.Subnets[0].ID
+	s.scope.Logger().Info("Creating ingress load balancer in subnet", "subnetID", vipSubnetID, "name", loadBalancerName)
+
+	lb, err = s.loadbalancerClient.CreateLoadBalancer(loadbalancers.CreateOpts{
+		Name:        loadBalancerName,
+		VipSubnetID: vipSubnetID,
+		Description: names.GetDescription(clusterResourceName),
+		Tags:        openStackCluster.Spec.Tags,
+	})
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedCreateLoadBalancer", "Failed to create load balancer %s: %v", loadBalancerName, err)
+		return nil, err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulCreateLoadBalancer", "Created load balancer %s with id %s", loadBalancerName, lb.ID)
+	return lb, nil
+}
+
+// reconcileIngressLoadBalancerListener ensures that the TCP listener on the given port exists
+// together with its pool and health monitor.
+func (s *Service) reconcileIngressLoadBalancerListener(lb *loadbalancers.LoadBalancer, openStackCluster *infrav1.OpenStackCluster, loadBalancerName string, port int) error {
+	lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+
+	listener, err := s.getOrCreateListener(openStackCluster, lb.ID, listeners.CreateOpts{
+		Name:           lbPortObjectsName,
+		Protocol:       listeners.ProtocolTCP,
+		ProtocolPort:   port,
+		LoadbalancerID: lb.ID,
+		Tags:           openStackCluster.Spec.Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	pool, err := s.getOrCreatePool(openStackCluster, lb.ID, pools.CreateOpts{
+		Name:       lbPortObjectsName,
+		Protocol:   pools.ProtocolTCP,
+		LBMethod:   getDefaultLBMethod(lb.Provider),
+		ListenerID: listener.ID,
+		Tags:       openStackCluster.Spec.Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.ensureMonitor(openStackCluster, lbPortObjectsName, pool.ID, lb.ID, monitorConfig{Type: monitors.TypeTCP})
+}
+
+// ReconcileIngressLoadBalancerMember ensures that the worker machine is a member of all pools of the ingress load balancer.
+func (s *Service) ReconcileIngressLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName, ip string) error {
+	loadBalancerName := getIngressLoadBalancerName(clusterResourceName)
+	s.scope.Logger().Info("Reconciling ingress load balancer member", "loadBalancerName", loadBalancerName)
+
+	lb, err := s.checkIfLbExists(loadBalancerName)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		return errors.New("ingress load balancer does not exist yet")
+	}
+
+	// The load balancer is created on the cluster network, so the members need no subnet.
+	for _, port := range getIngressPorts(openStackCluster) {
+		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+		if err := s.reconcilePoolMember(openStackCluster, lb.ID, lbPortObjectsName, openStackMachine.Name, ip, port, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteIngressLoadBalancerMember removes the machine from all pools of the ingress load balancer.
+func (s *Service) DeleteIngressLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) error {
+	if openStackMachine == nil {
+		return errors.New("openStackMachine is nil")
+	}
+
+	loadBalancerName := getIngressLoadBalancerName(clusterResourceName)
+	lb, err := s.checkIfLbExists(loadBalancerName)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		// nothing to do
+		return nil
+	}
+
+	for _, port := range getIngressPorts(openStackCluster) {
+		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+		if err := s.deletePoolMember(lb.ID, lbPortObjectsName, openStackMachine.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteIngressLoadBalancer deletes the ingress load balancer and its floating IP.
+func (s *Service) DeleteIngressLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*ctrl.Result, error) {
+	return s.deleteLoadBalancer(openStackCluster, getIngressLoadBalancerName(clusterResourceName), nil)
+}
+
+// getIngressPorts returns the ports of the ingress load balancer listeners.
+func getIngressPorts(openStackCluster *infrav1.OpenStackCluster) []int {
+	if ext := openStackCluster.Spec.Extensions; ext != nil && ext.LoadBalancers != nil && ext.LoadBalancers.Ingress != nil && len(ext.LoadBalancers.Ingress.Ports) > 0 {
+		return ext.LoadBalancers.Ingress.Ports
+	}
+	return defaultIngressPorts
+}
+
+func getIngressLoadBalancerName(clusterResourceName string) string {
+	return fmt.Sprintf("%s-cluster-%s-%s", networkPrefix, clusterResourceName, ingressLBSuffix)
+}
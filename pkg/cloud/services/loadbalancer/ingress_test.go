@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	. "github.com/onsi/gomega" //nolint:revive
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_ReconcileIngressLoadBalancer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// Shortcut wait timeout
+	backoffDurationPrev := backoff.Duration
+	backoff.Duration = 0
+	defer func() {
+		backoff.Duration = backoffDurationPrev
+	}()
+
+	const (
+		lbName      = "k8s-clusterapi-cluster-AAAAA-ingress"
+		lbID        = "aaaaaaaa-bbbb-cccc-dddd-333333333333"
+		vipPortID   = "aaaaaaaa-bbbb-cccc-dddd-444444444444"
+		fipID       = "aaaaaaaa-bbbb-cccc-dddd-555555555555"
+		subnetID    = "aaaaaaaa-bbbb-cccc-dddd-222222222222"
+		externalNet = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+	)
+
+	activeLB := loadbalancers.LoadBalancer{
+		ID:                 lbID,
+		Name:               lbName,
+		VipAddress:         "10.0.0.100",
+		VipPortID:          vipPortID,
+		ProvisioningStatus: "ACTIVE",
+	}
+
+	expectExistingListener := func(m *mock.MockLbClientMockRecorder, port string) {
+		name := lbName + "-" + port
+		m.ListListeners(listeners.ListOpts{LoadbalancerID: lbID, Name: name}).Return([]listeners.Listener{{ID: "listener-" + port, Name: name}}, nil)
+		m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: name}).Return([]pools.Pool{{ID: "pool-" + port, Name: name}}, nil)
+		m.ListMonitors(monitors.ListOpts{PoolID: "pool-" + port, Name: name}).Return([]monitors.Monitor{{
+			ID:             "monitor-" + port,
+			Name:           name,
+			Type:           "TCP",
+			Delay:          10,
+			Timeout:        5,
+			MaxRetries:     5,
+			MaxRetriesDown: 3,
+		}}, nil)
+	}
+
+	tests := []struct {
+		name               string
+		openStackCluster   *infrav1.OpenStackCluster
+		expectNetwork      func(m *mock.MockNetworkClientMockRecorder)
+		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
+		wantStatus         infrav1.ClusterVIPStatus
+	}{
+		{
+			name: "should create load balancer with floating IP and listeners on the given ports",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Extensions: &infrav1.OpenStackClusterExtensionsSpec{
+						LoadBalancers: &infrav1.ClusterLoadBalancersExtensionsSpec{
+							Ingress: &infrav1.IngressLoadBalancerExtensionsSpec{
+								Mode:  infrav1.IngressLoadBalancerModeOctavia,
+								Ports: []int{8080},
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					ExternalNetwork: &infrav1.NetworkStatus{ID: externalNet},
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{{ID: subnetID}},
+					},
+				},
+			},
+			expectNetwork: func(m *mock.MockNetworkClientMockRecorder) {
+				fip := floatingips.FloatingIP{ID: fipID, FloatingIP: "203.0.113.10"}
+				m.CreateFloatingIP(floatingips.CreateOpts{
+					FloatingNetworkID: externalNet,
+					Description:       "Created by cluster-api-provider-openstack cluster AAAAA",
+				}).Return(&fip, nil)
+				m.UpdateFloatingIP(fipID, &floatingips.UpdateOpts{PortID: ptr.To(vipPortID)}).Return(&fip, nil)
+				m.GetFloatingIP(fipID).Return(&floatingips.FloatingIP{ID: fipID, Status: "ACTIVE"}, nil)
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return(nil, nil)
+				m.CreateLoadBalancer(loadbalancers.CreateOpts{
+					Name:        lbName,
+					VipSubnetID: subnetID,
+					Description: "Created by cluster-api-provider-openstack cluster AAAAA",
+				}).Return(&activeLB, nil)
+
+				objectsName := lbName + "-8080"
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: lbID, Name: objectsName}).Return(nil, nil)
+				m.CreateListener(listeners.CreateOpts{
+					Name:           objectsName,
+					Protocol:       listeners.ProtocolTCP,
+					ProtocolPort:   8080,
+					LoadbalancerID: lbID,
+				}).Return(&listeners.Listener{ID: "listener-8080", Name: objectsName}, nil)
+				m.GetListener("listener-8080").Return(&listeners.Listener{ID: "listener-8080"}, nil)
+
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: objectsName}).Return(nil, nil)
+				m.CreatePool(pools.CreateOpts{
+					Name:       objectsName,
+					Protocol:   pools.ProtocolTCP,
+					LBMethod:   pools.LBMethodRoundRobin,
+					ListenerID: "listener-8080",
+				}).Return(&pools.Pool{ID: "pool-8080", Name: objectsName}, nil)
+
+				m.ListMonitors(monitors.ListOpts{PoolID: "pool-8080", Name: objectsName}).Return(nil, nil)
+				m.CreateMonitor(monitors.CreateOpts{
+					Name:           objectsName,
+					PoolID:         "pool-8080",
+					Type:           "TCP",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+				}).Return(&monitors.Monitor{ID: "monitor-8080"}, nil)
+
+				// Expect wait for loadbalancer to be active after each creation
+				m.GetLoadBalancer(lbID).Return(&activeLB, nil).Times(3)
+			},
+			wantStatus: infrav1.ClusterVIPStatus{VIP: "10.0.0.100", FloatingIP: "203.0.113.10"},
+		},
+		{
+			name: "should reconcile existing load balancer on the default ports without an external network",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Extensions: &infrav1.OpenStackClusterExtensionsSpec{
+						LoadBalancers: &infrav1.ClusterLoadBalancersExtensionsSpec{
+							Ingress: &infrav1.IngressLoadBalancerExtensionsSpec{
+								Mode: infrav1.IngressLoadBalancerModeOctavia,
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{{ID: subnetID}},
+					},
+				},
+			},
+			expectNetwork: func(*mock.MockNetworkClientMockRecorder) {},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return([]loadbalancers.LoadBalancer{activeLB}, nil)
+				expectExistingListener(m, "80")
+				expectExistingListener(m, "443")
+			},
+			wantStatus: infrav1.ClusterVIPStatus{VIP: "10.0.0.100"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			lbs, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tt.expectNetwork(mockScopeFactory.NetworkClient.EXPECT())
+			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
+
+			var ingressStatus infrav1.ClusterVIPStatus
+			err = lbs.ReconcileIngressLoadBalancer(tt.openStackCluster, "AAAAA", &ingressStatus)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ingressStatus).To(Equal(tt.wantStatus))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// reconcileL7Policies ensures that the L7 policies of a listener match the
+// spec. Policies are positioned in the order of the spec, and policies of the
+// listener which are no longer in the spec are deleted.
+func (s *Service) reconcileL7Policies(openStackCluster *infrav1.OpenStackCluster, lbID, loadBalancerName, lbListenerObjectsName, listenerID string, l7PolicySpecs []infrav1.LoadBalancerL7Policy) error {
+	policyList, err := s.loadbalancerClient.ListL7Policies(l7policies.ListOpts{ListenerID: listenerID})
+	if err != nil {
+		return err
+	}
+
+	existingPolicies := make(map[string]*l7policies.L7Policy, len(policyList))
+	for i := range policyList {
+		existingPolicies[policyList[i].Name] = &policyList[i]
+	}
+
+	desiredNames := make(map[string]struct{}, len(l7PolicySpecs))
+	for i := range l7PolicySpecs {
+		policySpec := &l7PolicySpecs[i]
+		policyName := getListenerObjectsName(lbListenerObjectsName, policySpec.Name)
+		desiredNames[policyName] = struct{}{}
+
+		pool, err := s.checkIfPoolExists(lbID, getListenerObjectsName(loadBalancerName, policySpec.Pool))
+		if err != nil {
+			return err
+		}
+		if pool == nil {
+			return fmt.Errorf("pool %s of L7 policy %s does not exist yet", policySpec.Pool, policySpec.Name)
+		}
+
+		// Octavia positions start at 1.
+		position := int32(i + 1) //nolint:gosec // the number of policies is small
+		if policy, ok := existingPolicies[policyName]; ok {
+			if err := s.updateL7Policy(openStackCluster, lbID, policy, pool.ID, position); err != nil {
+				return err
+			}
+			if err := s.reconcileL7Rules(openStackCluster, lbID, policy, policySpec.Rules); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := s.createL7Policy(openStackCluster, lbID, listenerID, policyName, pool.ID, position, policySpec.Rules); err != nil {
+			return err
+		}
+	}
+
+	for i := range policyList {
+		policy := &policyList[i]
+		if _, ok := desiredNames[policy.Name]; ok || !strings.HasPrefix(policy.Name, lbListenerObjectsName+"-") {
+			continue
+		}
+
+		s.scope.Logger().Info("Deleting load balancer L7 policy", "name", policy.Name, "id", policy.ID)
+		if err := s.loadbalancerClient.DeleteL7Policy(policy.ID); err != nil {
+			record.Warnf(openStackCluster, "FailedDeleteL7Policy", "Failed to delete L7 policy %s with id %s: %v", policy.Name, policy.ID, err)
+			return err
+		}
+		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+			return err
+		}
+		record.Eventf(openStackCluster, "SuccessfulDeleteL7Policy", "Deleted L7 policy %s with id %s", policy.Name, policy.ID)
+	}
+	return nil
+}
+
+func (s *Service) createL7Policy(openStackCluster *infrav1.OpenStackCluster, lbID, listenerID, policyName, poolID string, position int32, ruleSpecs []infrav1.LoadBalancerL7Rule) error {
+	s.scope.Logger().Info("Creating load balancer L7 policy", "name", policyName, "listenerID", listenerID)
+
+	rules := make([]l7policies.CreateRuleOpts, 0, len(ruleSpecs))
+	for i := range ruleSpecs {
+		rules = append(rules, getL7RuleCreateOpts(&ruleSpecs[i], getLoadBalancerObjectTags(openStackCluster)))
+	}
+
+	policy, err := s.loadbalancerClient.CreateL7Policy(l7policies.CreateOpts{
+		Name:           policyName,
+		ListenerID:     listenerID,
+		Action:         l7policies.ActionRedirectToPool,
+		Position:       position,
+		RedirectPoolID: poolID,
+		Rules:          rules,
+		Tags:           getLoadBalancerObjectTags(openStackCluster),
+	})
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedCreateL7Policy", "Failed to create L7 policy %s: %v", policyName, err)
+		return err
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		record.Warnf(openStackCluster, "FailedCreateL7Policy", "Failed to create L7 policy %s with id %s: wait for load balancer active %s: %v", policyName, policy.ID, lbID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulCreateL7Policy", "Created L7 policy %s with id %s", policyName, policy.ID)
+	return nil
+}
+
+// updateL7Policy updates the target pool and the position of an existing L7 policy if they changed.
+func (s *Service) updateL7Policy(openStackCluster *infrav1.OpenStackCluster, lbID string, policy *l7policies.L7Policy, poolID string, position int32) error {
+	if policy.RedirectPoolID == poolID && policy.Position == position {
+		return nil
+	}
+
+	s.scope.Logger().Info("Updating load balancer L7 policy", "name", policy.Name, "poolID", poolID, "position", position)
+
+	if _, err := s.loadbalancerClient.UpdateL7Policy(policy.ID, l7policies.UpdateOpts{
+		Action:         l7policies.ActionRedirectToPool,
+		RedirectPoolID: &poolID,
+		Position:       position,
+	}); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateL7Policy", "Failed to update L7 policy %s with id %s: %v", policy.Name, policy.ID, err)
+		return err
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateL7Policy", "Failed to update L7 policy %s with id %s: wait for load balancer active %s: %v", policy.Name, policy.ID, lbID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulUpdateL7Policy", "Updated L7 policy %s with id %s", policy.Name, policy.ID)
+	return nil
+}
+
+// reconcileL7Rules ensures that the rules of an existing L7 policy match the
+// spec. Missing rules are created and rules which are not in the spec are
+// deleted.
+func (s *Service) reconcileL7Rules(openStackCluster *infrav1.OpenStackCluster, lbID string, policy *l7policies.L7Policy, ruleSpecs []infrav1.LoadBalancerL7Rule) error {
+	ruleList, err := s.loadbalancerClient.ListL7Rules(policy.ID, l7policies.ListRulesOpts{})
+	if err != nil {
+		return err
+	}
+
+	desiredRules := make([]l7policies.CreateRuleOpts, 0, len(ruleSpecs))
+	for i := range ruleSpecs {
+		desiredRules = append(desiredRules, getL7RuleCreateOpts(&ruleSpecs[i], getLoadBalancerObjectTags(openStackCluster)))
+	}
+
+	var obsoleteRules []*l7policies.Rule
+	for i := range ruleList {
+		rule := &ruleList[i]
+		idx := slices.IndexFunc(desiredRules, func(opts l7policies.CreateRuleOpts) bool {
+			return string(opts.RuleType) == rule.RuleType && string(opts.CompareType) == rule.CompareType && opts.Value == rule.Value && opts.Invert == rule.Invert
+		})
+		if idx >= 0 {
+			// The rule already exists, so there is no need to create it.
+			desiredRules = slices.Delete(desiredRules, idx, idx+1)
+			continue
+		}
+		obsoleteRules = append(obsoleteRules, rule)
+	}
+
+	// Rules are combined with a logical AND, so create the missing rules
+	// before deleting obsolete ones: a policy which temporarily matches fewer
+	// requests is safer than one which matches too many.
+	for _, ruleOpts := range desiredRules {
+		s.scope.Logger().Info("Creating load balancer L7 rule", "policy", policy.Name, "type", ruleOpts.RuleType, "value", ruleOpts.Value)
+		if _, err := s.loadbalancerClient.CreateL7Rule(policy.ID, ruleOpts); err != nil {
+			record.Warnf(openStackCluster, "FailedCreateL7Rule", "Failed to create L7 rule of policy %s: %v", policy.Name, err)
+			return err
+		}
+		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range obsoleteRules {
+		s.scope.Logger().Info("Deleting load balancer L7 rule", "policy", policy.Name, "id", rule.ID)
+		if err := s.loadbalancerClient.DeleteL7Rule(policy.ID, rule.ID); err != nil {
+			record.Warnf(openStackCluster, "FailedDeleteL7Rule", "Failed to delete L7 rule %s of policy %s: %v", rule.ID, policy.Name, err)
+			return err
+		}
+		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getL7RuleCreateOpts(ruleSpec *infrav1.LoadBalancerL7Rule, tags []string) l7policies.CreateRuleOpts {
+	return l7policies.CreateRuleOpts{
+		RuleType:    l7policies.RuleType(ruleSpec.Type),
+		CompareType: l7policies.CompareType(cmp.Or(ruleSpec.CompareType, infrav1.LoadBalancerL7RuleCompareTypeEqualTo)),
+		Value:       ruleSpec.Value,
+		Invert:      ruleSpec.Invert,
+		Tags:        tags,
+	}
+}
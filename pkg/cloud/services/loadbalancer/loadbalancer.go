@@ -29,9 +29,13 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilsnet "k8s.io/utils/net"
 	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
@@ -62,6 +66,12 @@ const (
 	defaultMonitorMaxRetriesDown = 3
 )
 
+// Default values for HTTP and HTTPS monitors of listeners.
+const (
+	defaultMonitorURLPath       = "/"
+	defaultMonitorExpectedCodes = "200"
+)
+
 // We wrap the LookupHost function in a variable to allow overriding it in unit tests.
 //
 //nolint:gocritic
@@ -79,7 +89,9 @@ var lookupHost = func(host string) (*string, error) {
 }
 
 // ReconcileLoadBalancer reconciles the load balancer for the given cluster.
-func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, apiServerPort int) (bool, error) {
+// tlsCertificates contains the certificates of the listeners which use a
+// certificate from a Secret, keyed by the name of the Secret.
+func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, apiServerPort int, tlsCertificates map[string]TLSCertificate) (bool, error) {
 	lbSpec := openStackCluster.Spec.APIServerLoadBalancer
 	if !lbSpec.IsEnabled() {
 		return false, nil
@@ -106,6 +118,13 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 	lbStatus.ID = lb.ID
 	lbStatus.InternalIP = lb.VipAddress
 	lbStatus.Tags = lb.Tags
+	lbStatus.Adopted = isAdoptedLoadBalancer(openStackCluster)
+
+	if lbStatus.Adopted {
+		if err := s.reconcileAdoptedLoadBalancerNetwork(openStackCluster, lb); err != nil {
+			return false, err
+		}
+	}
 
 	if lb.ProvisioningStatus != loadBalancerProvisioningStatusActive {
 		var err error
@@ -116,7 +135,13 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 		}
 	}
 
-	if !ptr.Deref(openStackCluster.Spec.DisableAPIServerFloatingIP, false) {
+	if lbStatus.Adopted {
+		floatingIP, err := s.getAdoptedLoadBalancerFloatingIP(lb)
+		if err != nil {
+			return false, err
+		}
+		lbStatus.IP = floatingIP
+	} else if !ptr.Deref(openStackCluster.Spec.DisableAPIServerFloatingIP, false) {
 		floatingIPAddress, err := getAPIServerFloatingIP(openStackCluster)
 		if err != nil {
 			return false, err
@@ -161,6 +186,20 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 		}
 	}
 
+	// Named pools must exist before the L7 policies of the listeners can
+	// route requests to them.
+	for i := range lbSpec.Pools {
+		if err := s.reconcileLoadBalancerPool(lb, openStackCluster, clusterResourceName, &lbSpec.Pools[i]); err != nil {
+			return false, err
+		}
+	}
+
+	for i := range lbSpec.Listeners {
+		if err := s.reconcileLoadBalancerListener(lb, openStackCluster, clusterResourceName, &lbSpec.Listeners[i], tlsCertificates); err != nil {
+			return false, err
+		}
+	}
+
 	return false, nil
 }
 
@@ -276,7 +315,12 @@ func (s *Service) isAllowsCIDRSSupported(lb *loadbalancers.LoadBalancer) (bool,
 }
 
 // getOrCreateAPILoadBalancer returns an existing API loadbalancer if it already exists, or creates a new one if it does not.
+// A load balancer referenced by the spec is adopted and never created.
 func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*loadbalancers.LoadBalancer, error) {
+	if isAdoptedLoadBalancer(openStackCluster) {
+		return s.getAdoptedAPILoadBalancer(openStackCluster)
+	}
+
 	loadBalancerName := getLoadBalancerName(clusterResourceName)
 	lb, err := s.checkIfLbExists(loadBalancerName)
 	if err != nil {
@@ -407,16 +451,34 @@ func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalance
 
 	allowedCIDRs := openStackCluster.Status.APIServerLoadBalancer.AllowedCIDRs
 
-	listener, err := s.getOrCreateListener(openStackCluster, lbPortObjectsName, lb.ID, allowedCIDRs, port)
+	listener, err := s.getOrCreateListener(openStackCluster, lb.ID, listeners.CreateOpts{
+		Name:           lbPortObjectsName,
+		Protocol:       listeners.ProtocolTCP,
+		ProtocolPort:   port,
+		LoadbalancerID: lb.ID,
+		Tags:           getLoadBalancerObjectTags(openStackCluster),
+		AllowedCIDRs:   allowedCIDRs,
+	})
 	if err != nil {
 		return err
 	}
 
-	pool, err := s.getOrCreatePool(openStackCluster, lbPortObjectsName, listener.ID, lb.ID, lb.Provider)
+	pool, err := s.getOrCreatePool(openStackCluster, lb.ID, pools.CreateOpts{
+		Name:       lbPortObjectsName,
+		Protocol:   pools.ProtocolTCP,
+		LBMethod:   getDefaultLBMethod(lb.Provider),
+		ListenerID: listener.ID,
+		Tags:       getLoadBalancerObjectTags(openStackCluster),
+	})
 	if err != nil {
 		return err
 	}
-	if err := s.ensureMonitor(openStackCluster, lbPortObjectsName, pool.ID, lb.ID); err != nil {
+
+	monitorCfg := monitorConfig{Type: monitors.TypeTCP}
+	if openStackCluster.Spec.APIServerLoadBalancer.Monitor != nil {
+		monitorCfg.APIServerLoadBalancerMonitor = *openStackCluster.Spec.APIServerLoadBalancer.Monitor
+	}
+	if err := s.ensureMonitor(openStackCluster, lbPortObjectsName, pool.ID, lb.ID, monitorCfg); err != nil {
 		return err
 	}
 
@@ -431,10 +493,163 @@ func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalance
 	return nil
 }
 
-// getOrCreateListener returns an existing listener for the given loadbalancer
-// and port if it already exists, or creates a new one if it does not.
-func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster, listenerName, lbID string, allowedCIDRs []string, port int) (*listeners.Listener, error) {
-	listener, err := s.checkIfListenerExists(listenerName)
+// reconcileLoadBalancerListener ensures that a listener from the spec exists
+// together with its pool, health monitor and L7 policies.
+func (s *Service) reconcileLoadBalancerListener(lb *loadbalancers.LoadBalancer, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, lbListener *infrav1.LoadBalancerListener, tlsCertificates map[string]TLSCertificate) error {
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	lbListenerObjectsName := getListenerObjectsName(loadBalancerName, lbListener.Name)
+
+	protocol := cmp.Or(lbListener.Protocol, infrav1.LoadBalancerProtocolTCP)
+	listenerCreateOpts := listeners.CreateOpts{
+		Name:           lbListenerObjectsName,
+		Protocol:       listeners.Protocol(protocol),
+		ProtocolPort:   lbListener.Port,
+		LoadbalancerID: lb.ID,
+		Tags:           getLoadBalancerObjectTags(openStackCluster),
+	}
+	if protocol == infrav1.LoadBalancerProtocolTerminatedHTTPS {
+		tlsContainerRef, err := s.getListenerTLSContainerRef(openStackCluster, lbListenerObjectsName, lbListener, tlsCertificates)
+		if err != nil {
+			return err
+		}
+		listenerCreateOpts.DefaultTlsContainerRef = tlsContainerRef
+	}
+	listener, err := s.getOrCreateListener(openStackCluster, lb.ID, listenerCreateOpts)
+	if err != nil {
+		return err
+	}
+
+	if protocol == infrav1.LoadBalancerProtocolTerminatedHTTPS && listener.DefaultTlsContainerRef != listenerCreateOpts.DefaultTlsContainerRef {
+		if err := s.updateListenerTLSContainerRef(openStackCluster, lb.ID, lbListenerObjectsName, listener, listenerCreateOpts.DefaultTlsContainerRef); err != nil {
+			return err
+		}
+	}
+
+	// Octavia terminates TLS on the listener and forwards plain HTTP to the members.
+	poolProtocol := pools.Protocol(protocol)
+	if protocol == infrav1.LoadBalancerProtocolTerminatedHTTPS {
+		poolProtocol = pools.ProtocolHTTP
+	}
+	lbMethod := getDefaultLBMethod(lb.Provider)
+	if lbListener.Algorithm != "" {
+		lbMethod = pools.LBMethod(lbListener.Algorithm)
+	}
+	pool, err := s.getOrCreatePool(openStackCluster, lb.ID, pools.CreateOpts{
+		Name:       lbListenerObjectsName,
+		Protocol:   poolProtocol,
+		LBMethod:   lbMethod,
+		ListenerID: listener.ID,
+		TLSEnabled: lbListener.MemberTLS,
+		Tags:       getLoadBalancerObjectTags(openStackCluster),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureMonitor(openStackCluster, lbListenerObjectsName, pool.ID, lb.ID, getListenerMonitorConfig(lbListener)); err != nil {
+		return err
+	}
+
+	return s.reconcileL7Policies(openStackCluster, lb.ID, loadBalancerName, lbListenerObjectsName, listener.ID, lbListener.L7Policies)
+}
+
+// getListenerTLSContainerRef returns the reference of the Barbican container
+// with the certificate of a TERMINATED_HTTPS listener from the spec. The
+// certificate of a listener using a Secret is uploaded to Barbican if needed.
+func (s *Service) getListenerTLSContainerRef(openStackCluster *infrav1.OpenStackCluster, lbListenerObjectsName string, lbListener *infrav1.LoadBalancerListener, tlsCertificates map[string]TLSCertificate) (string, error) {
+	if lbListener.TLSSecretName == nil {
+		return ptr.Deref(lbListener.DefaultTLSContainerRef, ""), nil
+	}
+
+	cert, ok := tlsCertificates[*lbListener.TLSSecretName]
+	if !ok {
+		return "", fmt.Errorf("certificate of listener %s from secret %s is not available", lbListener.Name, *lbListener.TLSSecretName)
+	}
+	return s.reconcileTLSContainer(openStackCluster, lbListenerObjectsName, &cert)
+}
+
+// updateListenerTLSContainerRef replaces the certificate of a listener and
+// deletes the previous Barbican container if it was created by us.
+func (s *Service) updateListenerTLSContainerRef(openStackCluster *infrav1.OpenStackCluster, lbID, lbListenerObjectsName string, listener *listeners.Listener, tlsContainerRef string) error {
+	s.scope.Logger().Info("Updating certificate of load balancer listener", "name", listener.Name, "tlsContainerRef", tlsContainerRef)
+
+	previousRef := listener.DefaultTlsContainerRef
+	if _, err := s.loadbalancerClient.UpdateListener(listener.ID, listeners.UpdateOpts{DefaultTlsContainerRef: &tlsContainerRef}); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update certificate of listener %s with id %s: %v", listener.Name, listener.ID, err)
+		return err
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update certificate of listener %s with id %s: wait for load balancer active %s: %v", listener.Name, listener.ID, lbID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulUpdateListener", "Updated certificate of listener %s with id %s", listener.Name, listener.ID)
+	return s.deleteTLSContainer(openStackCluster, lbListenerObjectsName, previousRef)
+}
+
+// reconcileLoadBalancerPool ensures that a named pool from the spec exists
+// together with its health monitor.
+func (s *Service) reconcileLoadBalancerPool(lb *loadbalancers.LoadBalancer, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, lbPool *infrav1.LoadBalancerPool) error {
+	lbPoolObjectsName := getListenerObjectsName(getLoadBalancerName(clusterResourceName), lbPool.Name)
+
+	lbMethod := getDefaultLBMethod(lb.Provider)
+	if lbPool.Algorithm != "" {
+		lbMethod = pools.LBMethod(lbPool.Algorithm)
+	}
+	pool, err := s.getOrCreatePool(openStackCluster, lb.ID, pools.CreateOpts{
+		Name:           lbPoolObjectsName,
+		Protocol:       pools.ProtocolHTTP,
+		LBMethod:       lbMethod,
+		LoadbalancerID: lb.ID,
+		TLSEnabled:     lbPool.MemberTLS,
+		Tags:           getLoadBalancerObjectTags(openStackCluster),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.ensureMonitor(openStackCluster, lbPoolObjectsName, pool.ID, lb.ID, getMonitorConfig(lbPool.Monitor, infrav1.LoadBalancerProtocolHTTP, lbPool.MemberTLS))
+}
+
+// getListenerMonitorConfig returns the health monitor configuration of a listener from the spec.
+func getListenerMonitorConfig(lbListener *infrav1.LoadBalancerListener) monitorConfig {
+	return getMonitorConfig(lbListener.Monitor, lbListener.Protocol, lbListener.MemberTLS)
+}
+
+// getMonitorConfig returns the health monitor configuration of a pool from
+// the spec. The monitor type defaults to one suited to the protocol of the
+// pool and to whether the members are reached with TLS.
+func getMonitorConfig(monitor *infrav1.LoadBalancerListenerMonitor, protocol infrav1.LoadBalancerProtocol, memberTLS bool) monitorConfig {
+	var cfg monitorConfig
+	if monitor != nil {
+		cfg.Type = string(monitor.Type)
+		cfg.URLPath = ptr.Deref(monitor.URLPath, "")
+		cfg.ExpectedCodes = ptr.Deref(monitor.ExpectedCodes, "")
+		cfg.APIServerLoadBalancerMonitor = monitor.APIServerLoadBalancerMonitor
+	}
+
+	if cfg.Type == "" {
+		switch protocol {
+		case infrav1.LoadBalancerProtocolUDP:
+			cfg.Type = monitors.TypeUDPConnect
+		case infrav1.LoadBalancerProtocolHTTP, infrav1.LoadBalancerProtocolTerminatedHTTPS:
+			cfg.Type = monitors.TypeHTTP
+			if memberTLS {
+				cfg.Type = monitors.TypeHTTPS
+			}
+		default:
+			cfg.Type = monitors.TypeTCP
+		}
+	}
+	return cfg
+}
+
+// getOrCreateListener returns an existing listener with the name given in
+// the create options if it already exists, or creates a new one if it does not.
+func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster, lbID string, listenerCreateOpts listeners.CreateOpts) (*listeners.Listener, error) {
+	listenerName := listenerCreateOpts.Name
+	listener, err := s.checkIfListenerExists(lbID, listenerName)
 	if err != nil {
 		return nil, err
 	}
@@ -445,14 +660,6 @@ func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster
 
 	s.scope.Logger().Info("Creating load balancer listener", "name", listenerName, "loadBalancerID", lbID)
 
-	listenerCreateOpts := listeners.CreateOpts{
-		Name:           listenerName,
-		Protocol:       "TCP",
-		ProtocolPort:   port,
-		LoadbalancerID: lbID,
-		Tags:           openStackCluster.Spec.Tags,
-		AllowedCIDRs:   allowedCIDRs,
-	}
 	listener, err = s.loadbalancerClient.CreateListener(listenerCreateOpts)
 	if err != nil {
 		record.Warnf(openStackCluster, "FailedCreateListener", "Failed to create listener %s: %v", listenerName, err)
@@ -501,8 +708,17 @@ func (s *Service) getOrUpdateAllowedCIDRs(openStackCluster *infrav1.OpenStackClu
 	return nil
 }
 
-func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, poolName, listenerID, lbID string, lbProvider string) (*pools.Pool, error) {
-	pool, err := s.checkIfPoolExists(poolName)
+// getDefaultLBMethod returns the load balancing algorithm used when none is specified.
+func getDefaultLBMethod(lbProvider string) pools.LBMethod {
+	if lbProvider == "ovn" {
+		return pools.LBMethodSourceIpPort
+	}
+	return pools.LBMethodRoundRobin
+}
+
+func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, lbID string, poolCreateOpts pools.CreateOpts) (*pools.Pool, error) {
+	poolName := poolCreateOpts.Name
+	pool, err := s.checkIfPoolExists(lbID, poolName)
 	if err != nil {
 		return nil, err
 	}
@@ -511,21 +727,8 @@ func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, po
 		return pool, nil
 	}
 
-	s.scope.Logger().Info("Creating load balancer pool for listener", "loadBalancerID", lbID, "listenerID", listenerID, "name", poolName)
-
-	method := pools.LBMethodRoundRobin
-
-	if lbProvider == "ovn" {
-		method = pools.LBMethodSourceIpPort
-	}
+	s.scope.Logger().Info("Creating load balancer pool for listener", "loadBalancerID", lbID, "listenerID", poolCreateOpts.ListenerID, "name", poolName)
 
-	poolCreateOpts := pools.CreateOpts{
-		Name:       poolName,
-		Protocol:   "TCP",
-		LBMethod:   method,
-		ListenerID: listenerID,
-		Tags:       openStackCluster.Spec.Tags,
-	}
 	pool, err = s.loadbalancerClient.CreatePool(poolCreateOpts)
 	if err != nil {
 		record.Warnf(openStackCluster, "FailedCreatePool", "Failed to create pool %s: %v", poolName, err)
@@ -541,23 +744,43 @@ func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, po
 	return pool, nil
 }
 
-func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, monitorName, poolID, lbID string) error {
-	var cfg infrav1.APIServerLoadBalancerMonitor
+// monitorConfig is the desired configuration of a health monitor.
+type monitorConfig struct {
+	Type          string
+	URLPath       string
+	ExpectedCodes string
+	infrav1.APIServerLoadBalancerMonitor
+}
 
-	if openStackCluster.Spec.APIServerLoadBalancer.Monitor != nil {
-		cfg = *openStackCluster.Spec.APIServerLoadBalancer.Monitor
-	}
+// isHTTP returns true if the monitor sends HTTP requests to the members.
+func (cfg *monitorConfig) isHTTP() bool {
+	return cfg.Type == monitors.TypeHTTP || cfg.Type == monitors.TypeHTTPS
+}
 
+func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, monitorName, poolID, lbID string, cfg monitorConfig) error {
 	cfg.Delay = cmp.Or(cfg.Delay, defaultMonitorDelay)
 	cfg.Timeout = cmp.Or(cfg.Timeout, defaultMonitorTimeout)
 	cfg.MaxRetries = cmp.Or(cfg.MaxRetries, defaultMonitorMaxRetries)
 	cfg.MaxRetriesDown = cmp.Or(cfg.MaxRetriesDown, defaultMonitorMaxRetriesDown)
+	if cfg.isHTTP() {
+		cfg.URLPath = cmp.Or(cfg.URLPath, defaultMonitorURLPath)
+		cfg.ExpectedCodes = cmp.Or(cfg.ExpectedCodes, defaultMonitorExpectedCodes)
+	}
 
-	monitor, err := s.checkIfMonitorExists(monitorName)
+	monitor, err := s.checkIfMonitorExists(poolID, monitorName)
 	if err != nil {
 		return err
 	}
 
+	// The type of a monitor can't be updated, so the monitor is replaced.
+	if monitor != nil && monitor.Type != cfg.Type {
+		s.scope.Logger().Info("Monitor type changed, recreating monitor", "name", monitorName, "monitorID", monitor.ID, "current", monitor.Type, "desired", cfg.Type)
+		if err := s.deleteMonitor(openStackCluster, lbID, monitor); err != nil {
+			return err
+		}
+		monitor = nil
+	}
+
 	if monitor != nil {
 		needsUpdate := false
 		monitorUpdateOpts := monitors.UpdateOpts{}
@@ -586,6 +809,18 @@ func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, moni
 			needsUpdate = true
 		}
 
+		if cfg.isHTTP() && monitor.URLPath != cfg.URLPath {
+			s.scope.Logger().Info("Monitor urlPath needs update", "current", monitor.URLPath, "desired", cfg.URLPath)
+			monitorUpdateOpts.URLPath = cfg.URLPath
+			needsUpdate = true
+		}
+
+		if cfg.isHTTP() && monitor.ExpectedCodes != cfg.ExpectedCodes {
+			s.scope.Logger().Info("Monitor expectedCodes needs update", "current", monitor.ExpectedCodes, "desired", cfg.ExpectedCodes)
+			monitorUpdateOpts.ExpectedCodes = cfg.ExpectedCodes
+			needsUpdate = true
+		}
+
 		if needsUpdate {
 			s.scope.Logger().Info("Updating load balancer monitor", "loadBalancerID", lbID, "name", monitorName, "monitorID", monitor.ID)
 
@@ -608,15 +843,21 @@ func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, moni
 
 	s.scope.Logger().Info("Creating load balancer monitor for pool", "loadBalancerID", lbID, "name", monitorName, "poolID", poolID)
 
-	monitor, err = s.loadbalancerClient.CreateMonitor(monitors.CreateOpts{
+	monitorCreateOpts := monitors.CreateOpts{
 		Name:           monitorName,
 		PoolID:         poolID,
-		Type:           "TCP",
+		Type:           cfg.Type,
 		Delay:          cfg.Delay,
 		Timeout:        cfg.Timeout,
 		MaxRetries:     cfg.MaxRetries,
 		MaxRetriesDown: cfg.MaxRetriesDown,
-	})
+	}
+	if cfg.isHTTP() {
+		monitorCreateOpts.HTTPMethod = "GET"
+		monitorCreateOpts.URLPath = cfg.URLPath
+		monitorCreateOpts.ExpectedCodes = cfg.ExpectedCodes
+	}
+	monitor, err = s.loadbalancerClient.CreateMonitor(monitorCreateOpts)
 	if err != nil {
 		if capoerrors.IsNotImplementedError(err) {
 			record.Warnf(openStackCluster, "SkippedCreateMonitor", "Health Monitor is not created as it's not implemented with the current Octavia provider.")
@@ -636,7 +877,28 @@ func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, moni
 	return nil
 }
 
-func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName, ip string) error {
+// deleteMonitor deletes a health monitor and waits for the load balancer to
+// become active again.
+func (s *Service) deleteMonitor(openStackCluster *infrav1.OpenStackCluster, lbID string, monitor *monitors.Monitor) error {
+	if err := s.loadbalancerClient.DeleteMonitor(monitor.ID); err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(openStackCluster, "FailedDeleteMonitor", "Failed to delete monitor %s with id %s: %v", monitor.Name, monitor.ID, err)
+		return err
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		record.Warnf(openStackCluster, "FailedDeleteMonitor", "Failed to delete monitor %s with id %s: wait for load balancer active %s: %v", monitor.Name, monitor.ID, lbID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulDeleteMonitor", "Deleted monitor %s with id %s", monitor.Name, monitor.ID)
+	return nil
+}
+
+// ReconcileLoadBalancerMember ensures that the machine is a member of every
+// pool of the load balancer which selects it: the API server pools for control
+// plane machines, and the pools of the listeners whose member selector matches
+// the machine. The machine is removed from listener pools which no longer select it.
+func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, clusterResourceName, ip string) error {
 	if openStackCluster.Status.Network == nil {
 		return errors.New("network is not yet available in openStackCluster.Status")
 	}
@@ -654,89 +916,243 @@ func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStac
 	s.scope.Logger().Info("Reconciling load balancer member", "loadBalancerName", loadBalancerName)
 
 	lbID := openStackCluster.Status.APIServerLoadBalancer.ID
-	var portList []int
-	if openStackCluster.Spec.ControlPlaneEndpoint != nil {
-		portList = append(portList, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
+	var memberSubnetID string
+	if openStackCluster.Status.Network.ID != openStackCluster.Status.APIServerLoadBalancer.LoadBalancerNetwork.ID {
+		memberSubnetID = openStackCluster.Status.Network.Subnets[0].ID
 	}
-	if openStackCluster.Spec.APIServerLoadBalancer != nil {
-		portList = append(portList, openStackCluster.Spec.APIServerLoadBalancer.AdditionalPorts...)
+
+	if util.IsControlPlaneMachine(machine) {
+		for _, port := range getAPIServerPorts(openStackCluster) {
+			lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+			if err := s.reconcilePoolMember(openStackCluster, lbID, lbPortObjectsName, openStackMachine.Name, ip, port, memberSubnetID); err != nil {
+				return err
+			}
+		}
 	}
-	for _, port := range portList {
-		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
-		name := lbPortObjectsName + "-" + openStackMachine.Name
 
-		pool, err := s.checkIfPoolExists(lbPortObjectsName)
+	for _, pool := range getMemberPools(openStackCluster, loadBalancerName) {
+		selected, err := isMachineSelected(pool.members, machine)
 		if err != nil {
-			return err
+			return fmt.Errorf("%s: %w", pool.specName, err)
 		}
-		if pool == nil {
-			return errors.New("load balancer pool does not exist yet")
+		if !selected {
+			if err := s.deletePoolMember(lbID, pool.name, openStackMachine.Name); err != nil {
+				return err
+			}
+			continue
 		}
 
-		lbMember, err := s.checkIfLbMemberExists(pool.ID, name)
-		if err != nil {
+		if err := s.reconcilePoolMember(openStackCluster, lbID, pool.name, openStackMachine.Name, ip, pool.memberPort, memberSubnetID); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if lbMember != nil {
-			// check if we have to recreate the LB Member
-			if lbMember.Address == ip {
-				// nothing to do continue to next port
-				continue
-			}
+// memberPool is a pool of the load balancer whose members are selected by a
+// member selector from the spec.
+type memberPool struct {
+	// name is the name of the Octavia pool.
+	name string
+	// specName identifies the listener or pool in the spec.
+	specName   string
+	memberPort int
+	members    *infrav1.LoadBalancerMemberSelector
+}
 
-			s.scope.Logger().Info("Deleting load balancer member because the IP of the machine changed", "name", name)
+// getMemberPools returns the pools of the listeners and the named pools defined in the spec.
+func getMemberPools(openStackCluster *infrav1.OpenStackCluster, loadBalancerName string) []memberPool {
+	if openStackCluster.Spec.APIServerLoadBalancer == nil {
+		return nil
+	}
+	lbSpec := openStackCluster.Spec.APIServerLoadBalancer
 
-			// lb member changed so let's delete it so we can create it again with the correct IP
-			_, err = s.waitForLoadBalancerActive(lbID)
-			if err != nil {
-				return err
-			}
-			if err := s.loadbalancerClient.DeletePoolMember(pool.ID, lbMember.ID); err != nil {
-				return err
-			}
-			_, err = s.waitForLoadBalancerActive(lbID)
-			if err != nil {
-				return err
-			}
-		}
+	memberPools := make([]memberPool, 0, len(lbSpec.Listeners)+len(lbSpec.Pools))
+	for i := range lbSpec.Listeners {
+		lbListener := &lbSpec.Listeners[i]
+		memberPools = append(memberPools, memberPool{
+			name:       getListenerObjectsName(loadBalancerName, lbListener.Name),
+			specName:   "listener " + lbListener.Name,
+			memberPort: ptr.Deref(lbListener.MemberPort, lbListener.Port),
+			members:    &lbListener.Members,
+		})
+	}
+	for i := range lbSpec.Pools {
+		lbPool := &lbSpec.Pools[i]
+		memberPools = append(memberPools, memberPool{
+			name:       getListenerObjectsName(loadBalancerName, lbPool.Name),
+			specName:   "pool " + lbPool.Name,
+			memberPort: lbPool.MemberPort,
+			members:    &lbPool.Members,
+		})
+	}
+	return memberPools
+}
 
-		s.scope.Logger().Info("Creating load balancer member", "name", name)
+// reconcilePoolMember ensures that the pool with the given name has a member
+// for the machine with the given IP and port. The subnet of the member must be
+// given if it is not on the VIP network of the load balancer.
+func (s *Service) reconcilePoolMember(openStackCluster *infrav1.OpenStackCluster, lbID, poolName, machineName, ip string, port int, subnetID string) error {
+	name := poolName + "-" + machineName
 
-		// if we got to this point we should either create or re-create the lb member
-		lbMemberOpts := pools.CreateMemberOpts{
-			Name:         name,
-			ProtocolPort: port,
-			Address:      ip,
-			Tags:         openStackCluster.Spec.Tags,
-		}
+	pool, err := s.checkIfPoolExists(lbID, poolName)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		return errors.New("load balancer pool does not exist yet")
+	}
 
-		if openStackCluster.Status.Network.ID != openStackCluster.Status.APIServerLoadBalancer.LoadBalancerNetwork.ID {
-			lbMemberOpts.SubnetID = openStackCluster.Status.Network.Subnets[0].ID
-		}
+	lbMember, err := s.checkIfLbMemberExists(pool.ID, name)
+	if err != nil {
+		return err
+	}
 
-		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
-			return err
+	if lbMember != nil {
+		// check if we have to recreate the LB Member
+		if lbMember.Address == ip {
+			// nothing to do
+			return nil
 		}
 
-		member, err := s.loadbalancerClient.CreatePoolMember(pool.ID, lbMemberOpts)
+		s.scope.Logger().Info("Deleting load balancer member because the IP of the machine changed", "name", name)
+
+		// lb member changed so let's delete it so we can create it again with the correct IP
+		_, err = s.waitForLoadBalancerActive(lbID)
 		if err != nil {
 			return err
 		}
-
-		if _, err := s.waitForPoolMemberActive(pool.ID, member.ID); err != nil {
+		if err := s.loadbalancerClient.DeletePoolMember(pool.ID, lbMember.ID); err != nil {
 			return err
 		}
-
-		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		_, err = s.waitForLoadBalancerActive(lbID)
+		if err != nil {
 			return err
 		}
 	}
+
+	s.scope.Logger().Info("Creating load balancer member", "name", name)
+
+	// if we got to this point we should either create or re-create the lb member
+	lbMemberOpts := pools.CreateMemberOpts{
+		Name:         name,
+		ProtocolPort: port,
+		Address:      ip,
+		Tags:         getLoadBalancerObjectTags(openStackCluster),
+		SubnetID:     subnetID,
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
+
+	member, err := s.loadbalancerClient.CreatePoolMember(pool.ID, lbMemberOpts)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.waitForPoolMemberActive(pool.ID, member.ID); err != nil {
+		return err
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
 	return nil
 }
 
+// deletePoolMember deletes the member of the machine from the pool with the given name, if any.
+func (s *Service) deletePoolMember(lbID, poolName, machineName string) error {
+	name := poolName + "-" + machineName
+
+	pool, err := s.checkIfPoolExists(lbID, poolName)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		s.scope.Logger().Info("Load balancer pool does not exist", "name", poolName)
+		return nil
+	}
+
+	lbMember, err := s.checkIfLbMemberExists(pool.ID, name)
+	if err != nil {
+		return err
+	}
+	if lbMember == nil {
+		return nil
+	}
+
+	s.scope.Logger().Info("Deleting load balancer member", "name", name)
+
+	_, err = s.waitForLoadBalancerActive(lbID)
+	if err != nil {
+		return err
+	}
+	if err := s.loadbalancerClient.DeletePoolMember(pool.ID, lbMember.ID); err != nil {
+		return err
+	}
+	_, err = s.waitForLoadBalancerActive(lbID)
+	return err
+}
+
+// isMachineSelected returns true if the member selector of a listener selects the machine.
+func isMachineSelected(selector *infrav1.LoadBalancerMemberSelector, machine *clusterv1.Machine) (bool, error) {
+	switch {
+	case selector.Role == infrav1.LoadBalancerMemberRoleControlPlane:
+		return util.IsControlPlaneMachine(machine), nil
+	case selector.Role == infrav1.LoadBalancerMemberRoleWorker:
+		return !util.IsControlPlaneMachine(machine), nil
+	case selector.MachineSelector != nil:
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector.MachineSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid machine selector: %w", err)
+		}
+		return labelSelector.Matches(labels.Set(machine.GetLabels())), nil
+	}
+	return false, nil
+}
+
+// getAPIServerPorts returns the ports of the API server listeners.
+func getAPIServerPorts(openStackCluster *infrav1.OpenStackCluster) []int {
+	var portList []int
+	if openStackCluster.Spec.ControlPlaneEndpoint != nil {
+		portList = append(portList, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
+	}
+	if openStackCluster.Spec.APIServerLoadBalancer != nil {
+		portList = append(portList, openStackCluster.Spec.APIServerLoadBalancer.AdditionalPorts...)
+	}
+	return portList
+}
+
+// getLoadBalancerListeners returns the listeners defined in the spec.
+func getLoadBalancerListeners(openStackCluster *infrav1.OpenStackCluster) []infrav1.LoadBalancerListener {
+	if openStackCluster.Spec.APIServerLoadBalancer == nil {
+		return nil
+	}
+	return openStackCluster.Spec.APIServerLoadBalancer.Listeners
+}
+
 func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (result *ctrl.Result, reterr error) {
-	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	// An adopted load balancer is left in place, only the objects CAPO
+	// created on it are deleted.
+	if isAdoptedLoadBalancer(openStackCluster) {
+		if err := s.deleteAdoptedLoadBalancerObjects(openStackCluster, getLoadBalancerName(clusterResourceName)); err != nil {
+			return nil, err
+		}
+	} else {
+		result, err := s.deleteLoadBalancer(openStackCluster, getLoadBalancerName(clusterResourceName), openStackCluster.Spec.APIServerFloatingIP)
+		if err != nil || result != nil {
+			return result, err
+		}
+	}
+
+	// Certificates can only be deleted once no listener uses them anymore.
+	return nil, s.deleteTLSContainers(openStackCluster, clusterResourceName)
+}
+
+// deleteLoadBalancer deletes the load balancer with the given name together
+// with its floating IP, unless it is the given user-provided floating IP.
+func (s *Service) deleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster, loadBalancerName string, userFloatingIP *string) (*ctrl.Result, error) {
 	lb, err := s.checkIfLbExists(loadBalancerName)
 	if err != nil {
 		return nil, err
@@ -766,7 +1182,7 @@ func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster,
 			}
 
 			// If the floating is user-provider (BYO floating IP), don't delete it.
-			if openStackCluster.Spec.APIServerFloatingIP == nil || *openStackCluster.Spec.APIServerFloatingIP != fip.FloatingIP {
+			if userFloatingIP == nil || *userFloatingIP != fip.FloatingIP {
 				if err = s.networkingService.DeleteFloatingIP(openStackCluster, fip.FloatingIP); err != nil {
 					return nil, err
 				}
@@ -793,13 +1209,14 @@ func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster,
 	return &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}, nil
 }
 
-func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) error {
+// DeleteLoadBalancerMember removes the machine from all pools of the load balancer.
+func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) error {
 	if openStackMachine == nil {
 		return errors.New("openStackMachine is nil")
 	}
 
 	loadBalancerName := getLoadBalancerName(clusterResourceName)
-	lb, err := s.checkIfLbExists(loadBalancerName)
+	lb, err := s.getAPILoadBalancer(openStackCluster, loadBalancerName)
 	if err != nil {
 		return err
 	}
@@ -810,53 +1227,146 @@ func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCl
 
 	lbID := lb.ID
 
-	var portList []int
-	if openStackCluster.Spec.ControlPlaneEndpoint != nil {
-		portList = append(portList, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
+	for _, poolName := range getMachinePoolNames(openStackCluster, machine, loadBalancerName) {
+		if err := s.deletePoolMember(lbID, poolName, openStackMachine.Name); err != nil {
+			return err
+		}
 	}
-	if openStackCluster.Spec.APIServerLoadBalancer != nil {
-		portList = append(portList, openStackCluster.Spec.APIServerLoadBalancer.AdditionalPorts...)
+	return nil
+}
+
+// DrainLoadBalancerMember stops the members of the machine in all pools of the
+// load balancer from receiving new connections while existing connections are
+// served, by setting their weight to 0 or their admin state to down depending
+// on the drain mode. It returns true if there is nothing left to drain: the
+// machine has no members left, or Octavia reports that none of them serves
+// connections anymore.
+func (s *Service) DrainLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) (bool, error) {
+	if openStackMachine == nil {
+		return false, errors.New("openStackMachine is nil")
 	}
-	for _, port := range portList {
-		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
-		name := lbPortObjectsName + "-" + openStackMachine.Name
 
-		pool, err := s.checkIfPoolExists(lbPortObjectsName)
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	lb, err := s.getAPILoadBalancer(openStackCluster, loadBalancerName)
+	if err != nil {
+		return false, err
+	}
+	if lb == nil {
+		// nothing to do
+		return true, nil
+	}
+
+	drainMode := ptr.Deref(openStackCluster.Spec.APIServerLoadBalancer.MemberDrainMode, infrav1.MemberDrainModeWeight)
+	found, drained := false, true
+	for _, poolName := range getMachinePoolNames(openStackCluster, machine, loadBalancerName) {
+		memberFound, memberDrained, err := s.drainPoolMember(openStackMachine, lb.ID, poolName, drainMode)
 		if err != nil {
-			return err
-		}
-		if pool == nil {
-			s.scope.Logger().Info("Load balancer pool does not exist", "name", lbPortObjectsName)
-			continue
+			return false, err
 		}
+		found = found || memberFound
+		drained = drained && (!memberFound || memberDrained)
+	}
+	if found && drained {
+		record.Eventf(openStackMachine, "SuccessfulDrainLoadBalancerMember", "Load balancer members of %s are drained", openStackMachine.Name)
+	}
+	return drained, nil
+}
 
-		lbMember, err := s.checkIfLbMemberExists(pool.ID, name)
-		if err != nil {
-			return err
+// drainPoolMember drains the member of the machine in the pool with the given
+// name. It returns false if the member doesn't exist, and whether the member
+// no longer serves any connections.
+func (s *Service) drainPoolMember(openStackMachine *infrav1.OpenStackMachine, lbID, poolName string, drainMode infrav1.MemberDrainMode) (bool, bool, error) {
+	name := poolName + "-" + openStackMachine.Name
+
+	pool, err := s.checkIfPoolExists(lbID, poolName)
+	if err != nil {
+		return false, false, err
+	}
+	if pool == nil {
+		return false, false, nil
+	}
+
+	lbMember, err := s.checkIfLbMemberExists(pool.ID, name)
+	if err != nil {
+		return false, false, err
+	}
+	if lbMember == nil {
+		return false, false, nil
+	}
+	s.scope.Logger().V(4).Info("Draining load balancer member", "name", name, "operatingStatus", lbMember.OperatingStatus)
+
+	var opts pools.UpdateMemberOpts
+	switch drainMode {
+	case infrav1.MemberDrainModeAdminStateDown:
+		if !lbMember.AdminStateUp {
+			return true, isMemberDrained(lbMember, drainMode), nil
 		}
+		opts.AdminStateUp = ptr.To(false)
+	default:
+		if lbMember.Weight == 0 {
+			return true, isMemberDrained(lbMember, drainMode), nil
+		}
+		opts.Weight = ptr.To(0)
+	}
 
-		if lbMember != nil {
-			// lb member changed so let's delete it so we can create it again with the correct IP
-			_, err = s.waitForLoadBalancerActive(lbID)
-			if err != nil {
-				return err
-			}
-			if err := s.loadbalancerClient.DeletePoolMember(pool.ID, lbMember.ID); err != nil {
-				return err
-			}
-			_, err = s.waitForLoadBalancerActive(lbID)
-			if err != nil {
-				return err
-			}
+	s.scope.Logger().Info("Starting to drain load balancer member", "name", name, "mode", drainMode)
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return false, false, err
+	}
+	if _, err := s.loadbalancerClient.UpdatePoolMember(pool.ID, lbMember.ID, opts); err != nil {
+		record.Warnf(openStackMachine, "FailedDrainLoadBalancerMember", "Failed to drain load balancer member %s with id %s: %v", name, lbMember.ID, err)
+		return false, false, err
+	}
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return false, false, err
+	}
+	record.Eventf(openStackMachine, "DrainingLoadBalancerMember", "Draining load balancer member %s with id %s", name, lbMember.ID)
+	return true, false, nil
+}
+
+// isMemberDrained returns true if Octavia reports that a member which is
+// being drained no longer serves any connections: its health monitor failed,
+// or it was taken offline while draining by weight. A member which is
+// draining by weight otherwise reports DRAINING, and a member whose admin
+// state is down reports OFFLINE as soon as it stops receiving new
+// connections, so both are drained until the drain timeout expires.
+func isMemberDrained(member *pools.Member, drainMode infrav1.MemberDrainMode) bool {
+	switch member.OperatingStatus {
+	case "ERROR":
+		return true
+	case "OFFLINE":
+		return drainMode != infrav1.MemberDrainModeAdminStateDown
+	}
+	return false
+}
+
+// getMachinePoolNames returns the names of the pools of the load balancer the machine may be a member of.
+func getMachinePoolNames(openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, loadBalancerName string) []string {
+	var poolNames []string
+	if util.IsControlPlaneMachine(machine) {
+		for _, port := range getAPIServerPorts(openStackCluster) {
+			poolNames = append(poolNames, fmt.Sprintf("%s-%d", loadBalancerName, port))
 		}
 	}
-	return nil
+	// The labels of the machine may have changed since it was added, so
+	// include the pools of all listeners regardless of their selector.
+	for _, pool := range getMemberPools(openStackCluster, loadBalancerName) {
+		poolNames = append(poolNames, pool.name)
+	}
+	return poolNames
 }
 
 func getLoadBalancerName(clusterResourceName string) string {
 	return fmt.Sprintf("%s-cluster-%s-%s", networkPrefix, clusterResourceName, kubeapiLBSuffix)
 }
 
+// getListenerObjectsName returns the name of the Octavia listener, pool and
+// health monitor of a listener from the spec, or of the Octavia pool and
+// health monitor of a named pool from the spec.
+func getListenerObjectsName(loadBalancerName, listenerName string) string {
+	return fmt.Sprintf("%s-%s", loadBalancerName, listenerName)
+}
+
 func (s *Service) checkIfLbExists(name string) (*loadbalancers.LoadBalancer, error) {
 	lbList, err := s.loadbalancerClient.ListLoadBalancers(loadbalancers.ListOpts{Name: name})
 	if err != nil {
@@ -868,8 +1378,11 @@ func (s *Service) checkIfLbExists(name string) (*loadbalancers.LoadBalancer, err
 	return &lbList[0], nil
 }
 
-func (s *Service) checkIfListenerExists(name string) (*listeners.Listener, error) {
-	listenerList, err := s.loadbalancerClient.ListListeners(listeners.ListOpts{Name: name})
+// checkIfListenerExists returns the listener of the load balancer with the
+// given name. Listeners are looked up by load balancer as their names are only
+// unique within it, e.g. for an adopted load balancer.
+func (s *Service) checkIfListenerExists(lbID, name string) (*listeners.Listener, error) {
+	listenerList, err := s.loadbalancerClient.ListListeners(listeners.ListOpts{LoadbalancerID: lbID, Name: name})
 	if err != nil {
 		return nil, err
 	}
@@ -879,8 +1392,9 @@ func (s *Service) checkIfListenerExists(name string) (*listeners.Listener, error
 	return &listenerList[0], nil
 }
 
-func (s *Service) checkIfPoolExists(name string) (*pools.Pool, error) {
-	poolList, err := s.loadbalancerClient.ListPools(pools.ListOpts{Name: name})
+// checkIfPoolExists returns the pool of the load balancer with the given name.
+func (s *Service) checkIfPoolExists(lbID, name string) (*pools.Pool, error) {
+	poolList, err := s.loadbalancerClient.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: name})
 	if err != nil {
 		return nil, err
 	}
@@ -890,8 +1404,9 @@ func (s *Service) checkIfPoolExists(name string) (*pools.Pool, error) {
 	return &poolList[0], nil
 }
 
-func (s *Service) checkIfMonitorExists(name string) (*monitors.Monitor, error) {
-	monitorList, err := s.loadbalancerClient.ListMonitors(monitors.ListOpts{Name: name})
+// checkIfMonitorExists returns the monitor of the pool with the given name.
+func (s *Service) checkIfMonitorExists(poolID, name string) (*monitors.Monitor, error) {
+	monitorList, err := s.loadbalancerClient.ListMonitors(monitors.ListOpts{PoolID: poolID, Name: name})
 	if err != nil {
 		return nil, err
 	}
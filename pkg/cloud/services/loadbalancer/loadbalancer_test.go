@@ -17,28 +17,39 @@ limitations under the License.
 package loadbalancer
 
 import (
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/apiversions"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/providers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	. "github.com/onsi/gomega" //nolint:revive
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 const apiHostname = "api.test-cluster.test"
@@ -87,11 +98,19 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 			},
 		},
 	}
+	tlsCertificate := TLSCertificate{
+		Certificate: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("certificate")}),
+		PrivateKey:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("private-key")}),
+	}
+
 	lbtests := []struct {
 		name               string
 		clusterSpec        *infrav1.OpenStackCluster
+		tlsCertificates    map[string]TLSCertificate
 		expectNetwork      func(m *mock.MockNetworkClientMockRecorder)
 		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
+		expectKeyManager   func(m *mock.MockKeyManagerClientMockRecorder)
+		wantLBStatus       *infrav1.LoadBalancer
 		wantError          error
 	}{
 		{
@@ -130,7 +149,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListListeners(listeners.ListOpts{Name: listenerList[0].Name}).Return(listenerList, nil)
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: listenerList[0].Name}).Return(listenerList, nil)
 
 				poolList := []pools.Pool{
 					{
@@ -138,20 +157,21 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListPools(pools.ListOpts{Name: poolList[0].Name}).Return(poolList, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: poolList[0].Name}).Return(poolList, nil)
 
 				// create a monitor with values that match defaults to prevent update
 				monitorList := []monitors.Monitor{
 					{
 						ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
 						Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+						Type:           "TCP",
 						Delay:          10,
 						Timeout:        5,
 						MaxRetries:     5,
 						MaxRetriesDown: 3,
 					},
 				}
-				m.ListMonitors(monitors.ListOpts{Name: monitorList[0].Name}).Return(monitorList, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: poolList[0].ID, Name: monitorList[0].Name}).Return(monitorList, nil)
 			},
 			wantError: nil,
 		},
@@ -235,7 +255,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListListeners(listeners.ListOpts{Name: listenerList[0].Name}).Return(listenerList, nil)
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: listenerList[0].Name}).Return(listenerList, nil)
 
 				poolList := []pools.Pool{
 					{
@@ -243,19 +263,20 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListPools(pools.ListOpts{Name: poolList[0].Name}).Return(poolList, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: poolList[0].Name}).Return(poolList, nil)
 
 				// existing monitor has default values that need updating
 				existingMonitor := monitors.Monitor{
 					ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
 					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type:           "TCP",
 					Delay:          10,
 					Timeout:        5,
 					MaxRetries:     5,
 					MaxRetriesDown: 3,
 				}
 				monitorList := []monitors.Monitor{existingMonitor}
-				m.ListMonitors(monitors.ListOpts{Name: monitorList[0].Name}).Return(monitorList, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: poolList[0].ID, Name: monitorList[0].Name}).Return(monitorList, nil)
 
 				// Expect update call with the new values
 				updateOpts := monitors.UpdateOpts{
@@ -336,7 +357,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListListeners(listeners.ListOpts{Name: listenerList[0].Name}).Return(listenerList, nil)
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: listenerList[0].Name}).Return(listenerList, nil)
 
 				poolList := []pools.Pool{
 					{
@@ -344,19 +365,20 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListPools(pools.ListOpts{Name: poolList[0].Name}).Return(poolList, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: poolList[0].Name}).Return(poolList, nil)
 
 				// existing monitor has default values that need updating
 				existingMonitor := monitors.Monitor{
 					ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
 					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type:           "TCP",
 					Delay:          10,
 					Timeout:        5,
 					MaxRetries:     5,
 					MaxRetriesDown: 3,
 				}
 				monitorList := []monitors.Monitor{existingMonitor}
-				m.ListMonitors(monitors.ListOpts{Name: monitorList[0].Name}).Return(monitorList, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: poolList[0].ID, Name: monitorList[0].Name}).Return(monitorList, nil)
 
 				// Expect update call with the new values but return an error
 				updateOpts := monitors.UpdateOpts{
@@ -371,6 +393,76 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 			},
 			wantError: fmt.Errorf("failed to update monitor"),
 		},
+		{
+			name:        "should recreate monitor when its type changed",
+			clusterSpec: openStackCluster,
+			expectNetwork: func(*mock.MockNetworkClientMockRecorder) {
+				// add network api call results here
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				activeLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "k8s-clusterapi-cluster-AAAAA-kubeapi",
+					ProvisioningStatus: "ACTIVE",
+				}
+
+				// return existing loadbalancer in active state
+				lbList := []loadbalancers.LoadBalancer{activeLB}
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: activeLB.Name}).Return(lbList, nil)
+
+				// return octavia versions
+				versions := []apiversions.APIVersion{
+					{ID: "2.24"},
+					{ID: "2.23"},
+					{ID: "2.22"},
+				}
+				m.ListOctaviaVersions().Return(versions, nil)
+
+				listenerList := []listeners.Listener{
+					{
+						ID:   "aaaaaaaa-bbbb-cccc-dddd-444444444444",
+						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					},
+				}
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: listenerList[0].Name}).Return(listenerList, nil)
+
+				poolList := []pools.Pool{
+					{
+						ID:   "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					},
+				}
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: poolList[0].Name}).Return(poolList, nil)
+
+				// existing monitor has a different type, which can't be updated
+				existingMonitor := monitors.Monitor{
+					ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
+					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type:           "HTTPS",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+				}
+				m.ListMonitors(monitors.ListOpts{PoolID: poolList[0].ID, Name: existingMonitor.Name}).Return([]monitors.Monitor{existingMonitor}, nil)
+
+				// Expect the monitor to be deleted and created again with the desired type
+				m.DeleteMonitor(existingMonitor.ID).Return(nil)
+				m.CreateMonitor(monitors.CreateOpts{
+					Name:           existingMonitor.Name,
+					PoolID:         "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+					Type:           "TCP",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+				}).Return(&monitors.Monitor{ID: "aaaaaaaa-bbbb-cccc-dddd-777777777777"}, nil)
+
+				// Expect wait for loadbalancer to be active after monitor deletion and creation
+				m.GetLoadBalancer(activeLB.ID).Return(&activeLB, nil).Times(2)
+			},
+			wantError: nil,
+		},
 		{
 			name: "should create monitor when it doesn't exist",
 			clusterSpec: &infrav1.OpenStackCluster{
@@ -429,7 +521,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListListeners(listeners.ListOpts{Name: listenerList[0].Name}).Return(listenerList, nil)
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: listenerList[0].Name}).Return(listenerList, nil)
 
 				poolList := []pools.Pool{
 					{
@@ -437,11 +529,11 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
 					},
 				}
-				m.ListPools(pools.ListOpts{Name: poolList[0].Name}).Return(poolList, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: poolList[0].Name}).Return(poolList, nil)
 
 				// No monitor exists yet
 				var emptyMonitorList []monitors.Monitor
-				m.ListMonitors(monitors.ListOpts{Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0"}).Return(emptyMonitorList, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: poolList[0].ID, Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0"}).Return(emptyMonitorList, nil)
 
 				// Expect create call with custom values
 				createOpts := monitors.CreateOpts{
@@ -470,6 +562,402 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 			},
 			wantError: nil,
 		},
+		{
+			name: "should create listener, pool and monitor for a TERMINATED_HTTPS listener",
+			clusterSpec: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:                   "ingress-https",
+								Protocol:               infrav1.LoadBalancerProtocolTerminatedHTTPS,
+								Port:                   443,
+								MemberPort:             ptr.To(30080),
+								DefaultTLSContainerRef: ptr.To("https://barbican.example.com/v1/containers/ingress"),
+								Members: infrav1.LoadBalancerMemberSelector{
+									Role: infrav1.LoadBalancerMemberRoleWorker,
+								},
+								Monitor: &infrav1.LoadBalancerListenerMonitor{
+									URLPath: ptr.To("/healthz"),
+								},
+							},
+						},
+					},
+					DisableAPIServerFloatingIP: ptr.To(true),
+					ControlPlaneEndpoint: &clusterv1beta1.APIEndpoint{
+						Host: apiHostname,
+						Port: 6443,
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					ExternalNetwork: &infrav1.NetworkStatus{
+						ID: "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+					},
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{
+							{ID: "aaaaaaaa-bbbb-cccc-dddd-222222222222"},
+						},
+					},
+				},
+			},
+			expectNetwork: func(*mock.MockNetworkClientMockRecorder) {
+				// add network api call results here
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				activeLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "k8s-clusterapi-cluster-AAAAA-kubeapi",
+					ProvisioningStatus: "ACTIVE",
+				}
+
+				// return existing loadbalancer in active state
+				lbList := []loadbalancers.LoadBalancer{activeLB}
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: activeLB.Name}).Return(lbList, nil)
+
+				// return octavia versions
+				versions := []apiversions.APIVersion{
+					{ID: "2.24"},
+					{ID: "2.23"},
+					{ID: "2.22"},
+				}
+				m.ListOctaviaVersions().Return(versions, nil)
+
+				// the API server listener, pool and monitor already exist
+				apiObjectsName := "k8s-clusterapi-cluster-AAAAA-kubeapi-0"
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: apiObjectsName}).Return([]listeners.Listener{{ID: "aaaaaaaa-bbbb-cccc-dddd-444444444444", Name: apiObjectsName}}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: apiObjectsName}).Return([]pools.Pool{{ID: "aaaaaaaa-bbbb-cccc-dddd-555555555555", Name: apiObjectsName}}, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: "aaaaaaaa-bbbb-cccc-dddd-555555555555", Name: apiObjectsName}).Return([]monitors.Monitor{
+					{
+						ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
+						Name:           apiObjectsName,
+						Type:           "TCP",
+						Delay:          10,
+						Timeout:        5,
+						MaxRetries:     5,
+						MaxRetriesDown: 3,
+					},
+				}, nil)
+
+				// the ingress listener, pool and monitor are created
+				ingressObjectsName := "k8s-clusterapi-cluster-AAAAA-kubeapi-ingress-https"
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: ingressObjectsName}).Return(nil, nil)
+				m.CreateListener(listeners.CreateOpts{
+					Name:                   ingressObjectsName,
+					Protocol:               listeners.ProtocolTerminatedHTTPS,
+					ProtocolPort:           443,
+					LoadbalancerID:         activeLB.ID,
+					DefaultTlsContainerRef: "https://barbican.example.com/v1/containers/ingress",
+				}).Return(&listeners.Listener{ID: "aaaaaaaa-bbbb-cccc-dddd-777777777777", Name: ingressObjectsName, DefaultTlsContainerRef: "https://barbican.example.com/v1/containers/ingress"}, nil)
+				m.GetListener("aaaaaaaa-bbbb-cccc-dddd-777777777777").Return(&listeners.Listener{ID: "aaaaaaaa-bbbb-cccc-dddd-777777777777"}, nil)
+
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: ingressObjectsName}).Return(nil, nil)
+				m.CreatePool(pools.CreateOpts{
+					Name:       ingressObjectsName,
+					Protocol:   pools.ProtocolHTTP,
+					LBMethod:   pools.LBMethodRoundRobin,
+					ListenerID: "aaaaaaaa-bbbb-cccc-dddd-777777777777",
+				}).Return(&pools.Pool{ID: "aaaaaaaa-bbbb-cccc-dddd-888888888888", Name: ingressObjectsName}, nil)
+
+				m.ListMonitors(monitors.ListOpts{PoolID: "aaaaaaaa-bbbb-cccc-dddd-888888888888", Name: ingressObjectsName}).Return(nil, nil)
+				m.CreateMonitor(monitors.CreateOpts{
+					Name:           ingressObjectsName,
+					PoolID:         "aaaaaaaa-bbbb-cccc-dddd-888888888888",
+					Type:           "HTTP",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+					HTTPMethod:     "GET",
+					URLPath:        "/healthz",
+					ExpectedCodes:  "200",
+				}).Return(&monitors.Monitor{ID: "aaaaaaaa-bbbb-cccc-dddd-999999999999"}, nil)
+
+				m.ListL7Policies(l7policies.ListOpts{ListenerID: "aaaaaaaa-bbbb-cccc-dddd-777777777777"}).Return(nil, nil)
+
+				// Expect wait for loadbalancer to be active after each creation
+				m.GetLoadBalancer(activeLB.ID).Return(&activeLB, nil).Times(3)
+			},
+			wantError: nil,
+		},
+		{
+			name: "should upload the certificate of a listener from a secret and route requests to named pools",
+			clusterSpec: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:          "https",
+								Protocol:      infrav1.LoadBalancerProtocolTerminatedHTTPS,
+								Port:          443,
+								MemberPort:    ptr.To(6443),
+								TLSSecretName: ptr.To("cluster-tls"),
+								Members: infrav1.LoadBalancerMemberSelector{
+									Role: infrav1.LoadBalancerMemberRoleControlPlane,
+								},
+								L7Policies: []infrav1.LoadBalancerL7Policy{
+									{
+										Name: "dashboard",
+										Pool: "dashboard",
+										Rules: []infrav1.LoadBalancerL7Rule{
+											{Type: infrav1.LoadBalancerL7RuleTypeHostName, Value: "dashboard.example.com"},
+											{Type: infrav1.LoadBalancerL7RuleTypePath, CompareType: infrav1.LoadBalancerL7RuleCompareTypeStartsWith, Value: "/ui"},
+										},
+									},
+								},
+							},
+						},
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30443,
+								Members: infrav1.LoadBalancerMemberSelector{
+									Role: infrav1.LoadBalancerMemberRoleWorker,
+								},
+							},
+						},
+					},
+					DisableAPIServerFloatingIP: ptr.To(true),
+					ControlPlaneEndpoint: &clusterv1beta1.APIEndpoint{
+						Host: apiHostname,
+						Port: 6443,
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{
+							{ID: "aaaaaaaa-bbbb-cccc-dddd-222222222222"},
+						},
+					},
+				},
+			},
+			tlsCertificates: map[string]TLSCertificate{"cluster-tls": tlsCertificate},
+			expectNetwork:   func(*mock.MockNetworkClientMockRecorder) {},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				activeLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "k8s-clusterapi-cluster-AAAAA-kubeapi",
+					ProvisioningStatus: "ACTIVE",
+				}
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: activeLB.Name}).Return([]loadbalancers.LoadBalancer{activeLB}, nil)
+				m.ListOctaviaVersions().Return([]apiversions.APIVersion{{ID: "2.24"}}, nil)
+
+				// the API server listener, pool and monitor already exist
+				apiObjectsName := "k8s-clusterapi-cluster-AAAAA-kubeapi-0"
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: apiObjectsName}).Return([]listeners.Listener{{ID: "aaaaaaaa-bbbb-cccc-dddd-444444444444", Name: apiObjectsName}}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: apiObjectsName}).Return([]pools.Pool{{ID: "aaaaaaaa-bbbb-cccc-dddd-555555555555", Name: apiObjectsName}}, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: "aaaaaaaa-bbbb-cccc-dddd-555555555555", Name: apiObjectsName}).Return([]monitors.Monitor{
+					{ID: "aaaaaaaa-bbbb-cccc-dddd-666666666666", Name: apiObjectsName, Type: "TCP", Delay: 10, Timeout: 5, MaxRetries: 5, MaxRetriesDown: 3},
+				}, nil)
+
+				// the named pool and its monitor are created
+				dashboardObjectsName := "k8s-clusterapi-cluster-AAAAA-kubeapi-dashboard"
+				dashboardPool := pools.Pool{ID: "aaaaaaaa-bbbb-cccc-dddd-777777777777", Name: dashboardObjectsName}
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: dashboardObjectsName}).Return(nil, nil)
+				m.CreatePool(pools.CreateOpts{
+					Name:           dashboardObjectsName,
+					Protocol:       pools.ProtocolHTTP,
+					LBMethod:       pools.LBMethodRoundRobin,
+					LoadbalancerID: activeLB.ID,
+				}).Return(&dashboardPool, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: dashboardPool.ID, Name: dashboardObjectsName}).Return(nil, nil)
+				m.CreateMonitor(monitors.CreateOpts{
+					Name:           dashboardObjectsName,
+					PoolID:         dashboardPool.ID,
+					Type:           "HTTP",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+					HTTPMethod:     "GET",
+					URLPath:        "/",
+					ExpectedCodes:  "200",
+				}).Return(&monitors.Monitor{ID: "aaaaaaaa-bbbb-cccc-dddd-888888888888"}, nil)
+
+				// the listener exists with the previous certificate, which is replaced
+				httpsObjectsName := "k8s-clusterapi-cluster-AAAAA-kubeapi-https"
+				httpsListener := listeners.Listener{
+					ID:                     "aaaaaaaa-bbbb-cccc-dddd-999999999999",
+					Name:                   httpsObjectsName,
+					DefaultTlsContainerRef: "https://barbican.example.com/v1/containers/old",
+				}
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: httpsObjectsName}).Return([]listeners.Listener{httpsListener}, nil)
+				newRef := "https://barbican.example.com/v1/containers/new"
+				m.UpdateListener(httpsListener.ID, listeners.UpdateOpts{DefaultTlsContainerRef: &newRef}).Return(&httpsListener, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: httpsObjectsName}).Return([]pools.Pool{{ID: "aaaaaaaa-bbbb-cccc-dddd-aaaaaaaaaaaa", Name: httpsObjectsName}}, nil)
+				m.ListMonitors(monitors.ListOpts{PoolID: "aaaaaaaa-bbbb-cccc-dddd-aaaaaaaaaaaa", Name: httpsObjectsName}).Return([]monitors.Monitor{
+					{ID: "aaaaaaaa-bbbb-cccc-dddd-bbbbbbbbbbbb", Name: httpsObjectsName, Type: "HTTP", Delay: 10, Timeout: 5, MaxRetries: 5, MaxRetriesDown: 3, URLPath: "/", ExpectedCodes: "200"},
+				}, nil)
+
+				// the L7 policy is created and the policy which was removed from the spec is deleted
+				m.ListL7Policies(l7policies.ListOpts{ListenerID: httpsListener.ID}).Return([]l7policies.L7Policy{
+					{ID: "aaaaaaaa-bbbb-cccc-dddd-cccccccccccc", Name: httpsObjectsName + "-removed"},
+				}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: dashboardObjectsName}).Return([]pools.Pool{dashboardPool}, nil)
+				m.CreateL7Policy(l7policies.CreateOpts{
+					Name:           httpsObjectsName + "-dashboard",
+					ListenerID:     httpsListener.ID,
+					Action:         l7policies.ActionRedirectToPool,
+					Position:       1,
+					RedirectPoolID: dashboardPool.ID,
+					Rules: []l7policies.CreateRuleOpts{
+						{RuleType: l7policies.TypeHostName, CompareType: l7policies.CompareTypeEqual, Value: "dashboard.example.com"},
+						{RuleType: l7policies.TypePath, CompareType: l7policies.CompareTypeStartWith, Value: "/ui"},
+					},
+				}).Return(&l7policies.L7Policy{ID: "aaaaaaaa-bbbb-cccc-dddd-dddddddddddd"}, nil)
+				m.DeleteL7Policy("aaaaaaaa-bbbb-cccc-dddd-cccccccccccc").Return(nil)
+
+				// Expect wait for loadbalancer to be active after each change
+				m.GetLoadBalancer(activeLB.ID).Return(&activeLB, nil).Times(5)
+			},
+			expectKeyManager: func(m *mock.MockKeyManagerClientMockRecorder) {
+				containerName := getTLSContainerName("k8s-clusterapi-cluster-AAAAA-kubeapi-https", &tlsCertificate)
+				m.ListContainers(containers.ListOpts{Name: containerName}).Return(nil, nil)
+				m.CreateSecret(secrets.CreateOpts{
+					Name:               containerName + "-certificate",
+					Payload:            string(tlsCertificate.Certificate),
+					PayloadContentType: "text/plain",
+					SecretType:         secrets.CertificateSecret,
+				}).Return(&secrets.Secret{SecretRef: "https://barbican.example.com/v1/secrets/certificate"}, nil)
+				m.CreateSecret(secrets.CreateOpts{
+					Name:               containerName + "-private_key",
+					Payload:            string(tlsCertificate.PrivateKey),
+					PayloadContentType: "text/plain",
+					SecretType:         secrets.PrivateSecret,
+				}).Return(&secrets.Secret{SecretRef: "https://barbican.example.com/v1/secrets/private_key"}, nil)
+				m.CreateContainer(containers.CreateOpts{
+					Type: containers.CertificateContainer,
+					Name: containerName,
+					SecretRefs: []containers.SecretRef{
+						{Name: "certificate", SecretRef: "https://barbican.example.com/v1/secrets/certificate"},
+						{Name: "private_key", SecretRef: "https://barbican.example.com/v1/secrets/private_key"},
+					},
+				}).Return(&containers.Container{ContainerRef: "https://barbican.example.com/v1/containers/new"}, nil)
+
+				// the previous container was created for the listener, so it is deleted
+				m.GetContainer("old").Return(&containers.Container{
+					Name:         "k8s-clusterapi-cluster-AAAAA-kubeapi-https-0123456789",
+					ContainerRef: "https://barbican.example.com/v1/containers/old",
+					SecretRefs: []containers.SecretRef{
+						{Name: "certificate", SecretRef: "https://barbican.example.com/v1/secrets/old-certificate"},
+					},
+				}, nil)
+				m.DeleteContainer("old").Return(nil)
+				m.DeleteSecret("old-certificate").Return(nil)
+			},
+			wantError: nil,
+		},
+		{
+			name: "should adopt the referenced load balancer and create the API server objects on it",
+			clusterSpec: &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "default",
+				},
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							ID: ptr.To("aaaaaaaa-bbbb-cccc-dddd-333333333333"),
+						},
+					},
+					Tags: []string{"k8s"},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{
+							{ID: "aaaaaaaa-bbbb-cccc-dddd-222222222222"},
+						},
+					},
+				},
+			},
+			expectNetwork: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetNetwork("aaaaaaaa-bbbb-cccc-dddd-111111111111").Return(&networks.Network{ID: "aaaaaaaa-bbbb-cccc-dddd-111111111111", Name: "vip-network"}, nil)
+				m.GetSubnet("aaaaaaaa-bbbb-cccc-dddd-444444444444").Return(&subnets.Subnet{ID: "aaaaaaaa-bbbb-cccc-dddd-444444444444", Name: "vip-subnet", CIDR: "10.0.0.0/24"}, nil)
+				// the floating IP of the VIP is reported, but not managed
+				m.ListFloatingIP(floatingips.ListOpts{PortID: "aaaaaaaa-bbbb-cccc-dddd-555555555555"}).Return([]floatingips.FloatingIP{{FloatingIP: "203.0.113.10"}}, nil)
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				adoptedLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "network-team-lb",
+					VipAddress:         "10.0.0.10",
+					VipNetworkID:       "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+					VipSubnetID:        "aaaaaaaa-bbbb-cccc-dddd-444444444444",
+					VipPortID:          "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+					ProvisioningStatus: "ACTIVE",
+				}
+				m.GetLoadBalancer(adoptedLB.ID).Return(&adoptedLB, nil)
+
+				// return octavia versions
+				versions := []apiversions.APIVersion{
+					{ID: "2.24"},
+					{ID: "2.23"},
+					{ID: "2.22"},
+				}
+				m.ListOctaviaVersions().Return(versions, nil)
+
+				// the API server listener and pool are created with the owner tag
+				tags := []string{"k8s", "cluster-api-provider-openstack:default/cluster"}
+				apiObjectsName := "k8s-clusterapi-cluster-AAAAA-kubeapi-0"
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: apiObjectsName}).Return(nil, nil)
+				m.CreateListener(listeners.CreateOpts{
+					Name:           apiObjectsName,
+					Protocol:       listeners.ProtocolTCP,
+					LoadbalancerID: adoptedLB.ID,
+					Tags:           tags,
+					AllowedCIDRs:   []string{},
+				}).Return(&listeners.Listener{ID: "aaaaaaaa-bbbb-cccc-dddd-666666666666", Name: apiObjectsName}, nil)
+				m.GetListener("aaaaaaaa-bbbb-cccc-dddd-666666666666").Return(&listeners.Listener{ID: "aaaaaaaa-bbbb-cccc-dddd-666666666666"}, nil)
+
+				m.ListPools(pools.ListOpts{LoadbalancerID: "aaaaaaaa-bbbb-cccc-dddd-333333333333", Name: apiObjectsName}).Return(nil, nil)
+				m.CreatePool(pools.CreateOpts{
+					Name:       apiObjectsName,
+					Protocol:   pools.ProtocolTCP,
+					LBMethod:   pools.LBMethodRoundRobin,
+					ListenerID: "aaaaaaaa-bbbb-cccc-dddd-666666666666",
+					Tags:       tags,
+				}).Return(&pools.Pool{ID: "aaaaaaaa-bbbb-cccc-dddd-777777777777", Name: apiObjectsName}, nil)
+
+				m.ListMonitors(monitors.ListOpts{PoolID: "aaaaaaaa-bbbb-cccc-dddd-777777777777", Name: apiObjectsName}).Return([]monitors.Monitor{
+					{
+						ID:             "aaaaaaaa-bbbb-cccc-dddd-888888888888",
+						Name:           apiObjectsName,
+						Type:           "TCP",
+						Delay:          10,
+						Timeout:        5,
+						MaxRetries:     5,
+						MaxRetriesDown: 3,
+					},
+				}, nil)
+
+				// Expect wait for loadbalancer to be active after each creation
+				m.GetLoadBalancer(adoptedLB.ID).Return(&adoptedLB, nil).Times(2)
+			},
+			wantLBStatus: &infrav1.LoadBalancer{
+				Name:         "network-team-lb",
+				ID:           "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+				IP:           "203.0.113.10",
+				InternalIP:   "10.0.0.10",
+				Adopted:      true,
+				AllowedCIDRs: []string{},
+				LoadBalancerNetwork: &infrav1.NetworkStatusWithSubnets{
+					NetworkStatus: infrav1.NetworkStatus{
+						Name: "vip-network",
+						ID:   "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+					},
+					Subnets: []infrav1.Subnet{
+						{
+							Name: "vip-subnet",
+							ID:   "aaaaaaaa-bbbb-cccc-dddd-444444444444",
+							CIDR: "10.0.0.0/24",
+						},
+					},
+				},
+			},
+			wantError: nil,
+		},
 	}
 	for _, tt := range lbtests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -482,12 +970,18 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 
 			tt.expectNetwork(mockScopeFactory.NetworkClient.EXPECT())
 			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
-			_, err = lbs.ReconcileLoadBalancer(tt.clusterSpec, "AAAAA", 0)
+			if tt.expectKeyManager != nil {
+				tt.expectKeyManager(mockScopeFactory.KeyManagerClient.EXPECT())
+			}
+			_, err = lbs.ReconcileLoadBalancer(tt.clusterSpec, "AAAAA", 0, tt.tlsCertificates)
 			if tt.wantError != nil {
 				g.Expect(err).To(MatchError(tt.wantError))
 			} else {
 				g.Expect(err).NotTo(HaveOccurred())
 			}
+			if tt.wantLBStatus != nil {
+				g.Expect(tt.clusterSpec.Status.APIServerLoadBalancer).To(Equal(tt.wantLBStatus))
+			}
 		})
 	}
 }
@@ -906,6 +1400,85 @@ func Test_getOrCreateAPILoadBalancer(t *testing.T) {
 				VipSubnetID: "aaaaaaaa-bbbb-cccc-dddd-222222222222",
 			},
 		},
+		{
+			name: "referenced loadbalancer adopted by filter",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							Filter: &infrav1.LoadBalancerFilter{
+								Name: "network-team-lb",
+								FilterByNeutronTags: infrav1.FilterByNeutronTags{
+									Tags: []infrav1.NeutronTag{"approved"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: "network-team-lb", Tags: []string{"approved"}}).Return([]loadbalancers.LoadBalancer{{ID: "BBBBB"}}, nil)
+			},
+			want: &loadbalancers.LoadBalancer{
+				ID: "BBBBB",
+			},
+		},
+		{
+			name: "referenced loadbalancer filter matches multiple loadbalancers",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							Filter: &infrav1.LoadBalancerFilter{Name: "network-team-lb"},
+						},
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: "network-team-lb"}).Return([]loadbalancers.LoadBalancer{{ID: "BBBBB"}, {ID: "CCCCC"}}, nil)
+			},
+			wantError: capoerrors.ErrMultipleMatches,
+		},
+		{
+			name: "referenced loadbalancer ID doesn't exist",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							ID: ptr.To("aaaaaaaa-bbbb-cccc-dddd-333333333333"),
+						},
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.GetLoadBalancer("aaaaaaaa-bbbb-cccc-dddd-333333333333").Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+			},
+			wantError: capoerrors.ErrNoMatches,
+		},
+		{
+			name: "adopted loadbalancer is looked up by the ID in the status",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							Filter: &infrav1.LoadBalancerFilter{Name: "network-team-lb"},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					APIServerLoadBalancer: &infrav1.LoadBalancer{
+						ID:      "BBBBB",
+						Adopted: true,
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.GetLoadBalancer("BBBBB").Return(&loadbalancers.LoadBalancer{ID: "BBBBB"}, nil)
+			},
+			want: &loadbalancers.LoadBalancer{
+				ID: "BBBBB",
+			},
+		},
 	}
 	for _, tt := range lbtests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -928,6 +1501,85 @@ func Test_getOrCreateAPILoadBalancer(t *testing.T) {
 	}
 }
 
+func Test_DeleteLoadBalancer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	adoptedCluster := func(lbStatus *infrav1.LoadBalancer) *infrav1.OpenStackCluster {
+		return &infrav1.OpenStackCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "test-namespace"},
+			Spec: infrav1.OpenStackClusterSpec{
+				APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+					Enabled: ptr.To(true),
+					LoadBalancerRef: &infrav1.LoadBalancerParam{
+						ID: ptr.To("aaaaaaaa-bbbb-cccc-dddd-333333333333"),
+					},
+				},
+			},
+			Status: infrav1.OpenStackClusterStatus{
+				APIServerLoadBalancer: lbStatus,
+			},
+		}
+	}
+
+	lbtests := []struct {
+		name               string
+		openStackCluster   *infrav1.OpenStackCluster
+		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
+	}{
+		{
+			name: "adopted loadbalancer is kept and only the objects created by CAPO are deleted",
+			openStackCluster: adoptedCluster(&infrav1.LoadBalancer{
+				ID:      "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+				Adopted: true,
+			}),
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				adoptedLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "network-team-lb",
+					ProvisioningStatus: "ACTIVE",
+				}
+				m.GetLoadBalancer(adoptedLB.ID).Return(&adoptedLB, nil).Times(4)
+
+				// Objects of the owner of the load balancer don't have the
+				// owner tag of the cluster, even if their names match
+				ownerTags := []string{"cluster-api-provider-openstack:test-namespace/cluster"}
+				m.ListListeners(listeners.ListOpts{LoadbalancerID: adoptedLB.ID, Tags: ownerTags}).Return([]listeners.Listener{
+					{ID: "aaaaaaaa-bbbb-cccc-dddd-444444444444", Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-6443", Tags: ownerTags},
+				}, nil)
+				m.DeleteListener("aaaaaaaa-bbbb-cccc-dddd-444444444444").Return(nil)
+
+				m.ListPools(pools.ListOpts{LoadbalancerID: adoptedLB.ID, Tags: ownerTags}).Return([]pools.Pool{
+					{ID: "aaaaaaaa-bbbb-cccc-dddd-666666666666", Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-6443", Tags: ownerTags},
+				}, nil)
+				m.DeletePool("aaaaaaaa-bbbb-cccc-dddd-666666666666").Return(nil)
+			},
+		},
+		{
+			name:             "referenced loadbalancer which was never adopted",
+			openStackCluster: adoptedCluster(nil),
+			expectLoadBalancer: func(*mock.MockLbClientMockRecorder) {
+				// nothing to delete
+			},
+		},
+	}
+	for _, tt := range lbtests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			lbs, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
+			result, err := lbs.DeleteLoadBalancer(tt.openStackCluster, "AAAAA")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(BeNil())
+		})
+	}
+}
+
 func Test_ReconcileLoadBalancerMember(t *testing.T) {
 	g := NewWithT(t)
 	mockCtrl := gomock.NewController(t)
@@ -988,6 +1640,43 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 		}
 	}
 
+	makeClusterWithListeners := func() *infrav1.OpenStackCluster {
+		cluster := makeCluster(nil, clusterNetID)
+		cluster.Spec.APIServerLoadBalancer.Listeners = []infrav1.LoadBalancerListener{
+			{
+				Name:       "ingress",
+				Port:       80,
+				MemberPort: ptr.To(30080),
+				Members: infrav1.LoadBalancerMemberSelector{
+					MachineSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"ingress": "true"},
+					},
+				},
+			},
+			{
+				Name: "control-plane-only",
+				Port: 8080,
+				Members: infrav1.LoadBalancerMemberSelector{
+					Role: infrav1.LoadBalancerMemberRoleControlPlane,
+				},
+			},
+		}
+		return cluster
+	}
+
+	controlPlaneMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   machineName,
+			Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+		},
+	}
+	ingressWorkerMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   machineName,
+			Labels: map[string]string{"ingress": "true"},
+		},
+	}
+
 	openStackMachine := &infrav1.OpenStackMachine{
 		ObjectMeta: metav1.ObjectMeta{Name: machineName},
 	}
@@ -995,6 +1684,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 	lbtests := []struct {
 		name               string
 		clusterSpec        *infrav1.OpenStackCluster
+		machine            *clusterv1.Machine
 		expectNetwork      func(m *mock.MockNetworkClientMockRecorder)
 		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
 		wantError          error
@@ -1015,7 +1705,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 					ID:   poolID,
 					Name: fmt.Sprintf("%s-kubeapi-%d", clusterResourceName, port),
 				}
-				m.ListPools(pools.ListOpts{Name: pool.Name}).Return([]pools.Pool{pool}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: pool.Name}).Return([]pools.Pool{pool}, nil)
 
 				member := pools.Member{
 					Name:    fmt.Sprintf("%s-kubeapi-%d-%s", clusterResourceName, port, machineName),
@@ -1041,7 +1731,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 					ID:   poolID,
 					Name: fmt.Sprintf("%s-kubeapi-%d", clusterResourceName, port),
 				}
-				m.ListPools(pools.ListOpts{Name: pool.Name}).Return([]pools.Pool{pool}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: pool.Name}).Return([]pools.Pool{pool}, nil)
 
 				poolMemberName := fmt.Sprintf("%s-kubeapi-%d-%s", clusterResourceName, port, machineName)
 				m.ListPoolMember(poolID, pools.ListMembersOpts{Name: poolMemberName}).Return([]pools.Member{}, nil)
@@ -1081,7 +1771,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 				m.GetLoadBalancer(lbID).Return(&activeLB, nil).AnyTimes()
 
 				poolName := fmt.Sprintf("%s-kubeapi-%d", clusterResourceName, port)
-				m.ListPools(pools.ListOpts{Name: poolName}).Return([]pools.Pool{}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: poolName}).Return([]pools.Pool{}, nil)
 			},
 			wantError: errors.New("load balancer pool does not exist yet"),
 		},
@@ -1101,7 +1791,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 					ID:   poolID,
 					Name: fmt.Sprintf("%s-kubeapi-%d", clusterResourceName, port),
 				}
-				m.ListPools(pools.ListOpts{Name: pool.Name}).Return([]pools.Pool{pool}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: pool.Name}).Return([]pools.Pool{pool}, nil)
 
 				poolMemberName := fmt.Sprintf("%s-kubeapi-%d-%s", clusterResourceName, port, machineName)
 
@@ -1151,7 +1841,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 					ID:   poolID,
 					Name: fmt.Sprintf("%s-kubeapi-%d", clusterResourceName, port),
 				}
-				m.ListPools(pools.ListOpts{Name: pool.Name}).Return([]pools.Pool{pool}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: pool.Name}).Return([]pools.Pool{pool}, nil)
 
 				poolMemberName := fmt.Sprintf("%s-kubeapi-%d-%s", clusterResourceName, port, machineName)
 				m.ListPoolMember(poolID, pools.ListMembersOpts{Name: poolMemberName}).Return([]pools.Member{}, nil)
@@ -1180,6 +1870,62 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 			},
 			wantError: nil,
 		},
+		{
+			name:          "Worker selected by a listener, create member in the listener pool only",
+			clusterSpec:   makeClusterWithListeners(),
+			machine:       ingressWorkerMachine,
+			expectNetwork: func(*mock.MockNetworkClientMockRecorder) {},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				activeLB := loadbalancers.LoadBalancer{
+					ID:                 lbID,
+					Name:               clusterResourceName + "-kubeapi",
+					ProvisioningStatus: "ACTIVE",
+				}
+				m.GetLoadBalancer(lbID).Return(&activeLB, nil).AnyTimes()
+
+				ingressPool := pools.Pool{
+					ID:   poolID,
+					Name: clusterResourceName + "-kubeapi-ingress",
+				}
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: ingressPool.Name}).Return([]pools.Pool{ingressPool}, nil)
+
+				poolMemberName := clusterResourceName + "-kubeapi-ingress-" + machineName
+				m.ListPoolMember(poolID, pools.ListMembersOpts{Name: poolMemberName}).Return([]pools.Member{}, nil)
+
+				m.CreatePoolMember(
+					poolID,
+					gomock.AssignableToTypeOf(pools.CreateMemberOpts{}),
+				).DoAndReturn(func(_ string, got pools.CreateMemberOpts) (*pools.Member, error) {
+					g.Expect(got.Name).To(Equal(poolMemberName))
+					g.Expect(got.Address).To(Equal(memberIP))
+					g.Expect(got.ProtocolPort).To(Equal(30080))
+					return &pools.Member{ID: memberID}, nil
+				})
+
+				activeMember := pools.Member{
+					ID:                 memberID,
+					Name:               poolMemberName,
+					ProvisioningStatus: "ACTIVE",
+				}
+				m.GetPoolMember(poolID, memberID).Return(&activeMember, nil)
+
+				// The worker is not selected by the control plane only listener, so a stale member is removed.
+				otherPoolID := "aaaaaaaa-bbbb-cccc-dddd-777777777777"
+				otherPool := pools.Pool{
+					ID:   otherPoolID,
+					Name: clusterResourceName + "-kubeapi-control-plane-only",
+				}
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: otherPool.Name}).Return([]pools.Pool{otherPool}, nil)
+
+				staleMember := pools.Member{
+					ID:   "aaaaaaaa-bbbb-cccc-dddd-888888888888",
+					Name: clusterResourceName + "-kubeapi-control-plane-only-" + machineName,
+				}
+				m.ListPoolMember(otherPoolID, pools.ListMembersOpts{Name: staleMember.Name}).Return([]pools.Member{staleMember}, nil)
+				m.DeletePoolMember(otherPoolID, staleMember.ID).Return(nil)
+			},
+			wantError: nil,
+		},
 	}
 
 	for _, tt := range lbtests {
@@ -1194,7 +1940,12 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 			tt.expectNetwork(mockScopeFactory.NetworkClient.EXPECT())
 			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
 
-			err = lbs.ReconcileLoadBalancerMember(tt.clusterSpec, openStackMachine, clusterName, memberIP)
+			machine := tt.machine
+			if machine == nil {
+				machine = controlPlaneMachine
+			}
+
+			err = lbs.ReconcileLoadBalancerMember(tt.clusterSpec, machine, openStackMachine, clusterName, memberIP)
 			if tt.wantError != nil {
 				g.Expect(err).To(MatchError(tt.wantError))
 			} else {
@@ -1203,3 +1954,149 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 		})
 	}
 }
+
+func Test_DrainLoadBalancerMember(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// Shortcut wait timeout
+	backoffDurationPrev := backoff.Duration
+	backoff.Duration = 0
+	defer func() {
+		backoff.Duration = backoffDurationPrev
+	}()
+
+	const (
+		lbName      = "k8s-clusterapi-cluster-AAAAA-kubeapi"
+		poolName    = lbName + "-6443"
+		memberName  = poolName + "-machine-1"
+		machineName = "machine-1"
+
+		lbID     = "aaaaaaaa-bbbb-cccc-dddd-333333333333"
+		poolID   = "aaaaaaaa-bbbb-cccc-dddd-555555555555"
+		memberID = "aaaaaaaa-bbbb-cccc-dddd-666666666666"
+	)
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+				Enabled:            ptr.To(true),
+				MemberDrainTimeout: &metav1.Duration{Duration: time.Minute},
+			},
+			ControlPlaneEndpoint: &clusterv1beta1.APIEndpoint{
+				Host: apiHostname,
+				Port: 6443,
+			},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   machineName,
+			Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+		},
+	}
+	openStackMachine := &infrav1.OpenStackMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: machineName},
+	}
+
+	activeLB := loadbalancers.LoadBalancer{
+		ID:                 lbID,
+		Name:               lbName,
+		ProvisioningStatus: "ACTIVE",
+	}
+
+	expectMember := func(m *mock.MockLbClientMockRecorder, member pools.Member) {
+		m.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return([]loadbalancers.LoadBalancer{activeLB}, nil)
+		m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: poolName}).Return([]pools.Pool{{ID: poolID, Name: poolName}}, nil)
+		member.ID = memberID
+		member.Name = memberName
+		m.ListPoolMember(poolID, pools.ListMembersOpts{Name: memberName}).Return([]pools.Member{member}, nil)
+	}
+
+	tests := []struct {
+		name               string
+		drainMode          *infrav1.MemberDrainMode
+		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
+		wantDrained        bool
+	}{
+		{
+			name: "should set the weight of an online member to 0",
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				expectMember(m, pools.Member{Weight: 1, AdminStateUp: true, OperatingStatus: "ONLINE"})
+				m.GetLoadBalancer(lbID).Return(&activeLB, nil).Times(2)
+				m.UpdatePoolMember(poolID, memberID, pools.UpdateMemberOpts{Weight: ptr.To(0)}).Return(&pools.Member{ID: memberID}, nil)
+			},
+		},
+		{
+			name: "should not update a member whose weight is already 0",
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				expectMember(m, pools.Member{Weight: 0, AdminStateUp: true, OperatingStatus: "DRAINING"})
+			},
+		},
+		{
+			name:      "should set the admin state of a member to down",
+			drainMode: ptr.To(infrav1.MemberDrainModeAdminStateDown),
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				expectMember(m, pools.Member{Weight: 1, AdminStateUp: true, OperatingStatus: "ONLINE"})
+				m.GetLoadBalancer(lbID).Return(&activeLB, nil).Times(2)
+				m.UpdatePoolMember(poolID, memberID, pools.UpdateMemberOpts{AdminStateUp: ptr.To(false)}).Return(&pools.Member{ID: memberID}, nil)
+			},
+		},
+		{
+			name:      "should not update a member whose admin state is already down",
+			drainMode: ptr.To(infrav1.MemberDrainModeAdminStateDown),
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				expectMember(m, pools.Member{Weight: 1, AdminStateUp: false, OperatingStatus: "OFFLINE"})
+			},
+		},
+		{
+			name: "should report a member taken offline while draining by weight as drained",
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				expectMember(m, pools.Member{Weight: 0, AdminStateUp: false, OperatingStatus: "OFFLINE"})
+			},
+			wantDrained: true,
+		},
+		{
+			name:      "should report a member in error as drained",
+			drainMode: ptr.To(infrav1.MemberDrainModeAdminStateDown),
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				expectMember(m, pools.Member{Weight: 1, AdminStateUp: false, OperatingStatus: "ERROR"})
+			},
+			wantDrained: true,
+		},
+		{
+			name: "should report a missing member",
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return([]loadbalancers.LoadBalancer{activeLB}, nil)
+				m.ListPools(pools.ListOpts{LoadbalancerID: lbID, Name: poolName}).Return([]pools.Pool{{ID: poolID, Name: poolName}}, nil)
+				m.ListPoolMember(poolID, pools.ListMembersOpts{Name: memberName}).Return(nil, nil)
+			},
+			wantDrained: true,
+		},
+		{
+			name: "should report a missing load balancer",
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: lbName}).Return(nil, nil)
+			},
+			wantDrained: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			lbs, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			openStackCluster := openStackCluster.DeepCopy()
+			openStackCluster.Spec.APIServerLoadBalancer.MemberDrainMode = tt.drainMode
+
+			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
+			drained, err := lbs.DrainLoadBalancerMember(openStackCluster, machine, openStackMachine, "AAAAA")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(drained).To(Equal(tt.wantDrained))
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 type Service struct {
 	scope              *scope.WithLogger
 	loadbalancerClient clients.LbClient
+	keyManagerClient   clients.KeyManagerClient
 	networkingService  *networking.Service
 }
 
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// TLSCertificate is a PEM encoded certificate chain and private key used by
+// a listener to terminate TLS.
+type TLSCertificate struct {
+	Certificate []byte
+	PrivateKey  []byte
+}
+
+// getKeyManagerClient returns the Barbican client of the service. It is only
+// created when needed so that clouds without Barbican keep working as long as
+// no listener uses a certificate from a Secret.
+func (s *Service) getKeyManagerClient() (clients.KeyManagerClient, error) {
+	if s.keyManagerClient == nil {
+		keyManagerClient, err := s.scope.NewKeyManagerClient()
+		if err != nil {
+			return nil, err
+		}
+		s.keyManagerClient = keyManagerClient
+	}
+	return s.keyManagerClient, nil
+}
+
+// getTLSContainerName returns the name of the Barbican container holding the
+// given certificate of a listener. The name contains a hash of the
+// certificate so that a new container is created when the certificate changes.
+func getTLSContainerName(listenerObjectsName string, cert *TLSCertificate) string {
+	hash := sha256.New()
+	hash.Write(cert.Certificate)
+	hash.Write(cert.PrivateKey)
+	return fmt.Sprintf("%s-%s", listenerObjectsName, hex.EncodeToString(hash.Sum(nil))[:10])
+}
+
+// getBarbicanID returns the ID of a Barbican secret or container from its reference.
+func getBarbicanID(ref string) string {
+	return path.Base(ref)
+}
+
+// reconcileTLSContainer ensures that a Barbican certificate container exists
+// for the certificate of a listener and returns its reference.
+func (s *Service) reconcileTLSContainer(openStackCluster *infrav1.OpenStackCluster, listenerObjectsName string, cert *TLSCertificate) (string, error) {
+	keyManagerClient, err := s.getKeyManagerClient()
+	if err != nil {
+		return "", err
+	}
+
+	containerName := getTLSContainerName(listenerObjectsName, cert)
+	containerList, err := keyManagerClient.ListContainers(containers.ListOpts{Name: containerName})
+	if err != nil {
+		return "", err
+	}
+	if len(containerList) > 0 {
+		return containerList[0].ContainerRef, nil
+	}
+
+	certificate, intermediates, err := splitCertificateChain(cert.Certificate)
+	if err != nil {
+		return "", fmt.Errorf("invalid certificate for listener %s: %w", listenerObjectsName, err)
+	}
+
+	s.scope.Logger().Info("Creating TLS certificate container", "name", containerName)
+
+	type tlsSecret struct {
+		name       string
+		secretType secrets.SecretType
+		payload    []byte
+	}
+	tlsSecrets := []tlsSecret{
+		{"certificate", secrets.CertificateSecret, certificate},
+		{"private_key", secrets.PrivateSecret, cert.PrivateKey},
+	}
+	if len(intermediates) > 0 {
+		tlsSecrets = append(tlsSecrets, tlsSecret{"intermediates", secrets.CertificateSecret, intermediates})
+	}
+
+	secretRefs := make([]containers.SecretRef, 0, len(tlsSecrets))
+	deleteSecrets := func() {
+		for _, secretRef := range secretRefs {
+			if err := keyManagerClient.DeleteSecret(getBarbicanID(secretRef.SecretRef)); err != nil {
+				s.scope.Logger().Error(err, "Failed to delete TLS secret", "secretRef", secretRef.SecretRef)
+			}
+		}
+	}
+	for _, p := range tlsSecrets {
+		secret, err := keyManagerClient.CreateSecret(secrets.CreateOpts{
+			Name:               containerName + "-" + p.name,
+			Payload:            string(p.payload),
+			PayloadContentType: "text/plain",
+			SecretType:         p.secretType,
+		})
+		if err != nil {
+			deleteSecrets()
+			record.Warnf(openStackCluster, "FailedCreateTLSContainer", "Failed to create TLS secret %s-%s: %v", containerName, p.name, err)
+			return "", err
+		}
+		secretRefs = append(secretRefs, containers.SecretRef{Name: p.name, SecretRef: secret.SecretRef})
+	}
+
+	container, err := keyManagerClient.CreateContainer(containers.CreateOpts{
+		Type:       containers.CertificateContainer,
+		Name:       containerName,
+		SecretRefs: secretRefs,
+	})
+	if err != nil {
+		deleteSecrets()
+		record.Warnf(openStackCluster, "FailedCreateTLSContainer", "Failed to create TLS container %s: %v", containerName, err)
+		return "", err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulCreateTLSContainer", "Created TLS container %s", containerName)
+	return container.ContainerRef, nil
+}
+
+// deleteTLSContainer deletes the Barbican container with the given reference
+// together with its secrets if it was created for the listener with the given
+// objects name. Containers provided by the user are left untouched.
+func (s *Service) deleteTLSContainer(openStackCluster *infrav1.OpenStackCluster, listenerObjectsName, containerRef string) error {
+	if containerRef == "" {
+		return nil
+	}
+
+	keyManagerClient, err := s.getKeyManagerClient()
+	if err != nil {
+		return err
+	}
+
+	container, err := keyManagerClient.GetContainer(getBarbicanID(containerRef))
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(container.Name, listenerObjectsName+"-") {
+		return nil
+	}
+	return s.deleteTLSContainerAndSecrets(openStackCluster, keyManagerClient, container)
+}
+
+// deleteTLSContainers deletes the Barbican containers created for the
+// listeners of the load balancer which use a certificate from a Secret.
+func (s *Service) deleteTLSContainers(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+	if !hasTLSSecretListeners(openStackCluster) {
+		return nil
+	}
+
+	keyManagerClient, err := s.getKeyManagerClient()
+	if err != nil {
+		return err
+	}
+
+	containerList, err := keyManagerClient.ListContainers(containers.ListOpts{})
+	if err != nil {
+		return err
+	}
+
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	for _, lbListener := range getLoadBalancerListeners(openStackCluster) {
+		prefix := getListenerObjectsName(loadBalancerName, lbListener.Name) + "-"
+		for i := range containerList {
+			if !strings.HasPrefix(containerList[i].Name, prefix) {
+				continue
+			}
+			if err := s.deleteTLSContainerAndSecrets(openStackCluster, keyManagerClient, &containerList[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteTLSContainerAndSecrets(openStackCluster *infrav1.OpenStackCluster, keyManagerClient clients.KeyManagerClient, container *containers.Container) error {
+	s.scope.Logger().Info("Deleting TLS certificate container", "name", container.Name)
+
+	if err := keyManagerClient.DeleteContainer(getBarbicanID(container.ContainerRef)); err != nil {
+		record.Warnf(openStackCluster, "FailedDeleteTLSContainer", "Failed to delete TLS container %s: %v", container.Name, err)
+		return err
+	}
+	for _, secretRef := range container.SecretRefs {
+		if err := keyManagerClient.DeleteSecret(getBarbicanID(secretRef.SecretRef)); err != nil {
+			record.Warnf(openStackCluster, "FailedDeleteTLSContainer", "Failed to delete TLS secret %s of container %s: %v", secretRef.Name, container.Name, err)
+			return err
+		}
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulDeleteTLSContainer", "Deleted TLS container %s", container.Name)
+	return nil
+}
+
+// hasTLSSecretListeners returns true if a listener of the load balancer uses
+// a certificate from a Secret.
+func hasTLSSecretListeners(openStackCluster *infrav1.OpenStackCluster) bool {
+	for _, lbListener := range getLoadBalancerListeners(openStackCluster) {
+		if lbListener.TLSSecretName != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCertificateChain splits a PEM encoded certificate chain into the leaf
+// certificate and the intermediate certificates.
+func splitCertificateChain(chain []byte) ([]byte, []byte, error) {
+	var certificate, intermediates []byte
+	for rest := chain; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if certificate == nil {
+			certificate = pem.EncodeToMemory(block)
+		} else {
+			intermediates = append(intermediates, pem.EncodeToMemory(block)...)
+		}
+	}
+	if certificate == nil {
+		return nil, nil, errors.New("no PEM encoded certificate found")
+	}
+	return certificate, intermediates, nil
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/addressgroups"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
+)
+
+const (
+	addressGroupSuffix string = "addrgroup"
+
+	allNodesRuleScope     string = "allnodes"
+	controlPlaneRuleScope string = "controlplane"
+	workerRuleScope       string = "worker"
+)
+
+// remoteAddressGroups maps a rule scope to a map of rule name to the resolved
+// address group ID.
+type remoteAddressGroups map[string]map[string]string
+
+// reconcileRemoteAddressGroups resolves the remoteAddressGroup of every managed
+// security group rule. Address groups referenced by ID or filter are only
+// looked up. Address groups given as an inline list of addresses are created
+// if they don't exist, and their addresses are kept in sync with the spec.
+// It returns the resolved address group IDs and the address groups owned by CAPO.
+func (s *Service) reconcileRemoteAddressGroups(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (remoteAddressGroups, []infrav1.AddressGroupStatus, error) {
+	managedSecurityGroups := openStackCluster.Spec.ManagedSecurityGroups
+	if managedSecurityGroups == nil {
+		return nil, nil, nil
+	}
+
+	rulesByScope := map[string][]infrav1.SecurityGroupRuleSpec{
+		allNodesRuleScope:     managedSecurityGroups.AllNodesSecurityGroupRules,
+		controlPlaneRuleScope: managedSecurityGroups.ControlPlaneNodesSecurityGroupRules,
+		workerRuleScope:       managedSecurityGroups.WorkerNodesSecurityGroupRules,
+	}
+
+	resolved := remoteAddressGroups{}
+	var managed []infrav1.AddressGroupStatus
+	// Iterate in a stable order so address groups are always reconciled in the same order
+	for _, ruleScope := range slices.Sorted(maps.Keys(rulesByScope)) {
+		for _, rule := range rulesByScope[ruleScope] {
+			if rule.RemoteAddressGroup == nil {
+				continue
+			}
+
+			var id string
+			var err error
+			if len(rule.RemoteAddressGroup.Addresses) > 0 {
+				name := getAddressGroupName(clusterResourceName, ruleScope, rule.Name)
+				id, err = s.getOrCreateAddressGroup(openStackCluster, name, rule.RemoteAddressGroup.Addresses)
+				if err != nil {
+					return nil, nil, err
+				}
+				managed = append(managed, infrav1.AddressGroupStatus{Name: name, ID: id})
+			} else {
+				id, err = s.getAddressGroupID(rule.RemoteAddressGroup)
+				if err != nil {
+					return nil, nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+				}
+			}
+
+			if resolved[ruleScope] == nil {
+				resolved[ruleScope] = map[string]string{}
+			}
+			resolved[ruleScope][rule.Name] = id
+		}
+	}
+
+	slices.SortFunc(managed, func(a, b infrav1.AddressGroupStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return resolved, managed, nil
+}
+
+// getAddressGroupID returns the ID of an existing address group referenced by ID or filter.
+func (s *Service) getAddressGroupID(param *infrav1.AddressGroupParam) (string, error) {
+	if param.ID != nil {
+		return *param.ID, nil
+	}
+
+	if param.Filter == nil {
+		// Should have been caught by validation
+		return "", fmt.Errorf("address group param must have id, filter or addresses")
+	}
+
+	listOpts := filterconvert.AddressGroupFilterToListOpts(param.Filter)
+	if listOpts.ProjectID == "" {
+		listOpts.ProjectID = s.scope.ProjectID()
+	}
+	addressGroups, err := s.client.ListAddressGroup(listOpts)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(addressGroups) {
+	case 0:
+		return "", fmt.Errorf("no address group found matching filter")
+	case 1:
+		return addressGroups[0].ID, nil
+	}
+	return "", fmt.Errorf("more than one address group found matching filter")
+}
+
+func (s *Service) getOrCreateAddressGroup(openStackCluster *infrav1.OpenStackCluster, name string, addresses []string) (string, error) {
+	addressGroup, err := s.getAddressGroupByName(name)
+	if err != nil {
+		return "", err
+	}
+
+	if addressGroup == nil {
+		s.scope.Logger().V(5).Info("Address group doesn't exist, creating it", "name", name)
+		addressGroup, err = s.client.CreateAddressGroup(addressgroups.CreateOpts{
+			Name:        name,
+			Description: "Cluster API managed address group",
+			Addresses:   addresses,
+		})
+		if err != nil {
+			record.Warnf(openStackCluster, "FailedCreateAddressGroup", "Failed to create address group %s: %v", name, err)
+			return "", err
+		}
+		record.Eventf(openStackCluster, "SuccessfulCreateAddressGroup", "Created address group %s with id %s", name, addressGroup.ID)
+		return addressGroup.ID, nil
+	}
+
+	if err := s.reconcileAddressGroupAddresses(addressGroup, addresses); err != nil {
+		return "", err
+	}
+	return addressGroup.ID, nil
+}
+
+// reconcileAddressGroupAddresses adds missing addresses to an observed address
+// group and removes the ones which are no longer desired.
+func (s *Service) reconcileAddressGroupAddresses(observed *addressgroups.AddressGroup, desired []string) error {
+	// Neutron stores canonical CIDRs, so compare the canonical form of both
+	// sides to avoid updating addresses which only differ in notation.
+	observedCanonical := make([]string, len(observed.Addresses))
+	for i, address := range observed.Addresses {
+		observedCanonical[i] = canonicalCIDR(address)
+	}
+	desiredCanonical := make([]string, len(desired))
+	for i, address := range desired {
+		desiredCanonical[i] = canonicalCIDR(address)
+	}
+
+	var toAdd, toRemove []string
+	for i, address := range desired {
+		if !slices.Contains(observedCanonical, desiredCanonical[i]) {
+			toAdd = append(toAdd, address)
+		}
+	}
+	for i, address := range observed.Addresses {
+		if !slices.Contains(desiredCanonical, observedCanonical[i]) {
+			toRemove = append(toRemove, address)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		s.scope.Logger().V(4).Info("Adding addresses to address group", "name", observed.Name, "addresses", toAdd)
+		if _, err := s.client.AddAddressGroupAddresses(observed.ID, addressgroups.UpdateAddressesOpts{Addresses: toAdd}); err != nil {
+			return err
+		}
+	}
+	if len(toRemove) > 0 {
+		s.scope.Logger().V(4).Info("Removing addresses from address group", "name", observed.Name, "addresses", toRemove)
+		if _, err := s.client.RemoveAddressGroupAddresses(observed.ID, addressgroups.UpdateAddressesOpts{Addresses: toRemove}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) getAddressGroupByName(name string) (*addressgroups.AddressGroup, error) {
+	s.scope.Logger().V(5).Info("Attempting to fetch address group with", "name", name)
+	addressGroups, err := s.client.ListAddressGroup(addressgroups.ListOpts{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(addressGroups) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &addressGroups[0], nil
+	}
+
+	return nil, fmt.Errorf("more than one address group found named: %s", name)
+}
+
+// deleteStaleAddressGroups deletes the address groups previously created by
+// CAPO which are no longer referenced by any rule. It must be called after
+// the rules referencing them have been removed.
+func (s *Service) deleteStaleAddressGroups(openStackCluster *infrav1.OpenStackCluster, desired []infrav1.AddressGroupStatus) error {
+	for _, observed := range openStackCluster.Status.AddressGroups {
+		if slices.ContainsFunc(desired, func(d infrav1.AddressGroupStatus) bool { return d.ID == observed.ID }) {
+			continue
+		}
+		if err := s.deleteAddressGroup(openStackCluster, observed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAddressGroups deletes all address groups created by CAPO for the cluster.
+// It must be called after the managed security groups have been deleted.
+func (s *Service) DeleteAddressGroups(openStackCluster *infrav1.OpenStackCluster) error {
+	for _, addressGroup := range openStackCluster.Status.AddressGroups {
+		if err := s.deleteAddressGroup(openStackCluster, addressGroup); err != nil {
+			return err
+		}
+	}
+	openStackCluster.Status.AddressGroups = nil
+	return nil
+}
+
+func (s *Service) deleteAddressGroup(openStackCluster *infrav1.OpenStackCluster, addressGroup infrav1.AddressGroupStatus) error {
+	if err := s.client.DeleteAddressGroup(addressGroup.ID); err != nil {
+		record.Warnf(openStackCluster, "FailedDeleteAddressGroup", "Failed to delete address group %s with id %s: %v", addressGroup.Name, addressGroup.ID, err)
+		return err
+	}
+	record.Eventf(openStackCluster, "SuccessfulDeleteAddressGroup", "Deleted address group %s with id %s", addressGroup.Name, addressGroup.ID)
+	return nil
+}
+
+func getAddressGroupName(clusterResourceName, ruleScope, ruleName string) string {
+	return fmt.Sprintf("%s-cluster-%s-%s-%s-%s", secGroupPrefix, clusterResourceName, addressGroupSuffix, ruleScope, ruleName)
+}
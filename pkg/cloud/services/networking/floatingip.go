@@ -18,9 +18,11 @@ package networking
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -80,11 +82,46 @@ func (s *Service) GetOrCreateFloatingIP(eventObject runtime.Object, openStackClu
 	return fp, nil
 }
 
-func (s *Service) CreateFloatingIPForPool(pool *infrav1alpha1.OpenStackFloatingIPPool) (*floatingips.FloatingIP, error) {
-	var fpCreateOpts floatingips.CreateOpts
+// floatingIPQoSCreateOptsExt adds the QoS policy to the options of a new floating IP.
+type floatingIPQoSCreateOptsExt struct {
+	floatingips.CreateOptsBuilder
+
+	QoSPolicyID string
+}
 
-	fpCreateOpts.FloatingNetworkID = pool.Status.FloatingIPNetwork.ID
-	fpCreateOpts.Description = fmt.Sprintf("Created by cluster-api-provider-openstack OpenStackFloatingIPPool %s", pool.Name)
+func (opts floatingIPQoSCreateOptsExt) ToFloatingIPCreateMap() (map[string]any, error) {
+	base, err := opts.CreateOptsBuilder.ToFloatingIPCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	base["floatingip"].(map[string]any)["qos_policy_id"] = opts.QoSPolicyID
+	return base, nil
+}
+
+// CreateFloatingIPForPool creates a floating IP with the resolved options in
+// the status of the pool, and the given DNS name and domain.
+func (s *Service) CreateFloatingIPForPool(pool *infrav1alpha1.OpenStackFloatingIPPool, dnsName, dnsDomain string) (*floatingips.FloatingIP, error) {
+	baseOpts := floatingips.CreateOpts{
+		FloatingNetworkID: pool.Status.FloatingIPNetwork.ID,
+		Description:       pool.GetFloatingIPDescription(),
+	}
+	if pool.Status.FloatingIPSubnet != nil {
+		baseOpts.SubnetID = pool.Status.FloatingIPSubnet.ID
+	}
+	var fpCreateOpts floatingips.CreateOptsBuilder = baseOpts
+	if dnsName != "" || dnsDomain != "" {
+		fpCreateOpts = dns.FloatingIPCreateOptsExt{
+			CreateOptsBuilder: fpCreateOpts,
+			DNSName:           dnsName,
+			DNSDomain:         dnsDomain,
+		}
+	}
+	if pool.Status.QoSPolicy != nil {
+		fpCreateOpts = floatingIPQoSCreateOptsExt{
+			CreateOptsBuilder: fpCreateOpts,
+			QoSPolicyID:       pool.Status.QoSPolicy.ID,
+		}
+	}
 
 	fp, err := s.client.CreateFloatingIP(fpCreateOpts)
 	if err != nil {
@@ -96,7 +133,8 @@ func (s *Service) CreateFloatingIPForPool(pool *infrav1alpha1.OpenStackFloatingI
 	return fp, nil
 }
 
-func (s *Service) TagFloatingIP(ip string, tag string) error {
+// TagFloatingIP adds the tags to a floating IP. Other tags of the floating IP are kept.
+func (s *Service) TagFloatingIP(ip string, tags ...string) error {
 	fip, err := s.GetFloatingIP(ip)
 	if err != nil {
 		return err
@@ -105,13 +143,35 @@ func (s *Service) TagFloatingIP(ip string, tag string) error {
 		return nil
 	}
 
-	mc := metrics.NewMetricPrometheusContext("floating_ip", "update")
-	_, err = s.client.ReplaceAllAttributesTags("floatingips", fip.ID, attributestags.ReplaceAllOpts{
-		Tags: []string{tag},
-	})
-	if mc.ObserveRequest(err) != nil {
+	for _, tag := range tags {
+		if slices.Contains(fip.Tags, tag) {
+			continue
+		}
+		if err := s.client.AddAttributesTag("floatingips", fip.ID, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UntagFloatingIP removes the tags from a floating IP. Other tags of the floating IP are kept.
+func (s *Service) UntagFloatingIP(ip string, tags ...string) error {
+	fip, err := s.GetFloatingIP(ip)
+	if err != nil {
 		return err
 	}
+	if fip == nil {
+		return nil
+	}
+
+	for _, tag := range tags {
+		if !slices.Contains(fip.Tags, tag) {
+			continue
+		}
+		if err := s.client.DeleteAttributesTag("floatingips", fip.ID, tag); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -123,6 +183,14 @@ func (s *Service) GetFloatingIPsByTag(tag string) ([]floatingips.FloatingIP, err
 	return fipList, nil
 }
 
+func (s *Service) GetFloatingIPsByDescription(description string) ([]floatingips.FloatingIP, error) {
+	fipList, err := s.client.ListFloatingIP(floatingips.ListOpts{Description: description})
+	if err != nil {
+		return nil, err
+	}
+	return fipList, nil
+}
+
 func (s *Service) GetFloatingIP(ip string) (*floatingips.FloatingIP, error) {
 	fpList, err := s.client.ListFloatingIP(floatingips.ListOpts{FloatingIP: ip})
 	if err != nil {
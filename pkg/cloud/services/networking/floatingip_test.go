@@ -23,8 +23,10 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	. "github.com/onsi/gomega" //nolint:revive
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -92,3 +94,71 @@ func Test_GetOrCreateFloatingIP(t *testing.T) {
 		})
 	}
 }
+
+func Test_CreateFloatingIPForPool(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name      string
+		status    infrav1alpha1.OpenStackFloatingIPPoolStatus
+		dnsName   string
+		dnsDomain string
+		want      map[string]any
+	}{
+		{
+			name: "creates floating IP on the floating IP network",
+			status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+				FloatingIPNetwork: &infrav1.NetworkStatus{ID: "network-id"},
+			},
+			want: map[string]any{
+				"floating_network_id": "network-id",
+				"description":         "Created by cluster-api-provider-openstack OpenStackFloatingIPPool test-namespace/test-pool (pool UID 7d2f6c1e-3b4a-4c5d-8e9f-0a1b2c3d4e5f)",
+			},
+		},
+		{
+			name: "creates floating IP with resolved options",
+			status: infrav1alpha1.OpenStackFloatingIPPoolStatus{
+				FloatingIPNetwork:     &infrav1.NetworkStatus{ID: "network-id"},
+				FloatingIPSubnet:      &infrav1.Subnet{ID: "subnet-id"},
+				QoSPolicy:             &infrav1alpha1.QoSPolicyStatus{ID: "qos-policy-id"},
+				FloatingIPDescription: "test description",
+			},
+			dnsName:   "test-claim",
+			dnsDomain: "example.com.",
+			want: map[string]any{
+				"floating_network_id": "network-id",
+				"subnet_id":           "subnet-id",
+				"qos_policy_id":       "qos-policy-id",
+				"description":         "test description",
+				"dns_name":            "test-claim",
+				"dns_domain":          "example.com.",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			mockClient.EXPECT().CreateFloatingIP(gomock.Any()).DoAndReturn(func(opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error) {
+				body, err := opts.ToFloatingIPCreateMap()
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(body).To(Equal(map[string]any{"floatingip": tt.want}))
+				return &floatingips.FloatingIP{ID: "fip-id", FloatingIP: "203.0.113.1"}, nil
+			})
+
+			s := Service{
+				scope:  scope.NewWithLogger(mockScopeFactory, testr.New(t)),
+				client: mockClient,
+			}
+			pool := &infrav1alpha1.OpenStackFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "test-namespace", UID: "7d2f6c1e-3b4a-4c5d-8e9f-0a1b2c3d4e5f"},
+				Status:     tt.status,
+			}
+			got, err := s.CreateFloatingIPForPool(pool, tt.dnsName, tt.dnsDomain)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.FloatingIP).To(Equal("203.0.113.1"))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"errors"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// GetQoSPolicyByParam returns the QoS policy specified by param.
+func (s *Service) GetQoSPolicyByParam(param *infrav1alpha1.QoSPolicyParam) (*policies.Policy, error) {
+	if param.ID != nil {
+		policy, err := s.client.GetQoSPolicy(*param.ID)
+		if capoerrors.IsNotFound(err) {
+			return nil, capoerrors.ErrNoMatches
+		}
+		return policy, err
+	}
+
+	if param.Filter == nil {
+		// Should have been caught by validation
+		return nil, errors.New("QoS policy filter: both id and filter are nil")
+	}
+
+	policyList, err := s.client.ListQoSPolicy(policies.ListOpts{
+		Name:      param.Filter.Name,
+		ProjectID: param.Filter.ProjectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(policyList) == 0 {
+		return nil, capoerrors.ErrNoMatches
+	}
+	if len(policyList) > 1 {
+		return nil, capoerrors.ErrMultipleMatches
+	}
+	return &policyList[0], nil
+}
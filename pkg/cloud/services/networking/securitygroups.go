@@ -19,6 +19,7 @@ package networking
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"slices"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
@@ -28,6 +29,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
 )
 
@@ -37,6 +39,9 @@ const (
 	workerSuffix       string = "worker"
 	bastionSuffix      string = "bastion"
 	remoteGroupIDSelf  string = "self"
+
+	defaultSecGroupDescription string = "Cluster API managed group"
+	statefulSecGroupExtAlias   string = "stateful-security-group"
 )
 
 // ReconcileSecurityGroups reconcile the security groups.
@@ -76,10 +81,19 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 		}
 	}
 
+	settingsBySuffix := make(map[string]*infrav1.ManagedSecurityGroupSettings)
+	for suffix := range suffixToNameMap {
+		settingsBySuffix[suffix] = getManagedSecurityGroupSettings(openStackCluster.Spec.ManagedSecurityGroups, suffix)
+	}
+	if err := s.validateSecurityGroupSettings(settingsBySuffix); err != nil {
+		return err
+	}
+
 	// create security groups first, because desired rules use group ids.
 	observedSecGroupBySuffix := make(map[string]*groups.SecGroup)
 	for suffix, secGroupName := range suffixToNameMap {
-		group, err := s.getOrCreateSecurityGroup(openStackCluster, secGroupName)
+		settings := settingsBySuffix[suffix]
+		group, err := s.getOrCreateSecurityGroup(openStackCluster, secGroupName, settings)
 		if err != nil {
 			return err
 		}
@@ -91,9 +105,14 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 			return slices.Compact(tags)
 		}
 
-		if !slices.Equal(normaliseTags(openStackCluster.Spec.Tags), normaliseTags(group.Tags)) {
+		desiredTags := openStackCluster.Spec.Tags
+		if settings != nil && settings.Tags != nil {
+			desiredTags = settings.Tags
+		}
+
+		if !slices.Equal(normaliseTags(desiredTags), normaliseTags(group.Tags)) {
 			_, err = s.client.ReplaceAllAttributesTags("security-groups", group.ID, attributestags.ReplaceAllOpts{
-				Tags: openStackCluster.Spec.Tags,
+				Tags: desiredTags,
 			})
 			if err != nil {
 				return err
@@ -102,8 +121,14 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 		}
 	}
 
+	// resolve or create the address groups referenced by rules, because desired rules use their ids.
+	resolvedAddressGroups, managedAddressGroups, err := s.reconcileRemoteAddressGroups(openStackCluster, clusterResourceName)
+	if err != nil {
+		return err
+	}
+
 	// create desired security groups
-	desiredSecGroupsBySuffix, err := s.generateDesiredSecGroups(openStackCluster, suffixToNameMap, observedSecGroupBySuffix)
+	desiredSecGroupsBySuffix, err := s.generateDesiredSecGroups(openStackCluster, suffixToNameMap, observedSecGroupBySuffix, resolvedAddressGroups)
 	if err != nil {
 		return err
 	}
@@ -123,6 +148,12 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 		continue
 	}
 
+	// Address groups which are no longer referenced can only be deleted once the rules using them are gone.
+	if err := s.deleteStaleAddressGroups(openStackCluster, managedAddressGroups); err != nil {
+		return err
+	}
+	openStackCluster.Status.AddressGroups = managedAddressGroups
+
 	openStackCluster.Status.ControlPlaneSecurityGroup = convertOSSecGroupToConfigSecGroup(observedSecGroupBySuffix[controlPlaneSuffix])
 	openStackCluster.Status.WorkerSecurityGroup = convertOSSecGroupToConfigSecGroup(observedSecGroupBySuffix[workerSuffix])
 	if bastionEnabled {
@@ -140,14 +171,15 @@ type securityGroupSpec struct {
 }
 
 type resolvedSecurityGroupRuleSpec struct {
-	Description    string `json:"description,omitempty"`
-	Direction      string `json:"direction,omitempty"`
-	EtherType      string `json:"etherType,omitempty"`
-	PortRangeMin   int    `json:"portRangeMin,omitempty"`
-	PortRangeMax   int    `json:"portRangeMax,omitempty"`
-	Protocol       string `json:"protocol,omitempty"`
-	RemoteGroupID  string `json:"remoteGroupID,omitempty"`
-	RemoteIPPrefix string `json:"remoteIPPrefix,omitempty"`
+	Description          string `json:"description,omitempty"`
+	Direction            string `json:"direction,omitempty"`
+	EtherType            string `json:"etherType,omitempty"`
+	PortRangeMin         int    `json:"portRangeMin,omitempty"`
+	PortRangeMax         int    `json:"portRangeMax,omitempty"`
+	Protocol             string `json:"protocol,omitempty"`
+	RemoteGroupID        string `json:"remoteGroupID,omitempty"`
+	RemoteIPPrefix       string `json:"remoteIPPrefix,omitempty"`
+	RemoteAddressGroupID string `json:"remoteAddressGroupID,omitempty"`
 }
 
 func (r resolvedSecurityGroupRuleSpec) Matches(other rules.SecGroupRule) bool {
@@ -158,10 +190,11 @@ func (r resolvedSecurityGroupRuleSpec) Matches(other rules.SecGroupRule) bool {
 		r.PortRangeMax == other.PortRangeMax &&
 		r.Protocol == other.Protocol &&
 		r.RemoteGroupID == other.RemoteGroupID &&
-		r.RemoteIPPrefix == other.RemoteIPPrefix
+		canonicalCIDR(r.RemoteIPPrefix) == canonicalCIDR(other.RemoteIPPrefix) &&
+		r.RemoteAddressGroupID == other.RemoteAddressGroupID
 }
 
-func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCluster, suffixToNameMap map[string]string, observedSecGroupsBySuffix map[string]*groups.SecGroup) (map[string]securityGroupSpec, error) {
+func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCluster, suffixToNameMap map[string]string, observedSecGroupsBySuffix map[string]*groups.SecGroup, resolvedAddressGroups remoteAddressGroups) (map[string]securityGroupSpec, error) {
 	if openStackCluster.Spec.ManagedSecurityGroups == nil {
 		return nil, nil
 	}
@@ -229,12 +262,12 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 	}
 
 	// Append any additional rules for control plane and worker nodes
-	controlPlaneExtraRules, err := getRulesFromSpecs(remoteManagedGroups, openStackCluster.Spec.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules)
+	controlPlaneExtraRules, err := getRulesFromSpecs(remoteManagedGroups, resolvedAddressGroups[controlPlaneRuleScope], openStackCluster.Spec.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules)
 	if err != nil {
 		return nil, err
 	}
 	controlPlaneRules = append(controlPlaneRules, controlPlaneExtraRules...)
-	workersExtraRules, err := getRulesFromSpecs(remoteManagedGroups, openStackCluster.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules)
+	workersExtraRules, err := getRulesFromSpecs(remoteManagedGroups, resolvedAddressGroups[workerRuleScope], openStackCluster.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules)
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +275,7 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 
 	// For now, we do not create a separate security group for allNodes.
 	// Instead, we append the rules for allNodes to the control plane and worker security groups.
-	allNodesRules, err := getRulesFromSpecs(remoteManagedGroups, openStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules)
+	allNodesRules, err := getRulesFromSpecs(remoteManagedGroups, resolvedAddressGroups[allNodesRuleScope], openStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules)
 	if err != nil {
 		return nil, err
 	}
@@ -286,7 +319,8 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 }
 
 // getAllNodesRules returns the rules for the allNodes security group that should be created.
-func getRulesFromSpecs(remoteManagedGroups map[string]string, securityGroupRules []infrav1.SecurityGroupRuleSpec) ([]resolvedSecurityGroupRuleSpec, error) {
+// remoteAddressGroups maps the name of rules with a remoteAddressGroup to the resolved address group ID.
+func getRulesFromSpecs(remoteManagedGroups map[string]string, remoteAddressGroups map[string]string, securityGroupRules []infrav1.SecurityGroupRuleSpec) ([]resolvedSecurityGroupRuleSpec, error) {
 	rules := make([]resolvedSecurityGroupRuleSpec, 0, len(securityGroupRules))
 	for _, rule := range securityGroupRules {
 		if err := validateRemoteManagedGroups(remoteManagedGroups, rule.RemoteManagedGroups); err != nil {
@@ -316,6 +350,14 @@ func getRulesFromSpecs(remoteManagedGroups map[string]string, securityGroupRules
 		if rule.RemoteIPPrefix != nil {
 			r.RemoteIPPrefix = *rule.RemoteIPPrefix
 		}
+		if rule.RemoteAddressGroup != nil {
+			addressGroupID, ok := remoteAddressGroups[rule.Name]
+			if !ok {
+				// This should never happen, as the address group should have been resolved earlier in this reconcile.
+				return nil, fmt.Errorf("remoteAddressGroup for rule %s has not been resolved", rule.Name)
+			}
+			r.RemoteAddressGroupID = addressGroupID
+		}
 
 		if len(rule.RemoteManagedGroups) > 0 {
 			if rule.RemoteGroupID != nil {
@@ -495,21 +537,79 @@ func (s *Service) reconcileGroupRules(desired *securityGroupSpec, observed *grou
 	return nil
 }
 
-func (s *Service) getOrCreateSecurityGroup(openStackCluster *infrav1.OpenStackCluster, groupName string) (*groups.SecGroup, error) {
+// getManagedSecurityGroupSettings returns the settings of the managed security group with the given suffix, or nil if there are none.
+func getManagedSecurityGroupSettings(managedSecurityGroups *infrav1.ManagedSecurityGroups, suffix string) *infrav1.ManagedSecurityGroupSettings {
+	switch suffix {
+	case controlPlaneSuffix:
+		return managedSecurityGroups.ControlPlane
+	case workerSuffix:
+		return managedSecurityGroups.Worker
+	case bastionSuffix:
+		return managedSecurityGroups.Bastion
+	}
+	return nil
+}
+
+// validateSecurityGroupSettings ensures that the cloud supports the requested security group settings.
+func (s *Service) validateSecurityGroupSettings(settingsBySuffix map[string]*infrav1.ManagedSecurityGroupSettings) error {
+	var statelessSuffixes []string
+	for suffix, settings := range settingsBySuffix {
+		if settings != nil && settings.Stateful != nil && !*settings.Stateful {
+			statelessSuffixes = append(statelessSuffixes, suffix)
+		}
+	}
+	if len(statelessSuffixes) == 0 {
+		return nil
+	}
+
+	supported, err := s.GetStatefulSecurityGroupSupport()
+	if err != nil {
+		return err
+	}
+	if !supported {
+		slices.Sort(statelessSuffixes)
+		return capoerrors.Terminal(infrav1.StatelessSecurityGroupsUnsupportedReason,
+			fmt.Sprintf("stateless security groups were requested for %v but the %s extension is not available", statelessSuffixes, statefulSecGroupExtAlias))
+	}
+	return nil
+}
+
+// GetStatefulSecurityGroupSupport returns true if the cloud supports stateless security groups.
+func (s *Service) GetStatefulSecurityGroupSupport() (bool, error) {
+	allExts, err := s.client.ListExtensions()
+	if err != nil {
+		return false, err
+	}
+
+	for _, ext := range allExts {
+		if ext.Alias == statefulSecGroupExtAlias {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Service) getOrCreateSecurityGroup(openStackCluster *infrav1.OpenStackCluster, groupName string, settings *infrav1.ManagedSecurityGroupSettings) (*groups.SecGroup, error) {
 	secGroup, err := s.getSecurityGroupByName(groupName)
 	if err != nil {
 		return nil, err
 	}
 	if secGroup != nil {
 		s.scope.Logger().V(5).Info("Reusing existing SecurityGroup", "name", groupName, "id", secGroup.ID)
-		return secGroup, nil
+		return s.updateSecurityGroupSettings(openStackCluster, secGroup, settings)
 	}
 
 	s.scope.Logger().V(5).Info("Group doesn't exist, creating it", "name", groupName)
 
 	createOpts := groups.CreateOpts{
 		Name:        groupName,
-		Description: "Cluster API managed group",
+		Description: defaultSecGroupDescription,
+	}
+	if settings != nil {
+		if settings.Description != nil {
+			createOpts.Description = *settings.Description
+		}
+		createOpts.Stateful = settings.Stateful
 	}
 	s.scope.Logger().V(5).Info("Creating group", "name", groupName)
 
@@ -523,6 +623,37 @@ func (s *Service) getOrCreateSecurityGroup(openStackCluster *infrav1.OpenStackCl
 	return group, nil
 }
 
+// updateSecurityGroupSettings updates the description and statefulness of an
+// existing security group if they were explicitly set and differ from the spec.
+func (s *Service) updateSecurityGroupSettings(openStackCluster *infrav1.OpenStackCluster, secGroup *groups.SecGroup, settings *infrav1.ManagedSecurityGroupSettings) (*groups.SecGroup, error) {
+	if settings == nil {
+		return secGroup, nil
+	}
+
+	var updateOpts groups.UpdateOpts
+	needsUpdate := false
+	if settings.Description != nil && *settings.Description != secGroup.Description {
+		updateOpts.Description = settings.Description
+		needsUpdate = true
+	}
+	if settings.Stateful != nil && *settings.Stateful != secGroup.Stateful {
+		updateOpts.Stateful = settings.Stateful
+		needsUpdate = true
+	}
+	if !needsUpdate {
+		return secGroup, nil
+	}
+
+	s.scope.Logger().V(4).Info("Updating security group settings", "name", secGroup.Name, "id", secGroup.ID)
+	group, err := s.client.UpdateSecGroup(secGroup.ID, updateOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateSecurityGroup", "Failed to update security group %s with id %s: %v", secGroup.Name, secGroup.ID, err)
+		return nil, err
+	}
+	record.Eventf(openStackCluster, "SuccessfulUpdateSecurityGroup", "Updated security group %s with id %s", secGroup.Name, secGroup.ID)
+	return group, nil
+}
+
 func (s *Service) getSecurityGroupByName(name string) (*groups.SecGroup, error) {
 	opts := groups.ListOpts{
 		Name: name,
@@ -550,17 +681,18 @@ func (s *Service) createRule(securityGroupID string, r resolvedSecurityGroupRule
 	etherType := rules.RuleEtherType(r.EtherType)
 
 	createOpts := rules.CreateOpts{
-		Description:    r.Description,
-		Direction:      dir,
-		PortRangeMin:   r.PortRangeMin,
-		PortRangeMax:   r.PortRangeMax,
-		Protocol:       proto,
-		EtherType:      etherType,
-		RemoteGroupID:  r.RemoteGroupID,
-		RemoteIPPrefix: r.RemoteIPPrefix,
-		SecGroupID:     securityGroupID,
-	}
-	s.scope.Logger().V(5).Info("Creating rule", "description", r.Description, "direction", dir, "portRangeMin", r.PortRangeMin, "portRangeMax", r.PortRangeMax, "proto", proto, "etherType", etherType, "remoteGroupID", r.RemoteGroupID, "remoteIPPrefix", r.RemoteIPPrefix, "securityGroupID", securityGroupID)
+		Description:          r.Description,
+		Direction:            dir,
+		PortRangeMin:         r.PortRangeMin,
+		PortRangeMax:         r.PortRangeMax,
+		Protocol:             proto,
+		EtherType:            etherType,
+		RemoteGroupID:        r.RemoteGroupID,
+		RemoteIPPrefix:       r.RemoteIPPrefix,
+		RemoteAddressGroupID: r.RemoteAddressGroupID,
+		SecGroupID:           securityGroupID,
+	}
+	s.scope.Logger().V(5).Info("Creating rule", "description", r.Description, "direction", dir, "portRangeMin", r.PortRangeMin, "portRangeMax", r.PortRangeMax, "proto", proto, "etherType", etherType, "remoteGroupID", r.RemoteGroupID, "remoteIPPrefix", r.RemoteIPPrefix, "remoteAddressGroupID", r.RemoteAddressGroupID, "securityGroupID", securityGroupID)
 	_, err := s.client.CreateSecGroupRule(createOpts)
 	if err != nil {
 		return err
@@ -660,11 +792,13 @@ func matchesRuleIgnoringDescription(desired resolvedSecurityGroupRuleSpec, obser
 		desired.PortRangeMin == observed.PortRangeMin &&
 		desired.PortRangeMax == observed.PortRangeMax &&
 		desired.Protocol == observed.Protocol &&
-		desired.RemoteGroupID == observed.RemoteGroupID
+		desired.RemoteGroupID == observed.RemoteGroupID &&
+		desired.RemoteAddressGroupID == observed.RemoteAddressGroupID
 }
 
 func remoteIPPrefixMatches(desiredPrefix, observedPrefix, etherType string) bool {
-	if desiredPrefix == observedPrefix {
+	desiredPrefix = canonicalCIDR(desiredPrefix)
+	if desiredPrefix == canonicalCIDR(observedPrefix) {
 		return true
 	}
 	// Neutron may store allow-all egress rules without a remote prefix.
@@ -679,6 +813,20 @@ func remoteIPPrefixMatches(desiredPrefix, observedPrefix, etherType string) bool
 	return false
 }
 
+// canonicalCIDR returns a CIDR in the form stored by Neutron, which clears
+// the host bits, adds the full prefix length to a bare address and compresses
+// IPv6 addresses, e.g. 10.0.0.1/24 becomes 10.0.0.0/24. Values which can't be
+// parsed are returned unchanged.
+func canonicalCIDR(cidr string) string {
+	if prefix, err := netip.ParsePrefix(cidr); err == nil {
+		return prefix.Masked().String()
+	}
+	if addr, err := netip.ParseAddr(cidr); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()).String()
+	}
+	return cidr
+}
+
 func getSecControlPlaneGroupName(clusterResourceName string) string {
 	return fmt.Sprintf("%s-cluster-%s-secgroup-%s", secGroupPrefix, clusterResourceName, controlPlaneSuffix)
 }
@@ -16,6 +16,7 @@ limitations under the License.
 package networking
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -23,6 +24,9 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/addressgroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	. "github.com/onsi/gomega" //nolint:revive
@@ -32,6 +36,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 func TestValidateRemoteManagedGroups(t *testing.T) {
@@ -99,6 +104,7 @@ func TestGetRulesFromSpecs(t *testing.T) {
 	tests := []struct {
 		name                       string
 		remoteManagedGroups        map[string]string
+		remoteAddressGroups        map[string]string
 		allNodesSecurityGroupRules []infrav1.SecurityGroupRuleSpec
 		wantRules                  []resolvedSecurityGroupRuleSpec
 		wantErr                    bool
@@ -269,11 +275,53 @@ func TestGetRulesFromSpecs(t *testing.T) {
 			wantRules: nil,
 			wantErr:   true,
 		},
+		{
+			name: "Valid allNodesSecurityGroupRules with remoteAddressGroup",
+			remoteAddressGroups: map[string]string{
+				"nodeports": "address-group-id",
+			},
+			allNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+				{
+					Name:         "nodeports",
+					Direction:    "ingress",
+					Protocol:     ptr.To("tcp"),
+					PortRangeMin: ptr.To(30000),
+					PortRangeMax: ptr.To(32767),
+					RemoteAddressGroup: &infrav1.AddressGroupParam{
+						Addresses: []string{"10.0.0.0/8", "192.168.0.0/16"},
+					},
+				},
+			},
+			wantRules: []resolvedSecurityGroupRuleSpec{
+				{
+					Direction:            "ingress",
+					Protocol:             "tcp",
+					PortRangeMin:         30000,
+					PortRangeMax:         32767,
+					RemoteAddressGroupID: "address-group-id",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid allNodesSecurityGroupRules with unresolved remoteAddressGroup",
+			allNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+				{
+					Name:      "nodeports",
+					Direction: "ingress",
+					RemoteAddressGroup: &infrav1.AddressGroupParam{
+						ID: ptr.To("7d2fce28-5b46-4b5c-b1c0-1ee4cbda8a93"),
+					},
+				},
+			},
+			wantRules: nil,
+			wantErr:   true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRules, err := getRulesFromSpecs(tt.remoteManagedGroups, tt.allNodesSecurityGroupRules)
+			gotRules, err := getRulesFromSpecs(tt.remoteManagedGroups, tt.remoteAddressGroups, tt.allNodesSecurityGroupRules)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("getRulesFromSpecs() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -400,7 +448,7 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 				t.Fatalf("Failed to create service: %v", err)
 			}
 
-			gotSecurityGroups, err := s.generateDesiredSecGroups(tt.openStackCluster, secGroupNames, observedSecGroupsBySuffix)
+			gotSecurityGroups, err := s.generateDesiredSecGroups(tt.openStackCluster, secGroupNames, observedSecGroupsBySuffix, nil)
 			if tt.wantErr {
 				g.Expect(err).To(HaveOccurred())
 			} else {
@@ -477,6 +525,59 @@ func TestReconcileGroupRules(t *testing.T) {
 			},
 			mockExpect: func(*mock.MockNetworkClientMockRecorder) {},
 		},
+		{
+			name: "Non-canonical desired remote IP prefix matches the prefix normalized by Neutron",
+			desiredSGSpec: securityGroupSpec{
+				Name: sgName,
+				Rules: []resolvedSecurityGroupRuleSpec{
+					{
+						Description:    "Allow SSH",
+						Direction:      "ingress",
+						EtherType:      "IPv4",
+						Protocol:       "tcp",
+						PortRangeMin:   22,
+						PortRangeMax:   22,
+						RemoteIPPrefix: "10.0.0.1/24",
+					},
+					{
+						Description:    "Allow SSH",
+						Direction:      "ingress",
+						EtherType:      "IPv6",
+						Protocol:       "tcp",
+						PortRangeMin:   22,
+						PortRangeMax:   22,
+						RemoteIPPrefix: "2001:DB8:0:0::1",
+					},
+				},
+			},
+			observedSG: groups.SecGroup{
+				ID:   sgID,
+				Name: sgName,
+				Rules: []rules.SecGroupRule{
+					{
+						Description:    "Allow SSH",
+						Direction:      "ingress",
+						EtherType:      "IPv4",
+						ID:             "idSGRule",
+						Protocol:       "tcp",
+						PortRangeMin:   22,
+						PortRangeMax:   22,
+						RemoteIPPrefix: "10.0.0.0/24",
+					},
+					{
+						Description:    "Allow SSH",
+						Direction:      "ingress",
+						EtherType:      "IPv6",
+						ID:             "idSGRuleIPv6",
+						Protocol:       "tcp",
+						PortRangeMin:   22,
+						PortRangeMax:   22,
+						RemoteIPPrefix: "2001:db8::1/128",
+					},
+				},
+			},
+			mockExpect: func(*mock.MockNetworkClientMockRecorder) {},
+		},
 		{
 			name: "Different desiredSGSpec and observedSG produces changes",
 			desiredSGSpec: securityGroupSpec{
@@ -565,14 +666,19 @@ func TestService_ReconcileSecurityGroups(t *testing.T) {
 		controlPlaneSGName = "k8s-cluster-test-cluster-secgroup-controlplane"
 		workerSGName       = "k8s-cluster-test-cluster-secgroup-worker"
 		bastionSGName      = "k8s-cluster-test-cluster-secgroup-bastion"
+
+		addressGroupName = "k8s-cluster-test-cluster-addrgroup-worker-nodeports"
 	)
 
 	tests := []struct {
-		name                  string
-		openStackClusterSpec  infrav1.OpenStackClusterSpec
-		expectedClusterStatus infrav1.OpenStackClusterStatus
-		expect                func(log logr.Logger, m *mock.MockNetworkClientMockRecorder)
-		wantErr               bool
+		name                   string
+		openStackClusterSpec   infrav1.OpenStackClusterSpec
+		openStackClusterStatus infrav1.OpenStackClusterStatus
+		expectedClusterStatus  infrav1.OpenStackClusterStatus
+		expect                 func(log logr.Logger, m *mock.MockNetworkClientMockRecorder)
+		wantErr                bool
+		wantTerminalErr        bool
+		wantTerminalReason     string
 	}{
 		{
 			name:                  "Do nothing if ManagedSecurityGroups is not enabled",
@@ -649,6 +755,141 @@ func TestService_ReconcileSecurityGroups(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Worker rule with inline remote address group",
+			openStackClusterSpec: infrav1.OpenStackClusterSpec{
+				ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+					WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+						{
+							Name:         "nodeports",
+							Direction:    "ingress",
+							PortRangeMin: ptr.To(30000),
+							PortRangeMax: ptr.To(32767),
+							Protocol:     ptr.To("tcp"),
+							RemoteAddressGroup: &infrav1.AddressGroupParam{
+								// 10.0.0.1/8 matches the canonical 10.0.0.0/8 stored by Neutron
+								Addresses: []string{"10.0.0.1/8", "192.168.0.0/16"},
+							},
+						},
+					},
+				},
+			},
+			openStackClusterStatus: infrav1.OpenStackClusterStatus{
+				AddressGroups: []infrav1.AddressGroupStatus{
+					{ID: "stale", Name: "k8s-cluster-test-cluster-addrgroup-worker-old"},
+				},
+			},
+			expect: func(log logr.Logger, m *mock.MockNetworkClientMockRecorder) {
+				m.ListSecGroup(groups.ListOpts{Name: controlPlaneSGName}).
+					Return([]groups.SecGroup{{ID: "0", Name: controlPlaneSGName}}, nil)
+				m.ListSecGroup(groups.ListOpts{Name: workerSGName}).
+					Return([]groups.SecGroup{{ID: "1", Name: workerSGName}}, nil)
+				m.ListSecGroup(groups.ListOpts{Name: bastionSGName}).Return(nil, nil)
+
+				m.ListAddressGroup(addressgroups.ListOpts{Name: addressGroupName}).
+					Return([]addressgroups.AddressGroup{{ID: "3", Name: addressGroupName, Addresses: []string{"10.0.0.0/8", "172.16.0.0/12"}}}, nil)
+				m.AddAddressGroupAddresses("3", addressgroups.UpdateAddressesOpts{Addresses: []string{"192.168.0.0/16"}}).
+					Return(&addressgroups.AddressGroup{ID: "3"}, nil)
+				m.RemoveAddressGroupAddresses("3", addressgroups.UpdateAddressesOpts{Addresses: []string{"172.16.0.0/12"}}).
+					Return(&addressgroups.AddressGroup{ID: "3"}, nil)
+
+				// The worker rule referencing the address group plus the 14 default rules.
+				// The specific expectation must come first so that it isn't consumed by the catch-all one.
+				m.CreateSecGroupRule(rules.CreateOpts{
+					Direction:            rules.DirIngress,
+					PortRangeMin:         30000,
+					PortRangeMax:         32767,
+					Protocol:             rules.ProtocolTCP,
+					RemoteAddressGroupID: "3",
+					SecGroupID:           "1",
+				}).Return(&rules.SecGroupRule{ID: uuid.NewString()}, nil)
+				m.CreateSecGroupRule(gomock.Any()).DoAndReturn(func(opts rules.CreateOpts) (*rules.SecGroupRule, error) {
+					log.Info("Created rule", "securityGroup", opts.SecGroupID, "description", opts.Description)
+					return &rules.SecGroupRule{ID: uuid.NewString()}, nil
+				}).Times(14)
+
+				m.DeleteAddressGroup("stale").Return(nil)
+			},
+			expectedClusterStatus: infrav1.OpenStackClusterStatus{
+				AddressGroups: []infrav1.AddressGroupStatus{
+					{ID: "3", Name: addressGroupName},
+				},
+				ControlPlaneSecurityGroup: &infrav1.SecurityGroupStatus{
+					ID:   "0",
+					Name: controlPlaneSGName,
+				},
+				WorkerSecurityGroup: &infrav1.SecurityGroupStatus{
+					ID:   "1",
+					Name: workerSGName,
+				},
+			},
+		},
+		{
+			name: "Stateless worker security group with description and tags overrides",
+			openStackClusterSpec: infrav1.OpenStackClusterSpec{
+				ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+					ControlPlane: &infrav1.ManagedSecurityGroupSettings{
+						Description: ptr.To("control plane"),
+					},
+					Worker: &infrav1.ManagedSecurityGroupSettings{
+						Stateful:    ptr.To(false),
+						Description: ptr.To("stateless workers"),
+						Tags:        []string{"udp"},
+					},
+				},
+			},
+			expect: func(log logr.Logger, m *mock.MockNetworkClientMockRecorder) {
+				m.ListSecGroup(groups.ListOpts{Name: bastionSGName}).Return(nil, nil)
+				statefulSecGroupExtension := extensions.Extension{}
+				statefulSecGroupExtension.Alias = "stateful-security-group"
+				m.ListExtensions().Return([]extensions.Extension{statefulSecGroupExtension}, nil)
+
+				m.ListSecGroup(groups.ListOpts{Name: controlPlaneSGName}).
+					Return([]groups.SecGroup{{ID: "0", Name: controlPlaneSGName, Description: "Cluster API managed group", Stateful: true}}, nil)
+				m.UpdateSecGroup("0", groups.UpdateOpts{Description: ptr.To("control plane")}).
+					Return(&groups.SecGroup{ID: "0", Name: controlPlaneSGName, Description: "control plane", Stateful: true}, nil)
+
+				m.ListSecGroup(groups.ListOpts{Name: workerSGName}).Return(nil, nil)
+				m.CreateSecGroup(groups.CreateOpts{Name: workerSGName, Description: "stateless workers", Stateful: ptr.To(false)}).
+					Return(&groups.SecGroup{ID: "1", Name: workerSGName, Description: "stateless workers"}, nil)
+				m.ReplaceAllAttributesTags("security-groups", "1", attributestags.ReplaceAllOpts{Tags: []string{"udp"}}).
+					Return([]string{"udp"}, nil)
+
+				m.CreateSecGroupRule(gomock.Any()).DoAndReturn(func(opts rules.CreateOpts) (*rules.SecGroupRule, error) {
+					log.Info("Created rule", "securityGroup", opts.SecGroupID, "description", opts.Description)
+					return &rules.SecGroupRule{ID: uuid.NewString()}, nil
+				}).Times(14)
+			},
+			expectedClusterStatus: infrav1.OpenStackClusterStatus{
+				ControlPlaneSecurityGroup: &infrav1.SecurityGroupStatus{
+					ID:   "0",
+					Name: controlPlaneSGName,
+				},
+				WorkerSecurityGroup: &infrav1.SecurityGroupStatus{
+					ID:   "1",
+					Name: workerSGName,
+				},
+			},
+		},
+		{
+			name: "Stateless security group without stateful-security-group extension",
+			openStackClusterSpec: infrav1.OpenStackClusterSpec{
+				ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+					Worker: &infrav1.ManagedSecurityGroupSettings{
+						Stateful: ptr.To(false),
+					},
+				},
+			},
+			expect: func(_ logr.Logger, m *mock.MockNetworkClientMockRecorder) {
+				m.ListSecGroup(groups.ListOpts{Name: bastionSGName}).Return(nil, nil)
+				trunkExtension := extensions.Extension{}
+				trunkExtension.Alias = "trunk"
+				m.ListExtensions().Return([]extensions.Extension{trunkExtension}, nil)
+			},
+			wantErr:            true,
+			wantTerminalErr:    true,
+			wantTerminalReason: infrav1.StatelessSecurityGroupsUnsupportedReason,
+		},
 	}
 	for i := range tests {
 		tt := &tests[i]
@@ -669,11 +910,17 @@ func TestService_ReconcileSecurityGroups(t *testing.T) {
 				tt.expect(log, mockScopeFactory.NetworkClient.EXPECT())
 			}
 			openStackCluster := &infrav1.OpenStackCluster{
-				Spec: tt.openStackClusterSpec,
+				Spec:   tt.openStackClusterSpec,
+				Status: tt.openStackClusterStatus,
 			}
 			err := s.ReconcileSecurityGroups(openStackCluster, clusterResourceName)
 			if tt.wantErr {
 				g.Expect(err).ToNot(BeNil(), "ReconcileSecurityGroups")
+				if tt.wantTerminalErr {
+					var terminalErr *capoerrors.TerminalError
+					g.Expect(errors.As(err, &terminalErr)).To(BeTrue(), "ReconcileSecurityGroups should return a terminal error")
+					g.Expect(terminalErr.Reason).To(Equal(tt.wantTerminalReason))
+				}
 			} else {
 				g.Expect(err).To(BeNil(), "ReconcileSecurityGroups")
 				g.Expect(openStackCluster.Status).To(Equal(tt.expectedClusterStatus), cmp.Diff(openStackCluster.Status, tt.expectedClusterStatus))
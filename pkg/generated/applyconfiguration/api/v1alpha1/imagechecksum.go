@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+// ImageChecksumApplyConfiguration represents a declarative configuration of the ImageChecksum type for use
+// with apply.
+type ImageChecksumApplyConfiguration struct {
+	Algorithm *apiv1alpha1.ImageChecksumAlgorithm `json:"algorithm,omitempty"`
+	Value     *string                             `json:"value,omitempty"`
+}
+
+// ImageChecksumApplyConfiguration constructs a declarative configuration of the ImageChecksum type for use with
+// apply.
+func ImageChecksum() *ImageChecksumApplyConfiguration {
+	return &ImageChecksumApplyConfiguration{}
+}
+
+// WithAlgorithm sets the Algorithm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Algorithm field is set to the value of the last call.
+func (b *ImageChecksumApplyConfiguration) WithAlgorithm(value apiv1alpha1.ImageChecksumAlgorithm) *ImageChecksumApplyConfiguration {
+	b.Algorithm = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *ImageChecksumApplyConfiguration) WithValue(value string) *ImageChecksumApplyConfiguration {
+	b.Value = &value
+	return b
+}
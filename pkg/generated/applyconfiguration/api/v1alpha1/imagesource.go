@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ImageSourceApplyConfiguration represents a declarative configuration of the ImageSource type for use
+// with apply.
+type ImageSourceApplyConfiguration struct {
+	URL *string `json:"url,omitempty"`
+	OCI *string `json:"oci,omitempty"`
+}
+
+// ImageSourceApplyConfiguration constructs a declarative configuration of the ImageSource type for use with
+// apply.
+func ImageSource() *ImageSourceApplyConfiguration {
+	return &ImageSourceApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *ImageSourceApplyConfiguration) WithURL(value string) *ImageSourceApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithOCI sets the OCI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OCI field is set to the value of the last call.
+func (b *ImageSourceApplyConfiguration) WithOCI(value string) *ImageSourceApplyConfiguration {
+	b.OCI = &value
+	return b
+}
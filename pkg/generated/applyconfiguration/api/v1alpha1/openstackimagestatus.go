@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	v1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// OpenStackImageStatusApplyConfiguration represents a declarative configuration of the OpenStackImageStatus type for use
+// with apply.
+type OpenStackImageStatusApplyConfiguration struct {
+	Ready        *bool                          `json:"ready,omitempty"`
+	ID           *string                        `json:"id,omitempty"`
+	ImportMethod *apiv1alpha1.ImageImportMethod `json:"importMethod,omitempty"`
+	Conditions   *v1beta1.Conditions            `json:"conditions,omitempty"`
+}
+
+// OpenStackImageStatusApplyConfiguration constructs a declarative configuration of the OpenStackImageStatus type for use with
+// apply.
+func OpenStackImageStatus() *OpenStackImageStatusApplyConfiguration {
+	return &OpenStackImageStatusApplyConfiguration{}
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *OpenStackImageStatusApplyConfiguration) WithReady(value bool) *OpenStackImageStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *OpenStackImageStatusApplyConfiguration) WithID(value string) *OpenStackImageStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithImportMethod sets the ImportMethod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImportMethod field is set to the value of the last call.
+func (b *OpenStackImageStatusApplyConfiguration) WithImportMethod(value apiv1alpha1.ImageImportMethod) *OpenStackImageStatusApplyConfiguration {
+	b.ImportMethod = &value
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *OpenStackImageStatusApplyConfiguration) WithConditions(value v1beta1.Conditions) *OpenStackImageStatusApplyConfiguration {
+	b.Conditions = &value
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1beta1"
+)
+
+// OpenStackServerGroupSpecApplyConfiguration represents a declarative configuration of the OpenStackServerGroupSpec type for use
+// with apply.
+type OpenStackServerGroupSpecApplyConfiguration struct {
+	Policy           *apiv1alpha1.ServerGroupPolicy                        `json:"policy,omitempty"`
+	MaxServerPerHost *int32                                                `json:"maxServerPerHost,omitempty"`
+	IdentityRef      *v1beta1.OpenStackIdentityReferenceApplyConfiguration `json:"identityRef,omitempty"`
+}
+
+// OpenStackServerGroupSpecApplyConfiguration constructs a declarative configuration of the OpenStackServerGroupSpec type for use with
+// apply.
+func OpenStackServerGroupSpec() *OpenStackServerGroupSpecApplyConfiguration {
+	return &OpenStackServerGroupSpecApplyConfiguration{}
+}
+
+// WithPolicy sets the Policy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Policy field is set to the value of the last call.
+func (b *OpenStackServerGroupSpecApplyConfiguration) WithPolicy(value apiv1alpha1.ServerGroupPolicy) *OpenStackServerGroupSpecApplyConfiguration {
+	b.Policy = &value
+	return b
+}
+
+// WithMaxServerPerHost sets the MaxServerPerHost field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxServerPerHost field is set to the value of the last call.
+func (b *OpenStackServerGroupSpecApplyConfiguration) WithMaxServerPerHost(value int32) *OpenStackServerGroupSpecApplyConfiguration {
+	b.MaxServerPerHost = &value
+	return b
+}
+
+// WithIdentityRef sets the IdentityRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdentityRef field is set to the value of the last call.
+func (b *OpenStackServerGroupSpecApplyConfiguration) WithIdentityRef(value *v1beta1.OpenStackIdentityReferenceApplyConfiguration) *OpenStackServerGroupSpecApplyConfiguration {
+	b.IdentityRef = value
+	return b
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// OpenStackServerGroupStatusApplyConfiguration represents a declarative configuration of the OpenStackServerGroupStatus type for use
+// with apply.
+type OpenStackServerGroupStatusApplyConfiguration struct {
+	Ready      *bool               `json:"ready,omitempty"`
+	ID         *string             `json:"id,omitempty"`
+	Members    []string            `json:"members,omitempty"`
+	Conditions *v1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// OpenStackServerGroupStatusApplyConfiguration constructs a declarative configuration of the OpenStackServerGroupStatus type for use with
+// apply.
+func OpenStackServerGroupStatus() *OpenStackServerGroupStatusApplyConfiguration {
+	return &OpenStackServerGroupStatusApplyConfiguration{}
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *OpenStackServerGroupStatusApplyConfiguration) WithReady(value bool) *OpenStackServerGroupStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *OpenStackServerGroupStatusApplyConfiguration) WithID(value string) *OpenStackServerGroupStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithMembers adds the given value to the Members field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Members field.
+func (b *OpenStackServerGroupStatusApplyConfiguration) WithMembers(values ...string) *OpenStackServerGroupStatusApplyConfiguration {
+	for i := range values {
+		b.Members = append(b.Members, values[i])
+	}
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *OpenStackServerGroupStatusApplyConfiguration) WithConditions(value v1beta1.Conditions) *OpenStackServerGroupStatusApplyConfiguration {
+	b.Conditions = &value
+	return b
+}
@@ -28,13 +28,21 @@ import (
 type OpenStackServerSpecApplyConfiguration struct {
 	AdditionalBlockDevices            []v1beta1.AdditionalBlockDeviceApplyConfiguration           `json:"additionalBlockDevices,omitempty"`
 	AvailabilityZone                  *string                                                     `json:"availabilityZone,omitempty"`
+	AvailabilityZoneFallback          []string                                                    `json:"availabilityZoneFallback,omitempty"`
 	ConfigDrive                       *bool                                                       `json:"configDrive,omitempty"`
 	Flavor                            *string                                                     `json:"flavor,omitempty"`
 	FlavorID                          *string                                                     `json:"flavorID,omitempty"`
+	FlavorSelector                    *v1beta1.FlavorSelectorApplyConfiguration                   `json:"flavorSelector,omitempty"`
+	Accelerators                      *v1beta1.AcceleratorRequirementsApplyConfiguration          `json:"accelerators,omitempty"`
 	FloatingIPPoolRef                 *v1.TypedLocalObjectReference                               `json:"floatingIPPoolRef,omitempty"`
+	HotAttachVolumes                  *bool                                                       `json:"hotAttachVolumes,omitempty"`
+	InPlaceResize                     *bool                                                       `json:"inPlaceResize,omitempty"`
+	InPlaceRebuild                    *bool                                                       `json:"inPlaceRebuild,omitempty"`
+	Remediation                       *v1beta1.InstanceRemediationApplyConfiguration              `json:"remediation,omitempty"`
 	IdentityRef                       *v1beta1.OpenStackIdentityReferenceApplyConfiguration       `json:"identityRef,omitempty"`
 	Image                             *v1beta1.ImageParamApplyConfiguration                       `json:"image,omitempty"`
 	Ports                             []v1beta1.PortOptsApplyConfiguration                        `json:"ports,omitempty"`
+	RequireEncryptedVolumes           *bool                                                       `json:"requireEncryptedVolumes,omitempty"`
 	RootVolume                        *v1beta1.RootVolumeApplyConfiguration                       `json:"rootVolume,omitempty"`
 	SSHKeyName                        *string                                                     `json:"sshKeyName,omitempty"`
 	SecurityGroups                    []v1beta1.SecurityGroupParamApplyConfiguration              `json:"securityGroups,omitempty"`
@@ -73,6 +81,16 @@ func (b *OpenStackServerSpecApplyConfiguration) WithAvailabilityZone(value strin
 	return b
 }
 
+// WithAvailabilityZoneFallback adds the given value to the AvailabilityZoneFallback field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AvailabilityZoneFallback field.
+func (b *OpenStackServerSpecApplyConfiguration) WithAvailabilityZoneFallback(values ...string) *OpenStackServerSpecApplyConfiguration {
+	for i := range values {
+		b.AvailabilityZoneFallback = append(b.AvailabilityZoneFallback, values[i])
+	}
+	return b
+}
+
 // WithConfigDrive sets the ConfigDrive field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ConfigDrive field is set to the value of the last call.
@@ -97,6 +115,22 @@ func (b *OpenStackServerSpecApplyConfiguration) WithFlavorID(value string) *Open
 	return b
 }
 
+// WithFlavorSelector sets the FlavorSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlavorSelector field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithFlavorSelector(value *v1beta1.FlavorSelectorApplyConfiguration) *OpenStackServerSpecApplyConfiguration {
+	b.FlavorSelector = value
+	return b
+}
+
+// WithAccelerators sets the Accelerators field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Accelerators field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithAccelerators(value *v1beta1.AcceleratorRequirementsApplyConfiguration) *OpenStackServerSpecApplyConfiguration {
+	b.Accelerators = value
+	return b
+}
+
 // WithFloatingIPPoolRef sets the FloatingIPPoolRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the FloatingIPPoolRef field is set to the value of the last call.
@@ -105,6 +139,38 @@ func (b *OpenStackServerSpecApplyConfiguration) WithFloatingIPPoolRef(value v1.T
 	return b
 }
 
+// WithHotAttachVolumes sets the HotAttachVolumes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HotAttachVolumes field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithHotAttachVolumes(value bool) *OpenStackServerSpecApplyConfiguration {
+	b.HotAttachVolumes = &value
+	return b
+}
+
+// WithInPlaceResize sets the InPlaceResize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InPlaceResize field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithInPlaceResize(value bool) *OpenStackServerSpecApplyConfiguration {
+	b.InPlaceResize = &value
+	return b
+}
+
+// WithInPlaceRebuild sets the InPlaceRebuild field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InPlaceRebuild field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithInPlaceRebuild(value bool) *OpenStackServerSpecApplyConfiguration {
+	b.InPlaceRebuild = &value
+	return b
+}
+
+// WithRemediation sets the Remediation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Remediation field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithRemediation(value *v1beta1.InstanceRemediationApplyConfiguration) *OpenStackServerSpecApplyConfiguration {
+	b.Remediation = value
+	return b
+}
+
 // WithIdentityRef sets the IdentityRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the IdentityRef field is set to the value of the last call.
@@ -134,6 +200,14 @@ func (b *OpenStackServerSpecApplyConfiguration) WithPorts(values ...*v1beta1.Por
 	return b
 }
 
+// WithRequireEncryptedVolumes sets the RequireEncryptedVolumes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequireEncryptedVolumes field is set to the value of the last call.
+func (b *OpenStackServerSpecApplyConfiguration) WithRequireEncryptedVolumes(value bool) *OpenStackServerSpecApplyConfiguration {
+	b.RequireEncryptedVolumes = &value
+	return b
+}
+
 // WithRootVolume sets the RootVolume field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RootVolume field is set to the value of the last call.
@@ -21,19 +21,25 @@ package v1alpha1
 import (
 	v1 "k8s.io/api/core/v1"
 	v1beta1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	apiv1beta1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1beta1"
 	corev1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
 // OpenStackServerStatusApplyConfiguration represents a declarative configuration of the OpenStackServerStatus type for use
 // with apply.
 type OpenStackServerStatusApplyConfiguration struct {
-	Ready         *bool                                 `json:"ready,omitempty"`
-	InstanceID    *string                               `json:"instanceID,omitempty"`
-	InstanceState *v1beta1.InstanceState                `json:"instanceState,omitempty"`
-	Addresses     []v1.NodeAddress                      `json:"addresses,omitempty"`
-	Resolved      *ResolvedServerSpecApplyConfiguration `json:"resolved,omitempty"`
-	Resources     *ServerResourcesApplyConfiguration    `json:"resources,omitempty"`
-	Conditions    *corev1beta1.Conditions               `json:"conditions,omitempty"`
+	Ready                    *bool                                                  `json:"ready,omitempty"`
+	InstanceID               *string                                                `json:"instanceID,omitempty"`
+	InstanceState            *v1beta1.InstanceState                                 `json:"instanceState,omitempty"`
+	Addresses                []v1.NodeAddress                                       `json:"addresses,omitempty"`
+	Resolved                 *ResolvedServerSpecApplyConfiguration                  `json:"resolved,omitempty"`
+	Resources                *ServerResourcesApplyConfiguration                     `json:"resources,omitempty"`
+	Resize                   *ServerResizeStatusApplyConfiguration                  `json:"resize,omitempty"`
+	Rebuild                  *ServerRebuildStatusApplyConfiguration                 `json:"rebuild,omitempty"`
+	Remediation              *ServerRemediationStatusApplyConfiguration             `json:"remediation,omitempty"`
+	AvailabilityZone         *string                                                `json:"availabilityZone,omitempty"`
+	AvailabilityZoneAttempts []apiv1beta1.AvailabilityZoneAttemptApplyConfiguration `json:"availabilityZoneAttempts,omitempty"`
+	Conditions               *corev1beta1.Conditions                                `json:"conditions,omitempty"`
 }
 
 // OpenStackServerStatusApplyConfiguration constructs a declarative configuration of the OpenStackServerStatus type for use with
@@ -92,6 +98,51 @@ func (b *OpenStackServerStatusApplyConfiguration) WithResources(value *ServerRes
 	return b
 }
 
+// WithResize sets the Resize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resize field is set to the value of the last call.
+func (b *OpenStackServerStatusApplyConfiguration) WithResize(value *ServerResizeStatusApplyConfiguration) *OpenStackServerStatusApplyConfiguration {
+	b.Resize = value
+	return b
+}
+
+// WithRebuild sets the Rebuild field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rebuild field is set to the value of the last call.
+func (b *OpenStackServerStatusApplyConfiguration) WithRebuild(value *ServerRebuildStatusApplyConfiguration) *OpenStackServerStatusApplyConfiguration {
+	b.Rebuild = value
+	return b
+}
+
+// WithRemediation sets the Remediation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Remediation field is set to the value of the last call.
+func (b *OpenStackServerStatusApplyConfiguration) WithRemediation(value *ServerRemediationStatusApplyConfiguration) *OpenStackServerStatusApplyConfiguration {
+	b.Remediation = value
+	return b
+}
+
+// WithAvailabilityZone sets the AvailabilityZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailabilityZone field is set to the value of the last call.
+func (b *OpenStackServerStatusApplyConfiguration) WithAvailabilityZone(value string) *OpenStackServerStatusApplyConfiguration {
+	b.AvailabilityZone = &value
+	return b
+}
+
+// WithAvailabilityZoneAttempts adds the given value to the AvailabilityZoneAttempts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AvailabilityZoneAttempts field.
+func (b *OpenStackServerStatusApplyConfiguration) WithAvailabilityZoneAttempts(values ...*apiv1beta1.AvailabilityZoneAttemptApplyConfiguration) *OpenStackServerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAvailabilityZoneAttempts")
+		}
+		b.AvailabilityZoneAttempts = append(b.AvailabilityZoneAttempts, *values[i])
+	}
+	return b
+}
+
 // WithConditions sets the Conditions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Conditions field is set to the value of the last call.
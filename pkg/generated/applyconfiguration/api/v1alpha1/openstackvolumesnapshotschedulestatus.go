@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// OpenStackVolumeSnapshotScheduleStatusApplyConfiguration represents a declarative configuration of the OpenStackVolumeSnapshotScheduleStatus type for use
+// with apply.
+type OpenStackVolumeSnapshotScheduleStatusApplyConfiguration struct {
+	LastScheduleTime *v1.Time                                 `json:"lastScheduleTime,omitempty"`
+	Snapshots        []VolumeSnapshotStatusApplyConfiguration `json:"snapshots,omitempty"`
+	Conditions       *v1beta1.Conditions                      `json:"conditions,omitempty"`
+}
+
+// OpenStackVolumeSnapshotScheduleStatusApplyConfiguration constructs a declarative configuration of the OpenStackVolumeSnapshotScheduleStatus type for use with
+// apply.
+func OpenStackVolumeSnapshotScheduleStatus() *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration {
+	return &OpenStackVolumeSnapshotScheduleStatusApplyConfiguration{}
+}
+
+// WithLastScheduleTime sets the LastScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScheduleTime field is set to the value of the last call.
+func (b *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration) WithLastScheduleTime(value v1.Time) *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration {
+	b.LastScheduleTime = &value
+	return b
+}
+
+// WithSnapshots adds the given value to the Snapshots field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Snapshots field.
+func (b *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration) WithSnapshots(values ...*VolumeSnapshotStatusApplyConfiguration) *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSnapshots")
+		}
+		b.Snapshots = append(b.Snapshots, *values[i])
+	}
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration) WithConditions(value v1beta1.Conditions) *OpenStackVolumeSnapshotScheduleStatusApplyConfiguration {
+	b.Conditions = &value
+	return b
+}
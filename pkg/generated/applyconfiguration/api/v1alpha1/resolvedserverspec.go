@@ -29,6 +29,7 @@ type ResolvedServerSpecApplyConfiguration struct {
 	ImageID       *string                                      `json:"imageID,omitempty"`
 	FlavorID      *string                                      `json:"flavorID,omitempty"`
 	Ports         []v1beta1.ResolvedPortSpecApplyConfiguration `json:"ports,omitempty"`
+	Volumes       []ResolvedVolumeSpecApplyConfiguration       `json:"volumes,omitempty"`
 }
 
 // ResolvedServerSpecApplyConfiguration constructs a declarative configuration of the ResolvedServerSpec type for use with
@@ -73,3 +74,16 @@ func (b *ResolvedServerSpecApplyConfiguration) WithPorts(values ...*v1beta1.Reso
 	}
 	return b
 }
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ResolvedServerSpecApplyConfiguration) WithVolumes(values ...*ResolvedVolumeSpecApplyConfiguration) *ResolvedServerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ServerRebuildStatusApplyConfiguration represents a declarative configuration of the ServerRebuildStatus type for use
+// with apply.
+type ServerRebuildStatusApplyConfiguration struct {
+	ImageID *string `json:"imageID,omitempty"`
+	Failed  *bool   `json:"failed,omitempty"`
+}
+
+// ServerRebuildStatusApplyConfiguration constructs a declarative configuration of the ServerRebuildStatus type for use with
+// apply.
+func ServerRebuildStatus() *ServerRebuildStatusApplyConfiguration {
+	return &ServerRebuildStatusApplyConfiguration{}
+}
+
+// WithImageID sets the ImageID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImageID field is set to the value of the last call.
+func (b *ServerRebuildStatusApplyConfiguration) WithImageID(value string) *ServerRebuildStatusApplyConfiguration {
+	b.ImageID = &value
+	return b
+}
+
+// WithFailed sets the Failed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failed field is set to the value of the last call.
+func (b *ServerRebuildStatusApplyConfiguration) WithFailed(value bool) *ServerRebuildStatusApplyConfiguration {
+	b.Failed = &value
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+// ServerRemediationStatusApplyConfiguration represents a declarative configuration of the ServerRemediationStatus type for use
+// with apply.
+type ServerRemediationStatusApplyConfiguration struct {
+	ErrorReboots   *int32                               `json:"errorReboots,omitempty"`
+	Attempts       *int32                               `json:"attempts,omitempty"`
+	LastAction     *apiv1alpha1.ServerRemediationAction `json:"lastAction,omitempty"`
+	LastActionTime *v1.Time                             `json:"lastActionTime,omitempty"`
+}
+
+// ServerRemediationStatusApplyConfiguration constructs a declarative configuration of the ServerRemediationStatus type for use with
+// apply.
+func ServerRemediationStatus() *ServerRemediationStatusApplyConfiguration {
+	return &ServerRemediationStatusApplyConfiguration{}
+}
+
+// WithErrorReboots sets the ErrorReboots field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ErrorReboots field is set to the value of the last call.
+func (b *ServerRemediationStatusApplyConfiguration) WithErrorReboots(value int32) *ServerRemediationStatusApplyConfiguration {
+	b.ErrorReboots = &value
+	return b
+}
+
+// WithAttempts sets the Attempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Attempts field is set to the value of the last call.
+func (b *ServerRemediationStatusApplyConfiguration) WithAttempts(value int32) *ServerRemediationStatusApplyConfiguration {
+	b.Attempts = &value
+	return b
+}
+
+// WithLastAction sets the LastAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastAction field is set to the value of the last call.
+func (b *ServerRemediationStatusApplyConfiguration) WithLastAction(value apiv1alpha1.ServerRemediationAction) *ServerRemediationStatusApplyConfiguration {
+	b.LastAction = &value
+	return b
+}
+
+// WithLastActionTime sets the LastActionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastActionTime field is set to the value of the last call.
+func (b *ServerRemediationStatusApplyConfiguration) WithLastActionTime(value v1.Time) *ServerRemediationStatusApplyConfiguration {
+	b.LastActionTime = &value
+	return b
+}
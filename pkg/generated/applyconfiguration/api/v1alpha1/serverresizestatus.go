@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ServerResizeStatusApplyConfiguration represents a declarative configuration of the ServerResizeStatus type for use
+// with apply.
+type ServerResizeStatusApplyConfiguration struct {
+	FlavorID *string `json:"flavorID,omitempty"`
+	Failed   *bool   `json:"failed,omitempty"`
+}
+
+// ServerResizeStatusApplyConfiguration constructs a declarative configuration of the ServerResizeStatus type for use with
+// apply.
+func ServerResizeStatus() *ServerResizeStatusApplyConfiguration {
+	return &ServerResizeStatusApplyConfiguration{}
+}
+
+// WithFlavorID sets the FlavorID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlavorID field is set to the value of the last call.
+func (b *ServerResizeStatusApplyConfiguration) WithFlavorID(value string) *ServerResizeStatusApplyConfiguration {
+	b.FlavorID = &value
+	return b
+}
+
+// WithFailed sets the Failed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failed field is set to the value of the last call.
+func (b *ServerResizeStatusApplyConfiguration) WithFailed(value bool) *ServerResizeStatusApplyConfiguration {
+	b.Failed = &value
+	return b
+}
@@ -25,7 +25,8 @@ import (
 // ServerResourcesApplyConfiguration represents a declarative configuration of the ServerResources type for use
 // with apply.
 type ServerResourcesApplyConfiguration struct {
-	Ports []v1beta1.PortStatusApplyConfiguration `json:"ports,omitempty"`
+	Ports   []v1beta1.PortStatusApplyConfiguration `json:"ports,omitempty"`
+	Volumes []ServerVolumeStatusApplyConfiguration `json:"volumes,omitempty"`
 }
 
 // ServerResourcesApplyConfiguration constructs a declarative configuration of the ServerResources type for use with
@@ -46,3 +47,16 @@ func (b *ServerResourcesApplyConfiguration) WithPorts(values ...*v1beta1.PortSta
 	}
 	return b
 }
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ServerResourcesApplyConfiguration) WithVolumes(values ...*ServerVolumeStatusApplyConfiguration) *ServerResourcesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
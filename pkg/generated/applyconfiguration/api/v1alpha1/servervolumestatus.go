@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+// ServerVolumeStatusApplyConfiguration represents a declarative configuration of the ServerVolumeStatus type for use
+// with apply.
+type ServerVolumeStatusApplyConfiguration struct {
+	Name     *string                            `json:"name,omitempty"`
+	VolumeID *string                            `json:"volumeID,omitempty"`
+	Device   *string                            `json:"device,omitempty"`
+	State    *apiv1alpha1.VolumeAttachmentState `json:"state,omitempty"`
+	Created  *bool                              `json:"created,omitempty"`
+}
+
+// ServerVolumeStatusApplyConfiguration constructs a declarative configuration of the ServerVolumeStatus type for use with
+// apply.
+func ServerVolumeStatus() *ServerVolumeStatusApplyConfiguration {
+	return &ServerVolumeStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ServerVolumeStatusApplyConfiguration) WithName(value string) *ServerVolumeStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVolumeID sets the VolumeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeID field is set to the value of the last call.
+func (b *ServerVolumeStatusApplyConfiguration) WithVolumeID(value string) *ServerVolumeStatusApplyConfiguration {
+	b.VolumeID = &value
+	return b
+}
+
+// WithDevice sets the Device field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Device field is set to the value of the last call.
+func (b *ServerVolumeStatusApplyConfiguration) WithDevice(value string) *ServerVolumeStatusApplyConfiguration {
+	b.Device = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ServerVolumeStatusApplyConfiguration) WithState(value apiv1alpha1.VolumeAttachmentState) *ServerVolumeStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithCreated sets the Created field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Created field is set to the value of the last call.
+func (b *ServerVolumeStatusApplyConfiguration) WithCreated(value bool) *ServerVolumeStatusApplyConfiguration {
+	b.Created = &value
+	return b
+}
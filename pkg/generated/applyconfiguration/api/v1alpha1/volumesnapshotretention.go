@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotRetentionApplyConfiguration represents a declarative configuration of the VolumeSnapshotRetention type for use
+// with apply.
+type VolumeSnapshotRetentionApplyConfiguration struct {
+	MaxCount *int32       `json:"maxCount,omitempty"`
+	MaxAge   *v1.Duration `json:"maxAge,omitempty"`
+}
+
+// VolumeSnapshotRetentionApplyConfiguration constructs a declarative configuration of the VolumeSnapshotRetention type for use with
+// apply.
+func VolumeSnapshotRetention() *VolumeSnapshotRetentionApplyConfiguration {
+	return &VolumeSnapshotRetentionApplyConfiguration{}
+}
+
+// WithMaxCount sets the MaxCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxCount field is set to the value of the last call.
+func (b *VolumeSnapshotRetentionApplyConfiguration) WithMaxCount(value int32) *VolumeSnapshotRetentionApplyConfiguration {
+	b.MaxCount = &value
+	return b
+}
+
+// WithMaxAge sets the MaxAge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAge field is set to the value of the last call.
+func (b *VolumeSnapshotRetentionApplyConfiguration) WithMaxAge(value v1.Duration) *VolumeSnapshotRetentionApplyConfiguration {
+	b.MaxAge = &value
+	return b
+}
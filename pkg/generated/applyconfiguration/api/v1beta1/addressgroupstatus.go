@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// AddressGroupStatusApplyConfiguration represents a declarative configuration of the AddressGroupStatus type for use
+// with apply.
+type AddressGroupStatusApplyConfiguration struct {
+	Name *string `json:"name,omitempty"`
+	ID   *string `json:"id,omitempty"`
+}
+
+// AddressGroupStatusApplyConfiguration constructs a declarative configuration of the AddressGroupStatus type for use with
+// apply.
+func AddressGroupStatus() *AddressGroupStatusApplyConfiguration {
+	return &AddressGroupStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AddressGroupStatusApplyConfiguration) WithName(value string) *AddressGroupStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *AddressGroupStatusApplyConfiguration) WithID(value string) *AddressGroupStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
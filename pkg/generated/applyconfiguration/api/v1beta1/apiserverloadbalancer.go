@@ -18,18 +18,28 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1beta1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
 // APIServerLoadBalancerApplyConfiguration represents a declarative configuration of the APIServerLoadBalancer type for use
 // with apply.
 type APIServerLoadBalancerApplyConfiguration struct {
-	Enabled          *bool                                           `json:"enabled,omitempty"`
-	AdditionalPorts  []int                                           `json:"additionalPorts,omitempty"`
-	AllowedCIDRs     []string                                        `json:"allowedCIDRs,omitempty"`
-	Provider         *string                                         `json:"provider,omitempty"`
-	Network          *NetworkParamApplyConfiguration                 `json:"network,omitempty"`
-	Subnets          []SubnetParamApplyConfiguration                 `json:"subnets,omitempty"`
-	AvailabilityZone *string                                         `json:"availabilityZone,omitempty"`
-	Flavor           *string                                         `json:"flavor,omitempty"`
-	Monitor          *APIServerLoadBalancerMonitorApplyConfiguration `json:"monitor,omitempty"`
+	Enabled            *bool                                           `json:"enabled,omitempty"`
+	LoadBalancerRef    *LoadBalancerParamApplyConfiguration            `json:"loadBalancerRef,omitempty"`
+	AdditionalPorts    []int                                           `json:"additionalPorts,omitempty"`
+	AllowedCIDRs       []string                                        `json:"allowedCIDRs,omitempty"`
+	Provider           *string                                         `json:"provider,omitempty"`
+	Network            *NetworkParamApplyConfiguration                 `json:"network,omitempty"`
+	Subnets            []SubnetParamApplyConfiguration                 `json:"subnets,omitempty"`
+	AvailabilityZone   *string                                         `json:"availabilityZone,omitempty"`
+	Flavor             *string                                         `json:"flavor,omitempty"`
+	Monitor            *APIServerLoadBalancerMonitorApplyConfiguration `json:"monitor,omitempty"`
+	MemberDrainTimeout *v1.Duration                                    `json:"memberDrainTimeout,omitempty"`
+	MemberDrainMode    *apiv1beta1.MemberDrainMode                     `json:"memberDrainMode,omitempty"`
+	Listeners          []LoadBalancerListenerApplyConfiguration        `json:"listeners,omitempty"`
+	Pools              []LoadBalancerPoolApplyConfiguration            `json:"pools,omitempty"`
 }
 
 // APIServerLoadBalancerApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancer type for use with
@@ -46,6 +56,14 @@ func (b *APIServerLoadBalancerApplyConfiguration) WithEnabled(value bool) *APISe
 	return b
 }
 
+// WithLoadBalancerRef sets the LoadBalancerRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LoadBalancerRef field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithLoadBalancerRef(value *LoadBalancerParamApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	b.LoadBalancerRef = value
+	return b
+}
+
 // WithAdditionalPorts adds the given value to the AdditionalPorts field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AdditionalPorts field.
@@ -118,3 +136,45 @@ func (b *APIServerLoadBalancerApplyConfiguration) WithMonitor(value *APIServerLo
 	b.Monitor = value
 	return b
 }
+
+// WithMemberDrainTimeout sets the MemberDrainTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemberDrainTimeout field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithMemberDrainTimeout(value v1.Duration) *APIServerLoadBalancerApplyConfiguration {
+	b.MemberDrainTimeout = &value
+	return b
+}
+
+// WithMemberDrainMode sets the MemberDrainMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemberDrainMode field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithMemberDrainMode(value apiv1beta1.MemberDrainMode) *APIServerLoadBalancerApplyConfiguration {
+	b.MemberDrainMode = &value
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *APIServerLoadBalancerApplyConfiguration) WithListeners(values ...*LoadBalancerListenerApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
+
+// WithPools adds the given value to the Pools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pools field.
+func (b *APIServerLoadBalancerApplyConfiguration) WithPools(values ...*LoadBalancerPoolApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPools")
+		}
+		b.Pools = append(b.Pools, *values[i])
+	}
+	return b
+}
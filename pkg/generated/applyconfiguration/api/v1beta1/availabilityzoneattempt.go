@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AvailabilityZoneAttemptApplyConfiguration represents a declarative configuration of the AvailabilityZoneAttempt type for use
+// with apply.
+type AvailabilityZoneAttemptApplyConfiguration struct {
+	Zone    *string  `json:"zone,omitempty"`
+	Message *string  `json:"message,omitempty"`
+	Time    *v1.Time `json:"time,omitempty"`
+}
+
+// AvailabilityZoneAttemptApplyConfiguration constructs a declarative configuration of the AvailabilityZoneAttempt type for use with
+// apply.
+func AvailabilityZoneAttempt() *AvailabilityZoneAttemptApplyConfiguration {
+	return &AvailabilityZoneAttemptApplyConfiguration{}
+}
+
+// WithZone sets the Zone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Zone field is set to the value of the last call.
+func (b *AvailabilityZoneAttemptApplyConfiguration) WithZone(value string) *AvailabilityZoneAttemptApplyConfiguration {
+	b.Zone = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *AvailabilityZoneAttemptApplyConfiguration) WithMessage(value string) *AvailabilityZoneAttemptApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithTime sets the Time field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Time field is set to the value of the last call.
+func (b *AvailabilityZoneAttemptApplyConfiguration) WithTime(value v1.Time) *AvailabilityZoneAttemptApplyConfiguration {
+	b.Time = &value
+	return b
+}
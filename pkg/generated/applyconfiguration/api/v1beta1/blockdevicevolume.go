@@ -23,6 +23,11 @@ package v1beta1
 type BlockDeviceVolumeApplyConfiguration struct {
 	Type             *string                                   `json:"type,omitempty"`
 	AvailabilityZone *VolumeAvailabilityZoneApplyConfiguration `json:"availabilityZone,omitempty"`
+	SnapshotRef      *VolumeSnapshotParamApplyConfiguration    `json:"snapshotRef,omitempty"`
+	VolumeRef        *VolumeParamApplyConfiguration            `json:"volumeRef,omitempty"`
+	Metadata         []VolumeMetadataApplyConfiguration        `json:"metadata,omitempty"`
+	SchedulerHints   *VolumeSchedulerHintsApplyConfiguration   `json:"schedulerHints,omitempty"`
+	Multiattach      *bool                                     `json:"multiattach,omitempty"`
 }
 
 // BlockDeviceVolumeApplyConfiguration constructs a declarative configuration of the BlockDeviceVolume type for use with
@@ -46,3 +51,48 @@ func (b *BlockDeviceVolumeApplyConfiguration) WithAvailabilityZone(value *Volume
 	b.AvailabilityZone = value
 	return b
 }
+
+// WithSnapshotRef sets the SnapshotRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotRef field is set to the value of the last call.
+func (b *BlockDeviceVolumeApplyConfiguration) WithSnapshotRef(value *VolumeSnapshotParamApplyConfiguration) *BlockDeviceVolumeApplyConfiguration {
+	b.SnapshotRef = value
+	return b
+}
+
+// WithVolumeRef sets the VolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeRef field is set to the value of the last call.
+func (b *BlockDeviceVolumeApplyConfiguration) WithVolumeRef(value *VolumeParamApplyConfiguration) *BlockDeviceVolumeApplyConfiguration {
+	b.VolumeRef = value
+	return b
+}
+
+// WithMetadata adds the given value to the Metadata field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Metadata field.
+func (b *BlockDeviceVolumeApplyConfiguration) WithMetadata(values ...*VolumeMetadataApplyConfiguration) *BlockDeviceVolumeApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMetadata")
+		}
+		b.Metadata = append(b.Metadata, *values[i])
+	}
+	return b
+}
+
+// WithSchedulerHints sets the SchedulerHints field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SchedulerHints field is set to the value of the last call.
+func (b *BlockDeviceVolumeApplyConfiguration) WithSchedulerHints(value *VolumeSchedulerHintsApplyConfiguration) *BlockDeviceVolumeApplyConfiguration {
+	b.SchedulerHints = value
+	return b
+}
+
+// WithMultiattach sets the Multiattach field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Multiattach field is set to the value of the last call.
+func (b *BlockDeviceVolumeApplyConfiguration) WithMultiattach(value bool) *BlockDeviceVolumeApplyConfiguration {
+	b.Multiattach = &value
+	return b
+}
@@ -21,7 +21,8 @@ package v1beta1
 // ClusterVIPStatusApplyConfiguration represents a declarative configuration of the ClusterVIPStatus type for use
 // with apply.
 type ClusterVIPStatusApplyConfiguration struct {
-	VIP *string `json:"vip,omitempty"`
+	VIP        *string `json:"vip,omitempty"`
+	FloatingIP *string `json:"floatingIP,omitempty"`
 }
 
 // ClusterVIPStatusApplyConfiguration constructs a declarative configuration of the ClusterVIPStatus type for use with
@@ -37,3 +38,11 @@ func (b *ClusterVIPStatusApplyConfiguration) WithVIP(value string) *ClusterVIPSt
 	b.VIP = &value
 	return b
 }
+
+// WithFloatingIP sets the FloatingIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FloatingIP field is set to the value of the last call.
+func (b *ClusterVIPStatusApplyConfiguration) WithFloatingIP(value string) *ClusterVIPStatusApplyConfiguration {
+	b.FloatingIP = &value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConsoleLogCaptureApplyConfiguration represents a declarative configuration of the ConsoleLogCapture type for use
+// with apply.
+type ConsoleLogCaptureApplyConfiguration struct {
+	Lines       *int32       `json:"lines,omitempty"`
+	NodeTimeout *v1.Duration `json:"nodeTimeout,omitempty"`
+}
+
+// ConsoleLogCaptureApplyConfiguration constructs a declarative configuration of the ConsoleLogCapture type for use with
+// apply.
+func ConsoleLogCapture() *ConsoleLogCaptureApplyConfiguration {
+	return &ConsoleLogCaptureApplyConfiguration{}
+}
+
+// WithLines sets the Lines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lines field is set to the value of the last call.
+func (b *ConsoleLogCaptureApplyConfiguration) WithLines(value int32) *ConsoleLogCaptureApplyConfiguration {
+	b.Lines = &value
+	return b
+}
+
+// WithNodeTimeout sets the NodeTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeTimeout field is set to the value of the last call.
+func (b *ConsoleLogCaptureApplyConfiguration) WithNodeTimeout(value v1.Duration) *ConsoleLogCaptureApplyConfiguration {
+	b.NodeTimeout = &value
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// FlavorExtraSpecApplyConfiguration represents a declarative configuration of the FlavorExtraSpec type for use
+// with apply.
+type FlavorExtraSpecApplyConfiguration struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// FlavorExtraSpecApplyConfiguration constructs a declarative configuration of the FlavorExtraSpec type for use with
+// apply.
+func FlavorExtraSpec() *FlavorExtraSpecApplyConfiguration {
+	return &FlavorExtraSpecApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *FlavorExtraSpecApplyConfiguration) WithKey(value string) *FlavorExtraSpecApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *FlavorExtraSpecApplyConfiguration) WithValue(value string) *FlavorExtraSpecApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -21,9 +21,10 @@ package v1beta1
 // ImageParamApplyConfiguration represents a declarative configuration of the ImageParam type for use
 // with apply.
 type ImageParamApplyConfiguration struct {
-	ID       *string                              `json:"id,omitempty"`
-	Filter   *ImageFilterApplyConfiguration       `json:"filter,omitempty"`
-	ImageRef *ResourceReferenceApplyConfiguration `json:"imageRef,omitempty"`
+	ID                *string                              `json:"id,omitempty"`
+	Filter            *ImageFilterApplyConfiguration       `json:"filter,omitempty"`
+	ImageRef          *ResourceReferenceApplyConfiguration `json:"imageRef,omitempty"`
+	OpenStackImageRef *ResourceReferenceApplyConfiguration `json:"openStackImageRef,omitempty"`
 }
 
 // ImageParamApplyConfiguration constructs a declarative configuration of the ImageParam type for use with
@@ -55,3 +56,11 @@ func (b *ImageParamApplyConfiguration) WithImageRef(value *ResourceReferenceAppl
 	b.ImageRef = value
 	return b
 }
+
+// WithOpenStackImageRef sets the OpenStackImageRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenStackImageRef field is set to the value of the last call.
+func (b *ImageParamApplyConfiguration) WithOpenStackImageRef(value *ResourceReferenceApplyConfiguration) *ImageParamApplyConfiguration {
+	b.OpenStackImageRef = value
+	return b
+}
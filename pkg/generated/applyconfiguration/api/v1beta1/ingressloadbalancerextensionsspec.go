@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// IngressLoadBalancerExtensionsSpecApplyConfiguration represents a declarative configuration of the IngressLoadBalancerExtensionsSpec type for use
+// with apply.
+type IngressLoadBalancerExtensionsSpecApplyConfiguration struct {
+	Mode  *string `json:"mode,omitempty"`
+	Ports []int   `json:"ports,omitempty"`
+}
+
+// IngressLoadBalancerExtensionsSpecApplyConfiguration constructs a declarative configuration of the IngressLoadBalancerExtensionsSpec type for use with
+// apply.
+func IngressLoadBalancerExtensionsSpec() *IngressLoadBalancerExtensionsSpecApplyConfiguration {
+	return &IngressLoadBalancerExtensionsSpecApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *IngressLoadBalancerExtensionsSpecApplyConfiguration) WithMode(value string) *IngressLoadBalancerExtensionsSpecApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithPorts adds the given value to the Ports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ports field.
+func (b *IngressLoadBalancerExtensionsSpecApplyConfiguration) WithPorts(values ...int) *IngressLoadBalancerExtensionsSpecApplyConfiguration {
+	for i := range values {
+		b.Ports = append(b.Ports, values[i])
+	}
+	return b
+}
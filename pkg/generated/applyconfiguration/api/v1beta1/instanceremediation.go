@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstanceRemediationApplyConfiguration represents a declarative configuration of the InstanceRemediation type for use
+// with apply.
+type InstanceRemediationApplyConfiguration struct {
+	StartShutoff    *bool        `json:"startShutoff,omitempty"`
+	Unpause         *bool        `json:"unpause,omitempty"`
+	MaxErrorReboots *int32       `json:"maxErrorReboots,omitempty"`
+	Backoff         *v1.Duration `json:"backoff,omitempty"`
+}
+
+// InstanceRemediationApplyConfiguration constructs a declarative configuration of the InstanceRemediation type for use with
+// apply.
+func InstanceRemediation() *InstanceRemediationApplyConfiguration {
+	return &InstanceRemediationApplyConfiguration{}
+}
+
+// WithStartShutoff sets the StartShutoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartShutoff field is set to the value of the last call.
+func (b *InstanceRemediationApplyConfiguration) WithStartShutoff(value bool) *InstanceRemediationApplyConfiguration {
+	b.StartShutoff = &value
+	return b
+}
+
+// WithUnpause sets the Unpause field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Unpause field is set to the value of the last call.
+func (b *InstanceRemediationApplyConfiguration) WithUnpause(value bool) *InstanceRemediationApplyConfiguration {
+	b.Unpause = &value
+	return b
+}
+
+// WithMaxErrorReboots sets the MaxErrorReboots field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxErrorReboots field is set to the value of the last call.
+func (b *InstanceRemediationApplyConfiguration) WithMaxErrorReboots(value int32) *InstanceRemediationApplyConfiguration {
+	b.MaxErrorReboots = &value
+	return b
+}
+
+// WithBackoff sets the Backoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backoff field is set to the value of the last call.
+func (b *InstanceRemediationApplyConfiguration) WithBackoff(value v1.Duration) *InstanceRemediationApplyConfiguration {
+	b.Backoff = &value
+	return b
+}
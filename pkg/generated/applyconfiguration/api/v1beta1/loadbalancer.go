@@ -25,6 +25,7 @@ type LoadBalancerApplyConfiguration struct {
 	ID                  *string                                     `json:"id,omitempty"`
 	IP                  *string                                     `json:"ip,omitempty"`
 	InternalIP          *string                                     `json:"internalIP,omitempty"`
+	Adopted             *bool                                       `json:"adopted,omitempty"`
 	AllowedCIDRs        []string                                    `json:"allowedCIDRs,omitempty"`
 	Tags                []string                                    `json:"tags,omitempty"`
 	LoadBalancerNetwork *NetworkStatusWithSubnetsApplyConfiguration `json:"loadBalancerNetwork,omitempty"`
@@ -68,6 +69,14 @@ func (b *LoadBalancerApplyConfiguration) WithInternalIP(value string) *LoadBalan
 	return b
 }
 
+// WithAdopted sets the Adopted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Adopted field is set to the value of the last call.
+func (b *LoadBalancerApplyConfiguration) WithAdopted(value bool) *LoadBalancerApplyConfiguration {
+	b.Adopted = &value
+	return b
+}
+
 // WithAllowedCIDRs adds the given value to the AllowedCIDRs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AllowedCIDRs field.
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// LoadBalancerL7PolicyApplyConfiguration represents a declarative configuration of the LoadBalancerL7Policy type for use
+// with apply.
+type LoadBalancerL7PolicyApplyConfiguration struct {
+	Name  *string                                `json:"name,omitempty"`
+	Pool  *string                                `json:"pool,omitempty"`
+	Rules []LoadBalancerL7RuleApplyConfiguration `json:"rules,omitempty"`
+}
+
+// LoadBalancerL7PolicyApplyConfiguration constructs a declarative configuration of the LoadBalancerL7Policy type for use with
+// apply.
+func LoadBalancerL7Policy() *LoadBalancerL7PolicyApplyConfiguration {
+	return &LoadBalancerL7PolicyApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LoadBalancerL7PolicyApplyConfiguration) WithName(value string) *LoadBalancerL7PolicyApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPool sets the Pool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pool field is set to the value of the last call.
+func (b *LoadBalancerL7PolicyApplyConfiguration) WithPool(value string) *LoadBalancerL7PolicyApplyConfiguration {
+	b.Pool = &value
+	return b
+}
+
+// WithRules adds the given value to the Rules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rules field.
+func (b *LoadBalancerL7PolicyApplyConfiguration) WithRules(values ...*LoadBalancerL7RuleApplyConfiguration) *LoadBalancerL7PolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRules")
+		}
+		b.Rules = append(b.Rules, *values[i])
+	}
+	return b
+}
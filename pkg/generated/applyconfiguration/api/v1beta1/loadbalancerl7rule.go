@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	apiv1beta1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+// LoadBalancerL7RuleApplyConfiguration represents a declarative configuration of the LoadBalancerL7Rule type for use
+// with apply.
+type LoadBalancerL7RuleApplyConfiguration struct {
+	Type        *apiv1beta1.LoadBalancerL7RuleType        `json:"type,omitempty"`
+	CompareType *apiv1beta1.LoadBalancerL7RuleCompareType `json:"compareType,omitempty"`
+	Value       *string                                   `json:"value,omitempty"`
+	Invert      *bool                                     `json:"invert,omitempty"`
+}
+
+// LoadBalancerL7RuleApplyConfiguration constructs a declarative configuration of the LoadBalancerL7Rule type for use with
+// apply.
+func LoadBalancerL7Rule() *LoadBalancerL7RuleApplyConfiguration {
+	return &LoadBalancerL7RuleApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *LoadBalancerL7RuleApplyConfiguration) WithType(value apiv1beta1.LoadBalancerL7RuleType) *LoadBalancerL7RuleApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithCompareType sets the CompareType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompareType field is set to the value of the last call.
+func (b *LoadBalancerL7RuleApplyConfiguration) WithCompareType(value apiv1beta1.LoadBalancerL7RuleCompareType) *LoadBalancerL7RuleApplyConfiguration {
+	b.CompareType = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *LoadBalancerL7RuleApplyConfiguration) WithValue(value string) *LoadBalancerL7RuleApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithInvert sets the Invert field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Invert field is set to the value of the last call.
+func (b *LoadBalancerL7RuleApplyConfiguration) WithInvert(value bool) *LoadBalancerL7RuleApplyConfiguration {
+	b.Invert = &value
+	return b
+}
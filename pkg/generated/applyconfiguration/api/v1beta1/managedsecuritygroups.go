@@ -21,10 +21,13 @@ package v1beta1
 // ManagedSecurityGroupsApplyConfiguration represents a declarative configuration of the ManagedSecurityGroups type for use
 // with apply.
 type ManagedSecurityGroupsApplyConfiguration struct {
-	AllNodesSecurityGroupRules          []SecurityGroupRuleSpecApplyConfiguration `json:"allNodesSecurityGroupRules,omitempty"`
-	ControlPlaneNodesSecurityGroupRules []SecurityGroupRuleSpecApplyConfiguration `json:"controlPlaneNodesSecurityGroupRules,omitempty"`
-	WorkerNodesSecurityGroupRules       []SecurityGroupRuleSpecApplyConfiguration `json:"workerNodesSecurityGroupRules,omitempty"`
-	AllowAllInClusterTraffic            *bool                                     `json:"allowAllInClusterTraffic,omitempty"`
+	AllNodesSecurityGroupRules          []SecurityGroupRuleSpecApplyConfiguration       `json:"allNodesSecurityGroupRules,omitempty"`
+	ControlPlaneNodesSecurityGroupRules []SecurityGroupRuleSpecApplyConfiguration       `json:"controlPlaneNodesSecurityGroupRules,omitempty"`
+	WorkerNodesSecurityGroupRules       []SecurityGroupRuleSpecApplyConfiguration       `json:"workerNodesSecurityGroupRules,omitempty"`
+	AllowAllInClusterTraffic            *bool                                           `json:"allowAllInClusterTraffic,omitempty"`
+	ControlPlane                        *ManagedSecurityGroupSettingsApplyConfiguration `json:"controlPlane,omitempty"`
+	Worker                              *ManagedSecurityGroupSettingsApplyConfiguration `json:"worker,omitempty"`
+	Bastion                             *ManagedSecurityGroupSettingsApplyConfiguration `json:"bastion,omitempty"`
 }
 
 // ManagedSecurityGroupsApplyConfiguration constructs a declarative configuration of the ManagedSecurityGroups type for use with
@@ -79,3 +82,27 @@ func (b *ManagedSecurityGroupsApplyConfiguration) WithAllowAllInClusterTraffic(v
 	b.AllowAllInClusterTraffic = &value
 	return b
 }
+
+// WithControlPlane sets the ControlPlane field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlPlane field is set to the value of the last call.
+func (b *ManagedSecurityGroupsApplyConfiguration) WithControlPlane(value *ManagedSecurityGroupSettingsApplyConfiguration) *ManagedSecurityGroupsApplyConfiguration {
+	b.ControlPlane = value
+	return b
+}
+
+// WithWorker sets the Worker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Worker field is set to the value of the last call.
+func (b *ManagedSecurityGroupsApplyConfiguration) WithWorker(value *ManagedSecurityGroupSettingsApplyConfiguration) *ManagedSecurityGroupsApplyConfiguration {
+	b.Worker = value
+	return b
+}
+
+// WithBastion sets the Bastion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bastion field is set to the value of the last call.
+func (b *ManagedSecurityGroupsApplyConfiguration) WithBastion(value *ManagedSecurityGroupSettingsApplyConfiguration) *ManagedSecurityGroupsApplyConfiguration {
+	b.Bastion = value
+	return b
+}
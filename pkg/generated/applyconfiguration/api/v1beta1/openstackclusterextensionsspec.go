@@ -28,6 +28,7 @@ type OpenStackClusterExtensionsSpecApplyConfiguration struct {
 	Networking        *ClusterNetworkingExtensionsSpecApplyConfiguration        `json:"networking,omitempty"`
 	NetworkInterfaces *ClusterNetworkInterfacesExtensionsSpecApplyConfiguration `json:"networkInterfaces,omitempty"`
 	OpenStack         *apiv1beta1.ClusterOpenStackExtensionsSpec                `json:"openStack,omitempty"`
+	LoadBalancers     *ClusterLoadBalancersExtensionsSpecApplyConfiguration     `json:"loadBalancers,omitempty"`
 }
 
 // OpenStackClusterExtensionsSpecApplyConfiguration constructs a declarative configuration of the OpenStackClusterExtensionsSpec type for use with
@@ -59,3 +60,11 @@ func (b *OpenStackClusterExtensionsSpecApplyConfiguration) WithOpenStack(value a
 	b.OpenStack = &value
 	return b
 }
+
+// WithLoadBalancers sets the LoadBalancers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LoadBalancers field is set to the value of the last call.
+func (b *OpenStackClusterExtensionsSpecApplyConfiguration) WithLoadBalancers(value *ClusterLoadBalancersExtensionsSpecApplyConfiguration) *OpenStackClusterExtensionsSpecApplyConfiguration {
+	b.LoadBalancers = value
+	return b
+}
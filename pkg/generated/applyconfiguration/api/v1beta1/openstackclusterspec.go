@@ -46,6 +46,9 @@ type OpenStackClusterSpecApplyConfiguration struct {
 	ControlPlaneOmitAvailabilityZone *bool                                             `json:"controlPlaneOmitAvailabilityZone,omitempty"`
 	Bastion                          *BastionApplyConfiguration                        `json:"bastion,omitempty"`
 	IdentityRef                      *OpenStackIdentityReferenceApplyConfiguration     `json:"identityRef,omitempty"`
+	VolumePolicy                     *VolumePolicyApplyConfiguration                   `json:"volumePolicy,omitempty"`
+	InstanceRemediation              *InstanceRemediationApplyConfiguration            `json:"instanceRemediation,omitempty"`
+	ConsoleLogCapture                *ConsoleLogCaptureApplyConfiguration              `json:"consoleLogCapture,omitempty"`
 	Extensions                       *OpenStackClusterExtensionsSpecApplyConfiguration `json:"extensions,omitempty"`
 }
 
@@ -242,6 +245,30 @@ func (b *OpenStackClusterSpecApplyConfiguration) WithIdentityRef(value *OpenStac
 	return b
 }
 
+// WithVolumePolicy sets the VolumePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumePolicy field is set to the value of the last call.
+func (b *OpenStackClusterSpecApplyConfiguration) WithVolumePolicy(value *VolumePolicyApplyConfiguration) *OpenStackClusterSpecApplyConfiguration {
+	b.VolumePolicy = value
+	return b
+}
+
+// WithInstanceRemediation sets the InstanceRemediation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InstanceRemediation field is set to the value of the last call.
+func (b *OpenStackClusterSpecApplyConfiguration) WithInstanceRemediation(value *InstanceRemediationApplyConfiguration) *OpenStackClusterSpecApplyConfiguration {
+	b.InstanceRemediation = value
+	return b
+}
+
+// WithConsoleLogCapture sets the ConsoleLogCapture field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConsoleLogCapture field is set to the value of the last call.
+func (b *OpenStackClusterSpecApplyConfiguration) WithConsoleLogCapture(value *ConsoleLogCaptureApplyConfiguration) *OpenStackClusterSpecApplyConfiguration {
+	b.ConsoleLogCapture = value
+	return b
+}
+
 // WithExtensions sets the Extensions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Extensions field is set to the value of the last call.
@@ -36,7 +36,9 @@ type OpenStackClusterStatusApplyConfiguration struct {
 	ControlPlaneSecurityGroup *SecurityGroupStatusApplyConfiguration              `json:"controlPlaneSecurityGroup,omitempty"`
 	WorkerSecurityGroup       *SecurityGroupStatusApplyConfiguration              `json:"workerSecurityGroup,omitempty"`
 	BastionSecurityGroup      *SecurityGroupStatusApplyConfiguration              `json:"bastionSecurityGroup,omitempty"`
+	AddressGroups             []AddressGroupStatusApplyConfiguration              `json:"addressGroups,omitempty"`
 	Bastion                   *BastionStatusApplyConfiguration                    `json:"bastion,omitempty"`
+	Servers                   []StandaloneServerStatusApplyConfiguration          `json:"servers,omitempty"`
 	FailureReason             *errors.DeprecatedCAPIClusterStatusError            `json:"failureReason,omitempty"`
 	FailureMessage            *string                                             `json:"failureMessage,omitempty"`
 	Conditions                *corev1beta1.Conditions                             `json:"conditions,omitempty"`
@@ -129,6 +131,19 @@ func (b *OpenStackClusterStatusApplyConfiguration) WithBastionSecurityGroup(valu
 	return b
 }
 
+// WithAddressGroups adds the given value to the AddressGroups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AddressGroups field.
+func (b *OpenStackClusterStatusApplyConfiguration) WithAddressGroups(values ...*AddressGroupStatusApplyConfiguration) *OpenStackClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAddressGroups")
+		}
+		b.AddressGroups = append(b.AddressGroups, *values[i])
+	}
+	return b
+}
+
 // WithBastion sets the Bastion field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Bastion field is set to the value of the last call.
@@ -137,6 +152,19 @@ func (b *OpenStackClusterStatusApplyConfiguration) WithBastion(value *BastionSta
 	return b
 }
 
+// WithServers adds the given value to the Servers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Servers field.
+func (b *OpenStackClusterStatusApplyConfiguration) WithServers(values ...*StandaloneServerStatusApplyConfiguration) *OpenStackClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithServers")
+		}
+		b.Servers = append(b.Servers, *values[i])
+	}
+	return b
+}
+
 // WithFailureReason sets the FailureReason field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the FailureReason field is set to the value of the last call.
@@ -28,6 +28,8 @@ type OpenStackMachineSpecApplyConfiguration struct {
 	ProviderID                        *string                                             `json:"providerID,omitempty"`
 	Flavor                            *string                                             `json:"flavor,omitempty"`
 	FlavorID                          *string                                             `json:"flavorID,omitempty"`
+	FlavorSelector                    *FlavorSelectorApplyConfiguration                   `json:"flavorSelector,omitempty"`
+	Accelerators                      *AcceleratorRequirementsApplyConfiguration          `json:"accelerators,omitempty"`
 	Image                             *ImageParamApplyConfiguration                       `json:"image,omitempty"`
 	SSHKeyName                        *string                                             `json:"sshKeyName,omitempty"`
 	Ports                             []PortOptsApplyConfiguration                        `json:"ports,omitempty"`
@@ -38,6 +40,8 @@ type OpenStackMachineSpecApplyConfiguration struct {
 	ConfigDrive                       *bool                                               `json:"configDrive,omitempty"`
 	RootVolume                        *RootVolumeApplyConfiguration                       `json:"rootVolume,omitempty"`
 	AdditionalBlockDevices            []AdditionalBlockDeviceApplyConfiguration           `json:"additionalBlockDevices,omitempty"`
+	HotAttachVolumes                  *bool                                               `json:"hotAttachVolumes,omitempty"`
+	AvailabilityZoneFallback          []string                                            `json:"availabilityZoneFallback,omitempty"`
 	ServerGroup                       *ServerGroupParamApplyConfiguration                 `json:"serverGroup,omitempty"`
 	IdentityRef                       *OpenStackIdentityReferenceApplyConfiguration       `json:"identityRef,omitempty"`
 	FloatingIPPoolRef                 *v1.TypedLocalObjectReference                       `json:"floatingIPPoolRef,omitempty"`
@@ -75,6 +79,22 @@ func (b *OpenStackMachineSpecApplyConfiguration) WithFlavorID(value string) *Ope
 	return b
 }
 
+// WithFlavorSelector sets the FlavorSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlavorSelector field is set to the value of the last call.
+func (b *OpenStackMachineSpecApplyConfiguration) WithFlavorSelector(value *FlavorSelectorApplyConfiguration) *OpenStackMachineSpecApplyConfiguration {
+	b.FlavorSelector = value
+	return b
+}
+
+// WithAccelerators sets the Accelerators field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Accelerators field is set to the value of the last call.
+func (b *OpenStackMachineSpecApplyConfiguration) WithAccelerators(value *AcceleratorRequirementsApplyConfiguration) *OpenStackMachineSpecApplyConfiguration {
+	b.Accelerators = value
+	return b
+}
+
 // WithImage sets the Image field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Image field is set to the value of the last call.
@@ -177,6 +197,24 @@ func (b *OpenStackMachineSpecApplyConfiguration) WithAdditionalBlockDevices(valu
 	return b
 }
 
+// WithHotAttachVolumes sets the HotAttachVolumes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HotAttachVolumes field is set to the value of the last call.
+func (b *OpenStackMachineSpecApplyConfiguration) WithHotAttachVolumes(value bool) *OpenStackMachineSpecApplyConfiguration {
+	b.HotAttachVolumes = &value
+	return b
+}
+
+// WithAvailabilityZoneFallback adds the given value to the AvailabilityZoneFallback field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AvailabilityZoneFallback field.
+func (b *OpenStackMachineSpecApplyConfiguration) WithAvailabilityZoneFallback(values ...string) *OpenStackMachineSpecApplyConfiguration {
+	for i := range values {
+		b.AvailabilityZoneFallback = append(b.AvailabilityZoneFallback, values[i])
+	}
+	return b
+}
+
 // WithServerGroup sets the ServerGroup field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServerGroup field is set to the value of the last call.
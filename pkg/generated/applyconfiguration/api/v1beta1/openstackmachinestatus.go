@@ -28,17 +28,18 @@ import (
 // OpenStackMachineStatusApplyConfiguration represents a declarative configuration of the OpenStackMachineStatus type for use
 // with apply.
 type OpenStackMachineStatusApplyConfiguration struct {
-	Ready          *bool                                        `json:"ready,omitempty"`
-	Initialization *MachineInitializationApplyConfiguration     `json:"initialization,omitempty"`
-	InstanceID     *string                                      `json:"instanceID,omitempty"`
-	Addresses      []v1.NodeAddress                             `json:"addresses,omitempty"`
-	InstanceState  *apiv1beta1.InstanceState                    `json:"instanceState,omitempty"`
-	Resolved       *ResolvedMachineSpecApplyConfiguration       `json:"resolved,omitempty"`
-	Resources      *MachineResourcesApplyConfiguration          `json:"resources,omitempty"`
-	FailureReason  *errors.DeprecatedCAPIMachineStatusError     `json:"failureReason,omitempty"`
-	FailureMessage *string                                      `json:"failureMessage,omitempty"`
-	Conditions     *corev1beta1.Conditions                      `json:"conditions,omitempty"`
-	Extensions     *apiv1beta1.OpenStackMachineExtensionsStatus `json:"extensions,omitempty"`
+	Ready                    *bool                                        `json:"ready,omitempty"`
+	Initialization           *MachineInitializationApplyConfiguration     `json:"initialization,omitempty"`
+	InstanceID               *string                                      `json:"instanceID,omitempty"`
+	Addresses                []v1.NodeAddress                             `json:"addresses,omitempty"`
+	InstanceState            *apiv1beta1.InstanceState                    `json:"instanceState,omitempty"`
+	Resolved                 *ResolvedMachineSpecApplyConfiguration       `json:"resolved,omitempty"`
+	Resources                *MachineResourcesApplyConfiguration          `json:"resources,omitempty"`
+	FailureReason            *errors.DeprecatedCAPIMachineStatusError     `json:"failureReason,omitempty"`
+	FailureMessage           *string                                      `json:"failureMessage,omitempty"`
+	AvailabilityZoneAttempts []AvailabilityZoneAttemptApplyConfiguration  `json:"availabilityZoneAttempts,omitempty"`
+	Conditions               *corev1beta1.Conditions                      `json:"conditions,omitempty"`
+	Extensions               *apiv1beta1.OpenStackMachineExtensionsStatus `json:"extensions,omitempty"`
 }
 
 // OpenStackMachineStatusApplyConfiguration constructs a declarative configuration of the OpenStackMachineStatus type for use with
@@ -121,6 +122,19 @@ func (b *OpenStackMachineStatusApplyConfiguration) WithFailureMessage(value stri
 	return b
 }
 
+// WithAvailabilityZoneAttempts adds the given value to the AvailabilityZoneAttempts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AvailabilityZoneAttempts field.
+func (b *OpenStackMachineStatusApplyConfiguration) WithAvailabilityZoneAttempts(values ...*AvailabilityZoneAttemptApplyConfiguration) *OpenStackMachineStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAvailabilityZoneAttempts")
+		}
+		b.AvailabilityZoneAttempts = append(b.AvailabilityZoneAttempts, *values[i])
+	}
+	return b
+}
+
 // WithConditions sets the Conditions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Conditions field is set to the value of the last call.
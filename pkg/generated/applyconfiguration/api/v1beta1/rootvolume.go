@@ -54,3 +54,48 @@ func (b *RootVolumeApplyConfiguration) WithAvailabilityZone(value *VolumeAvailab
 	b.BlockDeviceVolumeApplyConfiguration.AvailabilityZone = value
 	return b
 }
+
+// WithSnapshotRef sets the SnapshotRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotRef field is set to the value of the last call.
+func (b *RootVolumeApplyConfiguration) WithSnapshotRef(value *VolumeSnapshotParamApplyConfiguration) *RootVolumeApplyConfiguration {
+	b.BlockDeviceVolumeApplyConfiguration.SnapshotRef = value
+	return b
+}
+
+// WithVolumeRef sets the VolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeRef field is set to the value of the last call.
+func (b *RootVolumeApplyConfiguration) WithVolumeRef(value *VolumeParamApplyConfiguration) *RootVolumeApplyConfiguration {
+	b.BlockDeviceVolumeApplyConfiguration.VolumeRef = value
+	return b
+}
+
+// WithMetadata adds the given value to the Metadata field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Metadata field.
+func (b *RootVolumeApplyConfiguration) WithMetadata(values ...*VolumeMetadataApplyConfiguration) *RootVolumeApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMetadata")
+		}
+		b.BlockDeviceVolumeApplyConfiguration.Metadata = append(b.BlockDeviceVolumeApplyConfiguration.Metadata, *values[i])
+	}
+	return b
+}
+
+// WithSchedulerHints sets the SchedulerHints field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SchedulerHints field is set to the value of the last call.
+func (b *RootVolumeApplyConfiguration) WithSchedulerHints(value *VolumeSchedulerHintsApplyConfiguration) *RootVolumeApplyConfiguration {
+	b.BlockDeviceVolumeApplyConfiguration.SchedulerHints = value
+	return b
+}
+
+// WithMultiattach sets the Multiattach field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Multiattach field is set to the value of the last call.
+func (b *RootVolumeApplyConfiguration) WithMultiattach(value bool) *RootVolumeApplyConfiguration {
+	b.BlockDeviceVolumeApplyConfiguration.Multiattach = &value
+	return b
+}
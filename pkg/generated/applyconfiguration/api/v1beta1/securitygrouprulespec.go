@@ -35,6 +35,7 @@ type SecurityGroupRuleSpecApplyConfiguration struct {
 	RemoteGroupID       *string                               `json:"remoteGroupID,omitempty"`
 	RemoteIPPrefix      *string                               `json:"remoteIPPrefix,omitempty"`
 	RemoteManagedGroups []apiv1beta1.ManagedSecurityGroupName `json:"remoteManagedGroups,omitempty"`
+	RemoteAddressGroup  *AddressGroupParamApplyConfiguration  `json:"remoteAddressGroup,omitempty"`
 }
 
 // SecurityGroupRuleSpecApplyConfiguration constructs a declarative configuration of the SecurityGroupRuleSpec type for use with
@@ -124,3 +125,11 @@ func (b *SecurityGroupRuleSpecApplyConfiguration) WithRemoteManagedGroups(values
 	}
 	return b
 }
+
+// WithRemoteAddressGroup sets the RemoteAddressGroup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteAddressGroup field is set to the value of the last call.
+func (b *SecurityGroupRuleSpecApplyConfiguration) WithRemoteAddressGroup(value *AddressGroupParamApplyConfiguration) *SecurityGroupRuleSpecApplyConfiguration {
+	b.RemoteAddressGroup = value
+	return b
+}
@@ -21,8 +21,9 @@ package v1beta1
 // ServerGroupParamApplyConfiguration represents a declarative configuration of the ServerGroupParam type for use
 // with apply.
 type ServerGroupParamApplyConfiguration struct {
-	ID     *string                              `json:"id,omitempty"`
-	Filter *ServerGroupFilterApplyConfiguration `json:"filter,omitempty"`
+	ID             *string                              `json:"id,omitempty"`
+	Filter         *ServerGroupFilterApplyConfiguration `json:"filter,omitempty"`
+	ServerGroupRef *ResourceReferenceApplyConfiguration `json:"serverGroupRef,omitempty"`
 }
 
 // ServerGroupParamApplyConfiguration constructs a declarative configuration of the ServerGroupParam type for use with
@@ -46,3 +47,11 @@ func (b *ServerGroupParamApplyConfiguration) WithFilter(value *ServerGroupFilter
 	b.Filter = value
 	return b
 }
+
+// WithServerGroupRef sets the ServerGroupRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerGroupRef field is set to the value of the last call.
+func (b *ServerGroupParamApplyConfiguration) WithServerGroupRef(value *ResourceReferenceApplyConfiguration) *ServerGroupParamApplyConfiguration {
+	b.ServerGroupRef = value
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	apiv1beta1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+// StandaloneServerStatusApplyConfiguration represents a declarative configuration of the StandaloneServerStatus type for use
+// with apply.
+type StandaloneServerStatusApplyConfiguration struct {
+	Name  *string                   `json:"name,omitempty"`
+	ID    *string                   `json:"id,omitempty"`
+	State *apiv1beta1.InstanceState `json:"state,omitempty"`
+	IP    *string                   `json:"ip,omitempty"`
+	Ready *bool                     `json:"ready,omitempty"`
+}
+
+// StandaloneServerStatusApplyConfiguration constructs a declarative configuration of the StandaloneServerStatus type for use with
+// apply.
+func StandaloneServerStatus() *StandaloneServerStatusApplyConfiguration {
+	return &StandaloneServerStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *StandaloneServerStatusApplyConfiguration) WithName(value string) *StandaloneServerStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *StandaloneServerStatusApplyConfiguration) WithID(value string) *StandaloneServerStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *StandaloneServerStatusApplyConfiguration) WithState(value apiv1beta1.InstanceState) *StandaloneServerStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *StandaloneServerStatusApplyConfiguration) WithIP(value string) *StandaloneServerStatusApplyConfiguration {
+	b.IP = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *StandaloneServerStatusApplyConfiguration) WithReady(value bool) *StandaloneServerStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// VolumeSchedulerHintsApplyConfiguration represents a declarative configuration of the VolumeSchedulerHints type for use
+// with apply.
+type VolumeSchedulerHintsApplyConfiguration struct {
+	SameHost      []VolumeParamApplyConfiguration `json:"sameHost,omitempty"`
+	DifferentHost []VolumeParamApplyConfiguration `json:"differentHost,omitempty"`
+}
+
+// VolumeSchedulerHintsApplyConfiguration constructs a declarative configuration of the VolumeSchedulerHints type for use with
+// apply.
+func VolumeSchedulerHints() *VolumeSchedulerHintsApplyConfiguration {
+	return &VolumeSchedulerHintsApplyConfiguration{}
+}
+
+// WithSameHost adds the given value to the SameHost field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SameHost field.
+func (b *VolumeSchedulerHintsApplyConfiguration) WithSameHost(values ...*VolumeParamApplyConfiguration) *VolumeSchedulerHintsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSameHost")
+		}
+		b.SameHost = append(b.SameHost, *values[i])
+	}
+	return b
+}
+
+// WithDifferentHost adds the given value to the DifferentHost field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DifferentHost field.
+func (b *VolumeSchedulerHintsApplyConfiguration) WithDifferentHost(values ...*VolumeParamApplyConfiguration) *VolumeSchedulerHintsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDifferentHost")
+		}
+		b.DifferentHost = append(b.DifferentHost, *values[i])
+	}
+	return b
+}
@@ -75,6 +75,8 @@ var schemaYAML = typed.YAMLObject(`types:
     elementRelationship: atomic
 - name: io.k8s.apimachinery.pkg.api.resource.Quantity
   scalar: untyped
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+  scalar: string
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
   map:
     elementType:
@@ -234,6 +236,26 @@ var schemaYAML = typed.YAMLObject(`types:
     elementRelationship: atomic
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.Time
   scalar: untyped
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ImageChecksum
+  map:
+    fields:
+    - name: algorithm
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ImageSource
+  map:
+    fields:
+    - name: oci
+      type:
+        scalar: string
+    - name: url
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentity
   map:
     fields:
@@ -272,6 +294,71 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackImage
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackImageSpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackImageStatus
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackImageSpec
+  map:
+    fields:
+    - name: checksum
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ImageChecksum
+      default: {}
+    - name: containerFormat
+      type:
+        scalar: string
+    - name: diskFormat
+      type:
+        scalar: string
+      default: ""
+    - name: identityRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackIdentityReference
+      default: {}
+    - name: importMethod
+      type:
+        scalar: string
+    - name: source
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ImageSource
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackImageStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api.api.core.v1beta1.Condition
+          elementRelationship: atomic
+    - name: id
+      type:
+        scalar: string
+    - name: importMethod
+      type:
+        scalar: string
+    - name: ready
+      type:
+        scalar: boolean
+      default: false
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServer
   map:
     fields:
@@ -293,9 +380,69 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerStatus
       default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerGroup
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerGroupSpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerGroupStatus
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerGroupSpec
+  map:
+    fields:
+    - name: identityRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackIdentityReference
+      default: {}
+    - name: maxServerPerHost
+      type:
+        scalar: numeric
+    - name: policy
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerGroupStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api.api.core.v1beta1.Condition
+          elementRelationship: atomic
+    - name: id
+      type:
+        scalar: string
+    - name: members
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: ready
+      type:
+        scalar: boolean
+      default: false
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServerSpec
   map:
     fields:
+    - name: accelerators
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AcceleratorRequirements
     - name: additionalBlockDevices
       type:
         list:
@@ -307,6 +454,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: availabilityZone
       type:
         scalar: string
+    - name: availabilityZoneFallback
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: configDrive
       type:
         scalar: boolean
@@ -316,9 +469,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: flavorID
       type:
         scalar: string
+    - name: flavorSelector
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FlavorSelector
     - name: floatingIPPoolRef
       type:
         namedType: io.k8s.api.core.v1.TypedLocalObjectReference
+    - name: hotAttachVolumes
+      type:
+        scalar: boolean
     - name: identityRef
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackIdentityReference
@@ -327,12 +486,24 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageParam
       default: {}
+    - name: inPlaceRebuild
+      type:
+        scalar: boolean
+    - name: inPlaceResize
+      type:
+        scalar: boolean
     - name: ports
       type:
         list:
           elementType:
             namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PortOpts
           elementRelationship: atomic
+    - name: remediation
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.InstanceRemediation
+    - name: requireEncryptedVolumes
+      type:
+        scalar: boolean
     - name: rootVolume
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.RootVolume
@@ -386,6 +557,15 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.api.core.v1.NodeAddress
           elementRelationship: atomic
+    - name: availabilityZone
+      type:
+        scalar: string
+    - name: availabilityZoneAttempts
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AvailabilityZoneAttempt
+          elementRelationship: atomic
     - name: conditions
       type:
         list:
@@ -402,12 +582,93 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: boolean
       default: false
+    - name: rebuild
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerRebuildStatus
+    - name: remediation
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerRemediationStatus
+    - name: resize
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerResizeStatus
     - name: resolved
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ResolvedServerSpec
     - name: resources
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerResources
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackVolumeSnapshotSchedule
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackVolumeSnapshotScheduleSpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackVolumeSnapshotScheduleStatus
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackVolumeSnapshotScheduleSpec
+  map:
+    fields:
+    - name: identityRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackIdentityReference
+      default: {}
+    - name: incremental
+      type:
+        scalar: boolean
+    - name: interval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: retention
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.VolumeSnapshotRetention
+      default: {}
+    - name: serverRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: suspend
+      type:
+        scalar: boolean
+    - name: type
+      type:
+        scalar: string
+    - name: volumes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackVolumeSnapshotScheduleStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api.api.core.v1beta1.Condition
+          elementRelationship: atomic
+    - name: lastScheduleTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: snapshots
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.VolumeSnapshotStatus
+          elementRelationship: atomic
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ResolvedServerSpec
   map:
     fields:
@@ -426,75 +687,259 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: serverGroupID
       type:
         scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerResources
-  map:
-    fields:
-    - name: ports
+    - name: volumes
       type:
         list:
           elementType:
-            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PortStatus
-          elementRelationship: atomic
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.APIServerLoadBalancer
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ResolvedVolumeSpec
+          elementRelationship: associative
+          keys:
+          - name
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ResolvedVolumeSpec
   map:
     fields:
-    - name: additionalPorts
+    - name: differentHostVolumeIDs
       type:
         list:
           elementType:
-            scalar: numeric
-          elementRelationship: associative
-    - name: allowedCIDRs
+            scalar: string
+          elementRelationship: atomic
+    - name: multiattach
+      type:
+        scalar: boolean
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: sameHostVolumeIDs
       type:
         list:
           elementType:
             scalar: string
-          elementRelationship: associative
-    - name: availabilityZone
+          elementRelationship: atomic
+    - name: snapshotID
       type:
         scalar: string
-    - name: enabled
-      type:
-        scalar: boolean
-    - name: flavor
+    - name: volumeID
       type:
         scalar: string
-    - name: monitor
+    - name: volumeTypeID
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.APIServerLoadBalancerMonitor
-    - name: network
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerRebuildStatus
+  map:
+    fields:
+    - name: failed
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.NetworkParam
-    - name: provider
+        scalar: boolean
+    - name: imageID
       type:
         scalar: string
-    - name: subnets
-      type:
-        list:
-          elementType:
-            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SubnetParam
-          elementRelationship: atomic
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.APIServerLoadBalancerMonitor
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerRemediationStatus
   map:
     fields:
-    - name: delay
+    - name: attempts
       type:
         scalar: numeric
-    - name: maxRetries
+    - name: errorReboots
       type:
         scalar: numeric
-    - name: maxRetriesDown
+    - name: lastAction
       type:
-        scalar: numeric
-    - name: timeout
+        scalar: string
+    - name: lastActionTime
       type:
-        scalar: numeric
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AdditionalBlockDevice
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerResizeStatus
   map:
     fields:
-    - name: name
+    - name: failed
       type:
-        scalar: string
+        scalar: boolean
+    - name: flavorID
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerResources
+  map:
+    fields:
+    - name: ports
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PortStatus
+          elementRelationship: atomic
+    - name: volumes
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerVolumeStatus
+          elementRelationship: associative
+          keys:
+          - name
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerVolumeStatus
+  map:
+    fields:
+    - name: created
+      type:
+        scalar: boolean
+    - name: device
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: state
+      type:
+        scalar: string
+      default: ""
+    - name: volumeID
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.VolumeSnapshotRetention
+  map:
+    fields:
+    - name: maxAge
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxCount
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.VolumeSnapshotStatus
+  map:
+    fields:
+    - name: createdAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: id
+      type:
+        scalar: string
+      default: ""
+    - name: incremental
+      type:
+        scalar: boolean
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: status
+      type:
+        scalar: string
+    - name: volume
+      type:
+        scalar: string
+      default: ""
+    - name: volumeID
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.APIServerLoadBalancer
+  map:
+    fields:
+    - name: additionalPorts
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: associative
+    - name: allowedCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: availabilityZone
+      type:
+        scalar: string
+    - name: enabled
+      type:
+        scalar: boolean
+    - name: flavor
+      type:
+        scalar: string
+    - name: listeners
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerListener
+          elementRelationship: associative
+          keys:
+          - name
+    - name: loadBalancerRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerParam
+    - name: memberDrainMode
+      type:
+        scalar: string
+    - name: memberDrainTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: monitor
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.APIServerLoadBalancerMonitor
+    - name: network
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.NetworkParam
+    - name: pools
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerPool
+          elementRelationship: associative
+          keys:
+          - name
+    - name: provider
+      type:
+        scalar: string
+    - name: subnets
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SubnetParam
+          elementRelationship: atomic
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.APIServerLoadBalancerMonitor
+  map:
+    fields:
+    - name: delay
+      type:
+        scalar: numeric
+    - name: maxRetries
+      type:
+        scalar: numeric
+    - name: maxRetriesDown
+      type:
+        scalar: numeric
+    - name: timeout
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AcceleratorRequirements
+  map:
+    fields:
+    - name: pciAliases
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PCIAliasRequirement
+          elementRelationship: associative
+          keys:
+          - name
+    - name: resources
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PlacementResourceRequirement
+          elementRelationship: associative
+          keys:
+          - resourceClass
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AdditionalBlockDevice
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
       default: ""
     - name: sizeGiB
       type:
@@ -504,6 +949,44 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.BlockDeviceStorage
       default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressGroupFilter
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: projectID
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressGroupParam
+  map:
+    fields:
+    - name: addresses
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressGroupFilter
+    - name: id
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressGroupStatus
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressPair
   map:
     fields:
@@ -525,6 +1008,19 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AvailabilityZoneAttempt
+  map:
+    fields:
+    - name: message
+      type:
+        scalar: string
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: zone
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.Bastion
   map:
     fields:
@@ -597,9 +1093,29 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: availabilityZone
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeAvailabilityZone
+    - name: metadata
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeMetadata
+          elementRelationship: associative
+          keys:
+          - key
+    - name: multiattach
+      type:
+        scalar: boolean
+    - name: schedulerHints
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSchedulerHints
+    - name: snapshotRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSnapshotParam
     - name: type
       type:
         scalar: string
+    - name: volumeRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeParam
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.CiliumNetworkingStatus
   map:
     fields:
@@ -639,6 +1155,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: provisioned
       type:
         scalar: boolean
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterLoadBalancersExtensionsSpec
+  map:
+    fields:
+    - name: ingress
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.IngressLoadBalancerExtensionsSpec
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterLoadBalancersExtensionsStatus
   map:
     fields:
@@ -706,122 +1228,387 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: neutron
       type:
         scalar: string
-    - name: nova
+    - name: nova
+      type:
+        scalar: string
+    - name: project
+      type:
+        scalar: string
+    - name: projectDomain
+      type:
+        scalar: string
+    - name: region
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformExtensionsStatus
+  map:
+    fields:
+    - name: management
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformManagementStatus
+    - name: ntp
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformNTPStatus
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformManagementStatus
+  map:
+    fields:
+    - name: vip
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformNTPStatus
+  map:
+    fields:
+    - name: server
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterVIPStatus
+  map:
+    fields:
+    - name: floatingIP
+      type:
+        scalar: string
+    - name: vip
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ConsoleLogCapture
+  map:
+    fields:
+    - name: lines
+      type:
+        scalar: numeric
+    - name: nodeTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ExternalRouterIPParam
+  map:
+    fields:
+    - name: fixedIP
+      type:
+        scalar: string
+    - name: subnet
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SubnetParam
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FixedIP
+  map:
+    fields:
+    - name: ipAddress
+      type:
+        scalar: string
+    - name: subnet
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SubnetParam
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FlavorExtraSpec
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FlavorSelector
+  map:
+    fields:
+    - name: extraSpecs
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FlavorExtraSpec
+          elementRelationship: associative
+          keys:
+          - key
+    - name: maxRAMMiB
+      type:
+        scalar: numeric
+    - name: minDiskGiB
+      type:
+        scalar: numeric
+    - name: minRAMMiB
+      type:
+        scalar: numeric
+    - name: minVCPUs
+      type:
+        scalar: numeric
+    - name: preference
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageFilter
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageParam
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageFilter
+    - name: id
+      type:
+        scalar: string
+    - name: imageRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ResourceReference
+    - name: openStackImageRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ResourceReference
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.IngressLoadBalancerExtensionsSpec
+  map:
+    fields:
+    - name: mode
+      type:
+        scalar: string
+    - name: ports
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: associative
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.InstanceRemediation
+  map:
+    fields:
+    - name: backoff
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxErrorReboots
+      type:
+        scalar: numeric
+    - name: startShutoff
+      type:
+        scalar: boolean
+    - name: unpause
+      type:
+        scalar: boolean
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancer
+  map:
+    fields:
+    - name: adopted
+      type:
+        scalar: boolean
+    - name: allowedCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: id
+      type:
+        scalar: string
+      default: ""
+    - name: internalIP
+      type:
+        scalar: string
+      default: ""
+    - name: ip
+      type:
+        scalar: string
+      default: ""
+    - name: loadBalancerNetwork
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.NetworkStatusWithSubnets
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerFilter
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: notTags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: notTagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: projectID
+      type:
+        scalar: string
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: tagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: vipAddress
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerL7Policy
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: pool
+      type:
+        scalar: string
+      default: ""
+    - name: rules
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerL7Rule
+          elementRelationship: atomic
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerL7Rule
+  map:
+    fields:
+    - name: compareType
+      type:
+        scalar: string
+    - name: invert
+      type:
+        scalar: boolean
+    - name: type
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerListener
+  map:
+    fields:
+    - name: algorithm
+      type:
+        scalar: string
+    - name: defaultTLSContainerRef
       type:
         scalar: string
-    - name: project
+    - name: l7Policies
       type:
-        scalar: string
-    - name: projectDomain
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerL7Policy
+          elementRelationship: associative
+          keys:
+          - name
+    - name: memberPort
       type:
-        scalar: string
-    - name: region
+        scalar: numeric
+    - name: memberTLS
       type:
-        scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformExtensionsStatus
-  map:
-    fields:
-    - name: management
+        scalar: boolean
+    - name: members
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformManagementStatus
-    - name: ntp
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerMemberSelector
+      default: {}
+    - name: monitor
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformNTPStatus
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformManagementStatus
-  map:
-    fields:
-    - name: vip
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerListenerMonitor
+    - name: name
       type:
         scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterPlatformNTPStatus
-  map:
-    fields:
-    - name: server
+      default: ""
+    - name: port
+      type:
+        scalar: numeric
+      default: 0
+    - name: protocol
       type:
         scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterVIPStatus
-  map:
-    fields:
-    - name: vip
+    - name: tlsSecretName
       type:
         scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ExternalRouterIPParam
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerListenerMonitor
   map:
     fields:
-    - name: fixedIP
+    - name: delay
+      type:
+        scalar: numeric
+    - name: expectedCodes
       type:
         scalar: string
-    - name: subnet
+    - name: maxRetries
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SubnetParam
-      default: {}
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FixedIP
-  map:
-    fields:
-    - name: ipAddress
+        scalar: numeric
+    - name: maxRetriesDown
+      type:
+        scalar: numeric
+    - name: timeout
+      type:
+        scalar: numeric
+    - name: type
       type:
         scalar: string
-    - name: subnet
+    - name: urlPath
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SubnetParam
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageFilter
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerMemberSelector
   map:
     fields:
-    - name: name
+    - name: machineSelector
       type:
-        scalar: string
-    - name: tags
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: role
       type:
-        list:
-          elementType:
-            scalar: string
-          elementRelationship: associative
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageParam
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerParam
   map:
     fields:
     - name: filter
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ImageFilter
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerFilter
     - name: id
       type:
         scalar: string
-    - name: imageRef
-      type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ResourceReference
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancer
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerPool
   map:
     fields:
-    - name: allowedCIDRs
-      type:
-        list:
-          elementType:
-            scalar: string
-          elementRelationship: atomic
-    - name: id
+    - name: algorithm
       type:
         scalar: string
-      default: ""
-    - name: internalIP
+    - name: memberPort
       type:
-        scalar: string
-      default: ""
-    - name: ip
+        scalar: numeric
+      default: 0
+    - name: memberTLS
       type:
-        scalar: string
-      default: ""
-    - name: loadBalancerNetwork
+        scalar: boolean
+    - name: members
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.NetworkStatusWithSubnets
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerMemberSelector
+      default: {}
+    - name: monitor
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancerListenerMonitor
     - name: name
       type:
         scalar: string
       default: ""
-    - name: tags
-      type:
-        list:
-          elementType:
-            scalar: string
-          elementRelationship: atomic
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.MachineInitialization
   map:
     fields:
@@ -858,6 +1645,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PortStatus
           elementRelationship: atomic
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ManagedSecurityGroupSettings
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: stateful
+      type:
+        scalar: boolean
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ManagedSecurityGroups
   map:
     fields:
@@ -873,6 +1675,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: boolean
       default: false
+    - name: bastion
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ManagedSecurityGroupSettings
+    - name: controlPlane
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ManagedSecurityGroupSettings
     - name: controlPlaneNodesSecurityGroupRules
       type:
         list:
@@ -881,6 +1689,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+    - name: worker
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ManagedSecurityGroupSettings
     - name: workerNodesSecurityGroupRules
       type:
         list:
@@ -1004,6 +1815,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackClusterExtensionsSpec
   map:
     fields:
+    - name: loadBalancers
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterLoadBalancersExtensionsSpec
     - name: networkInterfaces
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ClusterNetworkInterfacesExtensionsSpec
@@ -1049,6 +1863,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: bastion
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.Bastion
+    - name: consoleLogCapture
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ConsoleLogCapture
     - name: controlPlaneAvailabilityZones
       type:
         list:
@@ -1086,6 +1903,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackIdentityReference
       default: {}
+    - name: instanceRemediation
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.InstanceRemediation
     - name: managedSecurityGroups
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ManagedSecurityGroups
@@ -1116,9 +1936,20 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: associative
+    - name: volumePolicy
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumePolicy
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackClusterStatus
   map:
     fields:
+    - name: addressGroups
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressGroupStatus
+          elementRelationship: associative
+          keys:
+          - name
     - name: apiServerLoadBalancer
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.LoadBalancer
@@ -1167,6 +1998,14 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: router
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.Router
+    - name: servers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.StandaloneServerStatus
+          elementRelationship: associative
+          keys:
+          - name
     - name: workerSecurityGroup
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.SecurityGroupStatus
@@ -1266,6 +2105,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackMachineSpec
   map:
     fields:
+    - name: accelerators
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AcceleratorRequirements
     - name: additionalBlockDevices
       type:
         list:
@@ -1274,6 +2116,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+    - name: availabilityZoneFallback
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: configDrive
       type:
         scalar: boolean
@@ -1286,9 +2134,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: flavorID
       type:
         scalar: string
+    - name: flavorSelector
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.FlavorSelector
     - name: floatingIPPoolRef
       type:
         namedType: io.k8s.api.core.v1.TypedLocalObjectReference
+    - name: hotAttachVolumes
+      type:
+        scalar: boolean
     - name: identityRef
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.OpenStackIdentityReference
@@ -1354,6 +2208,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.api.core.v1.NodeAddress
           elementRelationship: atomic
+    - name: availabilityZoneAttempts
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AvailabilityZoneAttempt
+          elementRelationship: atomic
     - name: conditions
       type:
         list:
@@ -1435,6 +2295,26 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.NodeInfo
       default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PCIAliasRequirement
+  map:
+    fields:
+    - name: count
+      type:
+        scalar: numeric
+    - name: name
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PlacementResourceRequirement
+  map:
+    fields:
+    - name: count
+      type:
+        scalar: numeric
+    - name: resourceClass
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.PortOpts
   map:
     fields:
@@ -1621,13 +2501,33 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: availabilityZone
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeAvailabilityZone
+    - name: metadata
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeMetadata
+          elementRelationship: associative
+          keys:
+          - key
+    - name: multiattach
+      type:
+        scalar: boolean
+    - name: schedulerHints
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSchedulerHints
     - name: sizeGiB
       type:
         scalar: numeric
       default: 0
+    - name: snapshotRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSnapshotParam
     - name: type
       type:
         scalar: string
+    - name: volumeRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeParam
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.Router
   map:
     fields:
@@ -1794,6 +2694,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: protocol
       type:
         scalar: string
+    - name: remoteAddressGroup
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.AddressGroupParam
     - name: remoteGroupID
       type:
         scalar: string
@@ -1832,6 +2735,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: id
       type:
         scalar: string
+    - name: serverGroupRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ResourceReference
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.ServerMetadata
   map:
     fields:
@@ -1843,6 +2749,25 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.StandaloneServerStatus
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+    - name: ip
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: ready
+      type:
+        scalar: boolean
+    - name: state
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.Subnet
   map:
     fields:
@@ -1967,6 +2892,71 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: name
       type:
         scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeFilter
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeMetadata
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeParam
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeFilter
+    - name: id
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumePolicy
+  map:
+    fields:
+    - name: requireEncryption
+      type:
+        scalar: boolean
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSchedulerHints
+  map:
+    fields:
+    - name: differentHost
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeParam
+          elementRelationship: atomic
+    - name: sameHost
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeParam
+          elementRelationship: atomic
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSnapshotFilter
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: volumeID
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSnapshotParam
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta1.VolumeSnapshotFilter
+    - name: id
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api.api.core.v1beta1.APIEndpoint
   map:
     fields:
@@ -34,26 +34,70 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=infrastructure.cluster.x-k8s.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("ImageChecksum"):
+		return &apiv1alpha1.ImageChecksumApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ImageSource"):
+		return &apiv1alpha1.ImageSourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackClusterIdentity"):
 		return &apiv1alpha1.OpenStackClusterIdentityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackClusterIdentitySpec"):
 		return &apiv1alpha1.OpenStackClusterIdentitySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCredentialSecretReference"):
 		return &apiv1alpha1.OpenStackCredentialSecretReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackImage"):
+		return &apiv1alpha1.OpenStackImageApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackImageSpec"):
+		return &apiv1alpha1.OpenStackImageSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackImageStatus"):
+		return &apiv1alpha1.OpenStackImageStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServer"):
 		return &apiv1alpha1.OpenStackServerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerGroup"):
+		return &apiv1alpha1.OpenStackServerGroupApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerGroupSpec"):
+		return &apiv1alpha1.OpenStackServerGroupSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerGroupStatus"):
+		return &apiv1alpha1.OpenStackServerGroupStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerSpec"):
 		return &apiv1alpha1.OpenStackServerSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerStatus"):
 		return &apiv1alpha1.OpenStackServerStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackVolumeSnapshotSchedule"):
+		return &apiv1alpha1.OpenStackVolumeSnapshotScheduleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackVolumeSnapshotScheduleSpec"):
+		return &apiv1alpha1.OpenStackVolumeSnapshotScheduleSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackVolumeSnapshotScheduleStatus"):
+		return &apiv1alpha1.OpenStackVolumeSnapshotScheduleStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResolvedServerSpec"):
 		return &apiv1alpha1.ResolvedServerSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResolvedVolumeSpec"):
+		return &apiv1alpha1.ResolvedVolumeSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServerRebuildStatus"):
+		return &apiv1alpha1.ServerRebuildStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServerRemediationStatus"):
+		return &apiv1alpha1.ServerRemediationStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServerResizeStatus"):
+		return &apiv1alpha1.ServerResizeStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServerResources"):
 		return &apiv1alpha1.ServerResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServerVolumeStatus"):
+		return &apiv1alpha1.ServerVolumeStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotRetention"):
+		return &apiv1alpha1.VolumeSnapshotRetentionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotStatus"):
+		return &apiv1alpha1.VolumeSnapshotStatusApplyConfiguration{}
 
 		// Group=infrastructure.cluster.x-k8s.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("AcceleratorRequirements"):
+		return &apiv1beta1.AcceleratorRequirementsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("AdditionalBlockDevice"):
 		return &apiv1beta1.AdditionalBlockDeviceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AddressGroupFilter"):
+		return &apiv1beta1.AddressGroupFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AddressGroupParam"):
+		return &apiv1beta1.AddressGroupParamApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AddressGroupStatus"):
+		return &apiv1beta1.AddressGroupStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("AddressPair"):
 		return &apiv1beta1.AddressPairApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("AllocationPool"):
@@ -62,6 +106,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.APIServerLoadBalancerApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("APIServerLoadBalancerMonitor"):
 		return &apiv1beta1.APIServerLoadBalancerMonitorApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AvailabilityZoneAttempt"):
+		return &apiv1beta1.AvailabilityZoneAttemptApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Bastion"):
 		return &apiv1beta1.BastionApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("BastionStatus"):
@@ -78,6 +124,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.ClusterEndpointsExtensionsStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterInitialization"):
 		return &apiv1beta1.ClusterInitializationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterLoadBalancersExtensionsSpec"):
+		return &apiv1beta1.ClusterLoadBalancersExtensionsSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterLoadBalancersExtensionsStatus"):
 		return &apiv1beta1.ClusterLoadBalancersExtensionsStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterNetworkingExtensionsSpec"):
@@ -98,18 +146,44 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.ClusterPlatformNTPStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterVIPStatus"):
 		return &apiv1beta1.ClusterVIPStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConsoleLogCapture"):
+		return &apiv1beta1.ConsoleLogCaptureApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ExternalRouterIPParam"):
 		return &apiv1beta1.ExternalRouterIPParamApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FilterByNeutronTags"):
 		return &apiv1beta1.FilterByNeutronTagsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FixedIP"):
 		return &apiv1beta1.FixedIPApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlavorExtraSpec"):
+		return &apiv1beta1.FlavorExtraSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlavorSelector"):
+		return &apiv1beta1.FlavorSelectorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ImageFilter"):
 		return &apiv1beta1.ImageFilterApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ImageParam"):
 		return &apiv1beta1.ImageParamApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("IngressLoadBalancerExtensionsSpec"):
+		return &apiv1beta1.IngressLoadBalancerExtensionsSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("InstanceRemediation"):
+		return &apiv1beta1.InstanceRemediationApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancer"):
 		return &apiv1beta1.LoadBalancerApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerFilter"):
+		return &apiv1beta1.LoadBalancerFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerL7Policy"):
+		return &apiv1beta1.LoadBalancerL7PolicyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerL7Rule"):
+		return &apiv1beta1.LoadBalancerL7RuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerListener"):
+		return &apiv1beta1.LoadBalancerListenerApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerListenerMonitor"):
+		return &apiv1beta1.LoadBalancerListenerMonitorApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerMemberSelector"):
+		return &apiv1beta1.LoadBalancerMemberSelectorApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerParam"):
+		return &apiv1beta1.LoadBalancerParamApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LoadBalancerPool"):
+		return &apiv1beta1.LoadBalancerPoolApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MachineInitialization"):
 		return &apiv1beta1.MachineInitializationApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MachineLoadBalancersSpec"):
@@ -122,6 +196,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.MachineResourcesApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ManagedSecurityGroups"):
 		return &apiv1beta1.ManagedSecurityGroupsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedSecurityGroupSettings"):
+		return &apiv1beta1.ManagedSecurityGroupSettingsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("NetworkFilter"):
 		return &apiv1beta1.NetworkFilterApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("NetworkParam"):
@@ -166,6 +242,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.OpenStackMachineTemplateSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("OpenStackMachineTemplateStatus"):
 		return &apiv1beta1.OpenStackMachineTemplateStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PCIAliasRequirement"):
+		return &apiv1beta1.PCIAliasRequirementApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PlacementResourceRequirement"):
+		return &apiv1beta1.PlacementResourceRequirementApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PortOpts"):
 		return &apiv1beta1.PortOptsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PortStatus"):
@@ -206,6 +286,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.ServerGroupParamApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ServerMetadata"):
 		return &apiv1beta1.ServerMetadataApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("StandaloneServerStatus"):
+		return &apiv1beta1.StandaloneServerStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Subnet"):
 		return &apiv1beta1.SubnetApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SubnetFilter"):
@@ -218,6 +300,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta1.ValueSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("VolumeAvailabilityZone"):
 		return &apiv1beta1.VolumeAvailabilityZoneApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeFilter"):
+		return &apiv1beta1.VolumeFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeMetadata"):
+		return &apiv1beta1.VolumeMetadataApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeParam"):
+		return &apiv1beta1.VolumeParamApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumePolicy"):
+		return &apiv1beta1.VolumePolicyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeSchedulerHints"):
+		return &apiv1beta1.VolumeSchedulerHintsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeSnapshotFilter"):
+		return &apiv1beta1.VolumeSnapshotFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VolumeSnapshotParam"):
+		return &apiv1beta1.VolumeSnapshotParamApplyConfiguration{}
 
 	}
 	return nil
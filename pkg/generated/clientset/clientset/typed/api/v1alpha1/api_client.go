@@ -29,7 +29,10 @@ import (
 type InfrastructureV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	OpenStackClusterIdentitiesGetter
+	OpenStackImagesGetter
 	OpenStackServersGetter
+	OpenStackServerGroupsGetter
+	OpenStackVolumeSnapshotSchedulesGetter
 }
 
 // InfrastructureV1alpha1Client is used to interact with features provided by the infrastructure.cluster.x-k8s.io group.
@@ -41,10 +44,22 @@ func (c *InfrastructureV1alpha1Client) OpenStackClusterIdentities(namespace stri
 	return newOpenStackClusterIdentities(c, namespace)
 }
 
+func (c *InfrastructureV1alpha1Client) OpenStackImages(namespace string) OpenStackImageInterface {
+	return newOpenStackImages(c, namespace)
+}
+
 func (c *InfrastructureV1alpha1Client) OpenStackServers(namespace string) OpenStackServerInterface {
 	return newOpenStackServers(c, namespace)
 }
 
+func (c *InfrastructureV1alpha1Client) OpenStackServerGroups(namespace string) OpenStackServerGroupInterface {
+	return newOpenStackServerGroups(c, namespace)
+}
+
+func (c *InfrastructureV1alpha1Client) OpenStackVolumeSnapshotSchedules(namespace string) OpenStackVolumeSnapshotScheduleInterface {
+	return newOpenStackVolumeSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new InfrastructureV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
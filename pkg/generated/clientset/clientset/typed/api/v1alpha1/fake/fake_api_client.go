@@ -32,10 +32,22 @@ func (c *FakeInfrastructureV1alpha1) OpenStackClusterIdentities(namespace string
 	return newFakeOpenStackClusterIdentities(c, namespace)
 }
 
+func (c *FakeInfrastructureV1alpha1) OpenStackImages(namespace string) v1alpha1.OpenStackImageInterface {
+	return newFakeOpenStackImages(c, namespace)
+}
+
 func (c *FakeInfrastructureV1alpha1) OpenStackServers(namespace string) v1alpha1.OpenStackServerInterface {
 	return newFakeOpenStackServers(c, namespace)
 }
 
+func (c *FakeInfrastructureV1alpha1) OpenStackServerGroups(namespace string) v1alpha1.OpenStackServerGroupInterface {
+	return newFakeOpenStackServerGroups(c, namespace)
+}
+
+func (c *FakeInfrastructureV1alpha1) OpenStackVolumeSnapshotSchedules(namespace string) v1alpha1.OpenStackVolumeSnapshotScheduleInterface {
+	return newFakeOpenStackVolumeSnapshotSchedules(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeInfrastructureV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1alpha1"
+	typedapiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/clientset/clientset/typed/api/v1alpha1"
+)
+
+// fakeOpenStackImages implements OpenStackImageInterface
+type fakeOpenStackImages struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.OpenStackImage, *v1alpha1.OpenStackImageList, *apiv1alpha1.OpenStackImageApplyConfiguration]
+	Fake *FakeInfrastructureV1alpha1
+}
+
+func newFakeOpenStackImages(fake *FakeInfrastructureV1alpha1, namespace string) typedapiv1alpha1.OpenStackImageInterface {
+	return &fakeOpenStackImages{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.OpenStackImage, *v1alpha1.OpenStackImageList, *apiv1alpha1.OpenStackImageApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("openstackimages"),
+			v1alpha1.SchemeGroupVersion.WithKind("OpenStackImage"),
+			func() *v1alpha1.OpenStackImage { return &v1alpha1.OpenStackImage{} },
+			func() *v1alpha1.OpenStackImageList { return &v1alpha1.OpenStackImageList{} },
+			func(dst, src *v1alpha1.OpenStackImageList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.OpenStackImageList) []*v1alpha1.OpenStackImage {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.OpenStackImageList, items []*v1alpha1.OpenStackImage) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1alpha1"
+	typedapiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/clientset/clientset/typed/api/v1alpha1"
+)
+
+// fakeOpenStackServerGroups implements OpenStackServerGroupInterface
+type fakeOpenStackServerGroups struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.OpenStackServerGroup, *v1alpha1.OpenStackServerGroupList, *apiv1alpha1.OpenStackServerGroupApplyConfiguration]
+	Fake *FakeInfrastructureV1alpha1
+}
+
+func newFakeOpenStackServerGroups(fake *FakeInfrastructureV1alpha1, namespace string) typedapiv1alpha1.OpenStackServerGroupInterface {
+	return &fakeOpenStackServerGroups{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.OpenStackServerGroup, *v1alpha1.OpenStackServerGroupList, *apiv1alpha1.OpenStackServerGroupApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("openstackservergroups"),
+			v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerGroup"),
+			func() *v1alpha1.OpenStackServerGroup { return &v1alpha1.OpenStackServerGroup{} },
+			func() *v1alpha1.OpenStackServerGroupList { return &v1alpha1.OpenStackServerGroupList{} },
+			func(dst, src *v1alpha1.OpenStackServerGroupList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.OpenStackServerGroupList) []*v1alpha1.OpenStackServerGroup {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.OpenStackServerGroupList, items []*v1alpha1.OpenStackServerGroup) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
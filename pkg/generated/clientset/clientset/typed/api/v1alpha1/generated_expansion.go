@@ -20,4 +20,10 @@ package v1alpha1
 
 type OpenStackClusterIdentityExpansion interface{}
 
+type OpenStackImageExpansion interface{}
+
 type OpenStackServerExpansion interface{}
+
+type OpenStackServerGroupExpansion interface{}
+
+type OpenStackVolumeSnapshotScheduleExpansion interface{}
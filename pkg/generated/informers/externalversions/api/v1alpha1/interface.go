@@ -26,8 +26,14 @@ import (
 type Interface interface {
 	// OpenStackClusterIdentities returns a OpenStackClusterIdentityInformer.
 	OpenStackClusterIdentities() OpenStackClusterIdentityInformer
+	// OpenStackImages returns a OpenStackImageInformer.
+	OpenStackImages() OpenStackImageInformer
 	// OpenStackServers returns a OpenStackServerInformer.
 	OpenStackServers() OpenStackServerInformer
+	// OpenStackServerGroups returns a OpenStackServerGroupInformer.
+	OpenStackServerGroups() OpenStackServerGroupInformer
+	// OpenStackVolumeSnapshotSchedules returns a OpenStackVolumeSnapshotScheduleInformer.
+	OpenStackVolumeSnapshotSchedules() OpenStackVolumeSnapshotScheduleInformer
 }
 
 type version struct {
@@ -46,7 +52,22 @@ func (v *version) OpenStackClusterIdentities() OpenStackClusterIdentityInformer
 	return &openStackClusterIdentityInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OpenStackImages returns a OpenStackImageInformer.
+func (v *version) OpenStackImages() OpenStackImageInformer {
+	return &openStackImageInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // OpenStackServers returns a OpenStackServerInformer.
 func (v *version) OpenStackServers() OpenStackServerInformer {
 	return &openStackServerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// OpenStackServerGroups returns a OpenStackServerGroupInformer.
+func (v *version) OpenStackServerGroups() OpenStackServerGroupInformer {
+	return &openStackServerGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// OpenStackVolumeSnapshotSchedules returns a OpenStackVolumeSnapshotScheduleInformer.
+func (v *version) OpenStackVolumeSnapshotSchedules() OpenStackVolumeSnapshotScheduleInformer {
+	return &openStackVolumeSnapshotScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
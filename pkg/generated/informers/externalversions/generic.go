@@ -56,8 +56,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=infrastructure.cluster.x-k8s.io, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("openstackclusteridentities"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Infrastructure().V1alpha1().OpenStackClusterIdentities().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("openstackimages"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Infrastructure().V1alpha1().OpenStackImages().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("openstackservers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Infrastructure().V1alpha1().OpenStackServers().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("openstackservergroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Infrastructure().V1alpha1().OpenStackServerGroups().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("openstackvolumesnapshotschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Infrastructure().V1alpha1().OpenStackVolumeSnapshotSchedules().Informer()}, nil
 
 		// Group=infrastructure.cluster.x-k8s.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("openstackclusters"):
@@ -26,6 +26,14 @@ type OpenStackClusterIdentityListerExpansion interface{}
 // OpenStackClusterIdentityNamespaceLister.
 type OpenStackClusterIdentityNamespaceListerExpansion interface{}
 
+// OpenStackImageListerExpansion allows custom methods to be added to
+// OpenStackImageLister.
+type OpenStackImageListerExpansion interface{}
+
+// OpenStackImageNamespaceListerExpansion allows custom methods to be added to
+// OpenStackImageNamespaceLister.
+type OpenStackImageNamespaceListerExpansion interface{}
+
 // OpenStackServerListerExpansion allows custom methods to be added to
 // OpenStackServerLister.
 type OpenStackServerListerExpansion interface{}
@@ -33,3 +41,19 @@ type OpenStackServerListerExpansion interface{}
 // OpenStackServerNamespaceListerExpansion allows custom methods to be added to
 // OpenStackServerNamespaceLister.
 type OpenStackServerNamespaceListerExpansion interface{}
+
+// OpenStackServerGroupListerExpansion allows custom methods to be added to
+// OpenStackServerGroupLister.
+type OpenStackServerGroupListerExpansion interface{}
+
+// OpenStackServerGroupNamespaceListerExpansion allows custom methods to be added to
+// OpenStackServerGroupNamespaceLister.
+type OpenStackServerGroupNamespaceListerExpansion interface{}
+
+// OpenStackVolumeSnapshotScheduleListerExpansion allows custom methods to be added to
+// OpenStackVolumeSnapshotScheduleLister.
+type OpenStackVolumeSnapshotScheduleListerExpansion interface{}
+
+// OpenStackVolumeSnapshotScheduleNamespaceListerExpansion allows custom methods to be added to
+// OpenStackVolumeSnapshotScheduleNamespaceLister.
+type OpenStackVolumeSnapshotScheduleNamespaceListerExpansion interface{}
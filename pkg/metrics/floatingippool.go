@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var floatingIPPoolLabels = []string{"namespace", "pool"}
+
+// FloatingIPPoolPrometheusMetrics are the metrics of the OpenStackFloatingIPPools.
+type FloatingIPPoolPrometheusMetrics struct {
+	ClaimedIPs         *prometheus.GaugeVec
+	AvailableIPs       *prometheus.GaugeVec
+	FailedIPs          *prometheus.GaugeVec
+	MaxIPs             *prometheus.GaugeVec
+	Allocations        *prometheus.CounterVec
+	Releases           *prometheus.CounterVec
+	AllocationDuration *prometheus.HistogramVec
+}
+
+var floatingIPPoolPrometheusMetrics = &FloatingIPPoolPrometheusMetrics{
+	ClaimedIPs: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_claimed_ips",
+			Help:      "Number of floating IPs of an OpenStackFloatingIPPool which are claimed",
+		}, floatingIPPoolLabels),
+	AvailableIPs: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_available_ips",
+			Help:      "Number of floating IPs of an OpenStackFloatingIPPool which are available",
+		}, floatingIPPoolLabels),
+	FailedIPs: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_failed_ips",
+			Help:      "Number of floating IPs of an OpenStackFloatingIPPool which failed to be allocated",
+		}, floatingIPPoolLabels),
+	MaxIPs: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_max_ips",
+			Help:      "Maximum number of floating IPs of an OpenStackFloatingIPPool, not set if there is no limit",
+		}, floatingIPPoolLabels),
+	Allocations: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_allocations_total",
+			Help:      "Total number of floating IPs allocated to claims by an OpenStackFloatingIPPool",
+		}, floatingIPPoolLabels),
+	Releases: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_releases_total",
+			Help:      "Total number of floating IPs released by claims of an OpenStackFloatingIPPool",
+		}, floatingIPPoolLabels),
+	AllocationDuration: prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "capo",
+			Name:      "floatingippool_allocation_duration_seconds",
+			Help:      "Latency from the creation of a claim to the allocation of its floating IP",
+			Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+		}, floatingIPPoolLabels),
+}
+
+var registerFloatingIPPoolPrometheusMetrics sync.Once
+
+func RegisterFloatingIPPoolPrometheusMetrics() {
+	registerFloatingIPPoolPrometheusMetrics.Do(func() {
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.ClaimedIPs)
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.AvailableIPs)
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.FailedIPs)
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.MaxIPs)
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.Allocations)
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.Releases)
+		metrics.Registry.MustRegister(floatingIPPoolPrometheusMetrics.AllocationDuration)
+	})
+}
+
+// ObserveFloatingIPPool records the number of claimed, available and failed
+// floating IPs of a pool, and its maximum number of floating IPs if any.
+func ObserveFloatingIPPool(namespace, pool string, claimed, available, failed int, maxIPs *int) {
+	floatingIPPoolPrometheusMetrics.ClaimedIPs.WithLabelValues(namespace, pool).Set(float64(claimed))
+	floatingIPPoolPrometheusMetrics.AvailableIPs.WithLabelValues(namespace, pool).Set(float64(available))
+	floatingIPPoolPrometheusMetrics.FailedIPs.WithLabelValues(namespace, pool).Set(float64(failed))
+	if maxIPs != nil {
+		floatingIPPoolPrometheusMetrics.MaxIPs.WithLabelValues(namespace, pool).Set(float64(*maxIPs))
+	} else {
+		floatingIPPoolPrometheusMetrics.MaxIPs.DeleteLabelValues(namespace, pool)
+	}
+}
+
+// ObserveFloatingIPAllocation counts the allocation of a floating IP to a
+// claim, and records the time since the claim was created.
+func ObserveFloatingIPAllocation(namespace, pool string, latency time.Duration) {
+	floatingIPPoolPrometheusMetrics.Allocations.WithLabelValues(namespace, pool).Inc()
+	floatingIPPoolPrometheusMetrics.AllocationDuration.WithLabelValues(namespace, pool).Observe(latency.Seconds())
+}
+
+// ObserveFloatingIPRelease counts the release of a floating IP by a claim.
+func ObserveFloatingIPRelease(namespace, pool string) {
+	floatingIPPoolPrometheusMetrics.Releases.WithLabelValues(namespace, pool).Inc()
+}
+
+// DeleteFloatingIPPoolMetrics removes the metrics of a deleted pool.
+func DeleteFloatingIPPoolMetrics(namespace, pool string) {
+	labels := prometheus.Labels{"namespace": namespace, "pool": pool}
+	floatingIPPoolPrometheusMetrics.ClaimedIPs.Delete(labels)
+	floatingIPPoolPrometheusMetrics.AvailableIPs.Delete(labels)
+	floatingIPPoolPrometheusMetrics.FailedIPs.Delete(labels)
+	floatingIPPoolPrometheusMetrics.MaxIPs.Delete(labels)
+	floatingIPPoolPrometheusMetrics.Allocations.Delete(labels)
+	floatingIPPoolPrometheusMetrics.Releases.Delete(labels)
+	floatingIPPoolPrometheusMetrics.AllocationDuration.Delete(labels)
+}
@@ -34,11 +34,12 @@ import (
 // MockScopeFactory implements both the ScopeFactory and ClientScope interfaces. It can be used in place of the default ProviderScopeFactory
 // when we want to use mocked service clients which do not attempt to connect to a running OpenStack cloud.
 type MockScopeFactory struct {
-	ComputeClient *mock.MockComputeClient
-	NetworkClient *mock.MockNetworkClient
-	VolumeClient  *mock.MockVolumeClient
-	ImageClient   *mock.MockImageClient
-	LbClient      *mock.MockLbClient
+	ComputeClient    *mock.MockComputeClient
+	NetworkClient    *mock.MockNetworkClient
+	VolumeClient     *mock.MockVolumeClient
+	ImageClient      *mock.MockImageClient
+	LbClient         *mock.MockLbClient
+	KeyManagerClient *mock.MockKeyManagerClient
 
 	projectID              string
 	clientScopeCreateError error
@@ -51,14 +52,16 @@ func NewMockScopeFactory(mockCtrl *gomock.Controller, projectID string) *MockSco
 	imageClient := mock.NewMockImageClient(mockCtrl)
 	networkClient := mock.NewMockNetworkClient(mockCtrl)
 	lbClient := mock.NewMockLbClient(mockCtrl)
+	keyManagerClient := mock.NewMockKeyManagerClient(mockCtrl)
 
 	return &MockScopeFactory{
-		ComputeClient: computeClient,
-		VolumeClient:  volumeClient,
-		ImageClient:   imageClient,
-		NetworkClient: networkClient,
-		LbClient:      lbClient,
-		projectID:     projectID,
+		ComputeClient:    computeClient,
+		VolumeClient:     volumeClient,
+		ImageClient:      imageClient,
+		NetworkClient:    networkClient,
+		LbClient:         lbClient,
+		KeyManagerClient: keyManagerClient,
+		projectID:        projectID,
 		serviceEndpoints: map[string]string{},
 	}
 }
@@ -101,6 +104,10 @@ func (f *MockScopeFactory) NewLbClient() (clients.LbClient, error) {
 	return f.LbClient, nil
 }
 
+func (f *MockScopeFactory) NewKeyManagerClient() (clients.KeyManagerClient, error) {
+	return f.KeyManagerClient, nil
+}
+
 func (f *MockScopeFactory) NewIdentityClient() (*gophercloud.ServiceClient, error) {
 	return nil, ErrIdentityClientUnavailable
 }
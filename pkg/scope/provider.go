@@ -216,6 +216,10 @@ func (s *providerScope) NewLbClient() (clients.LbClient, error) {
 	return clients.NewLbClient(s.providerClient, s.providerClientOpts)
 }
 
+func (s *providerScope) NewKeyManagerClient() (clients.KeyManagerClient, error) {
+	return clients.NewKeyManagerClient(s.providerClient, s.providerClientOpts)
+}
+
 func (s *providerScope) NewIdentityClient() (*gophercloud.ServiceClient, error) {
 	endpointOpts := gophercloud.EndpointOpts{
 		Region: s.providerClientOpts.RegionName,
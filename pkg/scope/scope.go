@@ -54,6 +54,7 @@ type Scope interface {
 	NewImageClient() (clients.ImageClient, error)
 	NewNetworkClient() (clients.NetworkClient, error)
 	NewLbClient() (clients.LbClient, error)
+	NewKeyManagerClient() (clients.KeyManagerClient, error)
 	NewIdentityClient() (*gophercloud.ServiceClient, error)
 	ServiceEndpoint(service string) (string, error)
 	ProjectID() string
@@ -18,7 +18,9 @@ package filterconvert
 
 import (
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/addressgroups"
 	securitygroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
@@ -41,6 +43,17 @@ func SecurityGroupFilterToListOpts(securityGroupFilter *infrav1.SecurityGroupFil
 	}
 }
 
+func AddressGroupFilterToListOpts(addressGroupFilter *infrav1.AddressGroupFilter) addressgroups.ListOpts {
+	if addressGroupFilter == nil {
+		return addressgroups.ListOpts{}
+	}
+	return addressgroups.ListOpts{
+		Name:        addressGroupFilter.Name,
+		Description: addressGroupFilter.Description,
+		ProjectID:   addressGroupFilter.ProjectID,
+	}
+}
+
 func SubnetFilterToListOpts(subnetFilter *infrav1.SubnetFilter) subnets.ListOpts {
 	if subnetFilter == nil {
 		return subnets.ListOpts{}
@@ -76,6 +89,36 @@ func NetworkFilterToListOpts(networkFilter *infrav1.NetworkFilter) networks.List
 	}
 }
 
+// LoadBalancerFilterToListOpts converts a LoadBalancerFilter to Octavia list
+// options. Unlike Neutron, Octavia takes tags as a list rather than a
+// comma-separated string.
+func LoadBalancerFilterToListOpts(loadBalancerFilter *infrav1.LoadBalancerFilter) loadbalancers.ListOpts {
+	if loadBalancerFilter == nil {
+		return loadbalancers.ListOpts{}
+	}
+	return loadbalancers.ListOpts{
+		Name:        loadBalancerFilter.Name,
+		Description: loadBalancerFilter.Description,
+		ProjectID:   loadBalancerFilter.ProjectID,
+		VipAddress:  loadBalancerFilter.VIPAddress,
+		Tags:        neutronTagsToStrings(loadBalancerFilter.Tags),
+		TagsAny:     neutronTagsToStrings(loadBalancerFilter.TagsAny),
+		TagsNot:     neutronTagsToStrings(loadBalancerFilter.NotTags),
+		TagsNotAny:  neutronTagsToStrings(loadBalancerFilter.NotTagsAny),
+	}
+}
+
+func neutronTagsToStrings(tags []infrav1.NeutronTag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	s := make([]string, len(tags))
+	for i := range tags {
+		s[i] = string(tags[i])
+	}
+	return s
+}
+
 func RouterFilterToListOpts(routerFilter *infrav1.RouterFilter) routers.ListOpts {
 	if routerFilter == nil {
 		return routers.ListOpts{}
@@ -19,8 +19,11 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"slices"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -55,23 +58,188 @@ func (*openStackClusterWebhook) ValidateCreate(_ context.Context, objRaw runtime
 		return nil, err
 	}
 
-	if newObj.Spec.ManagedSecurityGroups != nil {
-		for _, rule := range newObj.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules {
-			if rule.RemoteManagedGroups != nil && (rule.RemoteGroupID != nil || rule.RemoteIPPrefix != nil) {
-				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSecurityGroups", "allNodesSecurityGroupRules"), "remoteManagedGroups cannot be used with remoteGroupID or remoteIPPrefix"))
-			}
-			if rule.RemoteGroupID != nil && (rule.RemoteManagedGroups != nil || rule.RemoteIPPrefix != nil) {
-				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSecurityGroups", "allNodesSecurityGroupRules"), "remoteGroupID cannot be used with remoteManagedGroups or remoteIPPrefix"))
-			}
-			if rule.RemoteIPPrefix != nil && (rule.RemoteManagedGroups != nil || rule.RemoteGroupID != nil) {
-				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSecurityGroups", "allNodesSecurityGroupRules"), "remoteIPPrefix cannot be used with remoteManagedGroups or remoteGroupID"))
+	allErrs = append(allErrs, validateManagedSecurityGroups(newObj.Spec.ManagedSecurityGroups, field.NewPath("spec", "managedSecurityGroups"))...)
+	allErrs = append(allErrs, validateNetworkingExtensions(&newObj.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateLoadBalancerListeners(&newObj.Spec, field.NewPath("spec", "apiServerLoadBalancer"))...)
+
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+}
+
+// validateManagedSecurityGroups validates the rules of all managed security groups.
+func validateManagedSecurityGroups(managedSecurityGroups *infrav1.ManagedSecurityGroups, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if managedSecurityGroups == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateSecurityGroupRules(managedSecurityGroups.AllNodesSecurityGroupRules, fldPath.Child("allNodesSecurityGroupRules"))...)
+	allErrs = append(allErrs, validateSecurityGroupRules(managedSecurityGroups.ControlPlaneNodesSecurityGroupRules, fldPath.Child("controlPlaneNodesSecurityGroupRules"))...)
+	allErrs = append(allErrs, validateSecurityGroupRules(managedSecurityGroups.WorkerNodesSecurityGroupRules, fldPath.Child("workerNodesSecurityGroupRules"))...)
+	return allErrs
+}
+
+// validateManagedSecurityGroupSettingsUpdate ensures that the statefulness of a
+// managed security group is not changed. Neutron refuses to change it while the
+// security group is in use by a port.
+func validateManagedSecurityGroupSettingsUpdate(oldSettings, newSettings *infrav1.ManagedSecurityGroupSettings, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	var oldStateful, newStateful *bool
+	if oldSettings != nil {
+		oldStateful = oldSettings.Stateful
+	}
+	if newSettings != nil {
+		newStateful = newSettings.Stateful
+	}
+	if ptr.Deref(oldStateful, true) != ptr.Deref(newStateful, true) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("stateful"), "cannot be modified"))
+	}
+	return allErrs
+}
+
+// validateLoadBalancerListeners ensures that the listeners of the API server
+// load balancer don't share a port with each other or with the API server
+// listeners, that listeners and named pools have distinct names, valid
+// machine selectors and health monitors suited to their protocol, and that L7
+// policies route to existing named pools.
+func validateLoadBalancerListeners(spec *infrav1.OpenStackClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.APIServerLoadBalancer == nil {
+		return allErrs
+	}
+
+	// Octavia allows a TCP and a UDP listener on the same port, but not two
+	// listeners using TCP as transport.
+	type listenerPort struct {
+		udp  bool
+		port int
+	}
+	usedPorts := map[listenerPort]struct{}{}
+	if spec.ControlPlaneEndpoint != nil && spec.ControlPlaneEndpoint.Port != 0 {
+		usedPorts[listenerPort{port: int(spec.ControlPlaneEndpoint.Port)}] = struct{}{}
+	}
+	for _, port := range spec.APIServerLoadBalancer.AdditionalPorts {
+		usedPorts[listenerPort{port: port}] = struct{}{}
+	}
+
+	// Listeners and named pools are both used to name Octavia pools, so
+	// their names must not collide.
+	usedNames := map[string]struct{}{}
+	poolNames := map[string]struct{}{}
+	for i := range spec.APIServerLoadBalancer.Pools {
+		pool := &spec.APIServerLoadBalancer.Pools[i]
+		poolPath := fldPath.Child("pools").Index(i)
+
+		usedNames[pool.Name] = struct{}{}
+		poolNames[pool.Name] = struct{}{}
+		allErrs = append(allErrs, validateLoadBalancerMemberSelector(&pool.Members, poolPath.Child("members"))...)
+		allErrs = append(allErrs, validateLoadBalancerMonitor(pool.Monitor, infrav1.LoadBalancerProtocolHTTP, pool.MemberTLS, poolPath.Child("monitor"))...)
+	}
+
+	for i := range spec.APIServerLoadBalancer.Listeners {
+		listener := &spec.APIServerLoadBalancer.Listeners[i]
+		listenerPath := fldPath.Child("listeners").Index(i)
+
+		key := listenerPort{udp: listener.Protocol == infrav1.LoadBalancerProtocolUDP, port: listener.Port}
+		if _, ok := usedPorts[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(listenerPath.Child("port"), listener.Port))
+		}
+		usedPorts[key] = struct{}{}
+
+		if _, ok := usedNames[listener.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(listenerPath.Child("name"), listener.Name))
+		}
+		usedNames[listener.Name] = struct{}{}
+
+		allErrs = append(allErrs, validateLoadBalancerMemberSelector(&listener.Members, listenerPath.Child("members"))...)
+		allErrs = append(allErrs, validateLoadBalancerMonitor(listener.Monitor, listener.Protocol, listener.MemberTLS, listenerPath.Child("monitor"))...)
+
+		for j := range listener.L7Policies {
+			policy := &listener.L7Policies[j]
+			if _, ok := poolNames[policy.Pool]; !ok {
+				allErrs = append(allErrs, field.NotFound(listenerPath.Child("l7Policies").Index(j).Child("pool"), policy.Pool))
 			}
 		}
 	}
+	return allErrs
+}
 
-	allErrs = append(allErrs, validateNetworkingExtensions(&newObj.Spec, field.NewPath("spec"))...)
+// validateLoadBalancerMemberSelector ensures that the machine selector of a
+// load balancer member selector is valid.
+func validateLoadBalancerMemberSelector(selector *infrav1.LoadBalancerMemberSelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if selector.MachineSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector.MachineSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineSelector"), selector.MachineSelector, err.Error()))
+		}
+	}
+	return allErrs
+}
 
-	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+// validateLoadBalancerMonitor ensures that the type of a health monitor is
+// supported by the protocol of its pool. Octavia only accepts UDP-CONNECT
+// monitors on UDP pools, plain HTTP monitors are only accepted on HTTP pools
+// whose members are reached without TLS, and HTTPS monitors only on TCP pools
+// and pools with MemberTLS.
+func validateLoadBalancerMonitor(monitor *infrav1.LoadBalancerListenerMonitor, protocol infrav1.LoadBalancerProtocol, memberTLS bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if monitor == nil || monitor.Type == "" {
+		return allErrs
+	}
+
+	var allowed []infrav1.LoadBalancerMonitorType
+	switch {
+	case protocol == infrav1.LoadBalancerProtocolUDP:
+		allowed = []infrav1.LoadBalancerMonitorType{infrav1.LoadBalancerMonitorTypeUDPConnect, infrav1.LoadBalancerMonitorTypeTCP, infrav1.LoadBalancerMonitorTypeHTTP}
+	case (protocol == infrav1.LoadBalancerProtocolHTTP || protocol == infrav1.LoadBalancerProtocolTerminatedHTTPS) && !memberTLS:
+		allowed = []infrav1.LoadBalancerMonitorType{infrav1.LoadBalancerMonitorTypeTCP, infrav1.LoadBalancerMonitorTypeHTTP, infrav1.LoadBalancerMonitorTypePING}
+	default:
+		allowed = []infrav1.LoadBalancerMonitorType{infrav1.LoadBalancerMonitorTypeTCP, infrav1.LoadBalancerMonitorTypeHTTPS, infrav1.LoadBalancerMonitorTypePING}
+	}
+
+	if !slices.Contains(allowed, monitor.Type) {
+		allowedValues := make([]string, len(allowed))
+		for i := range allowed {
+			allowedValues[i] = string(allowed[i])
+		}
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), monitor.Type, allowedValues))
+	}
+	return allErrs
+}
+
+// validateSecurityGroupRules ensures that at most one remote is set on each
+// rule, and that inline remote address group addresses are valid CIDRs.
+func validateSecurityGroupRules(rules []infrav1.SecurityGroupRuleSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range rules {
+		rule := &rules[i]
+		rulePath := fldPath.Index(i)
+
+		remotes := 0
+		if rule.RemoteGroupID != nil {
+			remotes++
+		}
+		if rule.RemoteIPPrefix != nil {
+			remotes++
+		}
+		if rule.RemoteManagedGroups != nil {
+			remotes++
+		}
+		if rule.RemoteAddressGroup != nil {
+			remotes++
+		}
+		if remotes > 1 {
+			allErrs = append(allErrs, field.Forbidden(rulePath, "only one of remoteGroupID, remoteIPPrefix, remoteManagedGroups or remoteAddressGroup may be set"))
+		}
+
+		if rule.RemoteAddressGroup != nil {
+			for j, address := range rule.RemoteAddressGroup.Addresses {
+				if _, _, err := net.ParseCIDR(address); err != nil {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("remoteAddressGroup", "addresses").Index(j), address, "must be a valid CIDR"))
+				}
+			}
+		}
+	}
+	return allErrs
 }
 
 // allowSubnetFilterToIDTransition checks if changes to OpenStackCluster.Spec.Subnets
@@ -177,6 +345,8 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 
 	// Allow changes to the managed securityGroupRules.
 	if newObj.Spec.ManagedSecurityGroups != nil {
+		allErrs = append(allErrs, validateManagedSecurityGroups(newObj.Spec.ManagedSecurityGroups, field.NewPath("spec", "managedSecurityGroups"))...)
+
 		if oldObj.Spec.ManagedSecurityGroups == nil {
 			oldObj.Spec.ManagedSecurityGroups = &infrav1.ManagedSecurityGroups{}
 		}
@@ -193,9 +363,23 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 		// Allow change to the allowAllInClusterTraffic.
 		oldObj.Spec.ManagedSecurityGroups.AllowAllInClusterTraffic = false
 		newObj.Spec.ManagedSecurityGroups.AllowAllInClusterTraffic = false
+
+		// Allow changes to the description and tags of each managed security group, but not to its statefulness.
+		msgPath := field.NewPath("spec", "managedSecurityGroups")
+		allErrs = append(allErrs, validateManagedSecurityGroupSettingsUpdate(oldObj.Spec.ManagedSecurityGroups.ControlPlane, newObj.Spec.ManagedSecurityGroups.ControlPlane, msgPath.Child("controlPlane"))...)
+		allErrs = append(allErrs, validateManagedSecurityGroupSettingsUpdate(oldObj.Spec.ManagedSecurityGroups.Worker, newObj.Spec.ManagedSecurityGroups.Worker, msgPath.Child("worker"))...)
+		allErrs = append(allErrs, validateManagedSecurityGroupSettingsUpdate(oldObj.Spec.ManagedSecurityGroups.Bastion, newObj.Spec.ManagedSecurityGroups.Bastion, msgPath.Child("bastion"))...)
+
+		oldObj.Spec.ManagedSecurityGroups.ControlPlane = nil
+		newObj.Spec.ManagedSecurityGroups.ControlPlane = nil
+		oldObj.Spec.ManagedSecurityGroups.Worker = nil
+		newObj.Spec.ManagedSecurityGroups.Worker = nil
+		oldObj.Spec.ManagedSecurityGroups.Bastion = nil
+		newObj.Spec.ManagedSecurityGroups.Bastion = nil
 	}
 
 	allErrs = append(allErrs, validateNetworkingExtensions(&newObj.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateIngressLoadBalancerModeUpdate(&oldObj.Spec, &newObj.Spec, field.NewPath("spec"))...)
 
 	// Allow changes only to DNSNameservers in ManagedSubnets spec
 	if newObj.Spec.ManagedSubnets != nil && oldObj.Spec.ManagedSubnets != nil {
@@ -243,6 +427,41 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 		newObj.Spec.APIServerLoadBalancer.Monitor = &infrav1.APIServerLoadBalancerMonitor{}
 	}
 
+	// Allow changes on the APIServerLB member drain timeout and mode
+	if newObj.Spec.APIServerLoadBalancer != nil && oldObj.Spec.APIServerLoadBalancer != nil {
+		oldObj.Spec.APIServerLoadBalancer.MemberDrainTimeout = nil
+		newObj.Spec.APIServerLoadBalancer.MemberDrainTimeout = nil
+		oldObj.Spec.APIServerLoadBalancer.MemberDrainMode = nil
+		newObj.Spec.APIServerLoadBalancer.MemberDrainMode = nil
+	}
+
+	// Allow changes on the monitors and L7 policies of the APIServerLB
+	// listeners, and on the monitors of its named pools
+	allErrs = append(allErrs, validateLoadBalancerListeners(&newObj.Spec, field.NewPath("spec", "apiServerLoadBalancer"))...)
+	if newObj.Spec.APIServerLoadBalancer != nil && oldObj.Spec.APIServerLoadBalancer != nil {
+		for _, lbSpec := range []*infrav1.APIServerLoadBalancer{oldObj.Spec.APIServerLoadBalancer, newObj.Spec.APIServerLoadBalancer} {
+			for i := range lbSpec.Listeners {
+				lbSpec.Listeners[i].Monitor = nil
+				lbSpec.Listeners[i].L7Policies = nil
+			}
+			for i := range lbSpec.Pools {
+				lbSpec.Pools[i].Monitor = nil
+			}
+		}
+	}
+
+	// Allow changes to the volume policy. It only applies to machines created afterwards.
+	oldObj.Spec.VolumePolicy = nil
+	newObj.Spec.VolumePolicy = nil
+
+	// Allow changes to the instance remediation policy. It only applies to machines created afterwards.
+	oldObj.Spec.InstanceRemediation = nil
+	newObj.Spec.InstanceRemediation = nil
+
+	// Allow changes to the console log capture policy.
+	oldObj.Spec.ConsoleLogCapture = nil
+	newObj.Spec.ConsoleLogCapture = nil
+
 	// Allow changes to the availability zones.
 	oldObj.Spec.ControlPlaneAvailabilityZones = []string{}
 	newObj.Spec.ControlPlaneAvailabilityZones = []string{}
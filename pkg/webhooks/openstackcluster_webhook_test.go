@@ -19,8 +19,10 @@ package webhooks
 import (
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega" //nolint:revive
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
@@ -253,18 +255,18 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Changing CIDRs on the OpenStackCluster.Spec.APIServerLoadBalancer.AllowedCIDRs is allowed",
+			name: "Changing description and tags on the OpenStackCluster.Spec.ManagedSecurityGroups.Worker is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
-						Enabled: ptr.To(true),
-						AllowedCIDRs: []string{
-							"0.0.0.0/0",
-							"192.168.10.0/24",
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						Worker: &infrav1.ManagedSecurityGroupSettings{
+							Stateful:    ptr.To(false),
+							Description: ptr.To("foo"),
+							Tags:        []string{"foo"},
 						},
 					},
 				},
@@ -275,12 +277,11 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
-						Enabled: ptr.To(true),
-						AllowedCIDRs: []string{
-							"0.0.0.0/0",
-							"192.168.10.0/24",
-							"10.6.0.0/16",
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						Worker: &infrav1.ManagedSecurityGroupSettings{
+							Stateful:    ptr.To(false),
+							Description: ptr.To("bar"),
+							Tags:        []string{"bar"},
 						},
 					},
 				},
@@ -288,13 +289,18 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Adding OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
+			name: "Changing stateful on the OpenStackCluster.Spec.ManagedSecurityGroups.ControlPlane is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						ControlPlane: &infrav1.ManagedSecurityGroupSettings{
+							Stateful: ptr.To(true),
+						},
+					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -303,26 +309,24 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ControlPlaneAvailabilityZones: []string{
-						"alice",
-						"bob",
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						ControlPlane: &infrav1.ManagedSecurityGroupSettings{
+							Stateful: ptr.To(false),
+						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "Modifying OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
+			name: "Explicitly setting stateful to the default on the OpenStackCluster.Spec.ManagedSecurityGroups.Bastion is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ControlPlaneAvailabilityZones: []string{
-						"alice",
-						"bob",
-					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -331,26 +335,32 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ControlPlaneAvailabilityZones: []string{
-						"alice",
-						"bob",
-						"eve",
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						Bastion: &infrav1.ManagedSecurityGroupSettings{
+							Stateful: ptr.To(true),
+						},
 					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Removing OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
+			name: "Changing the monitor of a listener on the OpenStackCluster.Spec.APIServerLoadBalancer.Listeners is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ControlPlaneAvailabilityZones: []string{
-						"alice",
-						"bob",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:    "ingress",
+								Port:    80,
+								Members: infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
 					},
 				},
 			},
@@ -360,18 +370,49 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:    "ingress",
+								Port:    80,
+								Members: infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								Monitor: &infrav1.LoadBalancerListenerMonitor{
+									Type: infrav1.LoadBalancerMonitorTypePING,
+								},
+							},
+						},
+					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Modifying OpenstackCluster.Spec.ControlPlaneOmitAvailabilityZone is allowed",
+			name: "Changing the L7 policies of a listener on the OpenStackCluster.Spec.APIServerLoadBalancer.Listeners is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "http",
+								Protocol: infrav1.LoadBalancerProtocolHTTP,
+								Port:     80,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30080,
+								Members:    infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
+					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -380,20 +421,46 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ControlPlaneOmitAvailabilityZone: ptr.To(true),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "http",
+								Protocol: infrav1.LoadBalancerProtocolHTTP,
+								Port:     80,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								L7Policies: []infrav1.LoadBalancerL7Policy{
+									{
+										Name:  "dashboard",
+										Pool:  "dashboard",
+										Rules: []infrav1.LoadBalancerL7Rule{{Type: infrav1.LoadBalancerL7RuleTypePath, Value: "/dashboard"}},
+									},
+								},
+							},
+						},
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30080,
+								Members:    infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
+					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Changing OpenStackCluster.Spec.APIServerFixedIP is allowed when API Server Floating IP is disabled",
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.MemberDrainTimeout and MemberDrainMode is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(true),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -402,21 +469,33 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(true),
-					APIServerFixedIP:           ptr.To("20.1.56.1"),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled:            ptr.To(true),
+						MemberDrainTimeout: &metav1.Duration{Duration: 30 * time.Second},
+						MemberDrainMode:    ptr.To(infrav1.MemberDrainModeAdminStateDown),
+					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Changing OpenStackCluster.Spec.APIServerFixedIP is not allowed",
+			name: "Changing the port of a listener on the OpenStackCluster.Spec.APIServerLoadBalancer.Listeners is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(false),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:    "ingress",
+								Port:    80,
+								Members: infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
+					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -425,41 +504,67 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(false),
-					APIServerFixedIP:           ptr.To("20.1.56.1"),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:    "ingress",
+								Port:    81,
+								Members: infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
+					},
 				},
 			},
 			wantErr: true,
 		},
-
 		{
-			name: "Changing OpenStackCluster.Spec.APIServerPort is allowed when API Server Floating IP is disabled",
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.LoadBalancerRef is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(true),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							ID: ptr.To("aaaaaaaa-bbbb-cccc-dddd-333333333333"),
+						},
+					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
-					DisableAPIServerFloatingIP: ptr.To(true),
-					APIServerPort:              ptr.To(uint16(8443)),
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						LoadBalancerRef: &infrav1.LoadBalancerParam{
+							ID: ptr.To("aaaaaaaa-bbbb-cccc-dddd-444444444444"),
+						},
+					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "Changing OpenStackCluster.Spec.APIServerPort is not allowed",
+			name: "Changing CIDRs on the OpenStackCluster.Spec.APIServerLoadBalancer.AllowedCIDRs is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(false),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						AllowedCIDRs: []string{
+							"0.0.0.0/0",
+							"192.168.10.0/24",
+						},
+					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -468,14 +573,20 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					DisableAPIServerFloatingIP: ptr.To(false),
-					APIServerPort:              ptr.To(uint16(8443)),
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						AllowedCIDRs: []string{
+							"0.0.0.0/0",
+							"192.168.10.0/24",
+							"10.6.0.0/16",
+						},
+					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "Changing OpenStackCluster.Spec.APIServerFloatingIP is allowed when it matches the current api server loadbalancer IP",
+			name: "Adding OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
@@ -483,11 +594,6 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						CloudName: "foobar",
 					},
 				},
-				Status: infrav1.OpenStackClusterStatus{
-					APIServerLoadBalancer: &infrav1.LoadBalancer{
-						IP: "1.2.3.4",
-					},
-				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
@@ -495,28 +601,25 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					APIServerFloatingIP: ptr.To("1.2.3.4"),
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					APIServerLoadBalancer: &infrav1.LoadBalancer{
-						IP: "1.2.3.4",
+					ControlPlaneAvailabilityZones: []string{
+						"alice",
+						"bob",
 					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Changing OpenStackCluster.Spec.APIServerFloatingIP is not allowed when it doesn't matches the current api server loadbalancer IP",
+			name: "Modifying OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					APIServerLoadBalancer: &infrav1.LoadBalancer{
-						IP: "1.2.3.4",
+					ControlPlaneAvailabilityZones: []string{
+						"alice",
+						"bob",
 					},
 				},
 			},
@@ -526,34 +629,26 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					APIServerFloatingIP: ptr.To("5.6.7.8"),
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					APIServerLoadBalancer: &infrav1.LoadBalancer{
-						IP: "1.2.3.4",
+					ControlPlaneAvailabilityZones: []string{
+						"alice",
+						"bob",
+						"eve",
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "Removing OpenStackCluster.Spec.Bastion when it is enabled is not allowed",
+			name: "Removing OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Bastion: &infrav1.Bastion{
-						Enabled: ptr.To(true),
-						Spec: &infrav1.OpenStackMachineSpec{
-							Flavor: ptr.To("m1.small"),
-							Image: infrav1.ImageParam{
-								Filter: &infrav1.ImageFilter{
-									Name: ptr.To("ubuntu"),
-								},
-							},
-						},
+					ControlPlaneAvailabilityZones: []string{
+						"alice",
+						"bob",
 					},
 				},
 			},
@@ -565,27 +660,16 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "Removing OpenStackCluster.Spec.Bastion when it is disabled is allowed",
+			name: "Modifying OpenstackCluster.Spec.ControlPlaneOmitAvailabilityZone is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Bastion: &infrav1.Bastion{
-						Enabled: ptr.To(false),
-						Spec: &infrav1.OpenStackMachineSpec{
-							Flavor: ptr.To("m1.small"),
-							Image: infrav1.ImageParam{
-								Filter: &infrav1.ImageFilter{
-									Name: ptr.To("ubuntu"),
-								},
-							},
-						},
-					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -594,31 +678,19 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
+					ControlPlaneOmitAvailabilityZone: ptr.To(true),
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Switching OpenStackCluster.Spec.Network from filter.name to id is allowed when they refer to the same network",
+			name: "Adding OpenStackCluster.Spec.VolumePolicy is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
-						Filter: &infrav1.NetworkFilter{
-							Name: "testnetworkname",
-						},
-					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					Network: &infrav1.NetworkStatusWithSubnets{
-						NetworkStatus: infrav1.NetworkStatus{
-							ID:   "testnetworkid",
-							Name: "testnetworkname",
-						},
-					},
 				},
 			},
 			newTemplate: &infrav1.OpenStackCluster{
@@ -627,41 +699,23 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
-						ID: ptr.To("testnetworkid"),
-					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					Network: &infrav1.NetworkStatusWithSubnets{
-						NetworkStatus: infrav1.NetworkStatus{
-							ID:   "testnetworkid",
-							Name: "testnetworkname",
-						},
+					VolumePolicy: &infrav1.VolumePolicy{
+						RequireEncryption: ptr.To(true),
 					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Switching OpenStackCluster.Spec.Network from filter.name to id is not allowed when they refer to different networks",
+			name: "Changing OpenStackCluster.Spec.InstanceRemediation is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
-						Filter: &infrav1.NetworkFilter{
-							Name: "testetworkname",
-						},
-					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					Network: &infrav1.NetworkStatusWithSubnets{
-						NetworkStatus: infrav1.NetworkStatus{
-							ID:   "testetworkid1",
-							Name: "testnetworkname",
-						},
+					InstanceRemediation: &infrav1.InstanceRemediation{
+						StartShutoff: ptr.To(true),
 					},
 				},
 			},
@@ -671,30 +725,347 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
-						ID: ptr.To("testetworkid2"),
-					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					Network: &infrav1.NetworkStatusWithSubnets{
-						NetworkStatus: infrav1.NetworkStatus{
-							ID:   "testetworkid1",
-							Name: "testnetworkname",
-						},
+					InstanceRemediation: &infrav1.InstanceRemediation{
+						StartShutoff:    ptr.To(true),
+						MaxErrorReboots: ptr.To[int32](3),
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "Switching OpenStackCluster.Spec.Subnets from filter.name to id is allowed when they refer to the same subnet",
+			name: "Changing OpenStackCluster.Spec.ConsoleLogCapture is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ConsoleLogCapture: &infrav1.ConsoleLogCapture{
+						NodeTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerFixedIP is allowed when API Server Floating IP is disabled",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(true),
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(true),
+					APIServerFixedIP:           ptr.To("20.1.56.1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerFixedIP is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(false),
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(false),
+					APIServerFixedIP:           ptr.To("20.1.56.1"),
+				},
+			},
+			wantErr: true,
+		},
+
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerPort is allowed when API Server Floating IP is disabled",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(true),
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					DisableAPIServerFloatingIP: ptr.To(true),
+					APIServerPort:              ptr.To(uint16(8443)),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerPort is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(false),
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					DisableAPIServerFloatingIP: ptr.To(false),
+					APIServerPort:              ptr.To(uint16(8443)),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerFloatingIP is allowed when it matches the current api server loadbalancer IP",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					APIServerLoadBalancer: &infrav1.LoadBalancer{
+						IP: "1.2.3.4",
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerFloatingIP: ptr.To("1.2.3.4"),
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					APIServerLoadBalancer: &infrav1.LoadBalancer{
+						IP: "1.2.3.4",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerFloatingIP is not allowed when it doesn't matches the current api server loadbalancer IP",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					APIServerLoadBalancer: &infrav1.LoadBalancer{
+						IP: "1.2.3.4",
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerFloatingIP: ptr.To("5.6.7.8"),
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					APIServerLoadBalancer: &infrav1.LoadBalancer{
+						IP: "1.2.3.4",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Removing OpenStackCluster.Spec.Bastion when it is enabled is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled: ptr.To(true),
+						Spec: &infrav1.OpenStackMachineSpec{
+							Flavor: ptr.To("m1.small"),
+							Image: infrav1.ImageParam{
+								Filter: &infrav1.ImageFilter{
+									Name: ptr.To("ubuntu"),
+								},
+							},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Removing OpenStackCluster.Spec.Bastion when it is disabled is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled: ptr.To(false),
+						Spec: &infrav1.OpenStackMachineSpec{
+							Flavor: ptr.To("m1.small"),
+							Image: infrav1.ImageParam{
+								Filter: &infrav1.ImageFilter{
+									Name: ptr.To("ubuntu"),
+								},
+							},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Switching OpenStackCluster.Spec.Network from filter.name to id is allowed when they refer to the same network",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
+						Filter: &infrav1.NetworkFilter{
+							Name: "testnetworkname",
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   "testnetworkid",
+							Name: "testnetworkname",
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
+						ID: ptr.To("testnetworkid"),
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   "testnetworkid",
+							Name: "testnetworkname",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Switching OpenStackCluster.Spec.Network from filter.name to id is not allowed when they refer to different networks",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
+						Filter: &infrav1.NetworkFilter{
+							Name: "testetworkname",
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   "testetworkid1",
+							Name: "testnetworkname",
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
+						ID: ptr.To("testetworkid2"),
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   "testetworkid1",
+							Name: "testnetworkname",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Switching OpenStackCluster.Spec.Subnets from filter.name to id is allowed when they refer to the same subnet",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
 						ID: ptr.To("net-123"),
 					},
 					Subnets: []infrav1.SubnetParam{
@@ -816,46 +1187,270 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: true,
+		},
+		{
+			name: "Switching one OpenStackCluster.Spec.Subnets entry from filter to a mismatched ID (from another subnet) should be rejected, even if other subnets remain unchanged",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
+						ID: ptr.To("net-123"),
+					},
+					Subnets: []infrav1.SubnetParam{
+						{
+							Filter: &infrav1.SubnetFilter{
+								Name: "test-subnet-1",
+							},
+						},
+						{
+							Filter: &infrav1.SubnetFilter{
+								Name: "test-subnet-2",
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   "net-123",
+							Name: "testnetwork",
+						},
+						Subnets: []infrav1.Subnet{
+							{
+								ID:   "test-subnet-id-1",
+								Name: "test-subnet-1",
+							},
+							{
+								ID:   "test-subnet-id-2",
+								Name: "test-subnet-2",
+							},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Network: &infrav1.NetworkParam{
+						ID: ptr.To("net-123"),
+					},
+					Subnets: []infrav1.SubnetParam{
+						{
+							ID: ptr.To("test-subnet-id-2"),
+						},
+						{
+							Filter: &infrav1.SubnetFilter{
+								Name: "test-subnet-2",
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   "net-123",
+							Name: "testnetwork",
+						},
+						Subnets: []infrav1.Subnet{
+							{
+								ID:   "test-subnet-id-1",
+								Name: "test-subnet-1",
+							},
+							{
+								ID:   "test-subnet-id-2",
+								Name: "test-subnet-2",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+
+		{
+			name: "Changing OpenStackCluster.Spec.ManagedSubnets.DNSNameservers is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
+								"8.8.4.4",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"1.1.1.1",
+								"1.0.0.1",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Adding new DNSNameserver to OpenStackCluster.Spec.ManagedSubnets.DNSNameservers is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
+								"8.8.4.4",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Removing DNSNameservers from OpenStackCluster.Spec.ManagedSubnets is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
+								"8.8.4.4",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR:           "192.168.1.0/24",
+							DNSNameservers: []string{},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
 		},
 		{
-			name: "Switching one OpenStackCluster.Spec.Subnets entry from filter to a mismatched ID (from another subnet) should be rejected, even if other subnets remain unchanged",
+			name: "Multiple subnets with DNSNameservers changes are allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
-						ID: ptr.To("net-123"),
-					},
-					Subnets: []infrav1.SubnetParam{
+					ManagedSubnets: []infrav1.SubnetSpec{
 						{
-							Filter: &infrav1.SubnetFilter{
-								Name: "test-subnet-1",
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
 							},
-						},
-						{
-							Filter: &infrav1.SubnetFilter{
-								Name: "test-subnet-2",
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
 							},
 						},
-					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					Network: &infrav1.NetworkStatusWithSubnets{
-						NetworkStatus: infrav1.NetworkStatus{
-							ID:   "net-123",
-							Name: "testnetwork",
-						},
-						Subnets: []infrav1.Subnet{
-							{
-								ID:   "test-subnet-id-1",
-								Name: "test-subnet-1",
+						{
+							CIDR: "192.168.2.0/24",
+							DNSNameservers: []string{
+								"8.8.4.4",
 							},
-							{
-								ID:   "test-subnet-id-2",
-								Name: "test-subnet-2",
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.2.10",
+									End:   "192.168.2.100",
+								},
 							},
 						},
 					},
@@ -867,44 +1462,38 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					Network: &infrav1.NetworkParam{
-						ID: ptr.To("net-123"),
-					},
-					Subnets: []infrav1.SubnetParam{
-						{
-							ID: ptr.To("test-subnet-id-2"),
-						},
+					ManagedSubnets: []infrav1.SubnetSpec{
 						{
-							Filter: &infrav1.SubnetFilter{
-								Name: "test-subnet-2",
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"1.1.1.1",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.1.10",
+									End:   "192.168.1.100",
+								},
 							},
 						},
-					},
-				},
-				Status: infrav1.OpenStackClusterStatus{
-					Network: &infrav1.NetworkStatusWithSubnets{
-						NetworkStatus: infrav1.NetworkStatus{
-							ID:   "net-123",
-							Name: "testnetwork",
-						},
-						Subnets: []infrav1.Subnet{
-							{
-								ID:   "test-subnet-id-1",
-								Name: "test-subnet-1",
+						{
+							CIDR: "192.168.2.0/24",
+							DNSNameservers: []string{
+								"1.0.0.1",
 							},
-							{
-								ID:   "test-subnet-id-2",
-								Name: "test-subnet-2",
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.2.10",
+									End:   "192.168.2.100",
+								},
 							},
 						},
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
-
 		{
-			name: "Changing OpenStackCluster.Spec.ManagedSubnets.DNSNameservers is allowed",
+			name: "Changing CIDR in OpenStackCluster.Spec.ManagedSubnets is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
@@ -916,7 +1505,6 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 							CIDR: "192.168.1.0/24",
 							DNSNameservers: []string{
 								"8.8.8.8",
-								"8.8.4.4",
 							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
@@ -936,25 +1524,24 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 					},
 					ManagedSubnets: []infrav1.SubnetSpec{
 						{
-							CIDR: "192.168.1.0/24",
+							CIDR: "10.0.0.0/24",
 							DNSNameservers: []string{
-								"1.1.1.1",
-								"1.0.0.1",
+								"8.8.8.8",
 							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+									Start: "10.0.0.10",
+									End:   "10.0.0.100",
 								},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "Adding new DNSNameserver to OpenStackCluster.Spec.ManagedSubnets.DNSNameservers is allowed",
+			name: "Modifying AllocationPools in OpenStackCluster.Spec.ManagedSubnets is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
@@ -988,22 +1575,21 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 							CIDR: "192.168.1.0/24",
 							DNSNameservers: []string{
 								"8.8.8.8",
-								"8.8.4.4",
 							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+									Start: "192.168.1.20",
+									End:   "192.168.1.200",
 								},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "Removing DNSNameservers from OpenStackCluster.Spec.ManagedSubnets is allowed",
+			name: "Adding a new subnet to OpenStackCluster.Spec.ManagedSubnets is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
@@ -1015,7 +1601,6 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 							CIDR: "192.168.1.0/24",
 							DNSNameservers: []string{
 								"8.8.8.8",
-								"8.8.4.4",
 							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
@@ -1035,8 +1620,10 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 					},
 					ManagedSubnets: []infrav1.SubnetSpec{
 						{
-							CIDR:           "192.168.1.0/24",
-							DNSNameservers: []string{},
+							CIDR: "192.168.1.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
+							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
 									Start: "192.168.1.10",
@@ -1044,13 +1631,25 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 								},
 							},
 						},
+						{
+							CIDR: "192.168.2.0/24",
+							DNSNameservers: []string{
+								"8.8.8.8",
+							},
+							AllocationPools: []infrav1.AllocationPool{
+								{
+									Start: "192.168.2.10",
+									End:   "192.168.2.100",
+								},
+							},
+						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "Multiple subnets with DNSNameservers changes are allowed",
+			name: "Removing a subnet from OpenStackCluster.Spec.ManagedSubnets is not allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
@@ -1073,7 +1672,7 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						{
 							CIDR: "192.168.2.0/24",
 							DNSNameservers: []string{
-								"8.8.4.4",
+								"8.8.8.8",
 							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
@@ -1095,7 +1694,7 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 						{
 							CIDR: "192.168.1.0/24",
 							DNSNameservers: []string{
-								"1.1.1.1",
+								"8.8.8.8",
 							},
 							AllocationPools: []infrav1.AllocationPool{
 								{
@@ -1104,64 +1703,186 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 								},
 							},
 						},
-						{
-							CIDR: "192.168.2.0/24",
-							DNSNameservers: []string{
-								"1.0.0.1",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Changing the ingress load balancer mode in OpenStackCluster.Spec.Extensions is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Extensions: &infrav1.OpenStackClusterExtensionsSpec{
+						LoadBalancers: &infrav1.ClusterLoadBalancersExtensionsSpec{
+							Ingress: &infrav1.IngressLoadBalancerExtensionsSpec{
+								Mode: infrav1.IngressLoadBalancerModeOctavia,
 							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.2.10",
-									End:   "192.168.2.100",
-								},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Extensions: &infrav1.OpenStackClusterExtensionsSpec{
+						LoadBalancers: &infrav1.ClusterLoadBalancersExtensionsSpec{
+							Ingress: &infrav1.IngressLoadBalancerExtensionsSpec{
+								Mode: infrav1.IngressLoadBalancerModeKeepalived,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.TODO()
+
+			webhook := &openStackClusterWebhook{}
+			warn, err := webhook.ValidateUpdate(ctx, tt.oldTemplate, tt.newTemplate)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			// Nothing emits warnings yet
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestOpenStackCluster_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *infrav1.OpenStackCluster
+		wantErr  bool
+	}{
+		{
+			name: "OpenStackCluster.Spec.IdentityRef with correct spec on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with TCP and UDP listeners on the same port on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "dns-tcp",
+								Protocol: infrav1.LoadBalancerProtocolTCP,
+								Port:     53,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+							{
+								Name:     "dns-udp",
+								Protocol: infrav1.LoadBalancerProtocolUDP,
+								Port:     53,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with a port used by AdditionalPorts on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled:         ptr.To(true),
+						AdditionalPorts: []int{8080},
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "http",
+								Protocol: infrav1.LoadBalancerProtocolHTTP,
+								Port:     8080,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
 							},
 						},
 					},
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "Changing CIDR in OpenStackCluster.Spec.ManagedSubnets is not allowed",
-			oldTemplate: &infrav1.OpenStackCluster{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with invalid machineSelector on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name: "ingress",
+								Port: 80,
+								Members: infrav1.LoadBalancerMemberSelector{
+									MachineSelector: &metav1.LabelSelector{
+										MatchExpressions: []metav1.LabelSelectorRequirement{
+											{Key: "ingress", Operator: "Bogus"},
+										},
+									},
 								},
 							},
 						},
 					},
 				},
 			},
-			newTemplate: &infrav1.OpenStackCluster{
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Pools with the name of a listener on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "10.0.0.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "dashboard",
+								Protocol: infrav1.LoadBalancerProtocolHTTP,
+								Port:     80,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
 							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "10.0.0.10",
-									End:   "10.0.0.100",
-								},
+						},
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30080,
+								Members:    infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
 							},
 						},
 					},
@@ -1170,45 +1891,49 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Modifying AllocationPools in OpenStackCluster.Spec.ManagedSubnets is not allowed",
-			oldTemplate: &infrav1.OpenStackCluster{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with an HTTP monitor on a TCP listener on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "ingress",
+								Protocol: infrav1.LoadBalancerProtocolTCP,
+								Port:     443,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								Monitor: &infrav1.LoadBalancerListenerMonitor{
+									Type: infrav1.LoadBalancerMonitorTypeHTTP,
 								},
 							},
 						},
 					},
 				},
 			},
-			newTemplate: &infrav1.OpenStackCluster{
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with a UDP-CONNECT monitor on an HTTP listener on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.20",
-									End:   "192.168.1.200",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "ingress",
+								Protocol: infrav1.LoadBalancerProtocolHTTP,
+								Port:     80,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								Monitor: &infrav1.LoadBalancerListenerMonitor{
+									Type: infrav1.LoadBalancerMonitorTypeUDPConnect,
 								},
 							},
 						},
@@ -1218,57 +1943,48 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Adding a new subnet to OpenStackCluster.Spec.ManagedSubnets is not allowed",
-			oldTemplate: &infrav1.OpenStackCluster{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Pools with an HTTPS monitor and MemberTLS on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30443,
+								MemberTLS:  true,
+								Members:    infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								Monitor: &infrav1.LoadBalancerListenerMonitor{
+									Type: infrav1.LoadBalancerMonitorTypeHTTPS,
 								},
 							},
 						},
 					},
 				},
 			},
-			newTemplate: &infrav1.OpenStackCluster{
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Pools with an HTTPS monitor without MemberTLS on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
-								},
-							},
-						},
-						{
-							CIDR: "192.168.2.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.2.10",
-									End:   "192.168.2.100",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30080,
+								Members:    infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								Monitor: &infrav1.LoadBalancerListenerMonitor{
+									Type: infrav1.LoadBalancerMonitorTypeHTTPS,
 								},
 							},
 						},
@@ -1278,57 +1994,65 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Removing a subnet from OpenStackCluster.Spec.ManagedSubnets is not allowed",
-			oldTemplate: &infrav1.OpenStackCluster{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with an L7 policy routing to a named pool on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:          "https",
+								Protocol:      infrav1.LoadBalancerProtocolTerminatedHTTPS,
+								Port:          443,
+								TLSSecretName: ptr.To("cluster-tls"),
+								Members:       infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleControlPlane},
+								L7Policies: []infrav1.LoadBalancerL7Policy{
+									{
+										Name:  "dashboard",
+										Pool:  "dashboard",
+										Rules: []infrav1.LoadBalancerL7Rule{{Type: infrav1.LoadBalancerL7RuleTypeHostName, Value: "dashboard.example.com"}},
+									},
 								},
 							},
 						},
-						{
-							CIDR: "192.168.2.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.2.10",
-									End:   "192.168.2.100",
-								},
+						Pools: []infrav1.LoadBalancerPool{
+							{
+								Name:       "dashboard",
+								MemberPort: 30443,
+								Members:    infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
 							},
 						},
 					},
 				},
 			},
-			newTemplate: &infrav1.OpenStackCluster{
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerLoadBalancer.Listeners with an L7 policy routing to an unknown pool on create",
+			template: &infrav1.OpenStackCluster{
 				Spec: infrav1.OpenStackClusterSpec{
 					IdentityRef: infrav1.OpenStackIdentityReference{
 						Name:      "foobar",
 						CloudName: "foobar",
 					},
-					ManagedSubnets: []infrav1.SubnetSpec{
-						{
-							CIDR: "192.168.1.0/24",
-							DNSNameservers: []string{
-								"8.8.8.8",
-							},
-							AllocationPools: []infrav1.AllocationPool{
-								{
-									Start: "192.168.1.10",
-									End:   "192.168.1.100",
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+						Listeners: []infrav1.LoadBalancerListener{
+							{
+								Name:     "http",
+								Protocol: infrav1.LoadBalancerProtocolHTTP,
+								Port:     80,
+								Members:  infrav1.LoadBalancerMemberSelector{Role: infrav1.LoadBalancerMemberRoleWorker},
+								L7Policies: []infrav1.LoadBalancerL7Policy{
+									{
+										Name:  "dashboard",
+										Pool:  "dashboard",
+										Rules: []infrav1.LoadBalancerL7Rule{{Type: infrav1.LoadBalancerL7RuleTypePath, Value: "/dashboard"}},
+									},
 								},
 							},
 						},
@@ -1337,43 +2061,6 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			ctx := context.TODO()
-
-			webhook := &openStackClusterWebhook{}
-			warn, err := webhook.ValidateUpdate(ctx, tt.oldTemplate, tt.newTemplate)
-			if tt.wantErr {
-				g.Expect(err).To(HaveOccurred())
-			} else {
-				g.Expect(err).NotTo(HaveOccurred())
-			}
-			// Nothing emits warnings yet
-			g.Expect(warn).To(BeEmpty())
-		})
-	}
-}
-
-func TestOpenStackCluster_ValidateCreate(t *testing.T) {
-	tests := []struct {
-		name     string
-		template *infrav1.OpenStackCluster
-		wantErr  bool
-	}{
-		{
-			name: "OpenStackCluster.Spec.IdentityRef with correct spec on create",
-			template: &infrav1.OpenStackCluster{
-				Spec: infrav1.OpenStackClusterSpec{
-					IdentityRef: infrav1.OpenStackIdentityReference{
-						Name:      "foobar",
-						CloudName: "foobar",
-					},
-				},
-			},
-			wantErr: false,
-		},
 		{
 			name: "OpenStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules with correct spec on create",
 			template: &infrav1.OpenStackCluster{
@@ -1422,6 +2109,79 @@ func TestOpenStackCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "OpenStackCluster.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules with remoteAddressGroup on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:         "nodeports",
+								Direction:    "ingress",
+								PortRangeMin: ptr.To(30000),
+								PortRangeMax: ptr.To(32767),
+								Protocol:     ptr.To("tcp"),
+								RemoteAddressGroup: &infrav1.AddressGroupParam{
+									Addresses: []string{"10.0.0.0/8", "192.168.10.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules with remoteAddressGroup and remoteIPPrefix on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:           "nodeports",
+								Direction:      "ingress",
+								RemoteIPPrefix: ptr.To("10.0.0.0/8"),
+								RemoteAddressGroup: &infrav1.AddressGroupParam{
+									Addresses: []string{"192.168.10.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules with invalid remoteAddressGroup address on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						AllNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:      "nodeports",
+								Direction: "ingress",
+								RemoteAddressGroup: &infrav1.AddressGroupParam{
+									Addresses: []string{"not-a-cidr"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
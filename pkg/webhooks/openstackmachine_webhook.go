@@ -69,11 +69,18 @@ func (*openStackMachineWebhook) ValidateCreate(_ context.Context, objRaw runtime
 		}
 	}
 
+	allErrs = append(allErrs, validateAccelerators(newObj.Spec.Accelerators, field.NewPath("spec", "accelerators"))...)
+
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
 func (*openStackMachineWebhook) ValidateUpdate(_ context.Context, oldObjRaw, newObjRaw runtime.Object) (admission.Warnings, error) {
+	oldObj, err := castToOpenStackMachine(oldObjRaw)
+	if err != nil {
+		return nil, err
+	}
+
 	newObj, err := castToOpenStackMachine(newObjRaw)
 	if err != nil {
 		return nil, err
@@ -113,6 +120,19 @@ func (*openStackMachineWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 	delete(oldOpenStackMachineSpec, "identityRef")
 	delete(newOpenStackMachineSpec, "identityRef")
 
+	// allow changes to hotAttachVolumes, and to additionalBlockDevices of
+	// type Volume while it is enabled
+	delete(oldOpenStackMachineSpec, "hotAttachVolumes")
+	delete(newOpenStackMachineSpec, "hotAttachVolumes")
+	if ptr.Deref(newObj.Spec.HotAttachVolumes, false) {
+		blockDevicesPath := field.NewPath("spec", "additionalBlockDevices")
+		if errs := validateHotAttachBlockDevices(oldObj.Spec.AdditionalBlockDevices, newObj.Spec.AdditionalBlockDevices, blockDevicesPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		}
+		delete(oldOpenStackMachineSpec, "additionalBlockDevices")
+		delete(newOpenStackMachineSpec, "additionalBlockDevices")
+	}
+
 	if !reflect.DeepEqual(oldOpenStackMachineSpec, newOpenStackMachineSpec) {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "cannot be modified"))
 	}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func TestOpenStackMachine_ValidateUpdate(t *testing.T) {
+	localBlockDevice := infrav1.AdditionalBlockDevice{
+		Name:    "local",
+		SizeGiB: 10,
+		Storage: infrav1.BlockDeviceStorage{Type: infrav1.LocalBlockDevice},
+	}
+
+	tests := []struct {
+		name    string
+		old     *infrav1.OpenStackMachine
+		new     *infrav1.OpenStackMachine
+		wantErr bool
+	}{
+		{
+			name: "don't allow changing the flavor",
+			old: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{Flavor: ptr.To("foo")},
+			},
+			new: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{Flavor: ptr.To("bar")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "don't allow adding a volume without hotAttachVolumes",
+			old: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{Flavor: ptr.To("foo")},
+			},
+			new: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:                 ptr.To("foo"),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("data")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow enabling hotAttachVolumes",
+			old: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{Flavor: ptr.To("foo")},
+			},
+			new: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+		},
+		{
+			name: "allow adding and removing volumes with hotAttachVolumes",
+			old: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:                 ptr.To("foo"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("old")},
+				},
+			},
+			new: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:                 ptr.To("foo"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("new")},
+				},
+			},
+		},
+		{
+			name: "don't allow adding a local block device with hotAttachVolumes",
+			old: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+			new: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:                 ptr.To("foo"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{localBlockDevice},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "don't allow other changes with hotAttachVolumes",
+			old: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+			new: &infrav1.OpenStackMachine{
+				Spec: infrav1.OpenStackMachineSpec{
+					Flavor:           ptr.To("bar"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			webhook := &openStackMachineWebhook{}
+			_, err := webhook.ValidateUpdate(context.Background(), tt.old, tt.new)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
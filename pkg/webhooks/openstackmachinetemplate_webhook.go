@@ -60,6 +60,8 @@ func (*openStackMachineTemplateWebhook) ValidateCreate(_ context.Context, objRaw
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
 	}
 
+	allErrs = append(allErrs, validateAccelerators(newObj.Spec.Template.Spec.Accelerators, field.NewPath("spec", "template", "spec", "accelerators"))...)
+
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
@@ -201,3 +201,58 @@ func TestOpenStackMachineTemplate_ValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenStackMachineTemplate_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		accelerators *infrav1.AcceleratorRequirements
+		wantErr      bool
+	}{
+		{
+			name: "Valid accelerators",
+			accelerators: &infrav1.AcceleratorRequirements{
+				PCIAliases: []infrav1.PCIAliasRequirement{{Name: "a100", Count: 2}},
+				Resources:  []infrav1.PlacementResourceRequirement{{ResourceClass: "VGPU", Count: 1}},
+			},
+		},
+		{
+			name: "PCI alias which is not a valid resource name",
+			accelerators: &infrav1.AcceleratorRequirements{
+				PCIAliases: []infrav1.PCIAliasRequirement{{Name: "nvidia a100", Count: 1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Standard resource class",
+			accelerators: &infrav1.AcceleratorRequirements{
+				Resources: []infrav1.PlacementResourceRequirement{{ResourceClass: "VCPU", Count: 1}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			template := &infrav1.OpenStackMachineTemplate{
+				Spec: infrav1.OpenStackMachineTemplateSpec{
+					Template: infrav1.OpenStackMachineTemplateResource{
+						Spec: infrav1.OpenStackMachineSpec{
+							Flavor:       ptr.To("foo"),
+							Accelerators: tt.accelerators,
+						},
+					},
+				},
+			}
+
+			webhook := &openStackMachineTemplateWebhook{}
+			_, err := webhook.ValidateCreate(context.Background(), template)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -71,6 +71,8 @@ func (*openStackServerWebhook) ValidateCreate(_ context.Context, objRaw runtime.
 		}
 	}
 
+	allErrs = append(allErrs, validateAccelerators(newObj.Spec.Accelerators, field.NewPath("spec", "accelerators"))...)
+
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
@@ -113,8 +115,51 @@ func (*openStackServerWebhook) ValidateUpdate(ctx context.Context, oldObjRaw, ne
 	delete(oldOpenStackServerSpec, "identityRef")
 	delete(newOpenStackServerSpec, "identityRef")
 
+	newSpec := newObj.Spec.DeepCopy()
+	oldSpec := oldObj.Spec.DeepCopy()
+
+	// allow changes to hotAttachVolumes, and to additionalBlockDevices of
+	// type Volume while it is enabled
+	newSpec.HotAttachVolumes = nil
+	oldSpec.HotAttachVolumes = nil
+	if ptr.Deref(newObj.Spec.HotAttachVolumes, false) {
+		blockDevicesPath := field.NewPath("spec", "additionalBlockDevices")
+		if errs := validateHotAttachBlockDevices(oldSpec.AdditionalBlockDevices, newSpec.AdditionalBlockDevices, blockDevicesPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		}
+		newSpec.AdditionalBlockDevices = nil
+		oldSpec.AdditionalBlockDevices = nil
+	}
+
+	// allow changes to inPlaceResize, and to the flavor while it is enabled
+	newSpec.InPlaceResize = nil
+	oldSpec.InPlaceResize = nil
+	if ptr.Deref(newObj.Spec.InPlaceResize, false) {
+		newSpec.Flavor, newSpec.FlavorID = nil, nil
+		oldSpec.Flavor, oldSpec.FlavorID = nil, nil
+	}
+
+	// allow changes to inPlaceRebuild, and to the image while it is enabled
+	newSpec.InPlaceRebuild = nil
+	oldSpec.InPlaceRebuild = nil
+	if ptr.Deref(newObj.Spec.InPlaceRebuild, false) {
+		newSpec.Image = infrav1.ImageParam{}
+		oldSpec.Image = infrav1.ImageParam{}
+	}
+
+	// allow changes to ports until they have been resolved, so standalone
+	// servers can be attached to the network of their cluster
+	if oldObj.Status.Resolved == nil {
+		newSpec.Ports = nil
+		oldSpec.Ports = nil
+	}
+
+	// allow changes to the remediation policy
+	newSpec.Remediation = nil
+	oldSpec.Remediation = nil
+
 	if !topology.IsDryRunRequest(req, newObj) &&
-		!reflect.DeepEqual(newObj.Spec, oldObj.Spec) {
+		!reflect.DeepEqual(newSpec, oldSpec) {
 		allErrs = append(allErrs,
 			field.Forbidden(field.NewPath("spec"), "OpenStackServer spec field is immutable. Please create a new resource instead."),
 		)
@@ -123,6 +168,41 @@ func (*openStackServerWebhook) ValidateUpdate(ctx context.Context, oldObjRaw, ne
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
+// validateHotAttachBlockDevices checks that the only changes to the
+// additional block devices are additions and removals of block devices of type
+// Volume, which can be hot-attached to and detached from a running server.
+func validateHotAttachBlockDevices(oldBlockDevices, newBlockDevices []infrav1.AdditionalBlockDevice, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	oldByName := make(map[string]*infrav1.AdditionalBlockDevice, len(oldBlockDevices))
+	for i := range oldBlockDevices {
+		oldByName[oldBlockDevices[i].Name] = &oldBlockDevices[i]
+	}
+	newByName := make(map[string]struct{}, len(newBlockDevices))
+
+	for i := range newBlockDevices {
+		blockDevice := &newBlockDevices[i]
+		newByName[blockDevice.Name] = struct{}{}
+
+		oldBlockDevice, ok := oldByName[blockDevice.Name]
+		switch {
+		case ok && !reflect.DeepEqual(blockDevice, oldBlockDevice):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), "existing additional block devices cannot be modified"))
+		case !ok && blockDevice.Storage.Type != infrav1.VolumeBlockDevice:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("storage", "type"), "only additional block devices of type Volume can be added"))
+		}
+	}
+
+	for i := range oldBlockDevices {
+		blockDevice := &oldBlockDevices[i]
+		if _, ok := newByName[blockDevice.Name]; !ok && blockDevice.Storage.Type != infrav1.VolumeBlockDevice {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("additional block device %s of type %s cannot be removed", blockDevice.Name, blockDevice.Storage.Type)))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
 func (*openStackServerWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	return nil, nil
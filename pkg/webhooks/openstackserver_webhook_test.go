@@ -28,8 +28,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 )
 
+func volumeBlockDevice(name string) infrav1.AdditionalBlockDevice {
+	return infrav1.AdditionalBlockDevice{
+		Name:    name,
+		SizeGiB: 10,
+		Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice},
+	}
+}
+
 func TestOpenStackServer_ValidateUpdate(t *testing.T) {
 	g := NewWithT(t)
 
@@ -109,6 +118,238 @@ func TestOpenStackServer_ValidateUpdate(t *testing.T) {
 			},
 			req: &admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{DryRun: ptr.To(true)}},
 		},
+		{
+			name: "don't allow adding a volume without hotAttachVolumes",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:                 ptr.To("foo"),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("data")},
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "allow enabling hotAttachVolumes",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+			req: &admission.Request{},
+		},
+		{
+			name: "allow adding and removing volumes with hotAttachVolumes",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:                 ptr.To("foo"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("data"), volumeBlockDevice("logs")},
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:                 ptr.To("foo"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("data"), volumeBlockDevice("etcd")},
+				},
+			},
+			req: &admission.Request{},
+		},
+		{
+			name: "don't allow modifying a volume with hotAttachVolumes",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:                 ptr.To("foo"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("data")},
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{
+						{
+							Name:    "data",
+							SizeGiB: 20,
+							Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice},
+						},
+					},
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "don't allow adding a local block device with hotAttachVolumes",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{
+						{
+							Name:    "ephemeral",
+							SizeGiB: 10,
+							Storage: infrav1.BlockDeviceStorage{Type: infrav1.LocalBlockDevice},
+						},
+					},
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "don't allow other changes with hotAttachVolumes",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:           ptr.To("foo"),
+					HotAttachVolumes: ptr.To(true),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:                 ptr.To("new"),
+					HotAttachVolumes:       ptr.To(true),
+					AdditionalBlockDevices: []infrav1.AdditionalBlockDevice{volumeBlockDevice("data")},
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "don't allow changing the flavor without inPlaceResize",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("new"),
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "allow changing the flavor with inPlaceResize",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					FlavorID:      ptr.To("new"),
+					InPlaceResize: ptr.To(true),
+				},
+			},
+			req: &admission.Request{},
+		},
+		{
+			name: "don't allow other changes with inPlaceResize",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:        ptr.To("foo"),
+					InPlaceResize: ptr.To(true),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:        ptr.To("new"),
+					InPlaceResize: ptr.To(true),
+					SSHKeyName:    "bar",
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "don't allow changing the image without inPlaceRebuild",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+					Image:  infrav1.ImageParam{ID: ptr.To("old")},
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+					Image:  infrav1.ImageParam{ID: ptr.To("new")},
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
+		{
+			name: "allow changing the image with inPlaceRebuild",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+					Image:  infrav1.ImageParam{ID: ptr.To("old")},
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor:         ptr.To("foo"),
+					Image:          infrav1.ImageParam{Filter: &infrav1.ImageFilter{Name: ptr.To("new")}},
+					InPlaceRebuild: ptr.To(true),
+				},
+			},
+			req: &admission.Request{},
+		},
+		{
+			name: "allow changing the ports before they are resolved",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+					Ports:  []infrav1.PortOpts{{Network: &infrav1.NetworkParam{ID: ptr.To("network")}}},
+				},
+			},
+			req: &admission.Request{},
+		},
+		{
+			name: "don't allow changing the ports after they are resolved",
+			old: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+				},
+				Status: infrav1alpha1.OpenStackServerStatus{
+					Resolved: &infrav1alpha1.ResolvedServerSpec{},
+				},
+			},
+			new: &infrav1alpha1.OpenStackServer{
+				Spec: infrav1alpha1.OpenStackServerSpec{
+					Flavor: ptr.To("foo"),
+					Ports:  []infrav1.PortOpts{{Network: &infrav1.NetworkParam{ID: ptr.To("network")}}},
+				},
+			},
+			req:     &admission.Request{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+// validateAccelerators checks that the requested PCI aliases and placement
+// resource classes can be reported as extended resources.
+func validateAccelerators(accelerators *infrav1.AcceleratorRequirements, basePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if accelerators == nil {
+		return allErrs
+	}
+
+	for i, alias := range accelerators.PCIAliases {
+		if errs := validation.IsQualifiedName(infrav1.PCIAliasResourcePrefix + alias.Name); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(basePath.Child("pciAliases").Index(i).Child("name"), alias.Name, "is not valid in an extended resource name: "+strings.Join(errs, "; ")))
+		}
+	}
+
+	for i, res := range accelerators.Resources {
+		path := basePath.Child("resources").Index(i).Child("resourceClass")
+		if infrav1.StandardResourceClasses[res.ResourceClass] {
+			allErrs = append(allErrs, field.Invalid(path, res.ResourceClass, "must not be a standard resource class"))
+		}
+		if errs := validation.IsQualifiedName(infrav1.PlacementResourcePrefix + res.ResourceClass); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, res.ResourceClass, "is not valid in an extended resource name: "+strings.Join(errs, "; ")))
+		}
+	}
+
+	return allErrs
+}
@@ -39,3 +39,27 @@ func validateNetworkingExtensions(spec *infrav1.OpenStackClusterSpec, basePath *
 
 	return allErrs
 }
+
+// validateIngressLoadBalancerModeUpdate ensures that the ingress load balancer
+// mode is not changed. The Octavia load balancer or the keepalived port of the
+// previous mode would otherwise be left behind.
+func validateIngressLoadBalancerModeUpdate(oldSpec, newSpec *infrav1.OpenStackClusterSpec, basePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if getIngressLoadBalancerMode(oldSpec) != getIngressLoadBalancerMode(newSpec) {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("extensions", "loadBalancers", "ingress", "mode"), "cannot be modified"))
+	}
+	return allErrs
+}
+
+// getIngressLoadBalancerMode returns the ingress load balancer mode of the
+// cluster, or an empty string if no ingress load balancer is configured.
+func getIngressLoadBalancerMode(spec *infrav1.OpenStackClusterSpec) string {
+	ext := spec.Extensions
+	if ext == nil || ext.LoadBalancers == nil || ext.LoadBalancers.Ingress == nil {
+		return ""
+	}
+	if ext.LoadBalancers.Ingress.Mode == "" {
+		return infrav1.IngressLoadBalancerModeKeepalived
+	}
+	return ext.LoadBalancers.Ingress.Mode
+}
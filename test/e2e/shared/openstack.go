@@ -1024,5 +1024,5 @@ func GetOpenStackServerConsoleLog(e2eCtx *E2EContext, id string) (string, error)
 	if err != nil {
 		return "", fmt.Errorf("unable to create compute client: %w", err)
 	}
-	return computeClient.GetConsoleOutput(id)
+	return computeClient.GetConsoleOutput(id, 0)
 }
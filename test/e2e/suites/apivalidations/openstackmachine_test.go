@@ -169,6 +169,20 @@ var _ = Describe("OpenStackMachine API validations", func() {
 			machine.Spec.FlavorID = ptr.To("6aa02f56-c595-4d2f-9f8e-3c6296a4bed9")
 			Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "Creating a machine with a flavor id should succeed")
 		})
+
+		It("should allow a flavor selector instead of a flavor", func() {
+			machine := defaultMachine()
+			machine.Spec.Flavor = nil
+
+			By("Creating a machine with minRAMMiB greater than maxRAMMiB")
+			machine.Spec.FlavorSelector = &infrav1.FlavorSelector{MinRAMMiB: ptr.To[int32](8192), MaxRAMMiB: ptr.To[int32](4096)}
+			Expect(k8sClient.Create(ctx, machine)).NotTo(Succeed(), "Creating a machine with minRAMMiB greater than maxRAMMiB should fail")
+
+			By("Creating a machine with a flavor selector")
+			machine.Spec.FlavorSelector = &infrav1.FlavorSelector{MinVCPUs: ptr.To[int32](4)}
+			Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "Creating a machine with a flavor selector should succeed")
+			Expect(machine.Spec.FlavorSelector.Preference).To(Equal(infrav1.FlavorPreferenceSmallestMatching))
+		})
 	})
 
 	Context("volumes", func() {